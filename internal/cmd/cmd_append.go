@@ -0,0 +1,110 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+// maxStringLength is the largest value APPEND and SETRANGE can build.
+const maxStringLength = 512 * 1024 * 1024
+
+var cAPPEND = &CommandMeta{
+	Name:      "APPEND",
+	Syntax:    "APPEND key value",
+	HelpShort: "APPEND appends value to the string stored at key",
+	HelpLong: `
+APPEND appends value at the end of the string stored at key. The key is created with value if it
+does not exist, like SET would. Integers and floats are appended to as their string form, as
+returned by GET. The key keeps its expiry.
+
+Returns the length of the string after the append.
+	`,
+	Examples: `
+localhost:7379> APPEND k hello
+OK 5
+localhost:7379> APPEND k " world"
+OK 11
+localhost:7379> GET k
+OK "hello world"
+	`,
+	Eval:    evalAPPEND,
+	Execute: executeAPPEND,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cAPPEND)
+}
+
+var (
+	APPENDResNilRes = newIntRes(0)
+)
+
+func evalAPPEND(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return APPENDResNilRes, errors.ErrWrongArgumentCount("APPEND")
+	}
+
+	key, value := c.C.Args[0], c.C.Args[1]
+	obj := s.Get(key)
+	if obj == nil {
+		s.Put(key, CreateObjectFromValue(s, value, -1))
+		return newIntRes(int64(len(value))), nil
+	}
+
+	current, err := getStringFromObj(obj)
+	if err != nil {
+		return APPENDResNilRes, err
+	}
+	if len(current)+len(value) > maxStringLength {
+		return APPENDResNilRes, errors.ErrGeneral("string exceeds maximum allowed size (512MB)")
+	}
+	updated := current + value
+	setStringInPlace(obj, updated)
+	s.Reindex(key)
+	return newIntRes(int64(len(updated))), nil
+}
+
+func executeAPPEND(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return APPENDResNilRes, errors.ErrWrongArgumentCount("APPEND")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalAPPEND(c, shard.Thread.Store())
+}
+
+// getStringFromObj returns the string form of a value the string commands
+// operate on, the same that GET returns, or an error if obj holds a value
+// of another type.
+func getStringFromObj(obj *object.Obj) (string, error) {
+	switch obj.Type {
+	case object.ObjTypeString, object.ObjTypeInt, object.ObjTypeFloat, object.ObjTypeByteArray:
+		return getWireValueFromObj(obj)
+	default:
+		return "", errors.ErrWrongTypeOperation
+	}
+}
+
+// setStringInPlace replaces the value of obj by the string value, keeping
+// the object, and so the expiry of its key. A byte array stays one, so that
+// the bitmap commands keep working on it, and a string that is an integer
+// is stored as one, like SET does. The caller reindexes the key of a stored
+// obj.
+func setStringInPlace(obj *object.Obj, value string) {
+	if obj.Type == object.ObjTypeByteArray {
+		obj.Value = types.NewByteArrayFromBytes([]byte(value))
+		return
+	}
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil && strconv.FormatInt(i, 10) == value {
+		obj.Type, obj.Value = object.ObjTypeInt, i
+		return
+	}
+	obj.Type, obj.Value = object.ObjTypeString, value
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cBFADD = &CommandMeta{
+	Name:      "BF.ADD",
+	Syntax:    "BF.ADD key item",
+	HelpShort: "BF.ADD adds an item to the Bloom filter stored at key",
+	HelpLong: `
+BF.ADD adds an item to the Bloom filter stored at key. If the key does not exist, a new filter
+is created with an error rate of 0.01 and a capacity of 1024.
+
+Returns 1 if the item was added and 0 if the item may already have been in the filter.
+	`,
+	Examples: `
+localhost:7379> BF.ADD users alice
+OK 1
+localhost:7379> BF.ADD users alice
+OK 0
+	`,
+	Eval:    evalBFADD,
+	Execute: executeBFADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBFADD)
+}
+
+var (
+	BFADDResNilRes = newIntRes(0)
+)
+
+func evalBFADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return BFADDResNilRes, errors.ErrWrongArgumentCount("BF.ADD")
+	}
+
+	bf, err := getOrCreateBloomFilter(s, c.C.Args[0])
+	if err != nil {
+		return BFADDResNilRes, err
+	}
+
+	added, err := bf.Add(c.C.Args[1])
+	if err != nil {
+		return BFADDResNilRes, err
+	}
+	if added {
+		return newIntRes(1), nil
+	}
+	return newIntRes(0), nil
+}
+
+func executeBFADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return BFADDResNilRes, errors.ErrWrongArgumentCount("BF.ADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBFADD(c, shard.Thread.Store())
+}
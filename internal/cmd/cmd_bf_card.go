@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cBFCARD = &CommandMeta{
+	Name:      "BF.CARD",
+	Syntax:    "BF.CARD key",
+	HelpShort: "BF.CARD returns the number of items added to the Bloom filter stored at key",
+	HelpLong: `
+BF.CARD returns the number of items added to the Bloom filter stored at key. Items reported as
+possibly present when they were added are not counted.
+
+Returns 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> BF.MADD users alice bob
+OK
+0) alice="1"
+1) bob="1"
+localhost:7379> BF.CARD users
+OK 2
+	`,
+	Eval:    evalBFCARD,
+	Execute: executeBFCARD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBFCARD)
+}
+
+var (
+	BFCARDResNilRes = newIntRes(0)
+)
+
+func evalBFCARD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return BFCARDResNilRes, errors.ErrWrongArgumentCount("BF.CARD")
+	}
+
+	bf, err := getBloomFilter(s, c.C.Args[0])
+	if err != nil || bf == nil {
+		return BFCARDResNilRes, err
+	}
+	return newIntRes(int64(bf.Count())), nil
+}
+
+func executeBFCARD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return BFCARDResNilRes, errors.ErrWrongArgumentCount("BF.CARD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBFCARD(c, shard.Thread.Store())
+}
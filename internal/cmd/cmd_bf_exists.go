@@ -0,0 +1,67 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cBFEXISTS = &CommandMeta{
+	Name:      "BF.EXISTS",
+	Syntax:    "BF.EXISTS key item",
+	HelpShort: "BF.EXISTS checks if an item may be in the Bloom filter stored at key",
+	HelpLong: `
+BF.EXISTS checks if an item may have been added to the Bloom filter stored at key.
+
+Returns 1 if the item may be in the filter and 0 if it certainly is not or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> BF.ADD users alice
+OK 1
+localhost:7379> BF.EXISTS users alice
+OK 1
+localhost:7379> BF.EXISTS users bob
+OK 0
+	`,
+	Eval:    evalBFEXISTS,
+	Execute: executeBFEXISTS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBFEXISTS)
+}
+
+var (
+	BFEXISTSResNilRes = newIntRes(0)
+)
+
+func evalBFEXISTS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return BFEXISTSResNilRes, errors.ErrWrongArgumentCount("BF.EXISTS")
+	}
+
+	bf, err := getBloomFilter(s, c.C.Args[0])
+	if err != nil || bf == nil {
+		return BFEXISTSResNilRes, err
+	}
+
+	exists, err := bf.Exists(c.C.Args[1])
+	if err != nil {
+		return BFEXISTSResNilRes, err
+	}
+	if exists {
+		return newIntRes(1), nil
+	}
+	return newIntRes(0), nil
+}
+
+func executeBFEXISTS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return BFEXISTSResNilRes, errors.ErrWrongArgumentCount("BF.EXISTS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBFEXISTS(c, shard.Thread.Store())
+}
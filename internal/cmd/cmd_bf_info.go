@@ -0,0 +1,88 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cBFINFO = &CommandMeta{
+	Name:      "BF.INFO",
+	Syntax:    "BF.INFO key [CAPACITY | SIZE | FILTERS | ITEMS | EXPANSION]",
+	HelpShort: "BF.INFO returns the parameters of the Bloom filter stored at key",
+	HelpLong: `
+BF.INFO returns the parameters and metadata of the Bloom filter stored at key.
+
+- CAPACITY: the number of items the filter is expected to hold
+- SIZE: the number of bits reserved for the filter
+- FILTERS: the number of hash functions used by the filter
+- ITEMS: the number of items added to the filter
+- EXPANSION: the expansion rate of the filter
+
+Returns all the parameters, or only the requested one, as name and value pairs. Returns an
+error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> BF.RESERVE users 0.01 1000
+OK
+localhost:7379> BF.INFO users ITEMS
+OK
+0) Number of items inserted="0"
+	`,
+	Eval:    evalBFINFO,
+	Execute: executeBFINFO,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBFINFO)
+}
+
+var (
+	BFINFOResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalBFINFO(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return BFINFOResNilRes, errors.ErrWrongArgumentCount("BF.INFO")
+	}
+
+	bf, err := getBloomFilter(s, c.C.Args[0])
+	if err != nil {
+		return BFINFOResNilRes, err
+	}
+	if bf == nil {
+		return BFINFOResNilRes, errors.ErrKeyNotFound
+	}
+
+	opt := ""
+	if len(c.C.Args) == 2 {
+		opt = c.C.Args[1]
+	}
+	info, err := bf.Info(opt)
+	if err != nil {
+		return BFINFOResNilRes, err
+	}
+
+	elements := make([]*wire.HElement, 0, len(info)/2)
+	for i := 0; i+1 < len(info); i += 2 {
+		elements = append(elements, &wire.HElement{
+			Key:   fmt.Sprint(info[i]),
+			Value: fmt.Sprint(info[i+1]),
+		})
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeBFINFO(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return BFINFOResNilRes, errors.ErrWrongArgumentCount("BF.INFO")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBFINFO(c, shard.Thread.Store())
+}
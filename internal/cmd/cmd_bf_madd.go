@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cBFMADD = &CommandMeta{
+	Name:      "BF.MADD",
+	Syntax:    "BF.MADD key item [item ...]",
+	HelpShort: "BF.MADD adds items to the Bloom filter stored at key",
+	HelpLong: `
+BF.MADD adds one or more items to the Bloom filter stored at key. If the key does not exist, a
+new filter is created with an error rate of 0.01 and a capacity of 1024.
+
+Returns the items, in the order given, each paired with 1 if it was added and 0 if it may
+already have been in the filter.
+	`,
+	Examples: `
+localhost:7379> BF.ADD users alice
+OK 1
+localhost:7379> BF.MADD users alice bob
+OK
+0) alice="0"
+1) bob="1"
+	`,
+	Eval:    evalBFMADD,
+	Execute: executeBFMADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBFMADD)
+}
+
+var (
+	BFMADDResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalBFMADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BFMADDResNilRes, errors.ErrWrongArgumentCount("BF.MADD")
+	}
+
+	bf, err := getOrCreateBloomFilter(s, c.C.Args[0])
+	if err != nil {
+		return BFMADDResNilRes, err
+	}
+
+	items := c.C.Args[1:]
+	added := make([]bool, len(items))
+	for i, item := range items {
+		if added[i], err = bf.Add(item); err != nil {
+			return BFMADDResNilRes, err
+		}
+	}
+	return newBloomFlagsRes(items, added), nil
+}
+
+func executeBFMADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BFMADDResNilRes, errors.ErrWrongArgumentCount("BF.MADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBFMADD(c, shard.Thread.Store())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cBFMEXISTS = &CommandMeta{
+	Name:      "BF.MEXISTS",
+	Syntax:    "BF.MEXISTS key item [item ...]",
+	HelpShort: "BF.MEXISTS checks if items may be in the Bloom filter stored at key",
+	HelpLong: `
+BF.MEXISTS checks if each of the items may have been added to the Bloom filter stored at key.
+
+Returns the items, in the order given, each paired with 1 if it may be in the filter and 0 if
+it certainly is not or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> BF.ADD users alice
+OK 1
+localhost:7379> BF.MEXISTS users alice bob
+OK
+0) alice="1"
+1) bob="0"
+	`,
+	Eval:    evalBFMEXISTS,
+	Execute: executeBFMEXISTS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBFMEXISTS)
+}
+
+var (
+	BFMEXISTSResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalBFMEXISTS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BFMEXISTSResNilRes, errors.ErrWrongArgumentCount("BF.MEXISTS")
+	}
+
+	bf, err := getBloomFilter(s, c.C.Args[0])
+	if err != nil {
+		return BFMEXISTSResNilRes, err
+	}
+
+	items := c.C.Args[1:]
+	exists := make([]bool, len(items))
+	if bf != nil {
+		for i, item := range items {
+			if exists[i], err = bf.Exists(item); err != nil {
+				return BFMEXISTSResNilRes, err
+			}
+		}
+	}
+	return newBloomFlagsRes(items, exists), nil
+}
+
+func executeBFMEXISTS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BFMEXISTSResNilRes, errors.ErrWrongArgumentCount("BF.MEXISTS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBFMEXISTS(c, shard.Thread.Store())
+}
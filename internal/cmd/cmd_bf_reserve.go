@@ -0,0 +1,110 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cBFRESERVE = &CommandMeta{
+	Name:      "BF.RESERVE",
+	Syntax:    "BF.RESERVE key error_rate capacity",
+	HelpShort: "BF.RESERVE creates an empty Bloom filter",
+	HelpLong: `
+BF.RESERVE creates an empty Bloom filter at key with the given false positive rate and the
+number of items it is expected to hold.
+
+The error rate must be between 0 and 1, exclusive, and the capacity must be greater than 0.
+
+Returns "OK" if the filter was created and an error if the key already exists.
+	`,
+	Examples: `
+localhost:7379> BF.RESERVE users 0.01 1000
+OK
+localhost:7379> BF.RESERVE users 0.01 1000
+ERR key exists
+	`,
+	Eval:    evalBFRESERVE,
+	Execute: executeBFRESERVE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBFRESERVE)
+}
+
+var (
+	BFRESERVEResNilRes = newOKRes()
+	BFRESERVEResOKRes  = newOKRes()
+)
+
+func evalBFRESERVE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return BFRESERVEResNilRes, errors.ErrWrongArgumentCount("BF.RESERVE")
+	}
+
+	opts, err := types.NewBloomOpts(c.C.Args[1:])
+	if err != nil {
+		return BFRESERVEResNilRes, err
+	}
+
+	key := c.C.Args[0]
+	if s.Get(key) != nil {
+		return BFRESERVEResNilRes, errors.ErrKeyExists
+	}
+	s.Put(key, s.NewObj(types.NewBloomFilter(opts), -1, object.ObjTypeBF))
+	return BFRESERVEResOKRes, nil
+}
+
+func executeBFRESERVE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return BFRESERVEResNilRes, errors.ErrWrongArgumentCount("BF.RESERVE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBFRESERVE(c, shard.Thread.Store())
+}
+
+// getBloomFilter returns the Bloom filter stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getBloomFilter(s *dstore.Store, key string) (*types.Bloom, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeBF {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.Bloom), nil
+}
+
+// getOrCreateBloomFilter returns the Bloom filter stored at key and
+// creates one with the default options if the key does not exist.
+func getOrCreateBloomFilter(s *dstore.Store, key string) (*types.Bloom, error) {
+	bf, err := getBloomFilter(s, key)
+	if err != nil || bf != nil {
+		return bf, err
+	}
+	bf = types.NewBloomFilter(types.DefaultBloomOpts())
+	s.Put(key, s.NewObj(bf, -1, object.ObjTypeBF))
+	return bf, nil
+}
+
+// newBloomFlagsRes replies with the items, in the order given, each paired
+// with "1" if its flag is set and "0" if it is not.
+func newBloomFlagsRes(items []string, flags []bool) *CmdRes {
+	elements := make([]*wire.HElement, len(items))
+	for i, item := range items {
+		value := "0"
+		if flags[i] {
+			value = "1"
+		}
+		elements[i] = &wire.HElement{Key: item, Value: value}
+	}
+	return newPairsRes(elements)
+}
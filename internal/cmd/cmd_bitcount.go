@@ -0,0 +1,102 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cBITCOUNT = &CommandMeta{
+	Name:      "BITCOUNT",
+	Syntax:    "BITCOUNT key [start end [BYTE | BIT]]",
+	HelpShort: "BITCOUNT counts the bits set to 1 in the string value stored at key",
+	HelpLong: `
+BITCOUNT counts the bits set to 1 in the string value stored at key.
+
+The count can be limited to the range between start and end, both inclusive. They are byte
+offsets, or bit offsets with the BIT option, and negative offsets count from the end of the
+value, -1 being the last byte or bit.
+
+Returns 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k foobar
+OK
+localhost:7379> BITCOUNT k
+OK 26
+localhost:7379> BITCOUNT k 1 1
+OK 6
+localhost:7379> BITCOUNT k 5 30 BIT
+OK 17
+	`,
+	Eval:    evalBITCOUNT,
+	Execute: executeBITCOUNT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBITCOUNT)
+}
+
+var (
+	BITCOUNTResNilRes = newIntRes(0)
+)
+
+func evalBITCOUNT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return BITCOUNTResNilRes, errors.ErrWrongArgumentCount("BITCOUNT")
+	}
+	if len(c.C.Args) == 2 || len(c.C.Args) > 4 {
+		return BITCOUNTResNilRes, errors.ErrInvalidSyntax("BITCOUNT")
+	}
+
+	b, err := getBitmapBytes(s, c.C.Args[0])
+	if err != nil {
+		return BITCOUNTResNilRes, err
+	}
+
+	start, end := int64(0), int64(-1)
+	if len(c.C.Args) > 1 {
+		if start, err = strconv.ParseInt(c.C.Args[1], 10, 64); err != nil {
+			return BITCOUNTResNilRes, errors.ErrIntegerOutOfRange
+		}
+		if end, err = strconv.ParseInt(c.C.Args[2], 10, 64); err != nil {
+			return BITCOUNTResNilRes, errors.ErrIntegerOutOfRange
+		}
+	}
+	bitUnit, err := parseBitUnit("BITCOUNT", c.C.Args[min(len(c.C.Args), 3):])
+	if err != nil {
+		return BITCOUNTResNilRes, err
+	}
+	return newIntRes(int64(types.CountBits(b, start, end, bitUnit))), nil
+}
+
+func executeBITCOUNT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return BITCOUNTResNilRes, errors.ErrWrongArgumentCount("BITCOUNT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBITCOUNT(c, shard.Thread.Store())
+}
+
+// parseBitUnit parses the optional BYTE or BIT unit of a range of a bitmap
+// command, and returns whether the range is in bits.
+func parseBitUnit(cmd string, args []string) (bool, error) {
+	if len(args) == 0 {
+		return false, nil
+	}
+	switch strings.ToUpper(args[0]) {
+	case "BYTE":
+		return false, nil
+	case "BIT":
+		return true, nil
+	default:
+		return false, errors.ErrInvalidSyntax(cmd)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/server/utils"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cBITFIELD = &CommandMeta{
+	Name: "BITFIELD",
+	Syntax: "BITFIELD key [GET encoding offset | [OVERFLOW <WRAP | SAT | FAIL>] " +
+		"<SET encoding offset value | INCRBY encoding offset increment> ...]",
+	HelpShort: "BITFIELD reads and writes integers of arbitrary width in the string value stored at key",
+	HelpLong: `
+BITFIELD treats the string value stored at key as an array of bits and reads and writes integer
+fields of arbitrary width at any bit offset in it. The subcommands are executed in order:
+
+- GET encoding offset: Returns the field
+- SET encoding offset value: Sets the field to value and returns its previous value
+- INCRBY encoding offset increment: Increments the field by increment and returns its new value
+- OVERFLOW WRAP|SAT|FAIL: Sets how the following SET and INCRBY subcommands handle values that do not fit the field
+
+The encoding is i (signed) or u (unsigned) followed by the width in bits, up to i64 and u63. The
+offset is in bits, or in multiples of the width if prefixed with #. Values are grown with zero
+bytes as needed, and reading past their end returns zero bits.
+
+With OVERFLOW WRAP, the default, values wrap around; with SAT they saturate to the minimum or
+maximum value of the field; with FAIL the subcommand does nothing and returns an empty string.
+
+Returns the results of the GET, SET and INCRBY subcommands in order.
+	`,
+	Examples: `
+localhost:7379> BITFIELD k SET u8 0 200 INCRBY u8 0 100 OVERFLOW SAT INCRBY u8 #1 300
+OK
+0) 0
+1) 44
+2) 255
+localhost:7379> BITFIELD k GET i8 0
+OK
+0) 44
+	`,
+	Eval:    evalBITFIELD,
+	Execute: executeBITFIELD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBITFIELD)
+}
+
+var (
+	BITFIELDResNilRes = newListRes([]string{})
+)
+
+func evalBITFIELD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return BITFIELDResNilRes, errors.ErrWrongArgumentCount("BITFIELD")
+	}
+	return evalBitfieldOps(c, s, false)
+}
+
+func executeBITFIELD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return BITFIELDResNilRes, errors.ErrWrongArgumentCount("BITFIELD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBITFIELD(c, shard.Thread.Store())
+}
+
+// evalBitfieldOps evaluates the subcommands of BITFIELD, or of BITFIELD_RO
+// if readOnly is set. The key is only created or converted to a byte array
+// if a subcommand writes to it.
+func evalBitfieldOps(c *Cmd, s *dstore.Store, readOnly bool) (*CmdRes, error) {
+	ops, err := utils.ParseBitfieldOps(c.C.Args, readOnly)
+	if err != nil {
+		return BITFIELDResNilRes, err
+	}
+
+	writes := false
+	for _, op := range ops {
+		if op.Kind == utils.OVERFLOW {
+			continue
+		}
+		if op.Offset < 0 || op.Offset > maxBitOffset+1-op.EVal {
+			return BITFIELDResNilRes, errors.ErrGeneral("bit offset is not an integer or out of range")
+		}
+		writes = writes || op.Kind != utils.GET
+	}
+
+	var ba *types.ByteArray
+	if writes {
+		ba, err = getOrCreateByteArray(s, c.C.Args[0])
+	} else {
+		var b []byte
+		b, err = getBitmapBytes(s, c.C.Args[0])
+		ba = types.NewByteArrayFromBytes(b)
+	}
+	if err != nil {
+		return BITFIELDResNilRes, err
+	}
+
+	overflow := utils.WRAP
+	results := make([]string, 0, len(ops))
+	for _, op := range ops {
+		offset, width, signed := int(op.Offset), int(op.EVal), op.EType == utils.SIGNED
+		switch op.Kind {
+		case utils.GET:
+			results = append(results, strconv.FormatInt(ba.GetBits(offset, width, signed), 10))
+		case utils.SET:
+			prev := ba.GetBits(offset, width, signed)
+			if overflow == utils.FAIL && !fitsBitfield(op.Value, width, signed) {
+				results = append(results, "")
+				continue
+			}
+			if overflow == utils.SAT {
+				op.Value = saturateBitfield(op.Value, width, signed)
+			}
+			ba.SetBits(offset, width, op.Value)
+			results = append(results, strconv.FormatInt(prev, 10))
+		case utils.INCRBY:
+			v, err := ba.IncrByBits(offset, width, op.Value, overflow, signed)
+			if err != nil {
+				results = append(results, "")
+				continue
+			}
+			results = append(results, strconv.FormatInt(v, 10))
+		case utils.OVERFLOW:
+			overflow = op.EType
+		}
+	}
+	return newListRes(results), nil
+}
+
+// fitsBitfield reports whether value fits a field without overflowing.
+func fitsBitfield(value int64, width int, signed bool) bool {
+	minVal, maxVal := types.BitfieldRange(width, signed)
+	return value >= minVal && value <= maxVal
+}
+
+// saturateBitfield clamps value to the range of a field.
+func saturateBitfield(value int64, width int, signed bool) int64 {
+	minVal, maxVal := types.BitfieldRange(width, signed)
+	return max(minVal, min(value, maxVal))
+}
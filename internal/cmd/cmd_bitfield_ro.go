@@ -0,0 +1,56 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cBITFIELDRO = &CommandMeta{
+	Name:      "BITFIELD_RO",
+	Syntax:    "BITFIELD_RO key [GET encoding offset ...]",
+	HelpShort: "BITFIELD_RO reads integers of arbitrary width in the string value stored at key",
+	HelpLong: `
+BITFIELD_RO is the read-only variant of BITFIELD. It only accepts the GET subcommand and never
+modifies the key.
+
+Returns the fields in order.
+	`,
+	Examples: `
+localhost:7379> BITFIELD k SET u8 0 200
+OK
+0) 0
+localhost:7379> BITFIELD_RO k GET u8 0 GET i8 0
+OK
+0) 200
+1) -56
+	`,
+	Eval:    evalBITFIELDRO,
+	Execute: executeBITFIELDRO,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBITFIELDRO)
+}
+
+var (
+	BITFIELDROResNilRes = newListRes([]string{})
+)
+
+func evalBITFIELDRO(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return BITFIELDROResNilRes, errors.ErrWrongArgumentCount("BITFIELD_RO")
+	}
+	return evalBitfieldOps(c, s, true)
+}
+
+func executeBITFIELDRO(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return BITFIELDROResNilRes, errors.ErrWrongArgumentCount("BITFIELD_RO")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBITFIELDRO(c, shard.Thread.Store())
+}
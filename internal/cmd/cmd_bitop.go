@@ -0,0 +1,132 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cBITOP = &CommandMeta{
+	Name:      "BITOP",
+	Syntax:    "BITOP <AND | OR | XOR | NOT> destkey key [key ...]",
+	HelpShort: "BITOP performs a bitwise operation between the string values stored at the keys and stores the result in destkey",
+	HelpLong: `
+BITOP performs a bitwise operation between the string values stored at the keys and stores the
+result in destkey. The operation is one of AND, OR and XOR, which take one or more keys, or NOT,
+which takes a single key and inverts it.
+
+Values of different lengths are padded with zero bytes to the length of the longest one, and
+missing keys are treated as empty values. An existing destkey is overwritten, and deleted if
+the result is empty.
+
+The keys and destkey may live on different shards.
+
+Returns the length in bytes of the value stored in destkey.
+	`,
+	Examples: `
+localhost:7379> SETBIT day1 3 1
+OK 0
+localhost:7379> SETBIT day1 5 1
+OK 0
+localhost:7379> SETBIT day2 5 1
+OK 0
+localhost:7379> BITOP AND retained day1 day2
+OK 1
+localhost:7379> BITCOUNT retained
+OK 1
+	`,
+	Eval:       evalBITOP,
+	Execute:    executeBITOP,
+	NotifyKeys: nthKey(1),
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBITOP)
+}
+
+var (
+	BITOPResNilRes = newIntRes(0)
+)
+
+func evalBITOP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return BITOPResNilRes, errors.ErrWrongArgumentCount("BITOP")
+	}
+	return bitop(c, localStore(s))
+}
+
+func executeBITOP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return BITOPResNilRes, errors.ErrWrongArgumentCount("BITOP")
+	}
+	return bitop(c, shardStores(sm))
+}
+
+// bitop evaluates BITOP reading and writing every key in the store that
+// storeForKey returns for it.
+func bitop(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	op := strings.ToUpper(c.C.Args[0])
+	destKey, keys := c.C.Args[1], c.C.Args[2:]
+
+	switch op {
+	case "AND", "OR", "XOR":
+	case "NOT":
+		if len(keys) != 1 {
+			return BITOPResNilRes, errors.ErrGeneral("BITOP NOT must be called with a single source key")
+		}
+	default:
+		return BITOPResNilRes, errors.ErrInvalidSyntax("BITOP")
+	}
+
+	values := make([][]byte, len(keys))
+	length := 0
+	for i, key := range keys {
+		b, err := getBitmapBytes(storeForKey(key), key)
+		if err != nil {
+			return BITOPResNilRes, err
+		}
+		values[i] = b
+		length = max(length, len(b))
+	}
+
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = byteAt(values[0], i)
+		for _, v := range values[1:] {
+			switch op {
+			case "AND":
+				result[i] &= byteAt(v, i)
+			case "OR":
+				result[i] |= byteAt(v, i)
+			case "XOR":
+				result[i] ^= byteAt(v, i)
+			}
+		}
+		if op == "NOT" {
+			result[i] = ^result[i]
+		}
+	}
+
+	s := storeForKey(destKey)
+	if length == 0 {
+		s.Del(destKey)
+		return BITOPResNilRes, nil
+	}
+	s.Put(destKey, s.NewObj(types.NewByteArrayFromBytes(result), -1, object.ObjTypeByteArray))
+	return newIntRes(int64(length)), nil
+}
+
+// byteAt returns the byte at index i of b, or 0 past its end.
+func byteAt(b []byte, i int) byte {
+	if i < len(b) {
+		return b[i]
+	}
+	return 0
+}
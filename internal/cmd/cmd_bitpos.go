@@ -0,0 +1,104 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cBITPOS = &CommandMeta{
+	Name:      "BITPOS",
+	Syntax:    "BITPOS key bit [start [end [BYTE | BIT]]]",
+	HelpShort: "BITPOS returns the position of the first bit set to 1 or 0 in the string value stored at key",
+	HelpLong: `
+BITPOS returns the position of the first bit set to bit, 1 or 0, in the string value stored at
+key. Positions are counted in bits from the most significant bit of the first byte.
+
+The search can be limited to the range between start and end, both inclusive. They are byte
+offsets, or bit offsets with the BIT option, and negative offsets count from the end of the
+value, -1 being the last byte or bit.
+
+Returns -1 if no bit is found. When looking for a 0 bit without an end, the value is treated as
+if it were padded with zero bytes, so a value with all its bits set returns the first position
+past its end. A missing key is treated as an empty value.
+	`,
+	Examples: `
+localhost:7379> SETBIT k 10 1
+OK 0
+localhost:7379> BITPOS k 1
+OK 10
+localhost:7379> BITPOS k 0
+OK 0
+localhost:7379> BITPOS k 1 2
+OK -1
+	`,
+	Eval:    evalBITPOS,
+	Execute: executeBITPOS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBITPOS)
+}
+
+var (
+	BITPOSResNilRes = newIntRes(-1)
+)
+
+func evalBITPOS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args) > 5 {
+		return BITPOSResNilRes, errors.ErrWrongArgumentCount("BITPOS")
+	}
+
+	var bit byte
+	switch c.C.Args[1] {
+	case "0":
+		bit = 0
+	case "1":
+		bit = 1
+	default:
+		return BITPOSResNilRes, errors.ErrGeneral("the bit argument must be 1 or 0")
+	}
+
+	b, err := getBitmapBytes(s, c.C.Args[0])
+	if err != nil {
+		return BITPOSResNilRes, err
+	}
+
+	start, end := 0, -1
+	if len(c.C.Args) > 2 {
+		if start, err = strconv.Atoi(c.C.Args[2]); err != nil {
+			return BITPOSResNilRes, errors.ErrIntegerOutOfRange
+		}
+	}
+	if len(c.C.Args) > 3 {
+		if end, err = strconv.Atoi(c.C.Args[3]); err != nil {
+			return BITPOSResNilRes, errors.ErrIntegerOutOfRange
+		}
+	}
+	bitUnit, err := parseBitUnit("BITPOS", c.C.Args[min(len(c.C.Args), 4):])
+	if err != nil {
+		return BITPOSResNilRes, err
+	}
+
+	if b == nil {
+		if bit == 0 {
+			return newIntRes(0), nil
+		}
+		return newIntRes(-1), nil
+	}
+	return newIntRes(int64(types.BitPos(b, bit, start, end, bitUnit, len(c.C.Args) > 3))), nil
+}
+
+func executeBITPOS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args) > 5 {
+		return BITPOSResNilRes, errors.ErrWrongArgumentCount("BITPOS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalBITPOS(c, shard.Thread.Store())
+}
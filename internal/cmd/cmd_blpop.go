@@ -0,0 +1,197 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cBLPOP = &CommandMeta{
+	Name:      "BLPOP",
+	Syntax:    "BLPOP key [key ...] timeout",
+	HelpShort: "BLPOP pops the first element of the first non-empty list, blocking until one is available",
+	HelpLong: `
+BLPOP is the blocking variant of LPOP. It pops the first element of the first non-empty list among
+the given keys, checked in the order they are given.
+
+If all the lists are empty, the client is blocked until another client pushes to one of the keys,
+or stores a list at one of them with a command such as RENAME, COPY or RESTORE, or until the
+timeout expires. The timeout is in seconds and may be fractional; a timeout of 0 blocks
+indefinitely.
+
+Returns a list holding the key the element was popped from and the element, and an empty list if
+the timeout expired.
+	`,
+	Examples: `
+localhost:7379> RPUSH q2 a b
+OK 2
+localhost:7379> BLPOP q1 q2 0
+OK
+0) q2
+1) a
+localhost:7379> BLPOP q1 0.5
+OK
+	`,
+	NotifyKeys: poppedKey,
+	Eval:       evalBLPOP,
+	Execute:    executeBLPOP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBLPOP)
+}
+
+var (
+	BLPOPResNilRes = newListRes([]string{})
+)
+
+// evalBLPOP pops from the lists held by a single store without blocking.
+func evalBLPOP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BLPOPResNilRes, errors.ErrWrongArgumentCount("BLPOP")
+	}
+	return popFirstNonEmpty(c, c.C.Args[:len(c.C.Args)-1], localStore(s), (*types.Deque).LPop)
+}
+
+func executeBLPOP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BLPOPResNilRes, errors.ErrWrongArgumentCount("BLPOP")
+	}
+	return executeBlockingPop(c, sm, (*types.Deque).LPop)
+}
+
+// poppedKey is the NotifyKeys function of the blocking pops. The key an
+// element was popped from is only known once the command executed, so
+// popFirstNonEmpty records it with addModifiedKeys.
+func poppedKey(*Cmd) []string {
+	return nil
+}
+
+// blockedClients tracks the clients blocked on empty lists. A successful
+// command wakes up every client blocked on the keys it notifies, since any
+// of them may now hold a list; the woken clients race for the new elements
+// and the ones that lose go back to waiting.
+type blockedClients struct {
+	mu      sync.RWMutex
+	waiters map[string]map[chan struct{}]struct{}
+}
+
+var listWaiters = &blockedClients{
+	waiters: map[string]map[chan struct{}]struct{}{},
+}
+
+func (b *blockedClients) register(keys []string) chan struct{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan struct{}, 1)
+	for _, key := range keys {
+		if _, ok := b.waiters[key]; !ok {
+			b.waiters[key] = make(map[chan struct{}]struct{})
+		}
+		b.waiters[key][ch] = struct{}{}
+	}
+	return ch
+}
+
+func (b *blockedClients) unregister(keys []string, ch chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, key := range keys {
+		delete(b.waiters[key], ch)
+		if len(b.waiters[key]) == 0 {
+			delete(b.waiters, key)
+		}
+	}
+}
+
+func (b *blockedClients) notify(keys ...string) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, key := range keys {
+		for ch := range b.waiters[key] {
+			// The channel is buffered, so a pending wake-up is never lost
+			// and a waiter that was already woken is not waited on.
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// executeBlockingPop implements "BPOP key [key ...] timeout". The client
+// registers as a waiter before checking the lists so that a push landing
+// between the check and the wait still wakes it up. It stops waiting if the
+// client disconnects, and never waits while the WAL is replayed.
+func executeBlockingPop(c *Cmd, sm *shardmanager.ShardManager, pop func(*types.Deque) (string, error)) (*CmdRes, error) {
+	keys := c.C.Args[:len(c.C.Args)-1]
+	timeout, err := strconv.ParseFloat(c.C.Args[len(c.C.Args)-1], 64)
+	if err != nil || timeout < 0 || math.IsInf(timeout, 0) || math.IsNaN(timeout) {
+		return newListRes([]string{}), errors.ErrInvalidTimeout
+	}
+
+	storeForKey := shardStores(sm)
+	if c.IsReplay {
+		// A logged pop either found its element or timed out, so its replay
+		// pops once and does not wait for the timeout again.
+		return popFirstNonEmpty(c, keys, storeForKey, pop)
+	}
+
+	ch := listWaiters.register(keys)
+	defer listWaiters.unregister(keys, ch)
+
+	var expired <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(time.Duration(timeout * float64(time.Second)))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	var disconnected <-chan struct{}
+	if c.Ctx != nil {
+		disconnected = c.Ctx.Done()
+	}
+
+	for {
+		r, err := popFirstNonEmpty(c, keys, storeForKey, pop)
+		if err != nil || len(r.Rs.GetKEYSRes().Keys) > 0 {
+			return r, err
+		}
+
+		select {
+		case <-ch:
+		case <-expired:
+			return newListRes([]string{}), nil
+		case <-disconnected:
+			return newListRes([]string{}), c.Ctx.Err()
+		}
+	}
+}
+
+// popFirstNonEmpty pops one element from the first non-empty list among
+// the keys and replies with the key and the element.
+func popFirstNonEmpty(c *Cmd, keys []string, storeForKey func(string) *dstore.Store, pop func(*types.Deque) (string, error)) (*CmdRes, error) {
+	for _, key := range keys {
+		elements, err := popDeque(storeForKey(key), key, 1, pop)
+		if err != nil {
+			return newListRes([]string{}), err
+		}
+		if len(elements) > 0 {
+			c.addModifiedKeys(key)
+			return newListRes([]string{key, elements[0]}), nil
+		}
+	}
+	return newListRes([]string{}), nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cBRPOP = &CommandMeta{
+	Name:      "BRPOP",
+	Syntax:    "BRPOP key [key ...] timeout",
+	HelpShort: "BRPOP pops the last element of the first non-empty list, blocking until one is available",
+	HelpLong: `
+BRPOP is the blocking variant of RPOP. It pops the last element of the first non-empty list among
+the given keys, checked in the order they are given.
+
+If all the lists are empty, the client is blocked until another client pushes to one of the keys,
+or stores a list at one of them with a command such as RENAME, COPY or RESTORE, or until the
+timeout expires. The timeout is in seconds and may be fractional; a timeout of 0 blocks
+indefinitely.
+
+Returns a list holding the key the element was popped from and the element, and an empty list if
+the timeout expired.
+	`,
+	Examples: `
+localhost:7379> RPUSH q2 a b
+OK 2
+localhost:7379> BRPOP q1 q2 0
+OK
+0) q2
+1) b
+localhost:7379> BRPOP q1 0.5
+OK
+	`,
+	NotifyKeys: poppedKey,
+	Eval:       evalBRPOP,
+	Execute:    executeBRPOP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cBRPOP)
+}
+
+var (
+	BRPOPResNilRes = newListRes([]string{})
+)
+
+// evalBRPOP pops from the lists held by a single store without blocking.
+func evalBRPOP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BRPOPResNilRes, errors.ErrWrongArgumentCount("BRPOP")
+	}
+	return popFirstNonEmpty(c, c.C.Args[:len(c.C.Args)-1], localStore(s), (*types.Deque).RPop)
+}
+
+func executeBRPOP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return BRPOPResNilRes, errors.ErrWrongArgumentCount("BRPOP")
+	}
+	return executeBlockingPop(c, sm, (*types.Deque).RPop)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cCFADD = &CommandMeta{
+	Name:      "CF.ADD",
+	Syntax:    "CF.ADD key item",
+	HelpShort: "CF.ADD adds an item to the cuckoo filter stored at key",
+	HelpLong: `
+CF.ADD adds an item to the cuckoo filter stored at key. If the key does not exist, a new filter
+is created with a capacity of 1024 and the default options of CF.RESERVE.
+
+An item can be added more than once, and must then be deleted as many times to leave the
+filter. Use CF.ADDNX to add an item only once.
+
+Returns 1 if the item was added and an error if the filter is full and cannot expand.
+	`,
+	Examples: `
+localhost:7379> CF.ADD revoked session:1
+OK 1
+localhost:7379> CF.ADD revoked session:1
+OK 1
+localhost:7379> CF.COUNT revoked session:1
+OK 2
+	`,
+	Eval:    evalCFADD,
+	Execute: executeCFADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCFADD)
+}
+
+var (
+	CFADDResNilRes = newIntRes(0)
+)
+
+func evalCFADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFADDResNilRes, errors.ErrWrongArgumentCount("CF.ADD")
+	}
+
+	cf, err := getOrCreateCuckooFilter(s, c.C.Args[0])
+	if err != nil {
+		return CFADDResNilRes, err
+	}
+	if err := cf.Add(c.C.Args[1]); err != nil {
+		return CFADDResNilRes, errors.ErrGeneral(err.Error())
+	}
+	return newIntRes(1), nil
+}
+
+func executeCFADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFADDResNilRes, errors.ErrWrongArgumentCount("CF.ADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCFADD(c, shard.Thread.Store())
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cCFADDNX = &CommandMeta{
+	Name:      "CF.ADDNX",
+	Syntax:    "CF.ADDNX key item",
+	HelpShort: "CF.ADDNX adds an item to the cuckoo filter stored at key if it is not in it",
+	HelpLong: `
+CF.ADDNX adds an item to the cuckoo filter stored at key, unless the item may already be in the
+filter. If the key does not exist, a new filter is created with a capacity of 1024 and the
+default options of CF.RESERVE.
+
+A false positive makes CF.ADDNX skip an item that was never added.
+
+Returns 1 if the item was added, 0 if it may already have been in the filter, and an error if
+the filter is full and cannot expand.
+	`,
+	Examples: `
+localhost:7379> CF.ADDNX revoked session:1
+OK 1
+localhost:7379> CF.ADDNX revoked session:1
+OK 0
+	`,
+	Eval:    evalCFADDNX,
+	Execute: executeCFADDNX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCFADDNX)
+}
+
+var (
+	CFADDNXResNilRes = newIntRes(0)
+)
+
+func evalCFADDNX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFADDNXResNilRes, errors.ErrWrongArgumentCount("CF.ADDNX")
+	}
+
+	cf, err := getOrCreateCuckooFilter(s, c.C.Args[0])
+	if err != nil {
+		return CFADDNXResNilRes, err
+	}
+	if cf.Exists(c.C.Args[1]) {
+		return newIntRes(0), nil
+	}
+	if err := cf.Add(c.C.Args[1]); err != nil {
+		return CFADDNXResNilRes, errors.ErrGeneral(err.Error())
+	}
+	return newIntRes(1), nil
+}
+
+func executeCFADDNX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFADDNXResNilRes, errors.ErrWrongArgumentCount("CF.ADDNX")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCFADDNX(c, shard.Thread.Store())
+}
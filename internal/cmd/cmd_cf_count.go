@@ -0,0 +1,61 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cCFCOUNT = &CommandMeta{
+	Name:      "CF.COUNT",
+	Syntax:    "CF.COUNT key item",
+	HelpShort: "CF.COUNT returns the number of times an item may be in the cuckoo filter stored at key",
+	HelpLong: `
+CF.COUNT returns the number of times an item may have been added to the cuckoo filter stored at
+key and not deleted since. Items sharing the fingerprint of the item are counted too, so the
+count may be higher than the actual one, but never lower.
+
+Returns the count, or 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> CF.ADD revoked session:1
+OK 1
+localhost:7379> CF.ADD revoked session:1
+OK 1
+localhost:7379> CF.COUNT revoked session:1
+OK 2
+	`,
+	Eval:    evalCFCOUNT,
+	Execute: executeCFCOUNT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCFCOUNT)
+}
+
+var (
+	CFCOUNTResNilRes = newIntRes(0)
+)
+
+func evalCFCOUNT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFCOUNTResNilRes, errors.ErrWrongArgumentCount("CF.COUNT")
+	}
+
+	cf, err := getCuckooFilter(s, c.C.Args[0])
+	if err != nil || cf == nil {
+		return CFCOUNTResNilRes, err
+	}
+	return newIntRes(int64(cf.Count(c.C.Args[1]))), nil
+}
+
+func executeCFCOUNT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFCOUNTResNilRes, errors.ErrWrongArgumentCount("CF.COUNT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCFCOUNT(c, shard.Thread.Store())
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cCFDEL = &CommandMeta{
+	Name:      "CF.DEL",
+	Syntax:    "CF.DEL key item",
+	HelpShort: "CF.DEL deletes an item from the cuckoo filter stored at key",
+	HelpLong: `
+CF.DEL deletes one occurrence of an item from the cuckoo filter stored at key. An item added
+more than once stays in the filter until it is deleted as many times.
+
+Only delete items that were added. The filter stores fingerprints of the items, so deleting an
+item that was never added may delete another item that shares its fingerprint.
+
+Returns 1 if the item was deleted, 0 if it was not found, and an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> CF.ADD revoked session:1
+OK 1
+localhost:7379> CF.DEL revoked session:1
+OK 1
+localhost:7379> CF.DEL revoked session:1
+OK 0
+	`,
+	Eval:    evalCFDEL,
+	Execute: executeCFDEL,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCFDEL)
+}
+
+var (
+	CFDELResNilRes = newIntRes(0)
+)
+
+func evalCFDEL(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFDELResNilRes, errors.ErrWrongArgumentCount("CF.DEL")
+	}
+
+	cf, err := getCuckooFilter(s, c.C.Args[0])
+	if err != nil {
+		return CFDELResNilRes, err
+	}
+	if cf == nil {
+		return CFDELResNilRes, errors.ErrKeyNotFound
+	}
+	if cf.Delete(c.C.Args[1]) {
+		return newIntRes(1), nil
+	}
+	return newIntRes(0), nil
+}
+
+func executeCFDEL(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFDELResNilRes, errors.ErrWrongArgumentCount("CF.DEL")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCFDEL(c, shard.Thread.Store())
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cCFEXISTS = &CommandMeta{
+	Name:      "CF.EXISTS",
+	Syntax:    "CF.EXISTS key item",
+	HelpShort: "CF.EXISTS checks if an item may be in the cuckoo filter stored at key",
+	HelpLong: `
+CF.EXISTS checks if an item may have been added to the cuckoo filter stored at key and not
+deleted since.
+
+Returns 1 if the item may be in the filter and 0 if it certainly is not or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> CF.ADD revoked session:1
+OK 1
+localhost:7379> CF.EXISTS revoked session:1
+OK 1
+localhost:7379> CF.EXISTS revoked session:2
+OK 0
+	`,
+	Eval:    evalCFEXISTS,
+	Execute: executeCFEXISTS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCFEXISTS)
+}
+
+var (
+	CFEXISTSResNilRes = newIntRes(0)
+)
+
+func evalCFEXISTS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFEXISTSResNilRes, errors.ErrWrongArgumentCount("CF.EXISTS")
+	}
+
+	cf, err := getCuckooFilter(s, c.C.Args[0])
+	if err != nil || cf == nil {
+		return CFEXISTSResNilRes, err
+	}
+	if cf.Exists(c.C.Args[1]) {
+		return newIntRes(1), nil
+	}
+	return newIntRes(0), nil
+}
+
+func executeCFEXISTS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return CFEXISTSResNilRes, errors.ErrWrongArgumentCount("CF.EXISTS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCFEXISTS(c, shard.Thread.Store())
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cCFINFO = &CommandMeta{
+	Name:      "CF.INFO",
+	Syntax:    "CF.INFO key",
+	HelpShort: "CF.INFO returns the parameters of the cuckoo filter stored at key",
+	HelpLong: `
+CF.INFO returns the parameters and metadata of the cuckoo filter stored at key.
+
+- Size: the number of bytes taken by the fingerprints
+- Number of buckets: the number of buckets across all the chained filters
+- Number of filters: the number of chained filters, which grows as the filter expands
+- Number of items inserted: the number of items in the filter
+- Number of items deleted: the number of items deleted from the filter
+- Bucket size, Expansion rate, Max iterations: the options given to CF.RESERVE
+
+Returns the parameters as name and value pairs, or an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> CF.RESERVE revoked 8
+OK
+localhost:7379> CF.INFO revoked
+OK
+0) Size="16"
+1) Number of buckets="4"
+2) Number of filters="1"
+3) Number of items inserted="0"
+4) Number of items deleted="0"
+5) Bucket size="2"
+6) Expansion rate="1"
+7) Max iterations="20"
+	`,
+	Eval:    evalCFINFO,
+	Execute: executeCFINFO,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCFINFO)
+}
+
+var (
+	CFINFOResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalCFINFO(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return CFINFOResNilRes, errors.ErrWrongArgumentCount("CF.INFO")
+	}
+
+	cf, err := getCuckooFilter(s, c.C.Args[0])
+	if err != nil {
+		return CFINFOResNilRes, err
+	}
+	if cf == nil {
+		return CFINFOResNilRes, errors.ErrKeyNotFound
+	}
+
+	info := cf.Info()
+	elements := make([]*wire.HElement, 0, len(info)/2)
+	for i := 0; i+1 < len(info); i += 2 {
+		elements = append(elements, &wire.HElement{
+			Key:   fmt.Sprint(info[i]),
+			Value: fmt.Sprint(info[i+1]),
+		})
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeCFINFO(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return CFINFOResNilRes, errors.ErrWrongArgumentCount("CF.INFO")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCFINFO(c, shard.Thread.Store())
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cCFRESERVE = &CommandMeta{
+	Name:      "CF.RESERVE",
+	Syntax:    "CF.RESERVE key capacity [BUCKETSIZE bucketsize] [MAXITERATIONS maxiterations] [EXPANSION expansion]",
+	HelpShort: "CF.RESERVE creates an empty cuckoo filter",
+	HelpLong: `
+CF.RESERVE creates an empty cuckoo filter at key that holds capacity items before it expands.
+
+A cuckoo filter checks whether an item may have been added, like a Bloom filter, but also
+counts and deletes items. It stores a fingerprint of every item in one of two buckets. When both
+are full, it moves fingerprints to their other bucket to make room, and when that fails, it
+chains a new and larger filter to the existing ones.
+
+- BUCKETSIZE: The number of fingerprints in a bucket, between 1 and 255, 2 by default. Larger
+  buckets fill up better but raise the false positive rate.
+- MAXITERATIONS: The number of fingerprints moved to make room for an item before the filter
+  expands, between 1 and 65535, 20 by default
+- EXPANSION: How many times larger every new filter is than the previous one, between 0 and
+  32768, 1 by default. A filter with an expansion of 0 never expands and rejects the items it
+  has no room for.
+
+Returns "OK" if the filter was created and an error if the key already exists.
+	`,
+	Examples: `
+localhost:7379> CF.RESERVE revoked 1000 BUCKETSIZE 4
+OK
+localhost:7379> CF.RESERVE revoked 1000
+ERR key exists
+	`,
+	Eval:    evalCFRESERVE,
+	Execute: executeCFRESERVE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCFRESERVE)
+}
+
+var (
+	CFRESERVEResNilRes = newOKRes()
+)
+
+func evalCFRESERVE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args)%2 != 0 {
+		return CFRESERVEResNilRes, errors.ErrWrongArgumentCount("CF.RESERVE")
+	}
+
+	opts, err := parseCuckooOpts(c.C.Args[1:])
+	if err != nil {
+		return CFRESERVEResNilRes, err
+	}
+
+	key := c.C.Args[0]
+	if s.Get(key) != nil {
+		return CFRESERVEResNilRes, errors.ErrKeyExists
+	}
+	s.Put(key, s.NewObj(types.NewCuckoo(opts), -1, object.ObjTypeCuckoo))
+	return newOKRes(), nil
+}
+
+func executeCFRESERVE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args)%2 != 0 {
+		return CFRESERVEResNilRes, errors.ErrWrongArgumentCount("CF.RESERVE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCFRESERVE(c, shard.Thread.Store())
+}
+
+// parseCuckooOpts parses the capacity and the options of CF.RESERVE.
+func parseCuckooOpts(args []string) (types.CuckooOpts, error) {
+	opts := types.DefaultCuckooOpts()
+	capacity, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil || capacity == 0 || capacity > 1<<28 {
+		return opts, errors.ErrGeneral("capacity must be a positive integer up to 268435456")
+	}
+	opts.Capacity = capacity
+
+	for i := 1; i+1 < len(args); i += 2 {
+		value, err := strconv.ParseUint(args[i+1], 10, 64)
+		switch strings.ToUpper(args[i]) {
+		case "BUCKETSIZE":
+			if err != nil || value < 1 || value > types.MaxCuckooBucketSize {
+				return opts, errors.ErrGeneral("bucket size must be an integer between 1 and 255")
+			}
+			opts.BucketSize = value
+		case "MAXITERATIONS":
+			if err != nil || value < 1 || value > types.MaxCuckooMaxIterations {
+				return opts, errors.ErrGeneral("max iterations must be an integer between 1 and 65535")
+			}
+			opts.MaxIterations = value
+		case "EXPANSION":
+			if err != nil || value > types.MaxCuckooExpansion {
+				return opts, errors.ErrGeneral("expansion must be an integer between 0 and 32768")
+			}
+			opts.Expansion = value
+		default:
+			return opts, errors.ErrInvalidSyntax("CF.RESERVE")
+		}
+	}
+	return opts, nil
+}
+
+// getCuckooFilter returns the cuckoo filter stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getCuckooFilter(s *dstore.Store, key string) (*types.Cuckoo, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeCuckoo {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.Cuckoo), nil
+}
+
+// getOrCreateCuckooFilter returns the cuckoo filter stored at key and
+// creates one with the default options if the key does not exist.
+func getOrCreateCuckooFilter(s *dstore.Store, key string) (*types.Cuckoo, error) {
+	cf, err := getCuckooFilter(s, key)
+	if err != nil || cf != nil {
+		return cf, err
+	}
+	cf = types.NewCuckoo(types.DefaultCuckooOpts())
+	s.Put(key, s.NewObj(cf, -1, object.ObjTypeCuckoo))
+	return cf, nil
+}
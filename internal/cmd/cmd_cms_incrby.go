@@ -0,0 +1,82 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cCMSINCRBY = &CommandMeta{
+	Name:      "CMS.INCRBY",
+	Syntax:    "CMS.INCRBY key item increment [item increment ...]",
+	HelpShort: "CMS.INCRBY increases the counts of items in the Count-Min Sketch stored at key",
+	HelpLong: `
+CMS.INCRBY increases the count of each item by its increment in the Count-Min Sketch stored at key.
+Increments must be non-negative integers. No count is changed if any of the increments is invalid.
+
+Returns the items, in the order given, each paired with its estimated count after the increment.
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> CMS.INITBYDIM visits 2000 5
+OK
+localhost:7379> CMS.INCRBY visits home 3 about 1
+OK
+0) home="3"
+1) about="1"
+	`,
+	Eval:    evalCMSINCRBY,
+	Execute: executeCMSINCRBY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCMSINCRBY)
+}
+
+var (
+	CMSINCRBYResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalCMSINCRBY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args)%2 == 0 {
+		return CMSINCRBYResNilRes, errors.ErrWrongArgumentCount("CMS.INCRBY")
+	}
+
+	cms, err := getCountMinSketch(s, c.C.Args[0])
+	if err != nil {
+		return CMSINCRBYResNilRes, err
+	}
+
+	pairs := c.C.Args[1:]
+	items := make([]string, 0, len(pairs)/2)
+	increments := make([]uint64, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		increment, err := strconv.ParseUint(pairs[i+1], 10, 64)
+		if err != nil {
+			return CMSINCRBYResNilRes, errors.ErrIntegerOutOfRange
+		}
+		items = append(items, pairs[i])
+		increments = append(increments, increment)
+	}
+
+	counts := make([]uint64, len(items))
+	for i, item := range items {
+		cms.UpdateMatrix(item, increments[i])
+		counts[i] = cms.EstimateCount(item)
+	}
+	return newCMSCountsRes(items, counts), nil
+}
+
+func executeCMSINCRBY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args)%2 == 0 {
+		return CMSINCRBYResNilRes, errors.ErrWrongArgumentCount("CMS.INCRBY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCMSINCRBY(c, shard.Thread.Store())
+}
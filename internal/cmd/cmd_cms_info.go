@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cCMSINFO = &CommandMeta{
+	Name:      "CMS.INFO",
+	Syntax:    "CMS.INFO key",
+	HelpShort: "CMS.INFO returns the dimensions and total count of the Count-Min Sketch stored at key",
+	HelpLong: `
+CMS.INFO returns the width, the depth and the total of all the increments of the Count-Min Sketch
+stored at key.
+
+Returns the values as name and value pairs. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> CMS.INITBYDIM visits 2000 5
+OK
+localhost:7379> CMS.INFO visits
+OK
+0) width="2000"
+1) depth="5"
+2) count="0"
+	`,
+	Eval:    evalCMSINFO,
+	Execute: executeCMSINFO,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCMSINFO)
+}
+
+var (
+	CMSINFOResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalCMSINFO(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return CMSINFOResNilRes, errors.ErrWrongArgumentCount("CMS.INFO")
+	}
+
+	cms, err := getCountMinSketch(s, c.C.Args[0])
+	if err != nil {
+		return CMSINFOResNilRes, err
+	}
+	return newPairsRes([]*wire.HElement{
+		{Key: "width", Value: strconv.FormatUint(cms.Width(), 10)},
+		{Key: "depth", Value: strconv.FormatUint(cms.Depth(), 10)},
+		{Key: "count", Value: strconv.FormatUint(cms.Count(), 10)},
+	}), nil
+}
+
+func executeCMSINFO(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return CMSINFOResNilRes, errors.ErrWrongArgumentCount("CMS.INFO")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCMSINFO(c, shard.Thread.Store())
+}
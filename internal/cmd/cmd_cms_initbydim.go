@@ -0,0 +1,101 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cCMSINITBYDIM = &CommandMeta{
+	Name:      "CMS.INITBYDIM",
+	Syntax:    "CMS.INITBYDIM key width depth",
+	HelpShort: "CMS.INITBYDIM creates a Count-Min Sketch with the given dimensions",
+	HelpLong: `
+CMS.INITBYDIM creates an empty Count-Min Sketch at key whose matrix has the given width and depth.
+The width controls the error of the estimates and the depth the probability of exceeding it.
+
+Returns "OK" if the sketch was created and an error if the key already exists.
+	`,
+	Examples: `
+localhost:7379> CMS.INITBYDIM visits 2000 5
+OK
+localhost:7379> CMS.INITBYDIM visits 2000 5
+ERR key exists
+	`,
+	Eval:    evalCMSINITBYDIM,
+	Execute: executeCMSINITBYDIM,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCMSINITBYDIM)
+}
+
+var (
+	CMSINITBYDIMResNilRes = newOKRes()
+	CMSINITBYDIMResOKRes  = newOKRes()
+)
+
+func evalCMSINITBYDIM(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return CMSINITBYDIMResNilRes, errors.ErrWrongArgumentCount("CMS.INITBYDIM")
+	}
+
+	opts, err := types.NewCountMinSketchOpts(c.C.Args[1:])
+	if err != nil {
+		return CMSINITBYDIMResNilRes, err
+	}
+	if err := createCountMinSketch(s, c.C.Args[0], opts); err != nil {
+		return CMSINITBYDIMResNilRes, err
+	}
+	return CMSINITBYDIMResOKRes, nil
+}
+
+func executeCMSINITBYDIM(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return CMSINITBYDIMResNilRes, errors.ErrWrongArgumentCount("CMS.INITBYDIM")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCMSINITBYDIM(c, shard.Thread.Store())
+}
+
+// createCountMinSketch stores a new Count-Min Sketch with the options at key.
+// Returns an error if the key already exists.
+func createCountMinSketch(s *dstore.Store, key string, opts *types.CountMinSketchOpts) error {
+	if s.Get(key) != nil {
+		return errors.ErrKeyExists
+	}
+	s.Put(key, s.NewObj(types.NewCountMinSketch(opts), -1, object.ObjTypeCountMinSketch))
+	return nil
+}
+
+// getCountMinSketch returns the Count-Min Sketch stored at key.
+// Returns an error if the key does not exist or holds a value of
+// another type.
+func getCountMinSketch(s *dstore.Store, key string) (*types.CountMinSketch, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, errors.ErrKeyNotFound
+	}
+	if obj.Type != object.ObjTypeCountMinSketch {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.CountMinSketch), nil
+}
+
+// newCMSCountsRes replies with the items, in the order given, each paired
+// with its estimated count.
+func newCMSCountsRes(items []string, counts []uint64) *CmdRes {
+	elements := make([]*wire.HElement, len(items))
+	for i, item := range items {
+		elements[i] = &wire.HElement{Key: item, Value: strconv.FormatUint(counts[i], 10)}
+	}
+	return newPairsRes(elements)
+}
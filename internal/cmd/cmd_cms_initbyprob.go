@@ -0,0 +1,63 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cCMSINITBYPROB = &CommandMeta{
+	Name:      "CMS.INITBYPROB",
+	Syntax:    "CMS.INITBYPROB key error probability",
+	HelpShort: "CMS.INITBYPROB creates a Count-Min Sketch for the given error and probability",
+	HelpLong: `
+CMS.INITBYPROB creates an empty Count-Min Sketch at key sized so that an estimate exceeds the
+true count by more than error times the total count with at most the given probability.
+
+Both error and probability must be between 0 and 1, exclusive.
+
+Returns "OK" if the sketch was created and an error if the key already exists.
+	`,
+	Examples: `
+localhost:7379> CMS.INITBYPROB visits 0.001 0.01
+OK
+	`,
+	Eval:    evalCMSINITBYPROB,
+	Execute: executeCMSINITBYPROB,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCMSINITBYPROB)
+}
+
+var (
+	CMSINITBYPROBResNilRes = newOKRes()
+	CMSINITBYPROBResOKRes  = newOKRes()
+)
+
+func evalCMSINITBYPROB(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return CMSINITBYPROBResNilRes, errors.ErrWrongArgumentCount("CMS.INITBYPROB")
+	}
+
+	opts, err := types.NewCountMinSketchOptsWithErrorRate(c.C.Args[1:])
+	if err != nil {
+		return CMSINITBYPROBResNilRes, err
+	}
+	if err := createCountMinSketch(s, c.C.Args[0], opts); err != nil {
+		return CMSINITBYPROBResNilRes, err
+	}
+	return CMSINITBYPROBResOKRes, nil
+}
+
+func executeCMSINITBYPROB(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return CMSINITBYPROBResNilRes, errors.ErrWrongArgumentCount("CMS.INITBYPROB")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCMSINITBYPROB(c, shard.Thread.Store())
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cCMSMERGE = &CommandMeta{
+	Name:      "CMS.MERGE",
+	Syntax:    "CMS.MERGE destination numkeys source [source ...] [WEIGHTS weight [weight ...]]",
+	HelpShort: "CMS.MERGE merges Count-Min Sketches into the sketch stored at destination",
+	HelpLong: `
+CMS.MERGE replaces the counts of the Count-Min Sketch stored at destination with the weighted sum
+of the counts of the numkeys source sketches. The sources may include the destination and may be
+stored on any shard. All the sketches must exist and have the same width and depth.
+
+- WEIGHTS: the weight of each source, in the order given. Every weight defaults to 1.
+
+Returns "OK" if the sketches were merged.
+	`,
+	Examples: `
+localhost:7379> CMS.INITBYDIM total 2000 5
+OK
+localhost:7379> CMS.INITBYDIM day1 2000 5
+OK
+localhost:7379> CMS.INCRBY day1 home 3
+OK
+0) home="3"
+localhost:7379> CMS.MERGE total 2 total day1 WEIGHTS 1 2
+OK
+localhost:7379> CMS.QUERY total home
+OK
+0) home="6"
+	`,
+	Eval:    evalCMSMERGE,
+	Execute: executeCMSMERGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCMSMERGE)
+}
+
+var (
+	CMSMERGEResNilRes = newOKRes()
+	CMSMERGEResOKRes  = newOKRes()
+)
+
+func evalCMSMERGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return CMSMERGEResNilRes, errors.ErrWrongArgumentCount("CMS.MERGE")
+	}
+	return mergeCountMinSketches(c, localStore(s))
+}
+
+func executeCMSMERGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return CMSMERGEResNilRes, errors.ErrWrongArgumentCount("CMS.MERGE")
+	}
+	return mergeCountMinSketches(c, shardStores(sm))
+}
+
+// mergeCountMinSketches evaluates CMS.MERGE reading every sketch from the
+// store that storeForKey returns for its key, so the sources can live on
+// different shards than the destination.
+func mergeCountMinSketches(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	keys, weights, err := parseCMSMergeArgs(c.C.Args[1:])
+	if err != nil {
+		return CMSMERGEResNilRes, err
+	}
+
+	destination, err := getCountMinSketch(storeForKey(c.C.Args[0]), c.C.Args[0])
+	if err != nil {
+		return CMSMERGEResNilRes, err
+	}
+
+	sources := make([]*types.CountMinSketch, 0, len(keys))
+	for _, key := range keys {
+		cms, err := getCountMinSketch(storeForKey(key), key)
+		if err != nil {
+			return CMSMERGEResNilRes, err
+		}
+		if cms.Width() != destination.Width() || cms.Depth() != destination.Depth() {
+			return CMSMERGEResNilRes, errors.ErrGeneral("width/depth doesn't match")
+		}
+		sources = append(sources, cms)
+	}
+
+	destination.Merge(sources, weights)
+	return CMSMERGEResOKRes, nil
+}
+
+// parseCMSMergeArgs parses "numkeys source [source ...] [WEIGHTS weight
+// [weight ...]]" into the source keys and their weights.
+func parseCMSMergeArgs(args []string) ([]string, []uint64, error) {
+	numKeys, err := strconv.Atoi(args[0])
+	if err != nil || numKeys < 1 {
+		return nil, nil, errors.ErrIntegerOutOfRange
+	}
+
+	rest := args[1:]
+	if len(rest) != numKeys && len(rest) != 2*numKeys+1 {
+		return nil, nil, errors.ErrInvalidSyntax("CMS.MERGE")
+	}
+	keys := rest[:numKeys]
+	if len(rest) == numKeys {
+		return keys, slices.Repeat([]uint64{1}, numKeys), nil
+	}
+
+	if !strings.EqualFold(rest[numKeys], "WEIGHTS") {
+		return nil, nil, errors.ErrInvalidSyntax("CMS.MERGE")
+	}
+	weights := make([]uint64, 0, numKeys)
+	for _, arg := range rest[numKeys+1:] {
+		weight, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, nil, errors.ErrIntegerOutOfRange
+		}
+		weights = append(weights, weight)
+	}
+	return keys, weights, nil
+}
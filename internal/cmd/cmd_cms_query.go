@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cCMSQUERY = &CommandMeta{
+	Name:      "CMS.QUERY",
+	Syntax:    "CMS.QUERY key item [item ...]",
+	HelpShort: "CMS.QUERY returns the estimated counts of items in the Count-Min Sketch stored at key",
+	HelpLong: `
+CMS.QUERY returns the estimated count of each item in the Count-Min Sketch stored at key. An
+estimate is never lower than the true count.
+
+Returns the items, in the order given, each paired with its estimated count. Returns an error if
+the key does not exist.
+	`,
+	Examples: `
+localhost:7379> CMS.INCRBY visits home 3
+OK
+0) home="3"
+localhost:7379> CMS.QUERY visits home blog
+OK
+0) home="3"
+1) blog="0"
+	`,
+	Eval:        evalCMSQUERY,
+	Execute:     executeCMSQUERY,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCMSQUERY)
+}
+
+var (
+	CMSQUERYResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalCMSQUERY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return CMSQUERYResNilRes, errors.ErrWrongArgumentCount("CMS.QUERY")
+	}
+
+	cms, err := getCountMinSketch(s, c.C.Args[0])
+	if err != nil {
+		return CMSQUERYResNilRes, err
+	}
+
+	items := c.C.Args[1:]
+	counts := make([]uint64, len(items))
+	for i, item := range items {
+		counts[i] = cms.EstimateCount(item)
+	}
+	return newCMSCountsRes(items, counts), nil
+}
+
+func executeCMSQUERY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return CMSQUERYResNilRes, errors.ErrWrongArgumentCount("CMS.QUERY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCMSQUERY(c, shard.Thread.Store())
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cCMSQUERYWATCH = &CommandMeta{
+	Name:      "CMS.QUERY.WATCH",
+	Syntax:    "CMS.QUERY.WATCH key item [item ...]",
+	HelpShort: "CMS.QUERY.WATCH creates a query subscription over the CMS.QUERY command",
+	HelpLong: `
+CMS.QUERY.WATCH creates a query subscription over the CMS.QUERY command. The client invoking the command
+will receive the output of the CMS.QUERY command (not just the notification) whenever the sketch
+stored at the key is updated, so it can follow the estimated counts of the items as they change.
+	`,
+	Examples: `
+client1:7379> CMS.INITBYDIM visits 2000 5
+OK
+client1:7379> CMS.QUERY.WATCH visits home
+entered the watch mode for CMS.QUERY.WATCH visits home
+
+
+client2:7379> CMS.INCRBY visits home 3
+OK
+0) home="3"
+
+
+client1:7379> ...
+entered the watch mode for CMS.QUERY.WATCH visits home
+OK [fingerprint=2184712904]
+0) home="3"
+	`,
+	Eval:    evalCMSQUERYWATCH,
+	Execute: executeCMSQUERYWATCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCMSQUERYWATCH)
+}
+
+var (
+	CMSQUERYWATCHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalCMSQUERYWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	r, err := evalCMSQUERY(c, s)
+	if err != nil {
+		return CMSQUERYWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeCMSQUERYWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return CMSQUERYWATCHResNilRes, errors.ErrWrongArgumentCount("CMS.QUERY.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalCMSQUERYWATCH(c, shard.Thread.Store())
+}
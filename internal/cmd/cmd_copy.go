@@ -0,0 +1,97 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cCOPY = &CommandMeta{
+	Name:      "COPY",
+	Syntax:    "COPY source destination [REPLACE]",
+	HelpShort: "COPY copies the value stored at source to destination",
+	HelpLong: `
+COPY copies the value stored at source to destination. The copy shares nothing with the source,
+and keeps its expiry. The keys may live on different shards.
+
+The command does nothing if destination exists, unless REPLACE is given, in which case the value
+of destination is replaced.
+
+Returns 1 if the value was copied, or 0 if source does not exist or destination exists.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> COPY k1 k2
+OK 1
+localhost:7379> COPY k1 k2
+OK 0
+localhost:7379> COPY k1 k2 REPLACE
+OK 1
+	`,
+	Eval:       evalCOPY,
+	Execute:    executeCOPY,
+	NotifyKeys: copyNotifyKeys,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cCOPY)
+}
+
+var (
+	COPYResNilRes = newIntRes(0)
+)
+
+func evalCOPY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args) > 3 {
+		return COPYResNilRes, errors.ErrWrongArgumentCount("COPY")
+	}
+	return copyKey(c, localStore(s))
+}
+
+func executeCOPY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args) > 3 {
+		return COPYResNilRes, errors.ErrWrongArgumentCount("COPY")
+	}
+	return copyKey(c, shardStores(sm))
+}
+
+// copyNotifyKeys returns the destination of COPY, the only key it modifies.
+func copyNotifyKeys(c *Cmd) []string {
+	if len(c.C.Args) < 2 {
+		return []string{c.Key()}
+	}
+	return c.C.Args[1:2]
+}
+
+func copyKey(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	src, dst := c.C.Args[0], c.C.Args[1]
+	replace := false
+	if len(c.C.Args) == 3 {
+		if !strings.EqualFold(c.C.Args[2], "REPLACE") {
+			return COPYResNilRes, errors.ErrInvalidSyntax("COPY")
+		}
+		replace = true
+	}
+	if src == dst {
+		return COPYResNilRes, errors.ErrGeneral("source and destination objects are the same")
+	}
+
+	srcStore, dstStore := storeForKey(src), storeForKey(dst)
+	obj := srcStore.Get(src)
+	if obj == nil {
+		return COPYResNilRes, nil
+	}
+	if !replace && dstStore.Get(dst) != nil {
+		return COPYResNilRes, nil
+	}
+	if err := copyObject(obj, srcStore, dstStore, dst); err != nil {
+		return COPYResNilRes, err
+	}
+	return newIntRes(1), nil
+}
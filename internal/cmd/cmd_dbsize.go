@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cDBSIZE = &CommandMeta{
+	Name:      "DBSIZE",
+	Syntax:    "DBSIZE",
+	HelpShort: "DBSIZE returns the number of keys in the database",
+	HelpLong: `
+DBSIZE returns the number of keys in the database, summed over all the shards. The keys that
+expired are counted until they are deleted, which happens when they are accessed or when the
+shard periodically deletes its expired keys.
+	`,
+	Examples: `
+localhost:7379> MSET k1 v1 k2 v2
+OK
+localhost:7379> DBSIZE
+OK 2
+	`,
+	Eval:    evalDBSIZE,
+	Execute: executeDBSIZE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cDBSIZE)
+}
+
+var (
+	DBSIZEResNilRes = newIntRes(0)
+)
+
+func evalDBSIZE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 0 {
+		return DBSIZEResNilRes, errors.ErrWrongArgumentCount("DBSIZE")
+	}
+	return newIntRes(dbsize(s)), nil
+}
+
+func executeDBSIZE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 0 {
+		return DBSIZEResNilRes, errors.ErrWrongArgumentCount("DBSIZE")
+	}
+	var count int64
+	for _, shard := range sm.Shards() {
+		count += dbsize(shard.Thread.Store())
+	}
+	return newIntRes(count), nil
+}
+
+// dbsize returns the number of keys of s.
+func dbsize(s *dstore.Store) int64 {
+	return int64(s.GetKeyCount())
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"encoding/base64"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cDUMP = &CommandMeta{
+	Name:      "DUMP",
+	Syntax:    "DUMP key",
+	HelpShort: "DUMP serializes the value stored at key",
+	HelpLong: `
+DUMP serializes the value stored at key into a payload that RESTORE turns back into a key,
+on this instance or on another one. Values of every type can be dumped.
+
+The payload is base64 encoded. It carries the version of its format and a CRC64 checksum,
+so RESTORE rejects payloads that are damaged or that come from a newer version.
+The expiry of the key is not part of the payload.
+
+Returns an empty string if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> DUMP k1
+OK AQAAAAACdjGuM1L7/x8kYw==
+localhost:7379> RESTORE k2 0 AQAAAAACdjGuM1L7/x8kYw==
+OK
+localhost:7379> GET k2
+OK v1
+	`,
+	Eval:    evalDUMP,
+	Execute: executeDUMP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cDUMP)
+}
+
+var (
+	DUMPResNilRes = newValueRes("")
+)
+
+func evalDUMP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return DUMPResNilRes, errors.ErrWrongArgumentCount("DUMP")
+	}
+	obj := s.Get(c.C.Args[0])
+	if obj == nil {
+		return DUMPResNilRes, nil
+	}
+	data, err := object.Dump(obj)
+	if err != nil {
+		return DUMPResNilRes, errors.ErrGeneral(err.Error())
+	}
+	return newValueRes(base64.StdEncoding.EncodeToString(data)), nil
+}
+
+func executeDUMP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return DUMPResNilRes, errors.ErrWrongArgumentCount("DUMP")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalDUMP(c, shard.Thread.Store())
+}
@@ -15,7 +15,7 @@ var cFLUSHDB = &CommandMeta{
 	Syntax:    "FLUSHDB",
 	HelpShort: "FLUSHDB deletes all keys.",
 	HelpLong: `
-FLUSHDB deletes all keys present in the database.
+FLUSHDB deletes all keys present in the database, along with the indexes built over them.
 	`,
 	Examples: `
 localhost:7379> SET k1 v1
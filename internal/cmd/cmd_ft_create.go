@@ -0,0 +1,220 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cFTCREATE = &CommandMeta{
+	Name:      "FT.CREATE",
+	Syntax:    "FT.CREATE name ON HASH | STRING [PREFIX prefix] [SCHEMA field [WEIGHT weight] [field [WEIGHT weight] ...]]",
+	HelpShort: "FT.CREATE creates a full-text index over the fields of hashes or over strings",
+	HelpLong: `
+FT.CREATE creates a full-text index named name over the hashes, or the strings, stored at the keys
+that start with prefix, or at every key if PREFIX is not given. FT.SEARCH finds the keys whose text
+matches a query through the index and ranks them by relevance.
+
+Every shard indexes its own keys. The keys that already exist are indexed when the index is
+created, and the index is updated whenever a key it covers is written, deleted or expires.
+
+The text is split into words made of letters and digits, which are lowercased and stripped of
+their accents. Words are not stemmed, so "run" does not match "running" unless searched as a
+prefix.
+
+- SCHEMA: The fields of the hashes to index, required for HASH. A string is indexed as a whole.
+- WEIGHT: How much more the occurrences of a word in the field count towards relevance than
+  those in a field of weight 1, the default
+
+FLUSHDB deletes the indexes along with the keys, and FT.DROPINDEX deletes a single index.
+
+Returns OK, or an error if an index with the same name already exists.
+	`,
+	Examples: `
+localhost:7379> FT.CREATE articles ON HASH PREFIX article: SCHEMA title WEIGHT 2 body
+OK
+localhost:7379> FT.CREATE notes ON STRING PREFIX note:
+OK
+	`,
+	Eval:    evalFTCREATE,
+	Execute: executeFTCREATE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cFTCREATE)
+}
+
+var (
+	FTCREATEResNilRes = newOKRes()
+)
+
+// ftValueField is the name of the single field of a full-text index over
+// strings, which holds the whole string.
+const ftValueField = "value"
+
+func evalFTCREATE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	idx, err := parseFullTextKeyIndex(c.C.Args)
+	if err != nil {
+		return FTCREATEResNilRes, err
+	}
+	if s.GetIndex(idx.Name) != nil {
+		return FTCREATEResNilRes, errors.ErrGeneral("index '" + idx.Name + "' already exists")
+	}
+
+	s.GetStore().All(func(key string, _ *object.Obj) bool {
+		// GetNoTouch skips the keys that expired.
+		if obj := s.GetNoTouch(key); obj != nil {
+			idx.IndexKey(key, obj)
+		}
+		return true
+	})
+	s.PutIndex(idx.Name, idx)
+	return newOKRes(), nil
+}
+
+func executeFTCREATE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return FTCREATEResNilRes, errors.ErrWrongArgumentCount("FT.CREATE")
+	}
+	for _, shard := range sm.Shards() {
+		if shard.Thread.Store().GetIndex(c.C.Args[0]) != nil {
+			return FTCREATEResNilRes, errors.ErrGeneral("index '" + c.C.Args[0] + "' already exists")
+		}
+	}
+	for _, shard := range sm.Shards() {
+		if _, err := evalFTCREATE(c, shard.Thread.Store()); err != nil {
+			return FTCREATEResNilRes, err
+		}
+	}
+	return newOKRes(), nil
+}
+
+// fullTextKeyIndex is the full-text index of a shard over the fields of the
+// hashes, or over the strings, stored at the keys that start with prefix.
+// It implements store.KeyIndex, so the store keeps it up to date.
+type fullTextKeyIndex struct {
+	*types.FullTextIndex
+	on     object.ObjectType // ObjTypeSSMap for hashes and ObjTypeString for strings
+	prefix string
+}
+
+func (idx *fullTextKeyIndex) IndexKey(key string, obj *object.Obj) {
+	if !strings.HasPrefix(key, idx.prefix) {
+		return
+	}
+	texts, ok := idx.texts(obj)
+	if !ok {
+		// The key was overwritten with a value the index does not cover.
+		idx.Remove(key)
+		return
+	}
+	idx.Update(key, texts)
+}
+
+func (idx *fullTextKeyIndex) UnindexKey(key string) {
+	if strings.HasPrefix(key, idx.prefix) {
+		idx.Remove(key)
+	}
+}
+
+// texts returns the text of every indexed field of obj, or false if the
+// index does not cover values of its type.
+func (idx *fullTextKeyIndex) texts(obj *object.Obj) (map[string]string, bool) {
+	if idx.on == object.ObjTypeSSMap {
+		if obj.Type != object.ObjTypeSSMap {
+			return nil, false
+		}
+		texts := map[string]string{}
+		for _, f := range idx.Fields {
+			if v, ok := obj.Value.(*SSMap).Get(f.Name); ok {
+				texts[f.Name] = v
+			}
+		}
+		return texts, true
+	}
+
+	switch obj.Type {
+	case object.ObjTypeString, object.ObjTypeInt, object.ObjTypeFloat, object.ObjTypeByteArray:
+		value, err := getWireValueFromObj(obj)
+		if err != nil {
+			return nil, false
+		}
+		return map[string]string{ftValueField: value}, true
+	}
+	return nil, false
+}
+
+// parseFullTextKeyIndex parses the arguments of FT.CREATE into an empty
+// index.
+func parseFullTextKeyIndex(args []string) (*fullTextKeyIndex, error) {
+	if len(args) < 3 {
+		return nil, errors.ErrWrongArgumentCount("FT.CREATE")
+	}
+
+	idx := &fullTextKeyIndex{}
+	if !strings.EqualFold(args[1], "ON") {
+		return nil, errors.ErrInvalidSyntax("FT.CREATE")
+	}
+	switch strings.ToUpper(args[2]) {
+	case "HASH":
+		idx.on = object.ObjTypeSSMap
+	case "STRING":
+		idx.on = object.ObjTypeString
+	default:
+		return nil, errors.ErrGeneral("unknown index source '" + args[2] + "', must be HASH or STRING")
+	}
+
+	i := 3
+	if i < len(args) && strings.EqualFold(args[i], "PREFIX") {
+		if i+1 >= len(args) {
+			return nil, errors.ErrInvalidSyntax("FT.CREATE")
+		}
+		idx.prefix = args[i+1]
+		i += 2
+	}
+
+	if idx.on == object.ObjTypeString {
+		if i < len(args) {
+			return nil, errors.ErrInvalidSyntax("FT.CREATE")
+		}
+		idx.FullTextIndex = types.NewFullTextIndex(args[0], []types.FullTextField{{Name: ftValueField, Weight: 1}})
+		return idx, nil
+	}
+
+	if i >= len(args) || !strings.EqualFold(args[i], "SCHEMA") || i+1 >= len(args) {
+		return nil, errors.ErrInvalidSyntax("FT.CREATE")
+	}
+	fields := []types.FullTextField{}
+	names := map[string]bool{}
+	for i++; i < len(args); i++ {
+		f := types.FullTextField{Name: args[i], Weight: 1}
+		if i+1 < len(args) && strings.EqualFold(args[i+1], "WEIGHT") {
+			if i+2 >= len(args) {
+				return nil, errors.ErrInvalidSyntax("FT.CREATE")
+			}
+			weight, err := strconv.ParseFloat(args[i+2], 64)
+			if err != nil || weight <= 0 || math.IsInf(weight, 0) {
+				return nil, errors.ErrGeneral("weight must be a positive number")
+			}
+			f.Weight = weight
+			i += 2
+		}
+		if names[f.Name] {
+			return nil, errors.ErrGeneral("duplicate field '" + f.Name + "'")
+		}
+		names[f.Name] = true
+		fields = append(fields, f)
+	}
+
+	idx.FullTextIndex = types.NewFullTextIndex(args[0], fields)
+	return idx, nil
+}
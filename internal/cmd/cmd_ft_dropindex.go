@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cFTDROPINDEX = &CommandMeta{
+	Name:      "FT.DROPINDEX",
+	Syntax:    "FT.DROPINDEX name",
+	HelpShort: "FT.DROPINDEX deletes a full-text index",
+	HelpLong: `
+FT.DROPINDEX deletes the full-text index named name from every shard. The keys the index covered
+are kept.
+
+Returns OK, or an error if there is no full-text index with that name.
+	`,
+	Examples: `
+localhost:7379> FT.CREATE notes ON STRING PREFIX note:
+OK
+localhost:7379> FT.DROPINDEX notes
+OK
+localhost:7379> FT.SEARCH notes fox
+ERR no such full-text index 'notes'
+	`,
+	Eval:    evalFTDROPINDEX,
+	Execute: executeFTDROPINDEX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cFTDROPINDEX)
+}
+
+var (
+	FTDROPINDEXResNilRes = newOKRes()
+)
+
+func evalFTDROPINDEX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return FTDROPINDEXResNilRes, errors.ErrWrongArgumentCount("FT.DROPINDEX")
+	}
+	if _, err := getFullTextKeyIndex(s, c.C.Args[0]); err != nil {
+		return FTDROPINDEXResNilRes, err
+	}
+	s.DelIndex(c.C.Args[0])
+	return newOKRes(), nil
+}
+
+func executeFTDROPINDEX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return FTDROPINDEXResNilRes, errors.ErrWrongArgumentCount("FT.DROPINDEX")
+	}
+	for _, shard := range sm.Shards() {
+		if _, err := getFullTextKeyIndex(shard.Thread.Store(), c.C.Args[0]); err != nil {
+			return FTDROPINDEXResNilRes, err
+		}
+	}
+	for _, shard := range sm.Shards() {
+		if _, err := evalFTDROPINDEX(c, shard.Thread.Store()); err != nil {
+			return FTDROPINDEXResNilRes, err
+		}
+	}
+	return newOKRes(), nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cFTSEARCH = &CommandMeta{
+	Name:      "FT.SEARCH",
+	Syntax:    "FT.SEARCH name query [LIMIT offset count] [WITHDOCS] [HIGHLIGHT [TAGS open close]]",
+	HelpShort: "FT.SEARCH returns the keys whose text matches a query, most relevant first",
+	HelpLong: `
+FT.SEARCH returns the keys, covered by the index created with FT.CREATE, whose text matches the
+query. Words separated by spaces must all occur in the text, in any order:
+
+- word: The text has the word, ignoring case and accents
+- wor*: The text has a word starting with wor
+- "two words": The text has the words one after the other, within the same field
+- a | b, a OR b: The text has a or b
+- a AND b: The same as a b
+- -a, NOT a: The text does not have a
+- (a | b) c: Parentheses group expressions
+
+A query needs at least one word that is not negated.
+
+The keys are ranked by the BM25 relevance of their text to the words of the query that are not
+negated. The statistics BM25 relies on are gathered from every shard, so the scores of keys on
+different shards are comparable, and the matches of every shard are merged by score.
+
+- LIMIT: Skip the first offset matches and return at most count of them
+- WITHDOCS: Return the documents instead of the scores: the value of a string, or the fields of
+  a hash as JSON
+- HIGHLIGHT: Return the documents with the words that match the query wrapped in <b> and </b>,
+  or in open and close with TAGS. Only the indexed fields of a hash are highlighted.
+
+Returns the matching keys and their scores as key-score pairs, or with WITHDOCS or HIGHLIGHT the
+keys and their documents as key-document pairs.
+	`,
+	Examples: `
+localhost:7379> FT.CREATE notes ON STRING PREFIX note:
+OK
+localhost:7379> SET note:1 "The quick brown fox"
+OK
+localhost:7379> SET note:2 "A quick brown dog jumps over the lazy fox"
+OK
+localhost:7379> FT.SEARCH notes "quick fox"
+OK
+0) note:1="0.43273000201718687"
+1) note:2="0.3150692763931784"
+localhost:7379> FT.SEARCH notes "\"brown fox\"" HIGHLIGHT
+OK
+0) note:1="The quick <b>brown</b> <b>fox</b>"
+localhost:7379> FT.SEARCH notes "fox -dog" WITHDOCS
+OK
+0) note:1="The quick brown fox"
+	`,
+	Eval:    evalFTSEARCH,
+	Execute: executeFTSEARCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cFTSEARCH)
+}
+
+var (
+	FTSEARCHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalFTSEARCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return ftSearch(c, []*dstore.Store{s}, localStore(s))
+}
+
+func executeFTSEARCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	stores := make([]*dstore.Store, 0, len(sm.Shards()))
+	for _, shard := range sm.Shards() {
+		stores = append(stores, shard.Thread.Store())
+	}
+	return ftSearch(c, stores, shardStores(sm))
+}
+
+// ftSearch runs the query on the index of every store in stores and merges
+// the matches. The statistics of all the stores are gathered first so that
+// every store scores its matches against the whole corpus.
+func ftSearch(c *Cmd, stores []*dstore.Store, storeForKey func(string) *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return FTSEARCHResNilRes, errors.ErrWrongArgumentCount("FT.SEARCH")
+	}
+
+	// The query may arrive as a single argument or split on its spaces, so
+	// every argument up to the first option is part of it.
+	i := 1
+	for i < len(c.C.Args) && !isFTSearchOption(c.C.Args[i]) {
+		i++
+	}
+	q, err := types.ParseFTQuery(strings.Join(c.C.Args[1:i], " "))
+	if err != nil {
+		return FTSEARCHResNilRes, errors.ErrGeneral(err.Error())
+	}
+
+	offset, count, withDocs, highlight := 0, -1, false, false
+	open, close := "<b>", "</b>"
+	for ; i < len(c.C.Args); i++ {
+		switch strings.ToUpper(c.C.Args[i]) {
+		case "LIMIT":
+			if i+2 >= len(c.C.Args) {
+				return FTSEARCHResNilRes, errors.ErrInvalidSyntax("FT.SEARCH")
+			}
+			var err1, err2 error
+			offset, err1 = strconv.Atoi(c.C.Args[i+1])
+			count, err2 = strconv.Atoi(c.C.Args[i+2])
+			if err1 != nil || err2 != nil || offset < 0 || count < 0 {
+				return FTSEARCHResNilRes, errors.ErrIntegerOutOfRange
+			}
+			i += 2
+		case "WITHDOCS":
+			withDocs = true
+		case "HIGHLIGHT":
+			highlight = true
+			if i+1 < len(c.C.Args) && strings.EqualFold(c.C.Args[i+1], "TAGS") {
+				if i+3 >= len(c.C.Args) {
+					return FTSEARCHResNilRes, errors.ErrInvalidSyntax("FT.SEARCH")
+				}
+				open, close = c.C.Args[i+2], c.C.Args[i+3]
+				i += 3
+			}
+		default:
+			return FTSEARCHResNilRes, errors.ErrInvalidSyntax("FT.SEARCH")
+		}
+	}
+
+	indexes := make([]*fullTextKeyIndex, 0, len(stores))
+	stats := types.FTStats{}
+	for _, s := range stores {
+		idx, err := getFullTextKeyIndex(s, c.C.Args[0])
+		if err != nil {
+			return FTSEARCHResNilRes, err
+		}
+		indexes = append(indexes, idx)
+		stats.Merge(idx.Stats(q))
+	}
+	matches := []types.FTMatch{}
+	for _, idx := range indexes {
+		matches = append(matches, idx.Search(q, stats)...)
+	}
+	types.SortFTMatches(matches)
+
+	matches = matches[min(offset, len(matches)):]
+	if count >= 0 && count < len(matches) {
+		matches = matches[:count]
+	}
+
+	elements := make([]*wire.HElement, 0, len(matches))
+	for _, m := range matches {
+		if !withDocs && !highlight {
+			elements = append(elements, &wire.HElement{Key: m.Key, Value: strconv.FormatFloat(m.Score, 'f', -1, 64)})
+			continue
+		}
+		obj := storeForKey(m.Key).GetNoTouch(m.Key)
+		if obj == nil {
+			continue
+		}
+		doc, err := ftDocument(indexes[0], q, obj, highlight, open, close)
+		if err != nil {
+			return FTSEARCHResNilRes, err
+		}
+		elements = append(elements, &wire.HElement{Key: m.Key, Value: doc})
+	}
+	return newPairsRes(elements), nil
+}
+
+// ftDocument returns the document of a match: the value of a string, or
+// the fields of a hash as JSON, with the words matching the query
+// highlighted if highlight is set.
+func ftDocument(idx *fullTextKeyIndex, q *types.FTQuery, obj *object.Obj, highlight bool, open, close string) (string, error) {
+	if obj.Type != object.ObjTypeSSMap {
+		value, err := getWireValueFromObj(obj)
+		if err != nil || !highlight {
+			return value, err
+		}
+		return q.Highlight(value, open, close), nil
+	}
+
+	fields := maps.Collect(obj.Value.(*SSMap).All())
+	if highlight {
+		for _, f := range idx.Fields {
+			if v, ok := fields[f.Name]; ok {
+				fields[f.Name] = q.Highlight(v, open, close)
+			}
+		}
+	}
+	return marshalJSON(fields)
+}
+
+// getFullTextKeyIndex returns the full-text index named name held by the
+// store s.
+func getFullTextKeyIndex(s *dstore.Store, name string) (*fullTextKeyIndex, error) {
+	idx, ok := s.GetIndex(name).(*fullTextKeyIndex)
+	if !ok {
+		return nil, errors.ErrGeneral("no such full-text index '" + name + "'")
+	}
+	return idx, nil
+}
+
+func isFTSearchOption(arg string) bool {
+	switch strings.ToUpper(arg) {
+	case "LIMIT", "WITHDOCS", "HIGHLIGHT":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/eval/geo"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cGEOADD = &CommandMeta{
+	Name:      "GEOADD",
+	Syntax:    "GEOADD key [NX | XX] [CH] longitude latitude member [longitude latitude member ...]",
+	HelpShort: "GEOADD adds geospatial items to the sorted set stored at key",
+	HelpLong: `
+GEOADD adds the members at the given positions to the geospatial index stored at key. The index
+is a sorted set whose scores are the 52-bit geohashes of the positions, so it can also be read
+with the sorted set commands.
+
+Longitudes must be between -180 and 180 degrees and latitudes between -85.05112878 and
+85.05112878 degrees. If any of the positions is invalid, no member is added.
+
+- NX: Only add new members and do not update existing members
+- XX: Only update existing members and do not add new members
+- CH: Modify the return value from the number of new members added to the total number of members changed
+
+The command by default returns the number of members added to the index.
+	`,
+	Examples: `
+localhost:7379> GEOADD cities 13.361389 38.115556 Palermo 15.087269 37.502669 Catania
+OK 2
+localhost:7379> GEOADD cities CH 13.361389 38.115556 Palermo 15.087269 37.6 Catania
+OK 1
+	`,
+	Eval:    evalGEOADD,
+	Execute: executeGEOADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGEOADD)
+}
+
+var (
+	GEOADDResNilRes = newIntRes(0)
+)
+
+func evalGEOADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return GEOADDResNilRes, errors.ErrWrongArgumentCount("GEOADD")
+	}
+
+	key := c.C.Args[0]
+	var nx, xx, ch bool
+	i := 1
+	for ; i < len(c.C.Args); i++ {
+		switch strings.ToUpper(c.C.Args[i]) {
+		case "NX":
+			nx = true
+			continue
+		case "XX":
+			xx = true
+			continue
+		case "CH":
+			ch = true
+			continue
+		}
+		break
+	}
+
+	args := c.C.Args[i:]
+	if len(args) == 0 || len(args)%3 != 0 {
+		return GEOADDResNilRes, errors.ErrWrongArgumentCount("GEOADD")
+	}
+	if nx && xx {
+		return GEOADDResNilRes, errors.ErrGeneral("XX and NX options at the same time are not compatible")
+	}
+
+	// Validate every position before touching the index so that an invalid
+	// one leaves it unchanged.
+	hashes := make([]int64, 0, len(args)/3)
+	for j := 0; j < len(args); j += 3 {
+		lon, lat, err := parseLonLat(args[j], args[j+1])
+		if err != nil {
+			return GEOADDResNilRes, err
+		}
+		hashes = append(hashes, int64(geo.EncodeInt(lat, lon)))
+	}
+
+	ss, err := getSortedSet(s, key)
+	if err != nil {
+		return GEOADDResNilRes, err
+	}
+	if ss == nil {
+		if xx {
+			return GEOADDResNilRes, nil
+		}
+		ss = types.NewSortedSet()
+		s.Put(key, s.NewObj(ss, -1, object.ObjTypeSortedSet), dstore.WithPutCmd(dstore.ZAdd))
+	}
+
+	var added, changed int64
+	for j, hash := range hashes {
+		member := args[3*j+2]
+		score, exists := ss.Score(member)
+		if (nx && exists) || (xx && !exists) {
+			continue
+		}
+		if !exists {
+			added++
+		} else if int64(score) != hash {
+			changed++
+		}
+		ss.Upsert(member, float64(hash))
+	}
+
+	if ch {
+		return newIntRes(added + changed), nil
+	}
+	return newIntRes(added), nil
+}
+
+func executeGEOADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return GEOADDResNilRes, errors.ErrWrongArgumentCount("GEOADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalGEOADD(c, shard.Thread.Store())
+}
+
+// getGeoPos returns the position of member in the geospatial index, decoded
+// from its geohash score.
+func getGeoPos(ss *types.SortedSet, member string) (lon, lat float64, ok bool) {
+	if ss == nil {
+		return 0, 0, false
+	}
+	score, ok := ss.Score(member)
+	if !ok {
+		return 0, 0, false
+	}
+	lat, lon = geo.DecodeInt(score)
+	return lon, lat, true
+}
+
+// parseLonLat parses and validates a longitude and latitude pair. The
+// latitude is limited to the range covered by the Web Mercator projection.
+func parseLonLat(lonArg, latArg string) (lon, lat float64, err error) {
+	lon, err = strconv.ParseFloat(lonArg, 64)
+	if err != nil || math.IsNaN(lon) || lon < -180 || lon > 180 {
+		return 0, 0, errors.ErrGeneral("invalid longitude")
+	}
+	lat, err = strconv.ParseFloat(latArg, 64)
+	if err != nil || math.IsNaN(lat) || lat < -85.05112878 || lat > 85.05112878 {
+		return 0, 0, errors.ErrGeneral("invalid latitude")
+	}
+	return lon, lat, nil
+}
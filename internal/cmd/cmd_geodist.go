@@ -0,0 +1,79 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/eval/geo"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cGEODIST = &CommandMeta{
+	Name:      "GEODIST",
+	Syntax:    "GEODIST key member1 member2 [M | KM | FT | MI]",
+	HelpShort: "GEODIST returns the distance between two members of a geospatial index",
+	HelpLong: `
+GEODIST returns the distance between two members of the geospatial index stored at key, in
+meters by default or in the given unit: M (meters), KM (kilometers), FT (feet) or MI (miles).
+
+The distance is rounded to 4 decimal places. Returns an empty string if the key or either of
+the members does not exist.
+	`,
+	Examples: `
+localhost:7379> GEOADD cities 13.361389 38.115556 Palermo 15.087269 37.502669 Catania
+OK 2
+localhost:7379> GEODIST cities Palermo Catania KM
+OK 166.2741
+	`,
+	Eval:    evalGEODIST,
+	Execute: executeGEODIST,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGEODIST)
+}
+
+var (
+	GEODISTResNilRes = newValueRes("")
+)
+
+func evalGEODIST(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args) > 4 {
+		return GEODISTResNilRes, errors.ErrWrongArgumentCount("GEODIST")
+	}
+
+	unit := "m"
+	if len(c.C.Args) == 4 {
+		unit = strings.ToLower(c.C.Args[3])
+	}
+	metersPerUnit, err := geo.UnitToMeters(unit)
+	if err != nil {
+		return GEODISTResNilRes, err
+	}
+
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil {
+		return GEODISTResNilRes, err
+	}
+	lon1, lat1, ok1 := getGeoPos(ss, c.C.Args[1])
+	lon2, lat2, ok2 := getGeoPos(ss, c.C.Args[2])
+	if !ok1 || !ok2 {
+		return GEODISTResNilRes, nil
+	}
+
+	dist := geo.GetDistance(lon1, lat1, lon2, lat2) / metersPerUnit
+	return newValueRes(strconv.FormatFloat(dist, 'f', 4, 64)), nil
+}
+
+func executeGEODIST(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args) > 4 {
+		return GEODISTResNilRes, errors.ErrWrongArgumentCount("GEODIST")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalGEODIST(c, shard.Thread.Store())
+}
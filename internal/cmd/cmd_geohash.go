@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/eval/geo"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cGEOHASH = &CommandMeta{
+	Name:      "GEOHASH",
+	Syntax:    "GEOHASH key member [member ...]",
+	HelpShort: "GEOHASH returns the geohash strings of members of a geospatial index",
+	HelpLong: `
+GEOHASH returns the standard base32 geohash strings of the positions of the members of the
+geospatial index stored at key.
+
+Returns the members, in the order given, each paired with its geohash string, or with an empty
+string if the member or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> GEOADD cities 13.361389 38.115556 Palermo
+OK 1
+localhost:7379> GEOHASH cities Palermo Rome
+OK
+0) Palermo="sqc8b49rny"
+1) Rome=""
+	`,
+	Eval:    evalGEOHASH,
+	Execute: executeGEOHASH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGEOHASH)
+}
+
+var (
+	GEOHASHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalGEOHASH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return GEOHASHResNilRes, errors.ErrWrongArgumentCount("GEOHASH")
+	}
+
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil {
+		return GEOHASHResNilRes, err
+	}
+
+	members := c.C.Args[1:]
+	elements := make([]*wire.HElement, len(members))
+	for i, member := range members {
+		elements[i] = &wire.HElement{Key: member}
+		if lon, lat, ok := getGeoPos(ss, member); ok {
+			elements[i].Value = geo.EncodeString(lat, lon)
+		}
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeGEOHASH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return GEOHASHResNilRes, errors.ErrWrongArgumentCount("GEOHASH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalGEOHASH(c, shard.Thread.Store())
+}
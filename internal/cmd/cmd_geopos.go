@@ -0,0 +1,71 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cGEOPOS = &CommandMeta{
+	Name:      "GEOPOS",
+	Syntax:    "GEOPOS key member [member ...]",
+	HelpShort: "GEOPOS returns the positions of members of a geospatial index",
+	HelpLong: `
+GEOPOS returns the positions of the members of the geospatial index stored at key.
+
+Returns the members, in the order given, each paired with its longitude and latitude separated
+by a space, or with an empty string if the member or the key does not exist. Positions are
+decoded from the stored geohashes, so they may differ slightly from the ones that were added.
+	`,
+	Examples: `
+localhost:7379> GEOADD cities 13.361389 38.115556 Palermo
+OK 1
+localhost:7379> GEOPOS cities Palermo Rome
+OK
+0) Palermo="13.361387 38.115556"
+1) Rome=""
+	`,
+	Eval:    evalGEOPOS,
+	Execute: executeGEOPOS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGEOPOS)
+}
+
+var (
+	GEOPOSResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalGEOPOS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return GEOPOSResNilRes, errors.ErrWrongArgumentCount("GEOPOS")
+	}
+
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil {
+		return GEOPOSResNilRes, err
+	}
+
+	members := c.C.Args[1:]
+	elements := make([]*wire.HElement, len(members))
+	for i, member := range members {
+		elements[i] = &wire.HElement{Key: member}
+		if lon, lat, ok := getGeoPos(ss, member); ok {
+			elements[i].Value = formatGeoCoord(lon, lat)
+		}
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeGEOPOS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return GEOPOSResNilRes, errors.ErrWrongArgumentCount("GEOPOS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalGEOPOS(c, shard.Thread.Store())
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/eval/geo"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cGEOSEARCH = &CommandMeta{
+	Name: "GEOSEARCH",
+	Syntax: "GEOSEARCH key <FROMMEMBER member | FROMLONLAT longitude latitude> " +
+		"<BYRADIUS radius <M | KM | FT | MI> | BYBOX width height <M | KM | FT | MI>> " +
+		"[ASC | DESC] [COUNT count [ANY]] [WITHDIST] [WITHCOORD]",
+	HelpShort: "GEOSEARCH returns the members of a geospatial index within an area",
+	HelpLong: `
+GEOSEARCH returns the members of the geospatial index stored at key that lie within the given
+area. The center of the area is either the position of an existing member (FROMMEMBER) or the
+given position (FROMLONLAT), and the area is either a circle (BYRADIUS) or a rectangle aligned
+with the meridians (BYBOX) around it.
+
+- ASC, DESC: Sort the members from the nearest to the farthest from the center (the default) or the other way around
+- COUNT: Return at most count members
+- ANY: Together with COUNT, return as soon as count members are found instead of the nearest ones
+- WITHDIST: Return the distance of every member from the center, in the unit of the area
+- WITHCOORD: Return the longitude and latitude of every member
+
+Without WITHDIST and WITHCOORD the command returns the list of members. With either of them
+it returns the members paired with the requested values, separated by spaces, in the order
+distance, longitude, latitude. Returns an empty list if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> GEOADD cities 13.361389 38.115556 Palermo 15.087269 37.502669 Catania
+OK 2
+localhost:7379> GEOSEARCH cities FROMLONLAT 15 37 BYRADIUS 200 KM
+OK
+0) Catania
+1) Palermo
+localhost:7379> GEOSEARCH cities FROMMEMBER Palermo BYBOX 400 400 KM DESC COUNT 1 WITHDIST
+OK
+0) Catania="166.2741"
+	`,
+	Eval:    evalGEOSEARCH,
+	Execute: executeGEOSEARCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGEOSEARCH)
+}
+
+var (
+	GEOSEARCHResNilRes = newListRes([]string{})
+)
+
+// geoSearchQuery is a parsed GEOSEARCH or GEOSEARCHSTORE query. Distances
+// are kept in meters; metersPerUnit converts them back to the unit of the
+// query.
+type geoSearchQuery struct {
+	fromMember    string
+	fromLonLat    bool
+	lon, lat      float64
+	byRadius      bool
+	byBox         bool
+	radius        float64
+	width, height float64
+	metersPerUnit float64
+	desc          bool
+	count         int
+	any           bool
+	withDist      bool
+	withCoord     bool
+	storeDist     bool
+}
+
+// geoSearchMatch is a member of a geospatial index found by a search.
+type geoSearchMatch struct {
+	member   string
+	hash     int64
+	dist     float64
+	lon, lat float64
+}
+
+func evalGEOSEARCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 6 {
+		return GEOSEARCHResNilRes, errors.ErrWrongArgumentCount("GEOSEARCH")
+	}
+
+	q, err := parseGeoSearchQuery("GEOSEARCH", c.C.Args[1:], true)
+	if err != nil {
+		return GEOSEARCHResNilRes, err
+	}
+
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil {
+		return GEOSEARCHResNilRes, err
+	}
+	matches, err := searchGeoSet(ss, q)
+	if err != nil {
+		return GEOSEARCHResNilRes, err
+	}
+	return newGeoSearchRes(matches, q), nil
+}
+
+func executeGEOSEARCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 6 {
+		return GEOSEARCHResNilRes, errors.ErrWrongArgumentCount("GEOSEARCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalGEOSEARCH(c, shard.Thread.Store())
+}
+
+// parseGeoSearchQuery parses the arguments of a GEOSEARCH-like command that
+// follow its keys. The WITH options are only accepted if withOpts is set,
+// and the STOREDIST option of GEOSEARCHSTORE only if it is not.
+func parseGeoSearchQuery(cmd string, args []string, withOpts bool) (*geoSearchQuery, error) {
+	q := &geoSearchQuery{}
+	for i := 0; i < len(args); i++ {
+		// remaining is the number of arguments after the current one
+		remaining := len(args) - i - 1
+		switch opt := strings.ToUpper(args[i]); {
+		case opt == "FROMMEMBER" && remaining >= 1:
+			if q.fromMember != "" || q.fromLonLat {
+				return nil, errors.ErrGeneral("exactly one of FROMMEMBER or FROMLONLAT can be specified for " + cmd)
+			}
+			q.fromMember = args[i+1]
+			i++
+		case opt == "FROMLONLAT" && remaining >= 2:
+			if q.fromMember != "" || q.fromLonLat {
+				return nil, errors.ErrGeneral("exactly one of FROMMEMBER or FROMLONLAT can be specified for " + cmd)
+			}
+			lon, lat, err := parseLonLat(args[i+1], args[i+2])
+			if err != nil {
+				return nil, err
+			}
+			q.fromLonLat, q.lon, q.lat = true, lon, lat
+			i += 2
+		case opt == "BYRADIUS" && remaining >= 2:
+			if q.byRadius || q.byBox {
+				return nil, errors.ErrGeneral("exactly one of BYRADIUS and BYBOX can be specified for " + cmd)
+			}
+			radius, err := parseGeoDistance(args[i+1], "radius")
+			if err != nil {
+				return nil, err
+			}
+			if q.metersPerUnit, err = geo.UnitToMeters(strings.ToLower(args[i+2])); err != nil {
+				return nil, err
+			}
+			q.byRadius, q.radius = true, radius*q.metersPerUnit
+			i += 2
+		case opt == "BYBOX" && remaining >= 3:
+			if q.byRadius || q.byBox {
+				return nil, errors.ErrGeneral("exactly one of BYRADIUS and BYBOX can be specified for " + cmd)
+			}
+			width, err := parseGeoDistance(args[i+1], "width")
+			if err != nil {
+				return nil, err
+			}
+			height, err := parseGeoDistance(args[i+2], "height")
+			if err != nil {
+				return nil, err
+			}
+			if q.metersPerUnit, err = geo.UnitToMeters(strings.ToLower(args[i+3])); err != nil {
+				return nil, err
+			}
+			q.byBox, q.width, q.height = true, width*q.metersPerUnit, height*q.metersPerUnit
+			i += 3
+		case opt == "ASC":
+			q.desc = false
+		case opt == "DESC":
+			q.desc = true
+		case opt == "COUNT" && remaining >= 1:
+			count, err := strconv.Atoi(args[i+1])
+			if err != nil {
+				return nil, errors.ErrIntegerOutOfRange
+			}
+			if count <= 0 {
+				return nil, errors.ErrGeneral("COUNT must be > 0")
+			}
+			q.count = count
+			i++
+		case opt == "ANY":
+			q.any = true
+		case opt == "WITHDIST" && withOpts:
+			q.withDist = true
+		case opt == "WITHCOORD" && withOpts:
+			q.withCoord = true
+		case opt == "STOREDIST" && !withOpts:
+			q.storeDist = true
+		default:
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+	}
+
+	if q.fromMember == "" && !q.fromLonLat {
+		return nil, errors.ErrGeneral("exactly one of FROMMEMBER or FROMLONLAT can be specified for " + cmd)
+	}
+	if !q.byRadius && !q.byBox {
+		return nil, errors.ErrGeneral("exactly one of BYRADIUS and BYBOX can be specified for " + cmd)
+	}
+	if q.any && q.count == 0 {
+		return nil, errors.ErrGeneral("the ANY argument requires COUNT argument")
+	}
+	return q, nil
+}
+
+// parseGeoDistance parses a non-negative distance, such as a radius, named
+// name in error messages.
+func parseGeoDistance(arg, name string) (float64, error) {
+	d, err := strconv.ParseFloat(arg, 64)
+	if err != nil || math.IsNaN(d) || math.IsInf(d, 0) {
+		return 0, errors.ErrGeneral("need numeric " + name)
+	}
+	if d < 0 {
+		return 0, errors.ErrGeneral(name + " cannot be negative")
+	}
+	return d, nil
+}
+
+// searchGeoSet returns the members of the geospatial index that lie within
+// the area of the query, sorted and limited as the query asks for. A nil
+// index holds no members.
+func searchGeoSet(ss *types.SortedSet, q *geoSearchQuery) ([]geoSearchMatch, error) {
+	if ss == nil {
+		return nil, nil
+	}
+
+	lon, lat := q.lon, q.lat
+	if !q.fromLonLat {
+		var ok bool
+		if lon, lat, ok = getGeoPos(ss, q.fromMember); !ok {
+			return nil, errors.ErrGeneral("could not decode requested zset member")
+		}
+	}
+
+	// A box is searched through the cells that cover the circle around it.
+	radius := q.radius
+	if q.byBox {
+		radius = math.Hypot(q.width, q.height) / 2
+	}
+
+	var matches []geoSearchMatch
+	for _, r := range geo.SearchRanges(lat, lon, radius) {
+		elements := ss.RangeByScore(types.ScoreBound{Value: r.Min}, types.ScoreBound{Value: r.Max, Exclusive: true}, false, 0, -1)
+		for _, e := range elements {
+			m := geoSearchMatch{member: e.Member, hash: int64(e.Score)}
+			m.lat, m.lon = geo.DecodeInt(e.Score)
+			var ok bool
+			if m.dist, ok = geoDistanceInArea(q, lon, lat, m.lon, m.lat); !ok {
+				continue
+			}
+			matches = append(matches, m)
+			if q.any && len(matches) == q.count {
+				break
+			}
+		}
+		if q.any && len(matches) == q.count {
+			break
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].dist != matches[j].dist {
+			return (matches[i].dist < matches[j].dist) != q.desc
+		}
+		return matches[i].member < matches[j].member
+	})
+	if q.count > 0 && len(matches) > q.count {
+		matches = matches[:q.count]
+	}
+	return matches, nil
+}
+
+// geoDistanceInArea returns the distance in meters between the center of
+// the search area and a point, and whether the point lies within the area.
+func geoDistanceInArea(q *geoSearchQuery, centerLon, centerLat, lon, lat float64) (float64, bool) {
+	if q.byRadius {
+		dist := geo.GetDistance(centerLon, centerLat, lon, lat)
+		return dist, dist <= q.radius
+	}
+
+	// The height of the box is measured along the meridian of the center,
+	// and its width along the parallel of the point.
+	if geo.GetLatDistance(centerLat, lat) > q.height/2 {
+		return 0, false
+	}
+	if geo.GetDistance(centerLon, lat, lon, lat) > q.width/2 {
+		return 0, false
+	}
+	return geo.GetDistance(centerLon, centerLat, lon, lat), true
+}
+
+// newGeoSearchRes builds the reply to a GEOSEARCH query: the list of
+// members, or the members paired with the values asked for by the WITH
+// options.
+func newGeoSearchRes(matches []geoSearchMatch, q *geoSearchQuery) *CmdRes {
+	if !q.withDist && !q.withCoord {
+		members := make([]string, len(matches))
+		for i, m := range matches {
+			members[i] = m.member
+		}
+		return newListRes(members)
+	}
+
+	elements := make([]*wire.HElement, len(matches))
+	for i, m := range matches {
+		values := make([]string, 0, 2)
+		if q.withDist {
+			values = append(values, strconv.FormatFloat(m.dist/q.metersPerUnit, 'f', 4, 64))
+		}
+		if q.withCoord {
+			values = append(values, formatGeoCoord(m.lon, m.lat))
+		}
+		elements[i] = &wire.HElement{Key: m.member, Value: strings.Join(values, " ")}
+	}
+	return newPairsRes(elements)
+}
+
+// formatGeoCoord formats a position as its longitude and latitude separated
+// by a space.
+func formatGeoCoord(lon, lat float64) string {
+	return strconv.FormatFloat(lon, 'f', 6, 64) + " " + strconv.FormatFloat(lat, 'f', 6, 64)
+}
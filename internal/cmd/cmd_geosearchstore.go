@@ -0,0 +1,106 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cGEOSEARCHSTORE = &CommandMeta{
+	Name: "GEOSEARCHSTORE",
+	Syntax: "GEOSEARCHSTORE destination source <FROMMEMBER member | FROMLONLAT longitude latitude> " +
+		"<BYRADIUS radius <M | KM | FT | MI> | BYBOX width height <M | KM | FT | MI>> " +
+		"[ASC | DESC] [COUNT count [ANY]] [STOREDIST]",
+	HelpShort: "GEOSEARCHSTORE stores the members of a geospatial index within an area in destination",
+	HelpLong: `
+GEOSEARCHSTORE finds the members of the geospatial index stored at source that lie within the
+given area, like GEOSEARCH, and stores them with their positions as a geospatial index in
+destination. An existing destination is overwritten, and deleted if no member is found.
+
+With STOREDIST, destination is instead a sorted set whose scores are the distances of the
+members from the center, in the unit of the area.
+
+The source and the destination may live on different shards.
+
+Returns the number of members in the resulting index.
+	`,
+	Examples: `
+localhost:7379> GEOADD cities 13.361389 38.115556 Palermo 15.087269 37.502669 Catania
+OK 2
+localhost:7379> GEOSEARCHSTORE near cities FROMLONLAT 15 37 BYRADIUS 100 KM
+OK 1
+localhost:7379> GEOPOS near Catania
+OK
+0) Catania="15.087265 37.502668"
+localhost:7379> GEOSEARCHSTORE neardist cities FROMLONLAT 15 37 BYRADIUS 100 KM STOREDIST
+OK 1
+localhost:7379> ZSCORE neardist Catania
+OK 56.44118143671698
+	`,
+	Eval:    evalGEOSEARCHSTORE,
+	Execute: executeGEOSEARCHSTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGEOSEARCHSTORE)
+}
+
+var (
+	GEOSEARCHSTOREResNilRes = newIntRes(0)
+)
+
+func evalGEOSEARCHSTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 7 {
+		return GEOSEARCHSTOREResNilRes, errors.ErrWrongArgumentCount("GEOSEARCHSTORE")
+	}
+	return geoSearchStore(c, localStore(s))
+}
+
+func executeGEOSEARCHSTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 7 {
+		return GEOSEARCHSTOREResNilRes, errors.ErrWrongArgumentCount("GEOSEARCHSTORE")
+	}
+	return geoSearchStore(c, shardStores(sm))
+}
+
+// geoSearchStore searches the source index and stores the matches at the
+// destination, reading and writing each key in the store storeForKey
+// returns for it.
+func geoSearchStore(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	dst, src := c.C.Args[0], c.C.Args[1]
+	q, err := parseGeoSearchQuery("GEOSEARCHSTORE", c.C.Args[2:], false)
+	if err != nil {
+		return GEOSEARCHSTOREResNilRes, err
+	}
+
+	ss, err := getSortedSet(storeForKey(src), src)
+	if err != nil {
+		return GEOSEARCHSTOREResNilRes, err
+	}
+	matches, err := searchGeoSet(ss, q)
+	if err != nil {
+		return GEOSEARCHSTOREResNilRes, err
+	}
+
+	s := storeForKey(dst)
+	if len(matches) == 0 {
+		s.Del(dst)
+		return GEOSEARCHSTOREResNilRes, nil
+	}
+
+	result := types.NewSortedSet()
+	for _, m := range matches {
+		if q.storeDist {
+			result.Upsert(m.member, m.dist/q.metersPerUnit)
+		} else {
+			result.Upsert(m.member, float64(m.hash))
+		}
+	}
+	s.Put(dst, s.NewObj(result, -1, object.ObjTypeSortedSet))
+	return newIntRes(int64(len(matches))), nil
+}
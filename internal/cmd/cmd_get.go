@@ -6,10 +6,12 @@ package cmd
 import (
 	"fmt"
 
+	"github.com/axiomhq/hyperloglog"
 	"github.com/dicedb/dice/internal/errors"
 	"github.com/dicedb/dice/internal/object"
 	"github.com/dicedb/dice/internal/shardmanager"
 	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
 	"github.com/dicedb/dicedb-go/wire"
 )
 
@@ -95,8 +97,14 @@ func getWireValueFromObj(obj *object.Obj) (string, error) {
 		return fmt.Sprintf("%d", obj.Value.(int64)), nil
 	case object.ObjTypeString:
 		return obj.Value.(string), nil
-	case object.ObjTypeByteArray, object.ObjTypeHLL:
-		return string(obj.Value.([]byte)), nil
+	case object.ObjTypeByteArray:
+		return string(obj.Value.(*types.ByteArray).Bytes()), nil
+	case object.ObjTypeHLL:
+		b, err := obj.Value.(*hyperloglog.Sketch).MarshalBinary()
+		if err != nil {
+			return "", errors.ErrCorruptedHyperLogLogObject
+		}
+		return string(b), nil
 	case object.ObjTypeFloat:
 		return fmt.Sprintf("%f", obj.Value.(float64)), nil
 	default:
@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cGETBIT = &CommandMeta{
+	Name:      "GETBIT",
+	Syntax:    "GETBIT key offset",
+	HelpShort: "GETBIT returns the bit at offset in the string value stored at key",
+	HelpLong: `
+GETBIT returns the bit at offset in the string value stored at key. Bits are numbered from the
+most significant bit of the first byte.
+
+Returns 0 if offset is past the end of the value or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SETBIT users:active 7 1
+OK 0
+localhost:7379> GETBIT users:active 7
+OK 1
+localhost:7379> GETBIT users:active 100
+OK 0
+	`,
+	Eval:    evalGETBIT,
+	Execute: executeGETBIT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGETBIT)
+}
+
+var (
+	GETBITResNilRes = newIntRes(0)
+)
+
+func evalGETBIT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return GETBITResNilRes, errors.ErrWrongArgumentCount("GETBIT")
+	}
+
+	offset, err := parseBitOffset(c.C.Args[1])
+	if err != nil {
+		return GETBITResNilRes, err
+	}
+
+	b, err := getBitmapBytes(s, c.C.Args[0])
+	if err != nil {
+		return GETBITResNilRes, err
+	}
+	if offset/8 >= len(b) || b[offset/8]&(1<<(7-offset%8)) == 0 {
+		return newIntRes(0), nil
+	}
+	return newIntRes(1), nil
+}
+
+func executeGETBIT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return GETBITResNilRes, errors.ErrWrongArgumentCount("GETBIT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalGETBIT(c, shard.Thread.Store())
+}
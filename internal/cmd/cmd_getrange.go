@@ -0,0 +1,90 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cGETRANGE = &CommandMeta{
+	Name:      "GETRANGE",
+	Syntax:    "GETRANGE key start end",
+	HelpShort: "GETRANGE returns the substring of the string stored at key between start and end",
+	HelpLong: `
+GETRANGE returns the substring of the string stored at key between the offsets start and end,
+both included. Negative offsets count from the end of the string, -1 being the last byte. The
+range is clamped to the string.
+
+Returns an empty string if the key does not exist or the range is empty.
+	`,
+	Examples: `
+localhost:7379> SET k "hello world"
+OK
+localhost:7379> GETRANGE k 0 4
+OK "hello"
+localhost:7379> GETRANGE k -5 -1
+OK "world"
+localhost:7379> GETRANGE k 6 100
+OK "world"
+	`,
+	Eval:    evalGETRANGE,
+	Execute: executeGETRANGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cGETRANGE)
+}
+
+var (
+	GETRANGEResNilRes = newValueRes("")
+)
+
+func evalGETRANGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return GETRANGEResNilRes, errors.ErrWrongArgumentCount("GETRANGE")
+	}
+
+	start, err := strconv.Atoi(c.C.Args[1])
+	if err != nil {
+		return GETRANGEResNilRes, errors.ErrIntegerOutOfRange
+	}
+	end, err := strconv.Atoi(c.C.Args[2])
+	if err != nil {
+		return GETRANGEResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	obj := s.Get(c.C.Args[0])
+	if obj == nil {
+		return GETRANGEResNilRes, nil
+	}
+	value, err := getStringFromObj(obj)
+	if err != nil {
+		return GETRANGEResNilRes, err
+	}
+
+	n := len(value)
+	if start < 0 {
+		start = max(n+start, 0)
+	}
+	if end < 0 {
+		end = n + end
+	}
+	end = min(end, n-1)
+	if start > end {
+		return GETRANGEResNilRes, nil
+	}
+	return newValueRes(value[start : end+1]), nil
+}
+
+func executeGETRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return GETRANGEResNilRes, errors.ErrWrongArgumentCount("GETRANGE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalGETRANGE(c, shard.Thread.Store())
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHDEL = &CommandMeta{
+	Name:      "HDEL",
+	Syntax:    "HDEL key field [field ...]",
+	HelpShort: "HDEL removes the specified fields from the string-string map stored at key",
+	HelpLong: `
+HDEL removes the specified fields from the string-string map stored at key.
+Fields that do not exist in the map are ignored. The key is deleted once
+its last field is removed.
+
+The command returns the number of fields that were removed.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1 f2 v2
+OK 2
+localhost:7379> HDEL k1 f1 f3
+OK 1
+localhost:7379> HGETALL k1
+OK
+0) f2="v2"
+	`,
+	Eval:    evalHDEL,
+	Execute: executeHDEL,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHDEL)
+}
+
+var (
+	HDELResNilRes = newIntRes(0)
+)
+
+func evalHDEL(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return HDELResNilRes, errors.ErrWrongArgumentCount("HDEL")
+	}
+
+	key := c.C.Args[0]
+	m, err := getSSMap(s, key)
+	if err != nil || m == nil {
+		return HDELResNilRes, err
+	}
+
+	var count int64
+	for _, field := range c.C.Args[1:] {
+		if m.Del(field) {
+			count++
+		}
+	}
+	if m.Len() == 0 {
+		s.Del(key)
+	} else if count > 0 {
+		s.Reindex(key)
+	}
+	return newIntRes(count), nil
+}
+
+func executeHDEL(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return HDELResNilRes, errors.ErrWrongArgumentCount("HDEL")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHDEL(c, shard.Thread.Store())
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHEXISTS = &CommandMeta{
+	Name:      "HEXISTS",
+	Syntax:    "HEXISTS key field",
+	HelpShort: "HEXISTS checks if field exists in the string-string map stored at key",
+	HelpLong: `
+HEXISTS checks if field exists in the string-string map stored at key.
+
+Returns 1 if the field exists, and 0 if it does not or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1
+OK 1
+localhost:7379> HEXISTS k1 f1
+OK 1
+localhost:7379> HEXISTS k1 f2
+OK 0
+	`,
+	Eval:    evalHEXISTS,
+	Execute: executeHEXISTS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHEXISTS)
+}
+
+var (
+	HEXISTSResNilRes = newIntRes(0)
+)
+
+func evalHEXISTS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return HEXISTSResNilRes, errors.ErrWrongArgumentCount("HEXISTS")
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HEXISTSResNilRes, err
+	}
+	if _, ok := m.Get(c.C.Args[1]); !ok {
+		return HEXISTSResNilRes, nil
+	}
+	return newIntRes(1), nil
+}
+
+func executeHEXISTS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return HEXISTSResNilRes, errors.ErrWrongArgumentCount("HEXISTS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHEXISTS(c, shard.Thread.Store())
+}
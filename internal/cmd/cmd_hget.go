@@ -64,7 +64,7 @@ func evalHGET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 		return HGETResNilRes, nil
 	}
 
-	m, ok := obj.Value.(SSMap)
+	m, ok := obj.Value.(*SSMap)
 	if !ok {
 		return HGETResNilRes, errors.ErrWrongTypeOperation
 	}
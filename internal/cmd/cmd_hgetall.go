@@ -60,22 +60,22 @@ var (
 
 func evalHGETALL(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 	key := c.C.Args[0]
-	var m SSMap
+	var m *SSMap
 
 	obj := s.Get(key)
 	if obj != nil {
 		if err := object.AssertType(obj.Type, object.ObjTypeSSMap); err != nil {
 			return HGETALLResNilRes, errors.ErrWrongTypeOperation
 		}
-		m = obj.Value.(SSMap)
+		m = obj.Value.(*SSMap)
 	}
 
-	if len(m) == 0 {
+	if m.Len() == 0 {
 		return HGETALLResNilRes, nil
 	}
 
-	elements := make([]*wire.HElement, 0, len(m))
-	for k, v := range m {
+	elements := make([]*wire.HElement, 0, m.Len())
+	for k, v := range m.All() {
 		elements = append(elements, &wire.HElement{Key: k, Value: v})
 	}
 
@@ -0,0 +1,84 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHINCRBY = &CommandMeta{
+	Name:      "HINCRBY",
+	Syntax:    "HINCRBY key field increment",
+	HelpShort: "HINCRBY increments the integer value of field in the string-string map stored at key",
+	HelpLong: `
+HINCRBY increments the integer value of field in the string-string map stored at key by increment.
+A new map is created if the key does not exist, and a missing field is treated as 0.
+
+The range of values supported by HINCRBY is limited to 64-bit signed integers.
+The command returns the value of the field after the increment.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 5
+OK 1
+localhost:7379> HINCRBY k1 f1 10
+OK 15
+localhost:7379> HINCRBY k1 f2 -3
+OK -3
+	`,
+	Eval:    evalHINCRBY,
+	Execute: executeHINCRBY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHINCRBY)
+}
+
+var (
+	HINCRBYResNilRes = newIntRes(0)
+)
+
+func evalHINCRBY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return HINCRBYResNilRes, errors.ErrWrongArgumentCount("HINCRBY")
+	}
+
+	incr, err := strconv.ParseInt(c.C.Args[2], 10, 64)
+	if err != nil {
+		return HINCRBYResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	m, err := getOrCreateSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HINCRBYResNilRes, err
+	}
+
+	field := c.C.Args[1]
+	var value int64
+	if val, ok := m.Get(field); ok {
+		if value, err = strconv.ParseInt(val, 10, 64); err != nil {
+			return HINCRBYResNilRes, errors.ErrGeneral(errors.HashValueNotIntegerErr)
+		}
+	}
+	if (incr > 0 && value > math.MaxInt64-incr) || (incr < 0 && value < math.MinInt64-incr) {
+		return HINCRBYResNilRes, errors.ErrGeneral(errors.IncrDecrOverflowErr)
+	}
+
+	value += incr
+	m.Set(field, strconv.FormatInt(value, 10))
+	s.Reindex(c.C.Args[0])
+	return newIntRes(value), nil
+}
+
+func executeHINCRBY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return HINCRBYResNilRes, errors.ErrWrongArgumentCount("HINCRBY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHINCRBY(c, shard.Thread.Store())
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHINCRBYFLOAT = &CommandMeta{
+	Name:      "HINCRBYFLOAT",
+	Syntax:    "HINCRBYFLOAT key field increment",
+	HelpShort: "HINCRBYFLOAT increments the floating point value of field in the string-string map stored at key",
+	HelpLong: `
+HINCRBYFLOAT increments the floating point value of field in the string-string map stored at key by increment.
+A new map is created if the key does not exist, and a missing field is treated as 0.
+
+The command returns the value of the field after the increment.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 10.5
+OK 1
+localhost:7379> HINCRBYFLOAT k1 f1 0.1
+OK "10.6"
+localhost:7379> HINCRBYFLOAT k1 f2 -5
+OK "-5"
+	`,
+	Eval:    evalHINCRBYFLOAT,
+	Execute: executeHINCRBYFLOAT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHINCRBYFLOAT)
+}
+
+var (
+	HINCRBYFLOATResNilRes = newValueRes("")
+)
+
+func evalHINCRBYFLOAT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return HINCRBYFLOATResNilRes, errors.ErrWrongArgumentCount("HINCRBYFLOAT")
+	}
+
+	incr, err := strconv.ParseFloat(strings.TrimSpace(c.C.Args[2]), 64)
+	if err != nil || math.IsNaN(incr) || math.IsInf(incr, 0) {
+		return HINCRBYFLOATResNilRes, errors.ErrInvalidNumberFormat
+	}
+
+	m, err := getOrCreateSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HINCRBYFLOATResNilRes, err
+	}
+
+	field := c.C.Args[1]
+	var value float64
+	if val, ok := m.Get(field); ok {
+		if value, err = strconv.ParseFloat(strings.TrimSpace(val), 64); err != nil {
+			return HINCRBYFLOATResNilRes, errors.ErrGeneral(errors.IntOrFloatErr)
+		}
+	}
+
+	value += incr
+	if math.IsInf(value, 0) {
+		return HINCRBYFLOATResNilRes, errors.ErrGeneral(errors.IncrDecrOverflowErr)
+	}
+
+	formatted := strconv.FormatFloat(value, 'f', -1, 64)
+	m.Set(field, formatted)
+	s.Reindex(c.C.Args[0])
+	return newValueRes(formatted), nil
+}
+
+func executeHINCRBYFLOAT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return HINCRBYFLOATResNilRes, errors.ErrWrongArgumentCount("HINCRBYFLOAT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHINCRBYFLOAT(c, shard.Thread.Store())
+}
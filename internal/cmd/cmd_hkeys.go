@@ -0,0 +1,64 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHKEYS = &CommandMeta{
+	Name:      "HKEYS",
+	Syntax:    "HKEYS key",
+	HelpShort: "HKEYS returns all the fields of the string-string map stored at key",
+	HelpLong: `
+HKEYS returns all the fields of the string-string map stored at key.
+
+The command returns an empty list if the key does not exist. Note that the order of the fields is not guaranteed.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1 f2 v2
+OK 2
+localhost:7379> HKEYS k1
+OK
+0) f1
+1) f2
+	`,
+	Eval:    evalHKEYS,
+	Execute: executeHKEYS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHKEYS)
+}
+
+var (
+	HKEYSResNilRes = newListRes([]string{})
+)
+
+func evalHKEYS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return HKEYSResNilRes, errors.ErrWrongArgumentCount("HKEYS")
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HKEYSResNilRes, err
+	}
+
+	fields := make([]string, 0, m.Len())
+	for field := range m.All() {
+		fields = append(fields, field)
+	}
+	return newListRes(fields), nil
+}
+
+func executeHKEYS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return HKEYSResNilRes, errors.ErrWrongArgumentCount("HKEYS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHKEYS(c, shard.Thread.Store())
+}
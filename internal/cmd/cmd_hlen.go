@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHLEN = &CommandMeta{
+	Name:      "HLEN",
+	Syntax:    "HLEN key",
+	HelpShort: "HLEN returns the number of fields in the string-string map stored at key",
+	HelpLong: `
+HLEN returns the number of fields in the string-string map stored at key.
+
+The command returns 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1 f2 v2
+OK 2
+localhost:7379> HLEN k1
+OK 2
+localhost:7379> HLEN k2
+OK 0
+	`,
+	Eval:        evalHLEN,
+	Execute:     executeHLEN,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHLEN)
+}
+
+var (
+	HLENResNilRes = newIntRes(0)
+)
+
+func evalHLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return HLENResNilRes, errors.ErrWrongArgumentCount("HLEN")
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HLENResNilRes, err
+	}
+	return newIntRes(int64(m.Len())), nil
+}
+
+func executeHLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return HLENResNilRes, errors.ErrWrongArgumentCount("HLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHLEN(c, shard.Thread.Store())
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHLENWATCH = &CommandMeta{
+	Name:      "HLEN.WATCH",
+	Syntax:    "HLEN.WATCH key",
+	HelpShort: "HLEN.WATCH creates a query subscription over the HLEN command",
+	HelpLong: `
+HLEN.WATCH creates a query subscription over the HLEN command. The client invoking the command
+will receive the output of the HLEN command (not just the notification) whenever the
+string-string map stored at the key is updated.
+	`,
+	Examples: `
+client1:7379> HSET k1 f1 v1
+OK 1
+client1:7379> HLEN.WATCH k1
+entered the watch mode for HLEN.WATCH k1
+
+
+client2:7379> HSET k1 f2 v2
+OK 1
+
+
+client1:7379> ...
+entered the watch mode for HLEN.WATCH k1
+OK [fingerprint=1371327417] 2
+	`,
+	Eval:    evalHLENWATCH,
+	Execute: executeHLENWATCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHLENWATCH)
+}
+
+var (
+	HLENWATCHResNilRes = newIntRes(0)
+)
+
+func evalHLENWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	r, err := evalHLEN(c, s)
+	if err != nil {
+		return HLENWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeHLENWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return HLENWATCHResNilRes, errors.ErrWrongArgumentCount("HLEN.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHLENWATCH(c, shard.Thread.Store())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cHMGET = &CommandMeta{
+	Name:      "HMGET",
+	Syntax:    "HMGET key field [field ...]",
+	HelpShort: "HMGET returns the values of the specified fields from the string-string map stored at key",
+	HelpLong: `
+HMGET returns the values of the specified fields from the string-string map stored at key.
+
+The command returns one field-value pair per requested field, in the order they
+were requested. Fields that do not exist, or all fields if the key does not exist,
+are returned with an empty value.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1 f2 v2
+OK 2
+localhost:7379> HMGET k1 f1 f3 f2
+OK
+0) f1="v1"
+1) f3=""
+2) f2="v2"
+	`,
+	Eval:        evalHMGET,
+	Execute:     executeHMGET,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHMGET)
+}
+
+var (
+	HMGETResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalHMGET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return HMGETResNilRes, errors.ErrWrongArgumentCount("HMGET")
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HMGETResNilRes, err
+	}
+
+	fields := c.C.Args[1:]
+	elements := make([]*wire.HElement, len(fields))
+	for i, field := range fields {
+		value, _ := m.Get(field)
+		elements[i] = &wire.HElement{Key: field, Value: value}
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeHMGET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return HMGETResNilRes, errors.ErrWrongArgumentCount("HMGET")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHMGET(c, shard.Thread.Store())
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cHMGETWATCH = &CommandMeta{
+	Name:      "HMGET.WATCH",
+	Syntax:    "HMGET.WATCH key field [field ...]",
+	HelpShort: "HMGET.WATCH creates a query subscription over the HMGET command",
+	HelpLong: `
+HMGET.WATCH creates a query subscription over the HMGET command. The client invoking the command
+will receive the output of the HMGET command (not just the notification) whenever the
+string-string map stored at the key is updated.
+	`,
+	Examples: `
+client1:7379> HSET k1 f1 v1
+OK 1
+client1:7379> HMGET.WATCH k1 f1 f2
+entered the watch mode for HMGET.WATCH k1 f1 f2
+
+
+client2:7379> HSET k1 f2 v2
+OK 1
+
+
+client1:7379> ...
+entered the watch mode for HMGET.WATCH k1 f1 f2
+OK [fingerprint=2409381024]
+0) f1="v1"
+1) f2="v2"
+	`,
+	Eval:    evalHMGETWATCH,
+	Execute: executeHMGETWATCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHMGETWATCH)
+}
+
+var (
+	HMGETWATCHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalHMGETWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	r, err := evalHMGET(c, s)
+	if err != nil {
+		return HMGETWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeHMGETWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return HMGETWATCHResNilRes, errors.ErrWrongArgumentCount("HMGET.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHMGETWATCH(c, shard.Thread.Store())
+}
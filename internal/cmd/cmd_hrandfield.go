@@ -0,0 +1,130 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cHRANDFIELD = &CommandMeta{
+	Name:      "HRANDFIELD",
+	Syntax:    "HRANDFIELD key [count [WITHVALUES]]",
+	HelpShort: "HRANDFIELD returns random fields from the string-string map stored at key",
+	HelpLong: `
+HRANDFIELD returns random fields from the string-string map stored at key.
+
+Without count, a single random field is returned, or an empty value if the key does not exist.
+
+With a positive count, up to count distinct fields are returned as a list. With a negative
+count, exactly |count| fields are returned and the same field may appear more than once.
+
+With WITHVALUES, the fields are returned as field-value pairs.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1 f2 v2 f3 v3
+OK 3
+localhost:7379> HRANDFIELD k1
+OK "f2"
+localhost:7379> HRANDFIELD k1 2
+OK
+0) f3
+1) f1
+localhost:7379> HRANDFIELD k1 -2 WITHVALUES
+OK
+0) f1="v1"
+1) f1="v1"
+	`,
+	Eval:    evalHRANDFIELD,
+	Execute: executeHRANDFIELD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHRANDFIELD)
+}
+
+var (
+	HRANDFIELDResNilRes = newValueRes("")
+)
+
+func evalHRANDFIELD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 3 {
+		return HRANDFIELDResNilRes, errors.ErrWrongArgumentCount("HRANDFIELD")
+	}
+
+	count := int64(1)
+	withValues := false
+	if len(c.C.Args) > 1 {
+		var err error
+		if count, err = strconv.ParseInt(c.C.Args[1], 10, 64); err != nil {
+			return HRANDFIELDResNilRes, errors.ErrIntegerOutOfRange
+		}
+		if count < math.MinInt32 {
+			return HRANDFIELDResNilRes, errors.ErrValueOutOfRange
+		}
+		if len(c.C.Args) == 3 {
+			if !strings.EqualFold(c.C.Args[2], "WITHVALUES") {
+				return HRANDFIELDResNilRes, errors.ErrInvalidSyntax("HRANDFIELD")
+			}
+			withValues = true
+		}
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HRANDFIELDResNilRes, err
+	}
+
+	fields := make([]string, 0, m.Len())
+	for field := range m.All() {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	if count >= 0 {
+		rand.Shuffle(len(fields), func(i, j int) {
+			fields[i], fields[j] = fields[j], fields[i]
+		})
+		fields = fields[:min(count, int64(len(fields)))]
+	} else if len(fields) > 0 {
+		// A negative count allows repeats, so fields are picked
+		// independently of each other.
+		picked := make([]string, -count)
+		for i := range picked {
+			picked[i] = fields[rand.Intn(len(fields))]
+		}
+		fields = picked
+	}
+
+	switch {
+	case withValues:
+		elements := make([]*wire.HElement, len(fields))
+		for i, field := range fields {
+			value, _ := m.Get(field)
+			elements[i] = &wire.HElement{Key: field, Value: value}
+		}
+		return newPairsRes(elements), nil
+	case len(c.C.Args) > 1:
+		return newListRes(fields), nil
+	case len(fields) == 0:
+		return HRANDFIELDResNilRes, nil
+	}
+	return newValueRes(fields[0]), nil
+}
+
+func executeHRANDFIELD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 3 {
+		return HRANDFIELDResNilRes, errors.ErrWrongArgumentCount("HRANDFIELD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHRANDFIELD(c, shard.Thread.Store())
+}
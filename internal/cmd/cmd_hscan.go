@@ -0,0 +1,92 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHSCAN = &CommandMeta{
+	Name:      "HSCAN",
+	Syntax:    "HSCAN key cursor [MATCH pattern] [COUNT count]",
+	HelpShort: "HSCAN incrementally iterates over the fields of the string-string map stored at key",
+	HelpLong: `
+HSCAN incrementally iterates over the fields of the string-string map stored at key.
+
+Start the iteration with cursor 0 and pass the cursor returned by each call to the next one.
+The iteration is complete when the returned cursor is 0. Like SCAN, every field present from
+the start to the end of the iteration is returned, even if the map is modified in between.
+
+COUNT sets how many fields are examined per call (defaults to 10), and MATCH only returns
+the fields matching the glob-style pattern among them.
+
+The first element of the reply is the next cursor, followed by the field and value of
+every returned field.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1 f2 v2 f3 v3
+OK 3
+localhost:7379> HSCAN k1 0 COUNT 2
+OK
+0) 246058661394715
+1) f3
+2) v3
+3) f2
+4) v2
+localhost:7379> HSCAN k1 246058661394715 COUNT 2
+OK
+0) 0
+1) f1
+2) v1
+	`,
+	Eval:    evalHSCAN,
+	Execute: executeHSCAN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHSCAN)
+}
+
+var (
+	HSCANResNilRes = newListRes([]string{"0"})
+)
+
+func evalHSCAN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return HSCANResNilRes, errors.ErrWrongArgumentCount("HSCAN")
+	}
+	o, err := parseScanOptions("HSCAN", c.C.Args[1:], false)
+	if err != nil {
+		return HSCANResNilRes, err
+	}
+	if shard, _ := decodeScanCursor(o.cursor); shard != 0 {
+		return HSCANResNilRes, errors.ErrGeneral("invalid cursor")
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HSCANResNilRes, err
+	}
+
+	fields, next := scanCollection(m.ScanFields, o)
+	values := make([]string, 0, 1+2*len(fields))
+	values = append(values, strconv.FormatUint(next, 10))
+	for _, field := range fields {
+		value, _ := m.Get(field)
+		values = append(values, field, value)
+	}
+	return newListRes(values), nil
+}
+
+func executeHSCAN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return HSCANResNilRes, errors.ErrWrongArgumentCount("HSCAN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHSCAN(c, shard.Thread.Store())
+}
@@ -4,14 +4,28 @@
 package cmd
 
 import (
+	"bytes"
+	"iter"
+	"maps"
+
 	"github.com/dicedb/dice/internal/errors"
 	"github.com/dicedb/dice/internal/object"
 	"github.com/dicedb/dice/internal/shardmanager"
 	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
 	"github.com/dicedb/dicedb-go/wire"
 )
 
-type SSMap map[string]string
+// SSMap is the string-string map stored at the key of a hash. Its fields
+// are also kept in the order HSCAN walks them.
+type SSMap struct {
+	fields map[string]string
+	order  *types.ScanOrder
+}
+
+func NewSSMap() *SSMap {
+	return &SSMap{fields: map[string]string{}, order: types.NewScanOrder()}
+}
 
 var cHSET = &CommandMeta{
 	Name:      "HSET",
@@ -34,6 +48,7 @@ OK 2
 
 func init() {
 	CommandRegistry.AddCommand(cHSET)
+	object.RegisterSerializer(object.ObjTypeSSMap, object.NewSerializer(serializeSSMap, deserializeSSMap))
 }
 
 func newHSETRes(count int64) *CmdRes {
@@ -57,7 +72,7 @@ var (
 func evalHSET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 	key := c.C.Args[0]
 
-	var m SSMap
+	var m *SSMap
 	var countFieldsAdded int64
 
 	obj := s.Get(key)
@@ -65,9 +80,9 @@ func evalHSET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 		if err := object.AssertType(obj.Type, object.ObjTypeSSMap); err != nil {
 			return HSETResNilRes, errors.ErrWrongTypeOperation
 		}
-		m = obj.Value.(SSMap)
+		m = obj.Value.(*SSMap)
 	} else {
-		m = make(SSMap)
+		m = NewSSMap()
 	}
 
 	// kvs is the list of key-value pairs to set in the SSMap
@@ -78,11 +93,9 @@ func evalHSET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 	}
 
 	for i := 0; i < len(kvs); i += 2 {
-		k, v := kvs[i], kvs[i+1]
-		if _, ok := m[k]; !ok {
+		if _, ok := m.Set(kvs[i], kvs[i+1]); !ok {
 			countFieldsAdded++
 		}
-		m[k] = v
 	}
 
 	obj = s.NewObj(m, -1, object.ObjTypeSSMap)
@@ -99,28 +112,121 @@ func executeHSET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
 	return evalHSET(c, shard.Thread.Store())
 }
 
+// DeepCopy returns a copy of the SSMap. It implements object.DeepCopyable.
+func (h *SSMap) DeepCopy() interface{} {
+	return &SSMap{fields: maps.Clone(h.fields), order: h.order.Clone()}
+}
+
+func serializeSSMap(buf *bytes.Buffer, h *SSMap) error {
+	return types.WriteStringMap(buf, h.fields)
+}
+
+func deserializeSSMap(buf *bytes.Reader) (*SSMap, error) {
+	fields, err := types.ReadStringMap(buf)
+	if err != nil {
+		return nil, err
+	}
+	h := &SSMap{fields: fields, order: types.NewScanOrder()}
+	for k := range fields {
+		h.order.Add(k)
+	}
+	return h, nil
+}
+
+// Len returns the number of fields in the SSMap, 0 if h is nil.
+func (h *SSMap) Len() int {
+	if h == nil {
+		return 0
+	}
+	return len(h.fields)
+}
+
+// All returns the fields of the SSMap and their values in no particular
+// order, none if h is nil.
+func (h *SSMap) All() iter.Seq2[string, string] {
+	if h == nil {
+		return maps.All(map[string]string(nil))
+	}
+	return maps.All(h.fields)
+}
+
 // Get returns the value for the key in the SSMap.
-// Returns false if the key does not exist.
+// Returns false if the key does not exist or h is nil.
 // Returns the value if the key exists.
-func (h SSMap) Get(k string) (string, bool) {
-	value, ok := h[k]
-	if !ok {
+func (h *SSMap) Get(k string) (string, bool) {
+	if h == nil {
 		return "", false
 	}
-	return value, true
+	value, ok := h.fields[k]
+	return value, ok
 }
 
 // Set sets the value v for the key k in the SSMap.
 // Returns the old value if the key exists.
 // The bool return value indicates if the key was already present in the SSMap.
-func (h SSMap) Set(k, v string) (string, bool) {
-	value, ok := h[k]
-	if ok {
-		oldValue := value
-		h[k] = v
-		return oldValue, true
+func (h *SSMap) Set(k, v string) (string, bool) {
+	value, ok := h.fields[k]
+	h.fields[k] = v
+	if !ok {
+		h.order.Add(k)
+	}
+	return value, ok
+}
+
+// Del removes the key k from the SSMap.
+// Returns false if the key does not exist.
+func (h *SSMap) Del(k string) bool {
+	if _, ok := h.fields[k]; !ok {
+		return false
 	}
+	delete(h.fields, k)
+	h.order.Remove(k)
+	return true
+}
 
-	h[k] = v
-	return "", false
+// ScanFields returns a page of the fields of the SSMap in the order HSCAN
+// walks them, as returned by types.ScanOrder.Page, none if h is nil.
+func (h *SSMap) ScanFields(from uint64, count int) ([]string, uint64, bool) {
+	if h == nil {
+		return nil, 0, false
+	}
+	return h.order.Page(from, count)
+}
+
+// getSSMapObj returns the string-string map object stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getSSMapObj(s *dstore.Store, key string) (*object.Obj, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if err := object.AssertType(obj.Type, object.ObjTypeSSMap); err != nil {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj, nil
+}
+
+// getSSMap returns the string-string map stored at key.
+// Returns nil if the key does not exist.
+func getSSMap(s *dstore.Store, key string) (*SSMap, error) {
+	obj, err := getSSMapObj(s, key)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+	return obj.Value.(*SSMap), nil
+}
+
+// getOrCreateSSMap returns the string-string map stored at key, creating
+// an empty one if the key does not exist.
+func getOrCreateSSMap(s *dstore.Store, key string) (*SSMap, error) {
+	obj, err := getSSMapObj(s, key)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj = s.NewObj(NewSSMap(), -1, object.ObjTypeSSMap)
+		s.Put(key, obj)
+	}
+	return obj.Value.(*SSMap), nil
 }
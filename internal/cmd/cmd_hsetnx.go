@@ -0,0 +1,65 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHSETNX = &CommandMeta{
+	Name:      "HSETNX",
+	Syntax:    "HSETNX key field value",
+	HelpShort: "HSETNX sets field value in the string-string map stored at key only if field does not exist",
+	HelpLong: `
+HSETNX sets the field and value for the key in the string-string map, only if the field
+does not already exist. A new map is created if the key does not exist.
+
+The command returns 1 if the field was set, and 0 if the field already existed.
+	`,
+	Examples: `
+localhost:7379> HSETNX k1 f1 v1
+OK 1
+localhost:7379> HSETNX k1 f1 v2
+OK 0
+localhost:7379> HGET k1 f1
+OK "v1"
+	`,
+	Eval:    evalHSETNX,
+	Execute: executeHSETNX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHSETNX)
+}
+
+var (
+	HSETNXResNilRes = newIntRes(0)
+)
+
+func evalHSETNX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return HSETNXResNilRes, errors.ErrWrongArgumentCount("HSETNX")
+	}
+
+	m, err := getOrCreateSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HSETNXResNilRes, err
+	}
+	if _, ok := m.Get(c.C.Args[1]); ok {
+		return HSETNXResNilRes, nil
+	}
+	m.Set(c.C.Args[1], c.C.Args[2])
+	s.Reindex(c.C.Args[0])
+	return newIntRes(1), nil
+}
+
+func executeHSETNX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return HSETNXResNilRes, errors.ErrWrongArgumentCount("HSETNX")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHSETNX(c, shard.Thread.Store())
+}
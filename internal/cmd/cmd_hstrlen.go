@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHSTRLEN = &CommandMeta{
+	Name:      "HSTRLEN",
+	Syntax:    "HSTRLEN key field",
+	HelpShort: "HSTRLEN returns the length of the value of field in the string-string map stored at key",
+	HelpLong: `
+HSTRLEN returns the length of the value associated with field in the string-string map stored at key.
+
+The command returns 0 if the field or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 hello
+OK 1
+localhost:7379> HSTRLEN k1 f1
+OK 5
+localhost:7379> HSTRLEN k1 f2
+OK 0
+	`,
+	Eval:    evalHSTRLEN,
+	Execute: executeHSTRLEN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHSTRLEN)
+}
+
+var (
+	HSTRLENResNilRes = newIntRes(0)
+)
+
+func evalHSTRLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return HSTRLENResNilRes, errors.ErrWrongArgumentCount("HSTRLEN")
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HSTRLENResNilRes, err
+	}
+	val, _ := m.Get(c.C.Args[1])
+	return newIntRes(int64(len(val))), nil
+}
+
+func executeHSTRLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return HSTRLENResNilRes, errors.ErrWrongArgumentCount("HSTRLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHSTRLEN(c, shard.Thread.Store())
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cHVALS = &CommandMeta{
+	Name:      "HVALS",
+	Syntax:    "HVALS key",
+	HelpShort: "HVALS returns all the values of the string-string map stored at key",
+	HelpLong: `
+HVALS returns all the values of the string-string map stored at key.
+
+The command returns an empty list if the key does not exist. Note that the order of the values is not guaranteed.
+	`,
+	Examples: `
+localhost:7379> HSET k1 f1 v1 f2 v2
+OK 2
+localhost:7379> HVALS k1
+OK
+0) v1
+1) v2
+	`,
+	Eval:    evalHVALS,
+	Execute: executeHVALS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cHVALS)
+}
+
+var (
+	HVALSResNilRes = newListRes([]string{})
+)
+
+func evalHVALS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return HVALSResNilRes, errors.ErrWrongArgumentCount("HVALS")
+	}
+
+	m, err := getSSMap(s, c.C.Args[0])
+	if err != nil {
+		return HVALSResNilRes, err
+	}
+
+	values := make([]string, 0, m.Len())
+	for _, value := range m.All() {
+		values = append(values, value)
+	}
+	return newListRes(values), nil
+}
+
+func executeHVALS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return HVALSResNilRes, errors.ErrWrongArgumentCount("HVALS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalHVALS(c, shard.Thread.Store())
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cINCRBYFLOAT = &CommandMeta{
+	Name:      "INCRBYFLOAT",
+	Syntax:    "INCRBYFLOAT key increment",
+	HelpShort: "INCRBYFLOAT increments the number stored at key by a float increment",
+	HelpLong: `
+INCRBYFLOAT increments the number stored at key by increment, which may be negative or fractional.
+The key is created with increment as its value if it does not exist. The value must be an integer,
+a float, or a string that parses as a float. The result is stored as a float.
+
+Returns the new value.
+	`,
+	Examples: `
+localhost:7379> SET k 10
+OK
+localhost:7379> INCRBYFLOAT k 0.5
+OK "10.5"
+localhost:7379> INCRBYFLOAT k -2
+OK "8.5"
+	`,
+	Eval:    evalINCRBYFLOAT,
+	Execute: executeINCRBYFLOAT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cINCRBYFLOAT)
+}
+
+var (
+	INCRBYFLOATResNilRes = newValueRes("")
+)
+
+func evalINCRBYFLOAT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return INCRBYFLOATResNilRes, errors.ErrWrongArgumentCount("INCRBYFLOAT")
+	}
+
+	key := c.C.Args[0]
+	incr, err := strconv.ParseFloat(c.C.Args[1], 64)
+	if err != nil || math.IsNaN(incr) || math.IsInf(incr, 0) {
+		return INCRBYFLOATResNilRes, errors.ErrGeneral("value is not a valid float")
+	}
+
+	obj := s.Get(key)
+	if obj == nil {
+		s.Put(key, s.NewObj(incr, -1, object.ObjTypeFloat))
+		return newValueRes(strconv.FormatFloat(incr, 'f', -1, 64)), nil
+	}
+
+	var value float64
+	switch obj.Type {
+	case object.ObjTypeFloat:
+		value = obj.Value.(float64)
+	case object.ObjTypeInt:
+		value = float64(obj.Value.(int64))
+	case object.ObjTypeString, object.ObjTypeByteArray:
+		str, _ := getWireValueFromObj(obj)
+		if value, err = strconv.ParseFloat(str, 64); err != nil {
+			return INCRBYFLOATResNilRes, errors.ErrGeneral("value is not a valid float")
+		}
+	default:
+		return INCRBYFLOATResNilRes, errors.ErrWrongTypeOperation
+	}
+
+	value += incr
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return INCRBYFLOATResNilRes, errors.ErrGeneral("increment would produce NaN or Infinity")
+	}
+	obj.Type, obj.Value = object.ObjTypeFloat, value
+	s.Reindex(key)
+	return newValueRes(strconv.FormatFloat(value, 'f', -1, 64)), nil
+}
+
+func executeINCRBYFLOAT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return INCRBYFLOATResNilRes, errors.ErrWrongArgumentCount("INCRBYFLOAT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalINCRBYFLOAT(c, shard.Thread.Store())
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/ohler55/ojg/jp"
+)
+
+var cINDEXCREATE = &CommandMeta{
+	Name:      "INDEX.CREATE",
+	Syntax:    "INDEX.CREATE name ON HASH | JSON [PREFIX prefix] SCHEMA field [AS alias] NUMERIC | TAG [field [AS alias] NUMERIC | TAG ...]",
+	HelpShort: "INDEX.CREATE creates a secondary index over the fields of hashes or JSON documents",
+	HelpLong: `
+INDEX.CREATE creates a secondary index named name over the hashes, or the JSON documents, stored at
+the keys that start with prefix, or at every key if PREFIX is not given. INDEX.QUERY finds the
+keys whose fields match a query through the index instead of scanning every key.
+
+Every shard indexes its own keys. The keys that already exist are indexed when the index is
+created, and the index is updated whenever a key it covers is written, deleted or expires.
+
+The fields of a hash are named by their name. The fields of a JSON document are named by a
+JSONPath, or by the name of a top-level field. AS gives the field the name queries refer to it by.
+
+- NUMERIC: A number, queried by range. Values that are not numbers are not indexed.
+- TAG: A comma separated list of tags, queried by exact match ignoring case. A JSON string, an
+  array of strings, a number or a boolean is also indexed as tags.
+
+FLUSHDB deletes the indexes along with the keys.
+
+Returns OK, or an error if an index with the same name already exists.
+	`,
+	Examples: `
+localhost:7379> INDEX.CREATE users ON HASH PREFIX user: SCHEMA age NUMERIC city TAG
+OK
+localhost:7379> INDEX.CREATE profiles ON JSON SCHEMA $.address.city AS city TAG
+OK
+	`,
+	Eval:    evalINDEXCREATE,
+	Execute: executeINDEXCREATE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cINDEXCREATE)
+}
+
+var (
+	INDEXCREATEResNilRes = newOKRes()
+)
+
+func evalINDEXCREATE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	idx, err := parseKeyFieldIndex(c.C.Args)
+	if err != nil {
+		return INDEXCREATEResNilRes, err
+	}
+	if s.GetIndex(idx.Name) != nil {
+		return INDEXCREATEResNilRes, errors.ErrGeneral("index '" + idx.Name + "' already exists")
+	}
+
+	s.GetStore().All(func(key string, _ *object.Obj) bool {
+		// GetNoTouch skips the keys that expired.
+		if obj := s.GetNoTouch(key); obj != nil {
+			idx.IndexKey(key, obj)
+		}
+		return true
+	})
+	s.PutIndex(idx.Name, idx)
+	return newOKRes(), nil
+}
+
+func executeINDEXCREATE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return INDEXCREATEResNilRes, errors.ErrWrongArgumentCount("INDEX.CREATE")
+	}
+	for _, shard := range sm.Shards() {
+		if shard.Thread.Store().GetIndex(c.C.Args[0]) != nil {
+			return INDEXCREATEResNilRes, errors.ErrGeneral("index '" + c.C.Args[0] + "' already exists")
+		}
+	}
+	for _, shard := range sm.Shards() {
+		if _, err := evalINDEXCREATE(c, shard.Thread.Store()); err != nil {
+			return INDEXCREATEResNilRes, err
+		}
+	}
+	return newOKRes(), nil
+}
+
+// keyFieldIndex is the secondary index of a shard over the fields of the
+// hashes or JSON documents stored at the keys that start with prefix. It
+// implements store.KeyIndex, so the store keeps it up to date.
+type keyFieldIndex struct {
+	*types.SecondaryIndex
+	on     object.ObjectType
+	prefix string
+	paths  []jp.Expr // the compiled path of every field of a JSON index
+}
+
+func (idx *keyFieldIndex) IndexKey(key string, obj *object.Obj) {
+	if !strings.HasPrefix(key, idx.prefix) {
+		return
+	}
+	if obj.Type != idx.on {
+		// The key was overwritten with a value the index does not cover.
+		idx.Remove(key)
+		return
+	}
+	idx.Update(key, idx.document(obj))
+}
+
+func (idx *keyFieldIndex) UnindexKey(key string) {
+	if strings.HasPrefix(key, idx.prefix) {
+		idx.Remove(key)
+	}
+}
+
+// document extracts the values of the indexed fields of obj.
+func (idx *keyFieldIndex) document(obj *object.Obj) types.IndexDoc {
+	doc := types.IndexDoc{}
+	for i, f := range idx.Fields {
+		var values []interface{}
+		if idx.on == object.ObjTypeSSMap {
+			if v, ok := obj.Value.(*SSMap).Get(f.Path); ok {
+				values = append(values, v)
+			}
+		} else {
+			values = idx.paths[i].Get(obj.Value)
+		}
+		if value := indexFieldValue(f.Type, values); value != nil {
+			doc[f.Name] = value
+		}
+	}
+	return doc
+}
+
+// indexFieldValue converts the values found for a field to the value the
+// index holds for it: the first number for a numeric field and the tags of
+// every value for a tag field. Returns nil if no value can be indexed.
+func indexFieldValue(t types.IndexFieldType, values []interface{}) any {
+	if t == types.IndexFieldNumeric {
+		for _, v := range values {
+			switch n := v.(type) {
+			case float64:
+				return n
+			case int64:
+				return float64(n)
+			case string:
+				if f, err := strconv.ParseFloat(strings.TrimSpace(n), 64); err == nil {
+					return f
+				}
+			}
+		}
+		return nil
+	}
+
+	tags := []string{}
+	var add func(v interface{})
+	add = func(v interface{}) {
+		switch t := v.(type) {
+		case string:
+			tags = append(tags, types.NormalizeTags(t)...)
+		case float64:
+			tags = append(tags, strconv.FormatFloat(t, 'f', -1, 64))
+		case int64:
+			tags = append(tags, strconv.FormatInt(t, 10))
+		case bool:
+			tags = append(tags, strconv.FormatBool(t))
+		case []interface{}:
+			for _, e := range t {
+				add(e)
+			}
+		}
+	}
+	for _, v := range values {
+		add(v)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// parseKeyFieldIndex parses the arguments of INDEX.CREATE into an empty
+// index.
+func parseKeyFieldIndex(args []string) (*keyFieldIndex, error) {
+	if len(args) < 6 {
+		return nil, errors.ErrWrongArgumentCount("INDEX.CREATE")
+	}
+
+	idx := &keyFieldIndex{}
+	if !strings.EqualFold(args[1], "ON") {
+		return nil, errors.ErrInvalidSyntax("INDEX.CREATE")
+	}
+	switch strings.ToUpper(args[2]) {
+	case "HASH":
+		idx.on = object.ObjTypeSSMap
+	case "JSON":
+		idx.on = object.ObjTypeJSON
+	default:
+		return nil, errors.ErrGeneral("unknown index source '" + args[2] + "', must be HASH or JSON")
+	}
+
+	i := 3
+	if strings.EqualFold(args[i], "PREFIX") {
+		idx.prefix = args[i+1]
+		i += 2
+	}
+	if i >= len(args) || !strings.EqualFold(args[i], "SCHEMA") || i+2 >= len(args) {
+		return nil, errors.ErrInvalidSyntax("INDEX.CREATE")
+	}
+
+	fields := []types.IndexField{}
+	names := map[string]bool{}
+	for i++; i < len(args); i++ {
+		f := types.IndexField{Path: args[i], Name: args[i]}
+		if i+2 < len(args) && strings.EqualFold(args[i+1], "AS") {
+			f.Name = args[i+2]
+			i += 2
+		}
+		if i+1 >= len(args) {
+			return nil, errors.ErrInvalidSyntax("INDEX.CREATE")
+		}
+		i++
+		t, ok := types.ParseIndexFieldType(args[i])
+		if !ok {
+			return nil, errors.ErrGeneral("unknown field type '" + args[i] + "', must be NUMERIC or TAG")
+		}
+		f.Type = t
+		if names[f.Name] {
+			return nil, errors.ErrGeneral("duplicate field '" + f.Name + "'")
+		}
+		names[f.Name] = true
+
+		if idx.on == object.ObjTypeJSON {
+			path := f.Path
+			if !strings.HasPrefix(path, "$") {
+				path = "$." + path
+			}
+			expr, err := parseJSONPath(path)
+			if err != nil {
+				return nil, err
+			}
+			idx.paths = append(idx.paths, expr)
+		}
+		fields = append(fields, f)
+	}
+
+	idx.SecondaryIndex = types.NewSecondaryIndex(args[0], fields)
+	return idx, nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cINDEXQUERY = &CommandMeta{
+	Name:      "INDEX.QUERY",
+	Syntax:    "INDEX.QUERY name query [LIMIT offset count] [WITHDOCS]",
+	HelpShort: "INDEX.QUERY returns the keys whose fields match a query",
+	HelpLong: `
+INDEX.QUERY returns the keys, covered by the index created with INDEX.CREATE, whose fields match
+the query. The query is made of clauses separated by spaces, all of which must match:
+
+- @field:[min max]: The numeric field is between min and max, both inclusive. A bound prefixed
+  with ( is exclusive, and -inf and +inf leave the range unbounded.
+- @field:{tag | tag ...}: The tag field has any of the tags
+- *: Match every key of the index
+
+The matches of every shard are merged and sorted by key.
+
+- LIMIT: Skip the first offset matches and return at most count of them
+- WITHDOCS: Return the documents along with the keys, as JSON
+
+Returns the matching keys as a list, or with WITHDOCS the keys and their documents as key-document
+pairs.
+	`,
+	Examples: `
+localhost:7379> INDEX.CREATE users ON HASH PREFIX user: SCHEMA age NUMERIC city TAG
+OK
+localhost:7379> HSET user:1 age 25 city Berlin
+OK 2
+localhost:7379> HSET user:2 age 35 city Berlin
+OK 2
+localhost:7379> HSET user:3 age 28 city Paris
+OK 2
+localhost:7379> INDEX.QUERY users "@age:[18 30] @city:{berlin}"
+OK
+0) user:1
+localhost:7379> INDEX.QUERY users "@age:[18 (35]" LIMIT 0 50 WITHDOCS
+OK
+0) user:1="{"age":"25","city":"Berlin"}"
+1) user:3="{"age":"28","city":"Paris"}"
+	`,
+	Eval:    evalINDEXQUERY,
+	Execute: executeINDEXQUERY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cINDEXQUERY)
+}
+
+var (
+	INDEXQUERYResNilRes = newListRes([]string{})
+)
+
+func evalINDEXQUERY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return indexQuery(c, []*dstore.Store{s}, localStore(s))
+}
+
+func executeINDEXQUERY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	stores := make([]*dstore.Store, 0, len(sm.Shards()))
+	for _, shard := range sm.Shards() {
+		stores = append(stores, shard.Thread.Store())
+	}
+	return indexQuery(c, stores, shardStores(sm))
+}
+
+// indexQuery runs the query on the index of every store in stores and
+// merges the matches.
+func indexQuery(c *Cmd, stores []*dstore.Store, storeForKey func(string) *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return INDEXQUERYResNilRes, errors.ErrWrongArgumentCount("INDEX.QUERY")
+	}
+
+	// The query may arrive as a single argument or split on its spaces, so
+	// every argument up to the first option is part of it.
+	i := 1
+	for i < len(c.C.Args) && !isIndexQueryOption(c.C.Args[i]) {
+		i++
+	}
+	q, err := types.ParseIndexQuery(strings.Join(c.C.Args[1:i], " "))
+	if err != nil {
+		return INDEXQUERYResNilRes, errors.ErrGeneral(err.Error())
+	}
+
+	offset, count, withDocs := 0, -1, false
+	for ; i < len(c.C.Args); i++ {
+		switch strings.ToUpper(c.C.Args[i]) {
+		case "LIMIT":
+			if i+2 >= len(c.C.Args) {
+				return INDEXQUERYResNilRes, errors.ErrInvalidSyntax("INDEX.QUERY")
+			}
+			var err1, err2 error
+			offset, err1 = strconv.Atoi(c.C.Args[i+1])
+			count, err2 = strconv.Atoi(c.C.Args[i+2])
+			if err1 != nil || err2 != nil || offset < 0 || count < 0 {
+				return INDEXQUERYResNilRes, errors.ErrIntegerOutOfRange
+			}
+			i += 2
+		case "WITHDOCS":
+			withDocs = true
+		default:
+			return INDEXQUERYResNilRes, errors.ErrInvalidSyntax("INDEX.QUERY")
+		}
+	}
+
+	keys := []string{}
+	for _, s := range stores {
+		idx, ok := s.GetIndex(c.C.Args[0]).(*keyFieldIndex)
+		if !ok {
+			return INDEXQUERYResNilRes, errors.ErrGeneral("no such index '" + c.C.Args[0] + "'")
+		}
+		shardKeys, err := idx.Search(q)
+		if err != nil {
+			return INDEXQUERYResNilRes, errors.ErrGeneral(err.Error())
+		}
+		keys = append(keys, shardKeys...)
+	}
+	sort.Strings(keys)
+
+	keys = keys[min(offset, len(keys)):]
+	if count >= 0 && count < len(keys) {
+		keys = keys[:count]
+	}
+	if !withDocs {
+		return newListRes(keys), nil
+	}
+
+	elements := make([]*wire.HElement, 0, len(keys))
+	for _, key := range keys {
+		obj := storeForKey(key).GetNoTouch(key)
+		if obj == nil {
+			continue
+		}
+		value := obj.Value
+		if obj.Type == object.ObjTypeSSMap {
+			value = maps.Collect(obj.Value.(*SSMap).All())
+		}
+		doc, err := marshalJSON(value)
+		if err != nil {
+			return INDEXQUERYResNilRes, err
+		}
+		elements = append(elements, &wire.HElement{Key: key, Value: doc})
+	}
+	return newPairsRes(elements), nil
+}
+
+func isIndexQueryOption(arg string) bool {
+	switch strings.ToUpper(arg) {
+	case "LIMIT", "WITHDOCS":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONARRAPPEND = &CommandMeta{
+	Name:      "JSON.ARRAPPEND",
+	Syntax:    "JSON.ARRAPPEND key path json [json ...]",
+	HelpShort: "JSON.ARRAPPEND appends JSON values to the arrays at path",
+	HelpLong: `
+JSON.ARRAPPEND appends one or more JSON values to the arrays at path in the JSON document stored at key.
+
+The reply is a JSON array with the new length of every matched array, and null for every matched
+value that is not an array.
+
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"tags":["a"]}
+OK
+localhost:7379> JSON.ARRAPPEND user $.tags "b" "c"
+OK [3]
+localhost:7379> JSON.GET user $.tags
+OK ["a","b","c"]
+	`,
+	Eval:    evalJSONARRAPPEND,
+	Execute: executeJSONARRAPPEND,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONARRAPPEND)
+}
+
+var (
+	JSONARRAPPENDResNilRes = newValueRes("")
+)
+
+func evalJSONARRAPPEND(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return JSONARRAPPENDResNilRes, errors.ErrWrongArgumentCount("JSON.ARRAPPEND")
+	}
+
+	values, err := parseJSONValues(c.C.Args[2:])
+	if err != nil {
+		return JSONARRAPPENDResNilRes, err
+	}
+
+	return evalJSONModifyMatches(c, s, c.C.Args[1], func(v interface{}) (interface{}, interface{}, bool) {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return v, nil, false
+		}
+		arr = append(arr, values...)
+		return arr, len(arr), true
+	})
+}
+
+func executeJSONARRAPPEND(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return JSONARRAPPENDResNilRes, errors.ErrWrongArgumentCount("JSON.ARRAPPEND")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONARRAPPEND(c, shard.Thread.Store())
+}
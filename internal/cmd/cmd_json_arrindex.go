@@ -0,0 +1,114 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONARRINDEX = &CommandMeta{
+	Name:      "JSON.ARRINDEX",
+	Syntax:    "JSON.ARRINDEX key path json [start [stop]]",
+	HelpShort: "JSON.ARRINDEX returns the index of the first occurrence of a JSON value in the arrays at path",
+	HelpLong: `
+JSON.ARRINDEX returns the index of the first occurrence of a JSON value in the arrays at path in the
+JSON document stored at key.
+
+The search is limited to the elements between start (inclusive, default 0) and stop (exclusive,
+default 0 meaning the end of the array). Negative indices count from the end of the array.
+
+The reply is a JSON array with the index found in every matched array, -1 if the value was not
+found, and null for every matched value that is not an array.
+
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"tags":["a","b","a"]}
+OK
+localhost:7379> JSON.ARRINDEX user $.tags "a" 1
+OK [2]
+	`,
+	Eval:    evalJSONARRINDEX,
+	Execute: executeJSONARRINDEX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONARRINDEX)
+}
+
+var (
+	JSONARRINDEXResNilRes = newValueRes("")
+)
+
+func evalJSONARRINDEX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args) > 5 {
+		return JSONARRINDEXResNilRes, errors.ErrWrongArgumentCount("JSON.ARRINDEX")
+	}
+
+	values, err := parseJSONValues(c.C.Args[2:3])
+	if err != nil {
+		return JSONARRINDEXResNilRes, err
+	}
+
+	var bounds [2]int
+	for i, arg := range c.C.Args[3:] {
+		bounds[i], err = strconv.Atoi(arg)
+		if err != nil {
+			return JSONARRINDEXResNilRes, errors.ErrIntegerOutOfRange
+		}
+	}
+
+	obj, err := getJSONObj(s, c.C.Args[0])
+	if err != nil {
+		return JSONARRINDEXResNilRes, err
+	}
+	if obj == nil {
+		return JSONARRINDEXResNilRes, errors.ErrKeyDoesNotExist
+	}
+
+	return evalJSONReadMatches(c, s, func(v interface{}) interface{} {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return nil
+		}
+		start, stop := adjustJSONArrayRange(bounds[0], bounds[1], len(arr))
+		for i := start; i < stop; i++ {
+			if reflect.DeepEqual(arr[i], values[0]) {
+				return i
+			}
+		}
+		return -1
+	})
+}
+
+func executeJSONARRINDEX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args) > 5 {
+		return JSONARRINDEXResNilRes, errors.ErrWrongArgumentCount("JSON.ARRINDEX")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONARRINDEX(c, shard.Thread.Store())
+}
+
+// adjustJSONArrayRange normalizes the start and stop indices of a search
+// over an array of the given length. A stop of 0 or less counts from the
+// end of the array. Returns an empty range if nothing is left to search.
+func adjustJSONArrayRange(start, stop, length int) (adjustedStart, adjustedStop int) {
+	if start < 0 {
+		start += length
+	}
+	if stop <= 0 {
+		stop += length
+	}
+	start = max(start, 0)
+	stop = min(max(stop, 0), length)
+	if start >= stop {
+		return 0, 0
+	}
+	return start, stop
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONARRINSERT = &CommandMeta{
+	Name:      "JSON.ARRINSERT",
+	Syntax:    "JSON.ARRINSERT key path index json [json ...]",
+	HelpShort: "JSON.ARRINSERT inserts JSON values into the arrays at path before the index",
+	HelpLong: `
+JSON.ARRINSERT inserts one or more JSON values into the arrays at path in the JSON document stored at
+key. The values are inserted before the element at index. An index equal to the length of the array
+appends the values and a negative index counts from the end of the array.
+
+The reply is a JSON array with the new length of every matched array, and null for every matched
+value that is not an array.
+
+Returns an error if the key does not exist or the index is out of range.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"tags":["a","d"]}
+OK
+localhost:7379> JSON.ARRINSERT user $.tags 1 "b" "c"
+OK [4]
+localhost:7379> JSON.GET user $.tags
+OK ["a","b","c","d"]
+	`,
+	Eval:    evalJSONARRINSERT,
+	Execute: executeJSONARRINSERT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONARRINSERT)
+}
+
+var (
+	JSONARRINSERTResNilRes = newValueRes("")
+)
+
+func evalJSONARRINSERT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return JSONARRINSERTResNilRes, errors.ErrWrongArgumentCount("JSON.ARRINSERT")
+	}
+
+	index, err := strconv.Atoi(c.C.Args[2])
+	if err != nil {
+		return JSONARRINSERTResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	values, err := parseJSONValues(c.C.Args[3:])
+	if err != nil {
+		return JSONARRINSERTResNilRes, err
+	}
+
+	var rangeErr error
+	r, err := evalJSONModifyMatches(c, s, c.C.Args[1], func(v interface{}) (interface{}, interface{}, bool) {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return v, nil, false
+		}
+		i := index
+		if i < 0 {
+			i += len(arr)
+		}
+		if i < 0 || i > len(arr) {
+			rangeErr = errors.ErrGeneral("index out of bounds")
+			return v, nil, false
+		}
+		arr = slices.Insert(arr, i, values...)
+		return arr, len(arr), true
+	})
+	if err != nil {
+		return JSONARRINSERTResNilRes, err
+	}
+	if rangeErr != nil {
+		return JSONARRINSERTResNilRes, rangeErr
+	}
+	return r, nil
+}
+
+func executeJSONARRINSERT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return JSONARRINSERTResNilRes, errors.ErrWrongArgumentCount("JSON.ARRINSERT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONARRINSERT(c, shard.Thread.Store())
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONARRLEN = &CommandMeta{
+	Name:      "JSON.ARRLEN",
+	Syntax:    "JSON.ARRLEN key [path]",
+	HelpShort: "JSON.ARRLEN returns the length of the arrays at path",
+	HelpLong: `
+JSON.ARRLEN returns the length of the arrays at path in the JSON document stored at key.
+
+The path defaults to the root "$" of the document. The reply is a JSON array with the length of
+every matched array, and null for every matched value that is not an array.
+
+The command returns an empty string if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"tags":["a","b"],"name":"alice"}
+OK
+localhost:7379> JSON.ARRLEN user $.*
+OK [2,null]
+	`,
+	Eval:    evalJSONARRLEN,
+	Execute: executeJSONARRLEN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONARRLEN)
+}
+
+var (
+	JSONARRLENResNilRes = newValueRes("")
+)
+
+func evalJSONARRLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONARRLENResNilRes, errors.ErrWrongArgumentCount("JSON.ARRLEN")
+	}
+
+	return evalJSONReadMatches(c, s, func(v interface{}) interface{} {
+		if arr, ok := v.([]interface{}); ok {
+			return len(arr)
+		}
+		return nil
+	})
+}
+
+func executeJSONARRLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONARRLENResNilRes, errors.ErrWrongArgumentCount("JSON.ARRLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONARRLEN(c, shard.Thread.Store())
+}
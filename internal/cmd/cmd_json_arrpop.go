@@ -0,0 +1,88 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONARRPOP = &CommandMeta{
+	Name:      "JSON.ARRPOP",
+	Syntax:    "JSON.ARRPOP key [path [index]]",
+	HelpShort: "JSON.ARRPOP removes and returns the element at index from the arrays at path",
+	HelpLong: `
+JSON.ARRPOP removes and returns the element at index from the arrays at path in the JSON document
+stored at key.
+
+The path defaults to the root "$" of the document and the index defaults to -1, the last element.
+Negative indices count from the end of the array and out of range indices are clamped to the bounds
+of the array.
+
+The reply is a JSON array with the element popped from every matched array, and null for every
+matched value that is not an array or is an empty array.
+
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"tags":["a","b","c"]}
+OK
+localhost:7379> JSON.ARRPOP user $.tags
+OK ["c"]
+localhost:7379> JSON.ARRPOP user $.tags 0
+OK ["a"]
+localhost:7379> JSON.GET user $.tags
+OK ["b"]
+	`,
+	Eval:    evalJSONARRPOP,
+	Execute: executeJSONARRPOP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONARRPOP)
+}
+
+var (
+	JSONARRPOPResNilRes = newValueRes("")
+)
+
+func evalJSONARRPOP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 3 {
+		return JSONARRPOPResNilRes, errors.ErrWrongArgumentCount("JSON.ARRPOP")
+	}
+
+	index := -1
+	if len(c.C.Args) == 3 {
+		var err error
+		if index, err = strconv.Atoi(c.C.Args[2]); err != nil {
+			return JSONARRPOPResNilRes, errors.ErrIntegerOutOfRange
+		}
+	}
+
+	return evalJSONModifyMatches(c, s, jsonPathOrRoot(c.C.Args, 1), func(v interface{}) (interface{}, interface{}, bool) {
+		arr, ok := v.([]interface{})
+		if !ok || len(arr) == 0 {
+			return v, nil, false
+		}
+		i := index
+		if i < 0 {
+			i += len(arr)
+		}
+		i = min(max(i, 0), len(arr)-1)
+		popped := arr[i]
+		return slices.Delete(arr, i, i+1), popped, true
+	})
+}
+
+func executeJSONARRPOP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 3 {
+		return JSONARRPOPResNilRes, errors.ErrWrongArgumentCount("JSON.ARRPOP")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONARRPOP(c, shard.Thread.Store())
+}
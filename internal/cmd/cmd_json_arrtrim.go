@@ -0,0 +1,89 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONARRTRIM = &CommandMeta{
+	Name:      "JSON.ARRTRIM",
+	Syntax:    "JSON.ARRTRIM key path start stop",
+	HelpShort: "JSON.ARRTRIM trims the arrays at path to the elements between start and stop",
+	HelpLong: `
+JSON.ARRTRIM trims the arrays at path in the JSON document stored at key so that they only contain
+the elements between start and stop, both inclusive. Negative indices count from the end of the
+array. If start is past the end of the array or after stop, the array is emptied.
+
+The reply is a JSON array with the new length of every matched array, and null for every matched
+value that is not an array.
+
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"tags":["a","b","c","d"]}
+OK
+localhost:7379> JSON.ARRTRIM user $.tags 1 -2
+OK [2]
+localhost:7379> JSON.GET user $.tags
+OK ["b","c"]
+	`,
+	Eval:    evalJSONARRTRIM,
+	Execute: executeJSONARRTRIM,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONARRTRIM)
+}
+
+var (
+	JSONARRTRIMResNilRes = newValueRes("")
+)
+
+func evalJSONARRTRIM(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 4 {
+		return JSONARRTRIMResNilRes, errors.ErrWrongArgumentCount("JSON.ARRTRIM")
+	}
+
+	start, err := strconv.Atoi(c.C.Args[2])
+	if err != nil {
+		return JSONARRTRIMResNilRes, errors.ErrIntegerOutOfRange
+	}
+	stop, err := strconv.Atoi(c.C.Args[3])
+	if err != nil {
+		return JSONARRTRIMResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	return evalJSONModifyMatches(c, s, c.C.Args[1], func(v interface{}) (interface{}, interface{}, bool) {
+		arr, ok := v.([]interface{})
+		if !ok {
+			return v, nil, false
+		}
+		from, to := start, stop
+		if from < 0 {
+			from += len(arr)
+		}
+		if to < 0 {
+			to += len(arr)
+		}
+		from, to = max(from, 0), min(to, len(arr)-1)
+		if from > to {
+			return []interface{}{}, 0, true
+		}
+		arr = arr[from : to+1]
+		return arr, len(arr), true
+	})
+}
+
+func executeJSONARRTRIM(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 4 {
+		return JSONARRTRIMResNilRes, errors.ErrWrongArgumentCount("JSON.ARRTRIM")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONARRTRIM(c, shard.Thread.Store())
+}
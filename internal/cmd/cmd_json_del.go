@@ -0,0 +1,90 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONDEL = &CommandMeta{
+	Name:      "JSON.DEL",
+	Syntax:    "JSON.DEL key [path]",
+	HelpShort: "JSON.DEL deletes the values at path from the JSON document stored at key",
+	HelpLong: `
+JSON.DEL deletes the values at path from the JSON document stored at key.
+
+The path defaults to the root "$" of the document, in which case the key itself is deleted.
+
+The command returns the number of values deleted, and 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice","age":30}
+OK
+localhost:7379> JSON.DEL user $.age
+OK 1
+localhost:7379> JSON.GET user
+OK {"name":"alice"}
+localhost:7379> JSON.DEL user
+OK 1
+	`,
+	Eval:    evalJSONDEL,
+	Execute: executeJSONDEL,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONDEL)
+}
+
+var (
+	JSONDELResNilRes = newIntRes(0)
+)
+
+func evalJSONDEL(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONDELResNilRes, errors.ErrWrongArgumentCount("JSON.DEL")
+	}
+
+	key := c.C.Args[0]
+	obj, err := getJSONObj(s, key)
+	if err != nil {
+		return JSONDELResNilRes, err
+	}
+	if obj == nil {
+		return JSONDELResNilRes, nil
+	}
+
+	path := jsonPathOrRoot(c.C.Args, 1)
+	if path == JSONRootPath || path == "." {
+		s.Del(key)
+		return newIntRes(1), nil
+	}
+
+	expr, err := parseJSONPath(path)
+	if err != nil {
+		return JSONDELResNilRes, err
+	}
+
+	count := len(expr.Get(obj.Value))
+	if count == 0 {
+		return JSONDELResNilRes, nil
+	}
+
+	// Remove deletes array elements along with the map entries,
+	// unlike Del which would leave nulls behind in arrays.
+	if obj.Value, err = expr.Remove(obj.Value); err != nil {
+		return JSONDELResNilRes, errors.ErrGeneral(err.Error())
+	}
+	s.Reindex(key)
+	return newIntRes(int64(count)), nil
+}
+
+func executeJSONDEL(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONDELResNilRes, errors.ErrWrongArgumentCount("JSON.DEL")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONDEL(c, shard.Thread.Store())
+}
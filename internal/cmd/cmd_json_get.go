@@ -0,0 +1,89 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONGET = &CommandMeta{
+	Name:      "JSON.GET",
+	Syntax:    "JSON.GET key [path]",
+	HelpShort: "JSON.GET returns the JSON value at path in the JSON document stored at key",
+	HelpLong: `
+JSON.GET returns the JSON value at path in the JSON document stored at key.
+
+The path defaults to the root "$" of the document. If the path matches a single value, the value is
+returned as JSON text. If it matches multiple values, a JSON array of the matched values is returned.
+
+The command returns an empty string if the key does not exist or the path does not match any value.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice","tags":["a","b"]}
+OK
+localhost:7379> JSON.GET user
+OK {"name":"alice","tags":["a","b"]}
+localhost:7379> JSON.GET user $.name
+OK "alice"
+localhost:7379> JSON.GET user $.tags[*]
+OK ["a","b"]
+	`,
+	Eval:        evalJSONGET,
+	Execute:     executeJSONGET,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONGET)
+}
+
+var (
+	JSONGETResNilRes = newValueRes("")
+)
+
+func evalJSONGET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONGETResNilRes, errors.ErrWrongArgumentCount("JSON.GET")
+	}
+
+	obj, err := getJSONObj(s, c.C.Args[0])
+	if err != nil {
+		return JSONGETResNilRes, err
+	}
+	if obj == nil {
+		return JSONGETResNilRes, nil
+	}
+
+	expr, err := parseJSONPath(jsonPathOrRoot(c.C.Args, 1))
+	if err != nil {
+		return JSONGETResNilRes, err
+	}
+
+	var result interface{}
+	matches := expr.Get(obj.Value)
+	switch len(matches) {
+	case 0:
+		return JSONGETResNilRes, nil
+	case 1:
+		result = matches[0]
+	default:
+		result = matches
+	}
+
+	value, err := marshalJSON(result)
+	if err != nil {
+		return JSONGETResNilRes, err
+	}
+	return newValueRes(value), nil
+}
+
+func executeJSONGET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONGETResNilRes, errors.ErrWrongArgumentCount("JSON.GET")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONGET(c, shard.Thread.Store())
+}
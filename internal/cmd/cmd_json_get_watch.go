@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONGETWATCH = &CommandMeta{
+	Name:      "JSON.GET.WATCH",
+	Syntax:    "JSON.GET.WATCH key [path]",
+	HelpShort: "JSON.GET.WATCH creates a query subscription over the JSON.GET command",
+	HelpLong: `
+JSON.GET.WATCH creates a query subscription over the JSON.GET command. The client invoking the command
+will receive the output of the JSON.GET command (not just the notification) whenever the document
+stored at the key is updated.
+
+Passing a path subscribes to a single sub-document. The subscriber then receives the value at
+that path every time it changes, and writes to other paths of the document are not pushed.
+	`,
+	Examples: `
+client1:7379> JSON.SET user $ {"name":"alice","address":{"city":"berlin"}}
+OK
+client1:7379> JSON.GET.WATCH user $.address
+entered the watch mode for JSON.GET.WATCH user $.address
+
+
+client2:7379> JSON.SET user $.address.city "paris"
+OK
+
+
+client1:7379> ...
+entered the watch mode for JSON.GET.WATCH user $.address
+OK [fingerprint=1780923423] {"city":"paris"}
+	`,
+	Eval:           evalJSONGETWATCH,
+	Execute:        executeJSONGETWATCH,
+	NotifyOnChange: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONGETWATCH)
+}
+
+var (
+	JSONGETWATCHResNilRes = newValueRes("")
+)
+
+func evalJSONGETWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	r, err := evalJSONGET(c, s)
+	if err != nil {
+		return JSONGETWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeJSONGETWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONGETWATCHResNilRes, errors.ErrWrongArgumentCount("JSON.GET.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONGETWATCH(c, shard.Thread.Store())
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONNUMINCRBY = &CommandMeta{
+	Name:      "JSON.NUMINCRBY",
+	Syntax:    "JSON.NUMINCRBY key path value",
+	HelpShort: "JSON.NUMINCRBY increments the numbers at path by value",
+	HelpLong: `
+JSON.NUMINCRBY increments the numbers at path in the JSON document stored at key by value.
+
+The reply is a JSON array with the new value of every matched number, and null for every matched
+value that is not a number.
+
+Returns an error if the key does not exist or the value is not a number.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"age":30,"score":1.5}
+OK
+localhost:7379> JSON.NUMINCRBY user $.age 2
+OK [32]
+	`,
+	Eval:    evalJSONNUMINCRBY,
+	Execute: executeJSONNUMINCRBY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONNUMINCRBY)
+}
+
+var (
+	JSONNUMINCRBYResNilRes = newValueRes("")
+)
+
+func evalJSONNUMINCRBY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return JSONNUMINCRBYResNilRes, errors.ErrWrongArgumentCount("JSON.NUMINCRBY")
+	}
+
+	delta, err := strconv.ParseFloat(c.C.Args[2], 64)
+	if err != nil {
+		return JSONNUMINCRBYResNilRes, errors.ErrInvalidNumberFormat
+	}
+
+	return evalJSONModifyMatches(c, s, c.C.Args[1], func(v interface{}) (interface{}, interface{}, bool) {
+		n, ok := v.(float64)
+		if !ok {
+			return v, nil, false
+		}
+		n += delta
+		return n, n, true
+	})
+}
+
+func executeJSONNUMINCRBY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return JSONNUMINCRBYResNilRes, errors.ErrWrongArgumentCount("JSON.NUMINCRBY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONNUMINCRBY(c, shard.Thread.Store())
+}
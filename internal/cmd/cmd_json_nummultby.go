@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONNUMMULTBY = &CommandMeta{
+	Name:      "JSON.NUMMULTBY",
+	Syntax:    "JSON.NUMMULTBY key path value",
+	HelpShort: "JSON.NUMMULTBY multiplies the numbers at path by value",
+	HelpLong: `
+JSON.NUMMULTBY multiplies the numbers at path in the JSON document stored at key by value.
+
+The reply is a JSON array with the new value of every matched number, and null for every matched
+value that is not a number.
+
+Returns an error if the key does not exist or the value is not a number.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"age":30,"score":1.5}
+OK
+localhost:7379> JSON.NUMMULTBY user $.score 2
+OK [3]
+	`,
+	Eval:    evalJSONNUMMULTBY,
+	Execute: executeJSONNUMMULTBY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONNUMMULTBY)
+}
+
+var (
+	JSONNUMMULTBYResNilRes = newValueRes("")
+)
+
+func evalJSONNUMMULTBY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return JSONNUMMULTBYResNilRes, errors.ErrWrongArgumentCount("JSON.NUMMULTBY")
+	}
+
+	factor, err := strconv.ParseFloat(c.C.Args[2], 64)
+	if err != nil {
+		return JSONNUMMULTBYResNilRes, errors.ErrInvalidNumberFormat
+	}
+
+	return evalJSONModifyMatches(c, s, c.C.Args[1], func(v interface{}) (interface{}, interface{}, bool) {
+		n, ok := v.(float64)
+		if !ok {
+			return v, nil, false
+		}
+		n *= factor
+		return n, n, true
+	})
+}
+
+func executeJSONNUMMULTBY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return JSONNUMMULTBYResNilRes, errors.ErrWrongArgumentCount("JSON.NUMMULTBY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONNUMMULTBY(c, shard.Thread.Store())
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"slices"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONOBJKEYS = &CommandMeta{
+	Name:      "JSON.OBJKEYS",
+	Syntax:    "JSON.OBJKEYS key [path]",
+	HelpShort: "JSON.OBJKEYS returns the keys of the objects at path",
+	HelpLong: `
+JSON.OBJKEYS returns the keys of the objects at path in the JSON document stored at key.
+
+The path defaults to the root "$" of the document. The reply is a JSON array with the sorted keys of
+every matched object, and null for every matched value that is not an object.
+
+The command returns an empty string if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice","address":{"city":"berlin","zip":"10115"}}
+OK
+localhost:7379> JSON.OBJKEYS user $.address
+OK [["city","zip"]]
+	`,
+	Eval:    evalJSONOBJKEYS,
+	Execute: executeJSONOBJKEYS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONOBJKEYS)
+}
+
+var (
+	JSONOBJKEYSResNilRes = newValueRes("")
+)
+
+func evalJSONOBJKEYS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONOBJKEYSResNilRes, errors.ErrWrongArgumentCount("JSON.OBJKEYS")
+	}
+
+	return evalJSONReadMatches(c, s, func(v interface{}) interface{} {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		return keys
+	})
+}
+
+func executeJSONOBJKEYS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONOBJKEYSResNilRes, errors.ErrWrongArgumentCount("JSON.OBJKEYS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONOBJKEYS(c, shard.Thread.Store())
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONOBJLEN = &CommandMeta{
+	Name:      "JSON.OBJLEN",
+	Syntax:    "JSON.OBJLEN key [path]",
+	HelpShort: "JSON.OBJLEN returns the number of keys in the objects at path",
+	HelpLong: `
+JSON.OBJLEN returns the number of keys in the objects at path in the JSON document stored at key.
+
+The path defaults to the root "$" of the document. The reply is a JSON array with the number of keys
+of every matched object, and null for every matched value that is not an object.
+
+The command returns an empty string if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice","address":{"city":"berlin","zip":"10115"}}
+OK
+localhost:7379> JSON.OBJLEN user $.address
+OK [2]
+	`,
+	Eval:    evalJSONOBJLEN,
+	Execute: executeJSONOBJLEN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONOBJLEN)
+}
+
+var (
+	JSONOBJLENResNilRes = newValueRes("")
+)
+
+func evalJSONOBJLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONOBJLENResNilRes, errors.ErrWrongArgumentCount("JSON.OBJLEN")
+	}
+
+	return evalJSONReadMatches(c, s, func(v interface{}) interface{} {
+		if m, ok := v.(map[string]interface{}); ok {
+			return len(m)
+		}
+		return nil
+	})
+}
+
+func executeJSONOBJLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONOBJLENResNilRes, errors.ErrWrongArgumentCount("JSON.OBJLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONOBJLEN(c, shard.Thread.Store())
+}
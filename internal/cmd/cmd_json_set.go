@@ -0,0 +1,270 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/ohler55/ojg/jp"
+)
+
+// JSONRootPath is the JSONPath that addresses the whole document.
+const JSONRootPath = "$"
+
+// jsonAPI serializes objects with sorted keys so that replies, and the
+// notifications sent to watchers, are stable across calls.
+var jsonAPI = sonic.Config{SortMapKeys: true}.Froze()
+
+var cJSONSET = &CommandMeta{
+	Name:      "JSON.SET",
+	Syntax:    "JSON.SET key path json [NX | XX]",
+	HelpShort: "JSON.SET sets the JSON value at path in the JSON document stored at key",
+	HelpLong: `
+JSON.SET sets the JSON value at path in the JSON document stored at key.
+
+The path is a JSONPath expression and "$" addresses the root of the document. A new key must be
+set at the root. When the path addresses a location inside an existing document, the value at
+that location is replaced or created.
+
+- NX: only set the value if the key does not already exist
+- XX: only set the value if the key already exists
+
+Returns "OK" if the value was set.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice","age":30}
+OK
+localhost:7379> JSON.SET user $.age 31
+OK
+localhost:7379> JSON.GET user
+OK {"age":31,"name":"alice"}
+	`,
+	Eval:    evalJSONSET,
+	Execute: executeJSONSET,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONSET)
+}
+
+var (
+	JSONSETResNilRes = newOKRes()
+	JSONSETResOKRes  = newOKRes()
+)
+
+func evalJSONSET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return JSONSETResNilRes, errors.ErrWrongArgumentCount("JSON.SET")
+	}
+
+	key, path, value := c.C.Args[0], c.C.Args[1], c.C.Args[2]
+	var nx, xx bool
+	for _, arg := range c.C.Args[3:] {
+		switch types.Param(strings.ToUpper(arg)) {
+		case types.NX:
+			nx = true
+		case types.XX:
+			xx = true
+		default:
+			return JSONSETResNilRes, errors.ErrInvalidSyntax("JSON.SET")
+		}
+	}
+	if nx && xx {
+		return JSONSETResNilRes, errors.ErrInvalidSyntax("JSON.SET")
+	}
+
+	var jsonValue interface{}
+	if err := sonic.UnmarshalString(value, &jsonValue); err != nil {
+		return JSONSETResNilRes, errors.ErrGeneral("invalid JSON")
+	}
+
+	obj, err := getJSONObj(s, key)
+	if err != nil {
+		return JSONSETResNilRes, err
+	}
+
+	if nx && obj != nil {
+		return JSONSETResNilRes, nil
+	}
+	if xx && obj == nil {
+		return JSONSETResNilRes, nil
+	}
+
+	if path == JSONRootPath {
+		if obj != nil {
+			obj.Value = jsonValue
+			s.Reindex(key)
+			return JSONSETResOKRes, nil
+		}
+		s.Put(key, s.NewObj(jsonValue, -1, object.ObjTypeJSON))
+		return JSONSETResOKRes, nil
+	}
+
+	if obj == nil {
+		return JSONSETResNilRes, errors.ErrGeneral("new objects must be created at the root")
+	}
+
+	expr, err := parseJSONPath(path)
+	if err != nil {
+		return JSONSETResNilRes, err
+	}
+	if err := expr.Set(obj.Value, jsonValue); err != nil {
+		return JSONSETResNilRes, errors.ErrGeneral("failed to set value")
+	}
+	s.Reindex(key)
+	return JSONSETResOKRes, nil
+}
+
+func executeJSONSET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return JSONSETResNilRes, errors.ErrWrongArgumentCount("JSON.SET")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONSET(c, shard.Thread.Store())
+}
+
+// getJSONObj returns the JSON document stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getJSONObj(s *dstore.Store, key string) (*object.Obj, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeJSON {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj, nil
+}
+
+// parseJSONPath compiles the JSONPath expression. The legacy "." path is
+// accepted as an alias of the root path.
+func parseJSONPath(path string) (jp.Expr, error) {
+	if path == "." {
+		path = JSONRootPath
+	}
+	expr, err := jp.ParseString(path)
+	if err != nil {
+		return nil, errors.ErrJSONPathNotFound(path)
+	}
+	return expr, nil
+}
+
+// parseJSONValues parses every argument as a JSON value.
+func parseJSONValues(args []string) ([]interface{}, error) {
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		if err := sonic.UnmarshalString(arg, &values[i]); err != nil {
+			return nil, errors.ErrGeneral("invalid JSON")
+		}
+	}
+	return values, nil
+}
+
+// marshalJSON serializes the value as JSON text.
+func marshalJSON(v interface{}) (string, error) {
+	s, err := jsonAPI.MarshalToString(v)
+	if err != nil {
+		return "", errors.ErrGeneral("could not serialize result")
+	}
+	return s, nil
+}
+
+// jsonPathOrRoot returns the path argument at index i or the root path
+// if the argument is absent.
+func jsonPathOrRoot(args []string, i int) string {
+	if len(args) > i {
+		return args[i]
+	}
+	return JSONRootPath
+}
+
+// modifyJSON applies fn to every value matched by the expression and
+// returns the result fn produced for each match. fn returns the new value,
+// the per-match result and whether the value changed. The root document is
+// replaced in place when the expression addresses it.
+func modifyJSON(obj *object.Obj, expr jp.Expr, fn func(v interface{}) (interface{}, interface{}, bool)) ([]interface{}, error) {
+	results := []interface{}{}
+	if strings.TrimSpace(expr.String()) == JSONRootPath {
+		nv, r, changed := fn(obj.Value)
+		if changed {
+			obj.Value = nv
+		}
+		return append(results, r), nil
+	}
+
+	nd, err := expr.Modify(obj.Value, func(v interface{}) (interface{}, bool) {
+		nv, r, changed := fn(v)
+		results = append(results, r)
+		return nv, changed
+	})
+	if err != nil {
+		return nil, errors.ErrGeneral(err.Error())
+	}
+	obj.Value = nd
+	return results, nil
+}
+
+// newJSONMatchesRes replies with a JSON array that holds one result per
+// value matched by a JSONPath. Matches the command could not act on, for
+// example an array command matching a string, are reported as null.
+func newJSONMatchesRes(results []interface{}) (*CmdRes, error) {
+	value, err := marshalJSON(results)
+	if err != nil {
+		return nil, err
+	}
+	return newValueRes(value), nil
+}
+
+// evalJSONReadMatches evaluates a read-only JSON command of the form
+// "CMD key [path]" by applying fn to every value matched by the path.
+func evalJSONReadMatches(c *Cmd, s *dstore.Store, fn func(v interface{}) interface{}) (*CmdRes, error) {
+	obj, err := getJSONObj(s, c.C.Args[0])
+	if err != nil || obj == nil {
+		return newValueRes(""), err
+	}
+
+	expr, err := parseJSONPath(jsonPathOrRoot(c.C.Args, 1))
+	if err != nil {
+		return newValueRes(""), err
+	}
+
+	matches := expr.Get(obj.Value)
+	results := make([]interface{}, 0, len(matches))
+	for _, m := range matches {
+		results = append(results, fn(m))
+	}
+	return newJSONMatchesRes(results)
+}
+
+// evalJSONModifyMatches evaluates a JSON command that modifies the values
+// matched by path by applying fn to every match.
+// Returns an error if the key does not exist.
+func evalJSONModifyMatches(c *Cmd, s *dstore.Store, path string, fn func(v interface{}) (interface{}, interface{}, bool)) (*CmdRes, error) {
+	obj, err := getJSONObj(s, c.C.Args[0])
+	if err != nil {
+		return newValueRes(""), err
+	}
+	if obj == nil {
+		return newValueRes(""), errors.ErrKeyDoesNotExist
+	}
+
+	expr, err := parseJSONPath(path)
+	if err != nil {
+		return newValueRes(""), err
+	}
+
+	results, err := modifyJSON(obj, expr, fn)
+	if err != nil {
+		return newValueRes(""), err
+	}
+	s.Reindex(c.C.Args[0])
+	return newJSONMatchesRes(results)
+}
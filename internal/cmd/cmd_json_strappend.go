@@ -0,0 +1,80 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONSTRAPPEND = &CommandMeta{
+	Name:      "JSON.STRAPPEND",
+	Syntax:    "JSON.STRAPPEND key [path] json",
+	HelpShort: "JSON.STRAPPEND appends a JSON string to the strings at path",
+	HelpLong: `
+JSON.STRAPPEND appends a JSON string to the strings at path in the JSON document stored at key.
+The value must be a JSON string, including its double quotes.
+
+The path defaults to the root "$" of the document. The reply is a JSON array with the new length of
+every matched string, and null for every matched value that is not a string.
+
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice"}
+OK
+localhost:7379> JSON.STRAPPEND user $.name "_smith"
+OK [11]
+localhost:7379> JSON.GET user $.name
+OK "alice_smith"
+	`,
+	Eval:    evalJSONSTRAPPEND,
+	Execute: executeJSONSTRAPPEND,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONSTRAPPEND)
+}
+
+var (
+	JSONSTRAPPENDResNilRes = newValueRes("")
+)
+
+func evalJSONSTRAPPEND(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args) > 3 {
+		return JSONSTRAPPENDResNilRes, errors.ErrWrongArgumentCount("JSON.STRAPPEND")
+	}
+
+	path := JSONRootPath
+	if len(c.C.Args) == 3 {
+		path = c.C.Args[1]
+	}
+
+	values, err := parseJSONValues(c.C.Args[len(c.C.Args)-1:])
+	if err != nil {
+		return JSONSTRAPPENDResNilRes, err
+	}
+	suffix, ok := values[0].(string)
+	if !ok {
+		return JSONSTRAPPENDResNilRes, errors.ErrGeneral("value must be a JSON string")
+	}
+
+	return evalJSONModifyMatches(c, s, path, func(v interface{}) (interface{}, interface{}, bool) {
+		str, ok := v.(string)
+		if !ok {
+			return v, nil, false
+		}
+		str += suffix
+		return str, len(str), true
+	})
+}
+
+func executeJSONSTRAPPEND(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args) > 3 {
+		return JSONSTRAPPENDResNilRes, errors.ErrWrongArgumentCount("JSON.STRAPPEND")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONSTRAPPEND(c, shard.Thread.Store())
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONSTRLEN = &CommandMeta{
+	Name:      "JSON.STRLEN",
+	Syntax:    "JSON.STRLEN key [path]",
+	HelpShort: "JSON.STRLEN returns the length of the strings at path",
+	HelpLong: `
+JSON.STRLEN returns the length of the strings at path in the JSON document stored at key.
+
+The path defaults to the root "$" of the document. The reply is a JSON array with the length of
+every matched string, and null for every matched value that is not a string.
+
+The command returns an empty string if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice","age":30}
+OK
+localhost:7379> JSON.STRLEN user $.name
+OK [5]
+	`,
+	Eval:    evalJSONSTRLEN,
+	Execute: executeJSONSTRLEN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONSTRLEN)
+}
+
+var (
+	JSONSTRLENResNilRes = newValueRes("")
+)
+
+func evalJSONSTRLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONSTRLENResNilRes, errors.ErrWrongArgumentCount("JSON.STRLEN")
+	}
+
+	return evalJSONReadMatches(c, s, func(v interface{}) interface{} {
+		if str, ok := v.(string); ok {
+			return len(str)
+		}
+		return nil
+	})
+}
+
+func executeJSONSTRLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONSTRLENResNilRes, errors.ErrWrongArgumentCount("JSON.STRLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONSTRLEN(c, shard.Thread.Store())
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/server/utils"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cJSONTYPE = &CommandMeta{
+	Name:      "JSON.TYPE",
+	Syntax:    "JSON.TYPE key [path]",
+	HelpShort: "JSON.TYPE returns the type of the JSON values at path",
+	HelpLong: `
+JSON.TYPE returns the type of the JSON values at path in the JSON document stored at key.
+
+The path defaults to the root "$" of the document. The reply is a JSON array with the type of every
+matched value: object, array, string, integer, number, boolean or null.
+
+The command returns an empty string if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> JSON.SET user $ {"name":"alice","age":30,"tags":[]}
+OK
+localhost:7379> JSON.TYPE user $.*
+OK ["string","integer","array"]
+	`,
+	Eval:    evalJSONTYPE,
+	Execute: executeJSONTYPE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cJSONTYPE)
+}
+
+var (
+	JSONTYPEResNilRes = newValueRes("")
+)
+
+func evalJSONTYPE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONTYPEResNilRes, errors.ErrWrongArgumentCount("JSON.TYPE")
+	}
+
+	return evalJSONReadMatches(c, s, func(v interface{}) interface{} {
+		// Numbers are decoded as float64, so integral values are
+		// reported as integers to match the type they were set with.
+		if f, ok := v.(float64); ok && f == math.Trunc(f) {
+			return utils.IntegerType
+		}
+		return utils.GetJSONFieldType(v)
+	})
+}
+
+func executeJSONTYPE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return JSONTYPEResNilRes, errors.ErrWrongArgumentCount("JSON.TYPE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalJSONTYPE(c, shard.Thread.Store())
+}
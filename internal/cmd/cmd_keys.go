@@ -20,7 +20,10 @@ KEYS returns all keys matching the pattern.
 The pattern can contain the following special characters to match multiple keys.
 Supports glob-style patterns:
 - *: matches any sequence of characters
-- ?: matches any single character`,
+- ?: matches any single character
+
+KEYS walks every key of every shard and returns all the matches in a single reply,
+so prefer SCAN to iterate over the keys of a large database.`,
 	Examples: `
 localhost:7379> SET k1 v1
 OK
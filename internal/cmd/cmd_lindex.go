@@ -0,0 +1,76 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLINDEX = &CommandMeta{
+	Name:      "LINDEX",
+	Syntax:    "LINDEX key index",
+	HelpShort: "LINDEX returns the element at index in the list stored at key",
+	HelpLong: `
+LINDEX returns the element at index in the list stored at key. Negative indices count from the
+end of the list, so -1 is the last element.
+
+Returns an empty string if the key does not exist or the index is out of range.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c
+OK 3
+localhost:7379> LINDEX q 0
+OK a
+localhost:7379> LINDEX q -1
+OK c
+	`,
+	Eval:    evalLINDEX,
+	Execute: executeLINDEX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLINDEX)
+}
+
+var (
+	LINDEXResNilRes = newValueRes("")
+)
+
+func evalLINDEX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return LINDEXResNilRes, errors.ErrWrongArgumentCount("LINDEX")
+	}
+
+	index, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil {
+		return LINDEXResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, c.C.Args[0])
+	if err != nil || obj == nil {
+		return LINDEXResNilRes, err
+	}
+
+	element, err := obj.Value.(*types.Deque).LIndex(index)
+	if err != nil {
+		return LINDEXResNilRes, nil
+	}
+	return newValueRes(element), nil
+}
+
+func executeLINDEX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return LINDEXResNilRes, errors.ErrWrongArgumentCount("LINDEX")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLINDEX(c, shard.Thread.Store())
+}
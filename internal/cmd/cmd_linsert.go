@@ -0,0 +1,76 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLINSERT = &CommandMeta{
+	Name:      "LINSERT",
+	Syntax:    "LINSERT key <BEFORE | AFTER> pivot element",
+	HelpShort: "LINSERT inserts an element before or after the pivot in the list stored at key",
+	HelpLong: `
+LINSERT inserts the element before or after the first occurrence of pivot in the list stored at key.
+
+Returns the length of the list after the insert, -1 if the pivot was not found and 0 if the key
+does not exist.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a c
+OK 2
+localhost:7379> LINSERT q BEFORE c b
+OK 3
+localhost:7379> LINSERT q AFTER x y
+OK -1
+	`,
+	Eval:    evalLINSERT,
+	Execute: executeLINSERT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLINSERT)
+}
+
+var (
+	LINSERTResNilRes = newIntRes(0)
+)
+
+func evalLINSERT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 4 {
+		return LINSERTResNilRes, errors.ErrWrongArgumentCount("LINSERT")
+	}
+
+	key, beforeAfter := c.C.Args[0], strings.ToLower(c.C.Args[1])
+	if beforeAfter != types.Before && beforeAfter != types.After {
+		return LINSERTResNilRes, errors.ErrInvalidSyntax("LINSERT")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, key)
+	if err != nil || obj == nil {
+		return LINSERTResNilRes, err
+	}
+
+	length, err := obj.Value.(*types.Deque).LInsert(c.C.Args[2], c.C.Args[3], beforeAfter)
+	if err != nil {
+		return LINSERTResNilRes, err
+	}
+	return newIntRes(length), nil
+}
+
+func executeLINSERT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 4 {
+		return LINSERTResNilRes, errors.ErrWrongArgumentCount("LINSERT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLINSERT(c, shard.Thread.Store())
+}
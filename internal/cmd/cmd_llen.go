@@ -0,0 +1,59 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLLEN = &CommandMeta{
+	Name:      "LLEN",
+	Syntax:    "LLEN key",
+	HelpShort: "LLEN returns the length of the list stored at key",
+	HelpLong: `
+LLEN returns the length of the list stored at key, and 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c
+OK 3
+localhost:7379> LLEN q
+OK 3
+	`,
+	Eval:    evalLLEN,
+	Execute: executeLLEN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLLEN)
+}
+
+var (
+	LLENResNilRes = newIntRes(0)
+)
+
+func evalLLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return LLENResNilRes, errors.ErrWrongArgumentCount("LLEN")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, c.C.Args[0])
+	if err != nil || obj == nil {
+		return LLENResNilRes, err
+	}
+	return newIntRes(obj.Value.(*types.Deque).GetLength()), nil
+}
+
+func executeLLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return LLENResNilRes, errors.ErrWrongArgumentCount("LLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLLEN(c, shard.Thread.Store())
+}
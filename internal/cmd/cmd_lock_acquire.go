@@ -0,0 +1,143 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLOCKACQUIRE = &CommandMeta{
+	Name:      "LOCK.ACQUIRE",
+	Syntax:    "LOCK.ACQUIRE key owner ttl_ms",
+	HelpShort: "LOCK.ACQUIRE acquires the lock stored at key for ttl_ms milliseconds",
+	HelpLong: `
+LOCK.ACQUIRE acquires the lock stored at key on behalf of owner, which identifies the client,
+for a lease of ttl_ms milliseconds. The lock is created if the key does not exist. The owner
+keeps the lock until it releases it with LOCK.RELEASE or the lease expires, and extends the
+lease with LOCK.RENEW.
+
+Every acquisition returns a fencing token greater than those of all the previous ones, including
+those of the same owner. Pass it to the resources the lock protects so that they reject the
+writes carrying a lower token, sent by an owner whose lease expired without it noticing.
+
+The lock keeps its token when it is released or its lease expires, so the tokens keep increasing
+until the key is deleted. Use LOCK.WATCH to wait for a lock to be released instead of retrying.
+
+Returns the fencing token, or an error if another owner holds the lock.
+	`,
+	Examples: `
+localhost:7379> LOCK.ACQUIRE scheduler node-1 10000
+OK 1
+localhost:7379> LOCK.ACQUIRE scheduler node-2 10000
+ERR lock is held by another owner
+localhost:7379> LOCK.RELEASE scheduler node-1
+OK 1
+localhost:7379> LOCK.ACQUIRE scheduler node-2 10000
+OK 2
+	`,
+	Eval:    evalLOCKACQUIRE,
+	Execute: executeLOCKACQUIRE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLOCKACQUIRE)
+}
+
+var (
+	LOCKACQUIREResNilRes = newIntRes(0)
+)
+
+func evalLOCKACQUIRE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LOCKACQUIREResNilRes, errors.ErrWrongArgumentCount("LOCK.ACQUIRE")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now().UnixMilli()
+	ttl, err := parseLockLease(c, "LOCK.ACQUIRE", now)
+	if err != nil {
+		return LOCKACQUIREResNilRes, err
+	}
+	l, err := getLock(s, c.C.Args[0])
+	if err != nil {
+		return LOCKACQUIREResNilRes, err
+	}
+	if l == nil {
+		l = types.NewLock()
+		s.Put(c.C.Args[0], s.NewObj(l, -1, object.ObjTypeLock))
+	}
+
+	// Only the acquisitions that succeeded are logged to the WAL, so they
+	// are replayed whoever held the lock, which rebuilds the same tokens.
+	token, ok := l.Acquire(c.C.Args[1], ttl, now, c.IsReplay)
+	if !ok {
+		return LOCKACQUIREResNilRes, errors.ErrGeneral("lock is held by another owner")
+	}
+	setLockLeaseExpiry(c, now+ttl)
+	return newIntRes(int64(token)), nil
+}
+
+func executeLOCKACQUIRE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LOCKACQUIREResNilRes, errors.ErrWrongArgumentCount("LOCK.ACQUIRE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLOCKACQUIRE(c, shard.Thread.Store())
+}
+
+// getLock returns the lock stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type. The caller must hold the lock of the store, as
+// the commands reading and updating the lock run on the io threads of
+// their clients.
+func getLock(s *dstore.Store, key string) (*types.Lock, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeLock {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.Lock), nil
+}
+
+// parseLockLease returns the duration of the lease that LOCK.ACQUIRE or
+// LOCK.RENEW grants at nowMs. A replayed command carries the absolute
+// expiry of the lease instead of its ttl, see setLockLeaseExpiry, and its
+// lease may have expired already.
+func parseLockLease(c *Cmd, cmd string, nowMs int64) (int64, error) {
+	if !c.IsReplay {
+		return parseLockTTL(c.C.Args[2], cmd)
+	}
+	expiresAt, err := strconv.ParseInt(c.C.Args[2], 10, 64)
+	if err != nil {
+		return 0, errors.ErrInvalidExpireTime(cmd)
+	}
+	return expiresAt - nowMs, nil
+}
+
+// setLockLeaseExpiry replaces the ttl argument of LOCK.ACQUIRE or
+// LOCK.RENEW with the absolute expiry of the lease it granted, so that the
+// command logged to the WAL does not extend the lease past the restart
+// when it is replayed.
+func setLockLeaseExpiry(c *Cmd, expiresAt int64) {
+	c.C.Args[2] = strconv.FormatInt(expiresAt, 10)
+}
+
+func parseLockTTL(arg, cmd string) (int64, error) {
+	ttl, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || ttl <= 0 || ttl > int64(365*24*time.Hour/time.Millisecond) {
+		return 0, errors.ErrInvalidExpireTime(cmd)
+	}
+	return ttl, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cLOCKINFO = &CommandMeta{
+	Name:      "LOCK.INFO",
+	Syntax:    "LOCK.INFO key",
+	HelpShort: "LOCK.INFO returns the state of the lock stored at key",
+	HelpLong: `
+LOCK.INFO returns the state of the lock stored at key.
+
+- owner: the owner holding the lock, or an empty string if it is free
+- token: the fencing token of the last acquisition, or 0 if the lock was never acquired
+- ttl_ms: the number of milliseconds left before the lease expires, or 0 if the lock is free
+
+Returns the state as name and value pairs. A key that does not exist is a free lock.
+	`,
+	Examples: `
+localhost:7379> LOCK.ACQUIRE scheduler node-1 10000
+OK 1
+localhost:7379> LOCK.INFO scheduler
+OK
+0) owner="node-1"
+1) token="1"
+2) ttl_ms="9998"
+	`,
+	Eval:    evalLOCKINFO,
+	Execute: executeLOCKINFO,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLOCKINFO)
+}
+
+var (
+	LOCKINFOResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalLOCKINFO(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return LOCKINFOResNilRes, errors.ErrWrongArgumentCount("LOCK.INFO")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	l, err := getLock(s, c.C.Args[0])
+	if err != nil {
+		return LOCKINFOResNilRes, err
+	}
+	if l == nil {
+		l = types.NewLock()
+	}
+	now := time.Now().UnixMilli()
+	return newPairsRes([]*wire.HElement{
+		{Key: "owner", Value: l.Owner(now)},
+		{Key: "token", Value: strconv.FormatUint(l.Token(), 10)},
+		{Key: "ttl_ms", Value: strconv.FormatInt(l.TTL(now), 10)},
+	}), nil
+}
+
+func executeLOCKINFO(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return LOCKINFOResNilRes, errors.ErrWrongArgumentCount("LOCK.INFO")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLOCKINFO(c, shard.Thread.Store())
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cLOCKRELEASE = &CommandMeta{
+	Name:      "LOCK.RELEASE",
+	Syntax:    "LOCK.RELEASE key owner",
+	HelpShort: "LOCK.RELEASE releases the lock stored at key if owner holds it",
+	HelpLong: `
+LOCK.RELEASE releases the lock stored at key if owner holds it, so that another owner can
+acquire it. The lock keeps its fencing token.
+
+Returns 1 if the lock was released, or 0 if owner did not hold it, because its lease expired or
+another owner holds it.
+	`,
+	Examples: `
+localhost:7379> LOCK.ACQUIRE scheduler node-1 10000
+OK 1
+localhost:7379> LOCK.RELEASE scheduler node-2
+OK 0
+localhost:7379> LOCK.RELEASE scheduler node-1
+OK 1
+	`,
+	Eval:    evalLOCKRELEASE,
+	Execute: executeLOCKRELEASE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLOCKRELEASE)
+}
+
+var (
+	LOCKRELEASEResNilRes = newIntRes(0)
+)
+
+func evalLOCKRELEASE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return LOCKRELEASEResNilRes, errors.ErrWrongArgumentCount("LOCK.RELEASE")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	l, err := getLock(s, c.C.Args[0])
+	if err != nil || l == nil {
+		return LOCKRELEASEResNilRes, err
+	}
+	if l.Release(c.C.Args[1], time.Now().UnixMilli()) {
+		return newIntRes(1), nil
+	}
+	return newIntRes(0), nil
+}
+
+func executeLOCKRELEASE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return LOCKRELEASEResNilRes, errors.ErrWrongArgumentCount("LOCK.RELEASE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLOCKRELEASE(c, shard.Thread.Store())
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cLOCKRENEW = &CommandMeta{
+	Name:      "LOCK.RENEW",
+	Syntax:    "LOCK.RENEW key owner ttl_ms",
+	HelpShort: "LOCK.RENEW extends the lease of the owner of the lock stored at key",
+	HelpLong: `
+LOCK.RENEW extends the lease of owner on the lock stored at key to ttl_ms milliseconds from now.
+The fencing token does not change. An owner whose lease expired cannot renew it, even if no
+other owner took the lock since, and has to acquire the lock again.
+
+Returns the fencing token, or an error if owner does not hold the lock.
+	`,
+	Examples: `
+localhost:7379> LOCK.ACQUIRE scheduler node-1 10000
+OK 1
+localhost:7379> LOCK.RENEW scheduler node-1 10000
+OK 1
+localhost:7379> LOCK.RENEW scheduler node-2 10000
+ERR lock is not held by 'node-2'
+	`,
+	Eval:    evalLOCKRENEW,
+	Execute: executeLOCKRENEW,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLOCKRENEW)
+}
+
+var (
+	LOCKRENEWResNilRes = newIntRes(0)
+)
+
+func evalLOCKRENEW(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LOCKRENEWResNilRes, errors.ErrWrongArgumentCount("LOCK.RENEW")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now().UnixMilli()
+	ttl, err := parseLockLease(c, "LOCK.RENEW", now)
+	if err != nil {
+		return LOCKRENEWResNilRes, err
+	}
+	l, err := getLock(s, c.C.Args[0])
+	if err != nil {
+		return LOCKRENEWResNilRes, err
+	}
+	owner := c.C.Args[1]
+	if l == nil || !l.Renew(owner, ttl, now, c.IsReplay) {
+		return LOCKRENEWResNilRes, errors.ErrGeneral("lock is not held by '" + owner + "'")
+	}
+	setLockLeaseExpiry(c, now+ttl)
+	return newIntRes(int64(l.Token())), nil
+}
+
+func executeLOCKRENEW(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LOCKRENEWResNilRes, errors.ErrWrongArgumentCount("LOCK.RENEW")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLOCKRENEW(c, shard.Thread.Store())
+}
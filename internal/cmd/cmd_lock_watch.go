@@ -0,0 +1,89 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cLOCKWATCH = &CommandMeta{
+	Name:      "LOCK.WATCH",
+	Syntax:    "LOCK.WATCH key",
+	HelpShort: "LOCK.WATCH creates a subscription over the owner of the lock stored at key",
+	HelpLong: `
+LOCK.WATCH creates a subscription over the lock stored at key. The client invoking the command
+receives the owner of the lock and its fencing token whenever they change: the lock is acquired,
+released, or its lease expires. Renewals are not pushed.
+
+Clients waiting for a lock watch it and try LOCK.ACQUIRE when it is pushed with an empty owner,
+instead of polling. The expiry of a lease is pushed when the shard next deletes its expired keys,
+which it does every second.
+	`,
+	Examples: `
+client1:7379> LOCK.ACQUIRE scheduler node-1 10000
+OK 1
+client2:7379> LOCK.WATCH scheduler
+entered the watch mode for LOCK.WATCH scheduler
+
+
+client1:7379> LOCK.RELEASE scheduler node-1
+OK 1
+
+
+client2:7379> ...
+entered the watch mode for LOCK.WATCH scheduler
+OK [fingerprint=5576649565246305299]
+0) owner=""
+1) token="1"
+	`,
+	Eval:           evalLOCKWATCH,
+	Execute:        executeLOCKWATCH,
+	NotifyOnChange: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLOCKWATCH)
+}
+
+var (
+	LOCKWATCHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalLOCKWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return LOCKWATCHResNilRes, errors.ErrWrongArgumentCount("LOCK.WATCH")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	l, err := getLock(s, c.C.Args[0])
+	if err != nil {
+		return LOCKWATCHResNilRes, err
+	}
+	owner, token := "", uint64(0)
+	if l != nil {
+		owner, token = l.Owner(time.Now().UnixMilli()), l.Token()
+	}
+	r := newPairsRes([]*wire.HElement{
+		{Key: "owner", Value: owner},
+		{Key: "token", Value: strconv.FormatUint(token, 10)},
+	})
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeLOCKWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return LOCKWATCHResNilRes, errors.ErrWrongArgumentCount("LOCK.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLOCKWATCH(c, shard.Thread.Store())
+}
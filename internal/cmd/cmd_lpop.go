@@ -0,0 +1,111 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLPOP = &CommandMeta{
+	Name:      "LPOP",
+	Syntax:    "LPOP key [count]",
+	HelpShort: "LPOP removes and returns the first elements of the list stored at key",
+	HelpLong: `
+LPOP removes and returns the first element of the list stored at key. The key is deleted once
+the list is empty.
+
+Without count, the popped element is returned as a value, and an empty string if the key does
+not exist. With count, up to count elements are popped and returned as a list.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c
+OK 3
+localhost:7379> LPOP q
+OK a
+localhost:7379> LPOP q 2
+OK
+0) b
+1) c
+	`,
+	Eval:    evalLPOP,
+	Execute: executeLPOP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLPOP)
+}
+
+var (
+	LPOPResNilRes = newValueRes("")
+)
+
+func evalLPOP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return LPOPResNilRes, errors.ErrWrongArgumentCount("LPOP")
+	}
+	return evalPop(c, s, (*types.Deque).LPop)
+}
+
+func executeLPOP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return LPOPResNilRes, errors.ErrWrongArgumentCount("LPOP")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLPOP(c, shard.Thread.Store())
+}
+
+// evalPop pops elements for a "POP key [count]" command from the list
+// stored at key. Without count the element is replied as a value,
+// otherwise the popped elements are replied as a list.
+func evalPop(c *Cmd, s *dstore.Store, pop func(*types.Deque) (string, error)) (*CmdRes, error) {
+	if len(c.C.Args) == 1 {
+		elements, err := popDeque(s, c.C.Args[0], 1, pop)
+		if err != nil || len(elements) == 0 {
+			return newValueRes(""), err
+		}
+		return newValueRes(elements[0]), nil
+	}
+
+	count, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil || count < 0 {
+		return newListRes([]string{}), errors.ErrIntegerOutOfRange
+	}
+	elements, err := popDeque(s, c.C.Args[0], count, pop)
+	if err != nil {
+		return newListRes([]string{}), err
+	}
+	return newListRes(elements), nil
+}
+
+// popDeque pops up to count elements from the list stored at key.
+// The key is deleted once the list is empty.
+func popDeque(s *dstore.Store, key string, count int64, pop func(*types.Deque) (string, error)) ([]string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, key)
+	if err != nil || obj == nil {
+		return []string{}, err
+	}
+
+	deq := obj.Value.(*types.Deque)
+	elements := make([]string, 0, min(count, deq.GetLength()))
+	for int64(len(elements)) < count && deq.GetLength() > 0 {
+		x, err := pop(deq)
+		if err != nil {
+			return elements, err
+		}
+		elements = append(elements, x)
+	}
+
+	if deq.GetLength() == 0 {
+		s.Del(key)
+	}
+	return elements, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLPUSH = &CommandMeta{
+	Name:      "LPUSH",
+	Syntax:    "LPUSH key element [element ...]",
+	HelpShort: "LPUSH inserts elements at the head of the list stored at key",
+	HelpLong: `
+LPUSH inserts all the specified elements at the head of the list stored at key. Elements are
+inserted one after the other, so the last element ends up at the head of the list.
+
+If the key does not exist, an empty list is created before the push. Returns the length of the
+list after the push.
+	`,
+	Examples: `
+localhost:7379> LPUSH q a b c
+OK 3
+localhost:7379> LRANGE q 0 -1
+OK
+0) c
+1) b
+2) a
+	`,
+	Eval:    evalLPUSH,
+	Execute: executeLPUSH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLPUSH)
+}
+
+var (
+	LPUSHResNilRes = newIntRes(0)
+)
+
+func evalLPUSH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return LPUSHResNilRes, errors.ErrWrongArgumentCount("LPUSH")
+	}
+	return evalPush(c, s, (*types.Deque).LPush)
+}
+
+func executeLPUSH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return LPUSHResNilRes, errors.ErrWrongArgumentCount("LPUSH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLPUSH(c, shard.Thread.Store())
+}
+
+// getDequeObj returns the list stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type. The clients of a shard run on their own io
+// threads, so the callers hold the lock of the store while they use the
+// list.
+func getDequeObj(s *dstore.Store, key string) (*object.Obj, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeDequeue {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj, nil
+}
+
+// evalPush pushes the elements of a "PUSH key element [element ...]"
+// command onto the list stored at key, creating the list if needed,
+// and wakes up the clients blocked on the key.
+func evalPush(c *Cmd, s *dstore.Store, push func(*types.Deque, string)) (*CmdRes, error) {
+	key := c.C.Args[0]
+
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, key)
+	if err != nil {
+		return newIntRes(0), err
+	}
+	if obj == nil {
+		obj = s.NewObj(types.NewDeque(), -1, object.ObjTypeDequeue)
+		s.Put(key, obj)
+	}
+
+	deq := obj.Value.(*types.Deque)
+	for _, element := range c.C.Args[1:] {
+		push(deq, element)
+	}
+
+	return newIntRes(deq.GetLength()), nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLRANGE = &CommandMeta{
+	Name:      "LRANGE",
+	Syntax:    "LRANGE key start stop",
+	HelpShort: "LRANGE returns the elements between start and stop of the list stored at key",
+	HelpLong: `
+LRANGE returns the elements between start and stop, both inclusive, of the list stored at key.
+Negative indices count from the end of the list, so -1 is the last element.
+
+Returns an empty list if the key does not exist or the range is empty.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c
+OK 3
+localhost:7379> LRANGE q 0 -2
+OK
+0) a
+1) b
+	`,
+	Eval:    evalLRANGE,
+	Execute: executeLRANGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLRANGE)
+}
+
+var (
+	LRANGEResNilRes = newListRes([]string{})
+)
+
+func evalLRANGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LRANGEResNilRes, errors.ErrWrongArgumentCount("LRANGE")
+	}
+
+	start, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil {
+		return LRANGEResNilRes, errors.ErrIntegerOutOfRange
+	}
+	stop, err := strconv.ParseInt(c.C.Args[2], 10, 64)
+	if err != nil {
+		return LRANGEResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, c.C.Args[0])
+	if err != nil || obj == nil {
+		return LRANGEResNilRes, err
+	}
+
+	elements, err := obj.Value.(*types.Deque).LRange(start, stop)
+	if err != nil {
+		return LRANGEResNilRes, err
+	}
+	return newListRes(elements), nil
+}
+
+func executeLRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LRANGEResNilRes, errors.ErrWrongArgumentCount("LRANGE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLRANGE(c, shard.Thread.Store())
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLSET = &CommandMeta{
+	Name:      "LSET",
+	Syntax:    "LSET key index element",
+	HelpShort: "LSET sets the element at index in the list stored at key",
+	HelpLong: `
+LSET sets the element at index in the list stored at key. Negative indices count from the end of
+the list, so -1 is the last element.
+
+Returns an error if the key does not exist or the index is out of range.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c
+OK 3
+localhost:7379> LSET q -1 z
+OK
+localhost:7379> LSET q 5 z
+ERR index out of range
+	`,
+	Eval:    evalLSET,
+	Execute: executeLSET,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLSET)
+}
+
+var (
+	LSETResNilRes = newOKRes()
+	LSETResOKRes  = newOKRes()
+)
+
+func evalLSET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LSETResNilRes, errors.ErrWrongArgumentCount("LSET")
+	}
+
+	index, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil {
+		return LSETResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, c.C.Args[0])
+	if err != nil {
+		return LSETResNilRes, err
+	}
+	if obj == nil {
+		return LSETResNilRes, errors.ErrKeyNotFound
+	}
+
+	if err := obj.Value.(*types.Deque).LSet(index, c.C.Args[2]); err != nil {
+		return LSETResNilRes, errors.ErrIndexOutOfRange
+	}
+	return LSETResOKRes, nil
+}
+
+func executeLSET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LSETResNilRes, errors.ErrWrongArgumentCount("LSET")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLSET(c, shard.Thread.Store())
+}
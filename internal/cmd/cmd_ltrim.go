@@ -0,0 +1,88 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cLTRIM = &CommandMeta{
+	Name:      "LTRIM",
+	Syntax:    "LTRIM key start stop",
+	HelpShort: "LTRIM trims the list stored at key to the elements between start and stop",
+	HelpLong: `
+LTRIM trims the list stored at key so that it only contains the elements between start and stop,
+both inclusive. Negative indices count from the end of the list, so -1 is the last element.
+
+The key is deleted if the range is empty. Returns "OK" even if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c d
+OK 4
+localhost:7379> LTRIM q 1 -2
+OK
+localhost:7379> LRANGE q 0 -1
+OK
+0) b
+1) c
+	`,
+	Eval:    evalLTRIM,
+	Execute: executeLTRIM,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cLTRIM)
+}
+
+var (
+	LTRIMResNilRes = newOKRes()
+	LTRIMResOKRes  = newOKRes()
+)
+
+func evalLTRIM(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LTRIMResNilRes, errors.ErrWrongArgumentCount("LTRIM")
+	}
+
+	start, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil {
+		return LTRIMResNilRes, errors.ErrIntegerOutOfRange
+	}
+	stop, err := strconv.ParseInt(c.C.Args[2], 10, 64)
+	if err != nil {
+		return LTRIMResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	key := c.C.Args[0]
+
+	s.Lock()
+	defer s.Unlock()
+
+	obj, err := getDequeObj(s, key)
+	if err != nil || obj == nil {
+		return LTRIMResNilRes, err
+	}
+
+	deq := obj.Value.(*types.Deque)
+	if err := deq.LTrim(start, stop); err != nil {
+		return LTRIMResNilRes, err
+	}
+	if deq.GetLength() == 0 {
+		s.Del(key)
+	}
+	return LTRIMResOKRes, nil
+}
+
+func executeLTRIM(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return LTRIMResNilRes, errors.ErrWrongArgumentCount("LTRIM")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalLTRIM(c, shard.Thread.Store())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cMGET = &CommandMeta{
+	Name:      "MGET",
+	Syntax:    "MGET key [key ...]",
+	HelpShort: "MGET returns the values of all the given keys",
+	HelpLong: `
+MGET returns the values of all the given keys, in the order of the keys, as GET would. The keys
+may live on different shards.
+
+A key that does not exist, or that holds a value other than a string or a number, has an empty
+string as its value.
+	`,
+	Examples: `
+localhost:7379> MSET k1 v1 k2 v2
+OK
+localhost:7379> MGET k1 k2 k3
+OK
+0) "v1"
+1) "v2"
+2) ""
+	`,
+	Eval:    evalMGET,
+	Execute: executeMGET,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cMGET)
+}
+
+var (
+	MGETResNilRes = newListRes([]string{})
+)
+
+func evalMGET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return MGETResNilRes, errors.ErrWrongArgumentCount("MGET")
+	}
+	return mget(c, localStore(s))
+}
+
+func executeMGET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return MGETResNilRes, errors.ErrWrongArgumentCount("MGET")
+	}
+	return mget(c, shardStores(sm))
+}
+
+// mget reads every key from the store that storeForKey returns for it.
+func mget(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	values := make([]string, len(c.C.Args))
+	for i, key := range c.C.Args {
+		obj := storeForKey(key).Get(key)
+		if obj == nil {
+			continue
+		}
+		if value, err := getStringFromObj(obj); err == nil {
+			values[i] = value
+		}
+	}
+	return newListRes(values), nil
+}
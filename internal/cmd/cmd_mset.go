@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cMSET = &CommandMeta{
+	Name:      "MSET",
+	Syntax:    "MSET key value [key value ...]",
+	HelpShort: "MSET sets the values of all the given keys",
+	HelpLong: `
+MSET sets the value of every given key, like SET without options would. The keys may live on
+different shards. Existing values are replaced and their expiry is removed.
+
+Returns "OK".
+	`,
+	Examples: `
+localhost:7379> MSET k1 v1 k2 v2
+OK
+localhost:7379> MGET k1 k2
+OK
+0) "v1"
+1) "v2"
+	`,
+	Eval:       evalMSET,
+	Execute:    executeMSET,
+	NotifyKeys: everyNthKey(2),
+}
+
+func init() {
+	CommandRegistry.AddCommand(cMSET)
+}
+
+var (
+	MSETResNilRes = newOKRes()
+)
+
+func evalMSET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args)%2 != 0 {
+		return MSETResNilRes, errors.ErrWrongArgumentCount("MSET")
+	}
+	mset(c.C.Args, localStore(s))
+	return newOKRes(), nil
+}
+
+func executeMSET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args)%2 != 0 {
+		return MSETResNilRes, errors.ErrWrongArgumentCount("MSET")
+	}
+	mset(c.C.Args, shardStores(sm))
+	return newOKRes(), nil
+}
+
+// mset sets every key of the key value pairs in the store that storeForKey
+// returns for it.
+func mset(pairs []string, storeForKey func(key string) *dstore.Store) {
+	for i := 0; i < len(pairs); i += 2 {
+		s := storeForKey(pairs[i])
+		s.Put(pairs[i], CreateObjectFromValue(s, pairs[i+1], -1))
+	}
+}
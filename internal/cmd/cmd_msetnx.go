@@ -0,0 +1,77 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cMSETNX = &CommandMeta{
+	Name:      "MSETNX",
+	Syntax:    "MSETNX key value [key value ...]",
+	HelpShort: "MSETNX sets the values of all the given keys only if none of them exists",
+	HelpLong: `
+MSETNX sets the value of every given key, like MSET, only if none of the keys exists. Either all
+the keys are set or none is, even when they live on different shards.
+
+Returns 1 if the keys were set, or 0 if at least one of them already existed.
+	`,
+	Examples: `
+localhost:7379> MSETNX k1 v1 k2 v2
+OK 1
+localhost:7379> MSETNX k2 v3 k3 v3
+OK 0
+localhost:7379> MGET k1 k2 k3
+OK
+0) "v1"
+1) "v2"
+2) ""
+	`,
+	Eval:       evalMSETNX,
+	Execute:    executeMSETNX,
+	NotifyKeys: everyNthKey(2),
+}
+
+func init() {
+	CommandRegistry.AddCommand(cMSETNX)
+}
+
+var (
+	MSETNXResNilRes = newIntRes(0)
+)
+
+func evalMSETNX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args)%2 != 0 {
+		return MSETNXResNilRes, errors.ErrWrongArgumentCount("MSETNX")
+	}
+	return msetnx(c.C.Args, localStore(s))
+}
+
+func executeMSETNX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 || len(c.C.Args)%2 != 0 {
+		return MSETNXResNilRes, errors.ErrWrongArgumentCount("MSETNX")
+	}
+	return msetnx(c.C.Args, shardStores(sm))
+}
+
+// msetnx checks that none of the keys exists in the store that storeForKey
+// returns for it before setting any of them. The stores are locked for the
+// whole check and set, so that two MSETNX sharing a key cannot both set it.
+func msetnx(pairs []string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	keys := make([]string, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		keys = append(keys, pairs[i])
+	}
+	defer lockStores(keys, storeForKey)()
+
+	for i := 0; i < len(pairs); i += 2 {
+		if storeForKey(pairs[i]).Get(pairs[i]) != nil {
+			return MSETNXResNilRes, nil
+		}
+	}
+	mset(pairs, storeForKey)
+	return newIntRes(1), nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cOBJECT = &CommandMeta{
+	Name:      "OBJECT",
+	Syntax:    "OBJECT ENCODING | FREQ | IDLETIME key",
+	HelpShort: "OBJECT returns the internals of the value stored at key",
+	HelpLong: `
+OBJECT returns the internals of the value stored at key, without counting as an access to it.
+
+- ENCODING returns how the value is represented in memory, such as skiplist for sorted sets
+  or hashtable for sets and hashes. Types without a distinct representation return their name.
+- FREQ returns the access frequency of the key. It is a logarithmic counter that starts at 5,
+  grows more slowly the more the key is accessed, up to 255, and decreases by one for every
+  minute passed without an access.
+- IDLETIME returns the number of seconds since the key was last read or written.
+
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k v
+OK
+localhost:7379> OBJECT ENCODING k
+OK raw
+localhost:7379> OBJECT FREQ k
+OK 5
+localhost:7379> GET k
+OK v
+localhost:7379> OBJECT FREQ k
+OK 6
+localhost:7379> OBJECT IDLETIME k
+OK 3
+	`,
+	Eval:       evalOBJECT,
+	Execute:    executeOBJECT,
+	NotifyKeys: nthKey(1),
+}
+
+func init() {
+	CommandRegistry.AddCommand(cOBJECT)
+}
+
+var (
+	OBJECTResNilRes = newValueRes("")
+)
+
+// objectEncodings names the representation of the values of the types that
+// have one distinct from their type.
+var objectEncodings = map[object.ObjectType]string{
+	object.ObjTypeString:    "raw",
+	object.ObjTypeByteArray: "raw",
+	object.ObjTypeSet:       "hashtable",
+	object.ObjTypeSSMap:     "hashtable",
+	object.ObjTypeSortedSet: "skiplist",
+	object.ObjTypeDequeue:   "quicklist",
+}
+
+func evalOBJECT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return OBJECTResNilRes, errors.ErrWrongArgumentCount("OBJECT")
+	}
+
+	subcommand := strings.ToUpper(c.C.Args[0])
+	if subcommand != "ENCODING" && subcommand != "FREQ" && subcommand != "IDLETIME" {
+		return OBJECTResNilRes, errors.ErrInvalidSyntax("OBJECT")
+	}
+	obj := s.GetNoTouch(c.C.Args[1])
+	if obj == nil {
+		return OBJECTResNilRes, errors.ErrKeyNotFound
+	}
+
+	switch subcommand {
+	case "ENCODING":
+		if encoding, ok := objectEncodings[obj.Type]; ok {
+			return newValueRes(encoding), nil
+		}
+		return newValueRes(obj.Type.String()), nil
+	case "FREQ":
+		return newIntRes(int64(dstore.GetFrequency(obj, time.Now().UnixMilli()))), nil
+	default:
+		return newIntRes(dstore.GetIdleTime(obj.LastAccessedAt) / 1000), nil
+	}
+}
+
+func executeOBJECT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return OBJECTResNilRes, errors.ErrWrongArgumentCount("OBJECT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[1])
+	return evalOBJECT(c, shard.Thread.Store())
+}
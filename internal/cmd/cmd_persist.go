@@ -0,0 +1,65 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPERSIST = &CommandMeta{
+	Name:      "PERSIST",
+	Syntax:    "PERSIST key",
+	HelpShort: "PERSIST removes the expiry of a key",
+	HelpLong: `
+PERSIST removes the expiry of a key, so that it is kept until it is deleted.
+
+Returns 1 if the expiry was removed, and 0 if the key does not exist or has no expiry.
+	`,
+	Examples: `
+localhost:7379> SET k v EX 100
+OK
+localhost:7379> PERSIST k
+OK 1
+localhost:7379> TTL k
+OK -1
+localhost:7379> PERSIST k
+OK 0
+	`,
+	Eval:    evalPERSIST,
+	Execute: executePERSIST,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPERSIST)
+}
+
+var (
+	PERSISTResNilRes = newIntRes(0)
+)
+
+func evalPERSIST(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return PERSISTResNilRes, errors.ErrWrongArgumentCount("PERSIST")
+	}
+
+	obj := s.Get(c.C.Args[0])
+	if obj == nil {
+		return PERSISTResNilRes, nil
+	}
+	if _, ok := dstore.GetExpiry(obj, s); !ok {
+		return PERSISTResNilRes, nil
+	}
+	dstore.DelExpiry(obj, s)
+	return newIntRes(1), nil
+}
+
+func executePERSIST(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return PERSISTResNilRes, errors.ErrWrongArgumentCount("PERSIST")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalPERSIST(c, shard.Thread.Store())
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPEXPIRE = &CommandMeta{
+	Name:      "PEXPIRE",
+	Syntax:    "PEXPIRE key milliseconds [NX | XX | GT | LT]",
+	HelpShort: "PEXPIRE sets an expiry (in milliseconds) on a specified key",
+	HelpLong: `
+PEXPIRE sets an expiry (in milliseconds) on a specified key. It is the same as EXPIRE, with a
+millisecond precision. After the expiry time has elapsed, the key will be automatically deleted.
+
+> If you want to delete the expiration time on the key, you can use the PERSIST command.
+
+The command returns true if the expiry was set (changed), and false if the expiry could not be set (changed) due to key
+not being present or due to the provided sub-command conditions not being met. The command
+supports the following options:
+
+- NX: Set the expiration only if the key does not already have an expiration time.
+- XX: Set the expiration only if the key already has an expiration time.
+- GT: Set the expiration only if the new expiration time is greater than the current one.
+- LT: Set the expiration only if the new expiration time is less than the current one.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> PEXPIRE k1 10000
+OK true
+localhost:7379> PEXPIRE k1 20000 NX
+OK false
+localhost:7379> PEXPIRE k1 20000 GT
+OK true
+	`,
+	Eval:    evalPEXPIRE,
+	Execute: executePEXPIRE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPEXPIRE)
+}
+
+var (
+	PEXPIREResNilRes    = newEXPIRERes(false)
+	PEXPIREResSetRes    = newEXPIRERes(true)
+	PEXPIREResNotSetRes = newEXPIRERes(false)
+)
+
+func evalPEXPIRE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return PEXPIREResNilRes, errors.ErrWrongArgumentCount("PEXPIRE")
+	}
+
+	key := c.C.Args[0]
+	now := time.Now().UnixMilli()
+	exDurationMs, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil || exDurationMs < 0 || exDurationMs > math.MaxInt64-now {
+		return PEXPIREResNilRes, errors.ErrInvalidExpireTime("PEXPIRE")
+	}
+
+	isExpirySet, err := dstore.EvaluateAndSetExpiry(c.C.Args[2:], now+exDurationMs, key, s)
+	if err != nil {
+		return PEXPIREResNilRes, err
+	}
+
+	if isExpirySet {
+		return PEXPIREResSetRes, nil
+	}
+	return PEXPIREResNotSetRes, nil
+}
+
+func executePEXPIRE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return PEXPIREResNilRes, errors.ErrWrongArgumentCount("PEXPIRE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalPEXPIRE(c, shard.Thread.Store())
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPEXPIREAT = &CommandMeta{
+	Name:      "PEXPIREAT",
+	Syntax:    "PEXPIREAT key unix-time-milliseconds [NX | XX | GT | LT]",
+	HelpShort: "PEXPIREAT sets the expiration time of a key as an absolute Unix timestamp (in milliseconds)",
+	HelpLong: `
+PEXPIREAT sets the expiration time of a key as an absolute Unix timestamp (in milliseconds).
+It is the same as EXPIREAT, with a millisecond precision. After the expiry time has elapsed,
+the key will be automatically deleted.
+
+> If you want to delete the expiration time on the key, you can use the PERSIST command.
+
+The command returns true if the expiry was set (changed), and false if the expiry could not be set (changed) due to key
+not being present or due to the provided sub-command conditions not being met. The command
+supports the following options:
+
+- NX: Set the expiration only if the key does not already have an expiration time.
+- XX: Set the expiration only if the key already has an expiration time.
+- GT: Set the expiration only if the new expiration time is greater than the current one.
+- LT: Set the expiration only if the new expiration time is less than the current one.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> PEXPIREAT k1 1740829942123
+OK true
+localhost:7379> PEXPIREAT k1 1740829942123 NX
+OK false
+localhost:7379> PEXPIREAT k1 1740829942000 LT
+OK true
+	`,
+	Eval:    evalPEXPIREAT,
+	Execute: executePEXPIREAT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPEXPIREAT)
+}
+
+var (
+	PEXPIREATResNilRes       = newEXPIREATRes(false)
+	PEXPIREATResChangedRes   = newEXPIREATRes(true)
+	PEXPIREATResUnchangedRes = newEXPIREATRes(false)
+)
+
+func evalPEXPIREAT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return PEXPIREATResNilRes, errors.ErrWrongArgumentCount("PEXPIREAT")
+	}
+
+	key := c.C.Args[0]
+	exUnixTimeMs, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil || exUnixTimeMs < 0 || exUnixTimeMs > EXPIREATMaxAbsTimestamp*1000 {
+		return PEXPIREATResNilRes, errors.ErrInvalidExpireTime("PEXPIREAT")
+	}
+
+	isExpirySet, err := dstore.EvaluateAndSetExpiry(c.C.Args[2:], exUnixTimeMs, key, s)
+	if err != nil {
+		return PEXPIREATResNilRes, err
+	}
+
+	if isExpirySet {
+		return PEXPIREATResChangedRes, nil
+	}
+	return PEXPIREATResUnchangedRes, nil
+}
+
+func executePEXPIREAT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return PEXPIREATResNilRes, errors.ErrWrongArgumentCount("PEXPIREAT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalPEXPIREAT(c, shard.Thread.Store())
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPEXPIRETIME = &CommandMeta{
+	Name:      "PEXPIRETIME",
+	Syntax:    "PEXPIRETIME key",
+	HelpShort: "PEXPIRETIME returns the absolute Unix timestamp in milliseconds at which the given key will expire",
+	HelpLong: `
+PEXPIRETIME returns the absolute timestamp in Unix milliseconds at which the given key will expire.
+It is the same as EXPIRETIME, with a millisecond precision.
+
+The command returns -1 if the key exists but has no associated expiration time.
+The command returns -2 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> PEXPIREAT k1 1744451192123
+OK true
+localhost:7379> PEXPIRETIME k1
+OK 1744451192123
+	`,
+	Eval:    evalPEXPIRETIME,
+	Execute: executePEXPIRETIME,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPEXPIRETIME)
+}
+
+var (
+	PEXPIRETIMEResNilRes = newIntRes(0)
+	PEXPIRETIMEResNegOne = newIntRes(-1)
+	PEXPIRETIMEResNegTwo = newIntRes(-2)
+)
+
+func evalPEXPIRETIME(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return PEXPIRETIMEResNilRes, errors.ErrWrongArgumentCount("PEXPIRETIME")
+	}
+
+	obj := s.Get(c.C.Args[0])
+	if obj == nil {
+		return PEXPIRETIMEResNegTwo, nil
+	}
+	expiry, ok := dstore.GetExpiry(obj, s)
+	if !ok {
+		return PEXPIRETIMEResNegOne, nil
+	}
+	return newIntRes(expiry), nil
+}
+
+func executePEXPIRETIME(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return PEXPIRETIMEResNilRes, errors.ErrWrongArgumentCount("PEXPIRETIME")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalPEXPIRETIME(c, shard.Thread.Store())
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/axiomhq/hyperloglog"
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPFADD = &CommandMeta{
+	Name:      "PFADD",
+	Syntax:    "PFADD key [element ...]",
+	HelpShort: "PFADD adds elements to the HyperLogLog stored at key",
+	HelpLong: `
+PFADD adds the elements to the HyperLogLog stored at key. If the key does not exist, a new
+HyperLogLog is created, even when no elements are given.
+
+Returns 1 if the estimated cardinality changed or the key was created, and 0 otherwise.
+	`,
+	Examples: `
+localhost:7379> PFADD visitors alice bob
+OK 1
+localhost:7379> PFADD visitors alice
+OK 0
+	`,
+	Eval:    evalPFADD,
+	Execute: executePFADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPFADD)
+}
+
+var (
+	PFADDResNilRes = newIntRes(0)
+)
+
+func evalPFADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return PFADDResNilRes, errors.ErrWrongArgumentCount("PFADD")
+	}
+
+	key := c.C.Args[0]
+	hll, err := getHLL(s, key)
+	if err != nil {
+		return PFADDResNilRes, err
+	}
+
+	if hll == nil {
+		hll = hyperloglog.New()
+		for _, element := range c.C.Args[1:] {
+			hll.Insert([]byte(element))
+		}
+		s.Put(key, s.NewObj(hll, -1, object.ObjTypeHLL))
+		return newIntRes(1), nil
+	}
+
+	before := hll.Estimate()
+	for _, element := range c.C.Args[1:] {
+		hll.Insert([]byte(element))
+	}
+	if hll.Estimate() != before {
+		return newIntRes(1), nil
+	}
+	return newIntRes(0), nil
+}
+
+func executePFADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return PFADDResNilRes, errors.ErrWrongArgumentCount("PFADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalPFADD(c, shard.Thread.Store())
+}
+
+// getHLL returns the HyperLogLog stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getHLL(s *dstore.Store, key string) (*hyperloglog.Sketch, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeHLL {
+		return nil, errors.ErrInvalidHyperLogLogKey
+	}
+	return obj.Value.(*hyperloglog.Sketch), nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/axiomhq/hyperloglog"
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPFCOUNT = &CommandMeta{
+	Name:      "PFCOUNT",
+	Syntax:    "PFCOUNT key [key ...]",
+	HelpShort: "PFCOUNT returns the estimated cardinality of the union of HyperLogLogs",
+	HelpLong: `
+PFCOUNT returns the estimated number of unique elements added to the HyperLogLog stored at key.
+With several keys, it returns the estimated cardinality of their union. The keys may be stored on
+any shard and keys that do not exist are skipped.
+
+Returns 0 if none of the keys exist.
+	`,
+	Examples: `
+localhost:7379> PFADD visitors alice bob
+OK 1
+localhost:7379> PFADD buyers bob carol
+OK 1
+localhost:7379> PFCOUNT visitors buyers
+OK 3
+	`,
+	Eval:        evalPFCOUNT,
+	Execute:     executePFCOUNT,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPFCOUNT)
+}
+
+var (
+	PFCOUNTResNilRes = newIntRes(0)
+)
+
+func evalPFCOUNT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return PFCOUNTResNilRes, errors.ErrWrongArgumentCount("PFCOUNT")
+	}
+	return countHLLs(c.C.Args, localStore(s))
+}
+
+func executePFCOUNT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return PFCOUNTResNilRes, errors.ErrWrongArgumentCount("PFCOUNT")
+	}
+	return countHLLs(c.C.Args, shardStores(sm))
+}
+
+// countHLLs replies with the estimated cardinality of the union of the
+// HyperLogLogs stored at keys, reading each one from storeForKey.
+func countHLLs(keys []string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	if len(keys) == 1 {
+		hll, err := getHLL(storeForKey(keys[0]), keys[0])
+		if err != nil || hll == nil {
+			return PFCOUNTResNilRes, err
+		}
+		return newIntRes(int64(hll.Estimate())), nil
+	}
+
+	union := hyperloglog.New()
+	if err := mergeHLLs(union, keys, storeForKey); err != nil {
+		return PFCOUNTResNilRes, err
+	}
+	return newIntRes(int64(union.Estimate())), nil
+}
+
+// mergeHLLs merges the HyperLogLogs stored at keys into hll, reading each
+// one from storeForKey. Keys that do not exist are skipped.
+func mergeHLLs(hll *hyperloglog.Sketch, keys []string, storeForKey func(key string) *dstore.Store) error {
+	for _, key := range keys {
+		other, err := getHLL(storeForKey(key), key)
+		if err != nil {
+			return err
+		}
+		if other == nil || other == hll {
+			continue
+		}
+		if err := hll.Merge(other); err != nil {
+			return errors.ErrCorruptedHyperLogLogObject
+		}
+	}
+	return nil
+}
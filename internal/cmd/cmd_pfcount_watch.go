@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPFCOUNTWATCH = &CommandMeta{
+	Name:      "PFCOUNT.WATCH",
+	Syntax:    "PFCOUNT.WATCH key [key ...]",
+	HelpShort: "PFCOUNT.WATCH creates a query subscription over the PFCOUNT command",
+	HelpLong: `
+PFCOUNT.WATCH creates a query subscription over the PFCOUNT command. The client invoking the command
+will receive the output of the PFCOUNT command (not just the notification) whenever the HyperLogLog
+stored at the first key is updated, either by PFADD or by PFMERGE.
+	`,
+	Examples: `
+client1:7379> PFADD visitors alice
+OK 1
+client1:7379> PFCOUNT.WATCH visitors
+entered the watch mode for PFCOUNT.WATCH visitors
+
+
+client2:7379> PFADD visitors bob
+OK 1
+
+
+client1:7379> ...
+entered the watch mode for PFCOUNT.WATCH visitors
+OK [fingerprint=3212964732] 2
+	`,
+	Eval:    evalPFCOUNTWATCH,
+	Execute: executePFCOUNTWATCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPFCOUNTWATCH)
+}
+
+var (
+	PFCOUNTWATCHResNilRes = newIntRes(0)
+)
+
+func evalPFCOUNTWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	r, err := evalPFCOUNT(c, s)
+	if err != nil {
+		return PFCOUNTWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executePFCOUNTWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return PFCOUNTWATCHResNilRes, errors.ErrWrongArgumentCount("PFCOUNT.WATCH")
+	}
+
+	r, err := executePFCOUNT(c, sm)
+	if err != nil {
+		return PFCOUNTWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/axiomhq/hyperloglog"
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPFMERGE = &CommandMeta{
+	Name:      "PFMERGE",
+	Syntax:    "PFMERGE destkey [sourcekey ...]",
+	HelpShort: "PFMERGE merges HyperLogLogs into the HyperLogLog stored at destkey",
+	HelpLong: `
+PFMERGE merges the HyperLogLogs stored at the source keys into the HyperLogLog stored at destkey,
+so that its estimated cardinality is that of the union of all of them. The source keys may be
+stored on any shard and keys that do not exist are skipped. If destkey does not exist, a new
+HyperLogLog is created.
+
+Returns "OK" if the HyperLogLogs were merged.
+	`,
+	Examples: `
+localhost:7379> PFADD visitors alice bob
+OK 1
+localhost:7379> PFADD buyers bob carol
+OK 1
+localhost:7379> PFMERGE all visitors buyers
+OK
+localhost:7379> PFCOUNT all
+OK 3
+	`,
+	Eval:    evalPFMERGE,
+	Execute: executePFMERGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPFMERGE)
+}
+
+var (
+	PFMERGEResNilRes = newOKRes()
+	PFMERGEResOKRes  = newOKRes()
+)
+
+func evalPFMERGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return PFMERGEResNilRes, errors.ErrWrongArgumentCount("PFMERGE")
+	}
+	return mergeHLLsInto(c.C.Args[0], c.C.Args[1:], localStore(s))
+}
+
+func executePFMERGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return PFMERGEResNilRes, errors.ErrWrongArgumentCount("PFMERGE")
+	}
+	return mergeHLLsInto(c.C.Args[0], c.C.Args[1:], shardStores(sm))
+}
+
+// mergeHLLsInto evaluates PFMERGE reading every HyperLogLog from the store
+// that storeForKey returns for its key. The sources are merged into a copy
+// of the destination so that a failed merge leaves it unchanged, and an
+// existing destination keeps its expiry.
+func mergeHLLsInto(destKey string, keys []string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	s := storeForKey(destKey)
+	dest, err := getHLL(s, destKey)
+	if err != nil {
+		return PFMERGEResNilRes, err
+	}
+
+	merged := hyperloglog.New()
+	if dest != nil {
+		merged = dest.Clone()
+	}
+	if err := mergeHLLs(merged, keys, storeForKey); err != nil {
+		return PFMERGEResNilRes, err
+	}
+
+	if obj := s.Get(destKey); obj != nil {
+		obj.Value = merged
+		return PFMERGEResOKRes, nil
+	}
+	s.Put(destKey, s.NewObj(merged, -1, object.ObjTypeHLL))
+	return PFMERGEResOKRes, nil
+}
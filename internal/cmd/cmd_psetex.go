@@ -0,0 +1,63 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPSETEX = &CommandMeta{
+	Name:      "PSETEX",
+	Syntax:    "PSETEX key milliseconds value",
+	HelpShort: "PSETEX sets the value of key with an expiry in milliseconds",
+	HelpLong: `
+PSETEX sets the value of key, like SET, and makes the key expire after the given number of
+milliseconds. It is the same as SET key value PX milliseconds.
+
+Returns "OK".
+	`,
+	Examples: `
+localhost:7379> PSETEX k 10000 v
+OK
+localhost:7379> GET k
+OK "v"
+	`,
+	Eval:    evalPSETEX,
+	Execute: executePSETEX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPSETEX)
+}
+
+var (
+	PSETEXResNilRes = newOKRes()
+)
+
+func evalPSETEX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return PSETEXResNilRes, errors.ErrWrongArgumentCount("PSETEX")
+	}
+
+	key, value := c.C.Args[0], c.C.Args[2]
+	exDurationMs, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil || exDurationMs <= 0 || exDurationMs >= MaxEXDurationSec*1000 {
+		return PSETEXResNilRes, errors.ErrInvalidExpireTime("PSETEX")
+	}
+
+	s.Put(key, CreateObjectFromValue(s, value, exDurationMs))
+	return newOKRes(), nil
+}
+
+func executePSETEX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return PSETEXResNilRes, errors.ErrWrongArgumentCount("PSETEX")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalPSETEX(c, shard.Thread.Store())
+}
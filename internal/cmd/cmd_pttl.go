@@ -0,0 +1,73 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cPTTL = &CommandMeta{
+	Name:      "PTTL",
+	Syntax:    "PTTL key",
+	HelpShort: "PTTL returns the remaining time to live in milliseconds",
+	HelpLong: `
+PTTL returns the remaining time to live (in milliseconds) of a key that has an expiration set.
+It is the same as TTL, with a millisecond precision.
+
+- Returns -1 if the key has no expiration.
+- Returns -2 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k 43
+OK
+localhost:7379> PTTL k
+OK -1
+localhost:7379> SET k 43 EX 10
+OK
+localhost:7379> PTTL k
+OK 8946
+localhost:7379> PTTL kn
+OK -2
+	`,
+	Eval:    evalPTTL,
+	Execute: executePTTL,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cPTTL)
+}
+
+var (
+	PTTLResNilRes      = newIntRes(0)
+	PTTLResNoExpiryRes = newIntRes(-1)
+	PTTLResNotFoundRes = newIntRes(-2)
+)
+
+func evalPTTL(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return PTTLResNilRes, errors.ErrWrongArgumentCount("PTTL")
+	}
+
+	obj := s.Get(c.C.Args[0])
+	if obj == nil {
+		return PTTLResNotFoundRes, nil
+	}
+	exp, ok := dstore.GetExpiry(obj, s)
+	if !ok {
+		return PTTLResNoExpiryRes, nil
+	}
+	return newIntRes(exp - time.Now().UnixMilli()), nil
+}
+
+func executePTTL(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return PTTLResNilRes, errors.ErrWrongArgumentCount("PTTL")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalPTTL(c, shard.Thread.Store())
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math/rand"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cRANDOMKEY = &CommandMeta{
+	Name:      "RANDOMKEY",
+	Syntax:    "RANDOMKEY",
+	HelpShort: "RANDOMKEY returns a random key of the database",
+	HelpLong: `
+RANDOMKEY returns a key picked at random among the keys of all the shards, every key being
+as likely to be picked. The key is not accessed, so its idle time is not reset.
+
+Returns an empty string if the database is empty.
+	`,
+	Examples: `
+localhost:7379> MSET k1 v1 k2 v2
+OK
+localhost:7379> RANDOMKEY
+OK k2
+localhost:7379> RANDOMKEY
+OK k1
+	`,
+	Eval:    evalRANDOMKEY,
+	Execute: executeRANDOMKEY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cRANDOMKEY)
+}
+
+var (
+	RANDOMKEYResNilRes = newValueRes("")
+)
+
+func evalRANDOMKEY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return randomKey(c, []*dstore.Store{s})
+}
+
+func executeRANDOMKEY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	stores := make([]*dstore.Store, 0, len(sm.Shards()))
+	for _, shard := range sm.Shards() {
+		stores = append(stores, shard.Thread.Store())
+	}
+	return randomKey(c, stores)
+}
+
+// randomKey picks the key at a random index of the keys of the stores,
+// taken one after the other. An expired key is deleted when it is picked
+// and another one is picked instead.
+func randomKey(c *Cmd, stores []*dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 0 {
+		return RANDOMKEYResNilRes, errors.ErrWrongArgumentCount("RANDOMKEY")
+	}
+
+	for {
+		total := 0
+		for _, s := range stores {
+			total += s.GetStore().Len()
+		}
+		if total == 0 {
+			return RANDOMKEYResNilRes, nil
+		}
+
+		index := rand.Intn(total)
+		for _, s := range stores {
+			if n := s.GetStore().Len(); index >= n {
+				index -= n
+				continue
+			}
+			var key string
+			s.GetStore().All(func(k string, _ *object.Obj) bool {
+				key = k
+				index--
+				return index >= 0
+			})
+			if s.GetNoTouch(key) != nil {
+				return newValueRes(key), nil
+			}
+			break
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cRENAME = &CommandMeta{
+	Name:      "RENAME",
+	Syntax:    "RENAME key newkey",
+	HelpShort: "RENAME renames key to newkey",
+	HelpLong: `
+RENAME renames key to newkey, replacing the value of newkey if it exists. The value keeps its
+expiry. The keys may live on different shards, in which case the value is copied to the shard of
+newkey and deleted from the shard of key.
+
+The watchers of both keys are notified.
+
+Returns "OK", or an error if key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> RENAME k1 k2
+OK
+localhost:7379> GET k2
+OK "v1"
+localhost:7379> RENAME k1 k3
+ERR no such key
+	`,
+	Eval:       evalRENAME,
+	Execute:    executeRENAME,
+	NotifyKeys: renameNotifyKeys,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cRENAME)
+}
+
+var (
+	RENAMEResNilRes = newOKRes()
+)
+
+func evalRENAME(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return RENAMEResNilRes, errors.ErrWrongArgumentCount("RENAME")
+	}
+	if _, err := renameKey(localStore(s), c.C.Args[0], c.C.Args[1], false); err != nil {
+		return RENAMEResNilRes, err
+	}
+	return newOKRes(), nil
+}
+
+func executeRENAME(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return RENAMEResNilRes, errors.ErrWrongArgumentCount("RENAME")
+	}
+	if _, err := renameKey(shardStores(sm), c.C.Args[0], c.C.Args[1], false); err != nil {
+		return RENAMEResNilRes, err
+	}
+	return newOKRes(), nil
+}
+
+// renameNotifyKeys returns the source and the destination of RENAME and
+// RENAMENX.
+func renameNotifyKeys(c *Cmd) []string {
+	if len(c.C.Args) < 2 {
+		return []string{c.Key()}
+	}
+	return c.C.Args[:2]
+}
+
+// renameKey moves the object stored at src to dst, reading and writing each
+// key in the store that storeForKey returns for it. With nx set, the object
+// is moved only if dst does not exist. Returns whether it was moved, or
+// ErrKeyNotFound if src does not exist.
+func renameKey(storeForKey func(key string) *dstore.Store, src, dst string, nx bool) (bool, error) {
+	srcStore, dstStore := storeForKey(src), storeForKey(dst)
+	obj := srcStore.Get(src)
+	if obj == nil {
+		return false, errors.ErrKeyNotFound
+	}
+	if src == dst {
+		return !nx, nil
+	}
+	if nx && dstStore.Get(dst) != nil {
+		return false, nil
+	}
+
+	if srcStore == dstStore {
+		return true, moveObject(obj, srcStore, src, dst)
+	}
+	if err := copyObject(obj, srcStore, dstStore, dst); err != nil {
+		return false, err
+	}
+	srcStore.Del(src)
+	return true, nil
+}
+
+// moveObject moves obj from src to dst within s, replacing the value of dst.
+// The object keeps its expiry, and goes through putObject for the indexes
+// that are not maintained by the store, such as vector indexes, to pick it
+// up under dst.
+func moveObject(obj *object.Obj, s *dstore.Store, src, dst string) error {
+	expiresAt, hasExpiry := dstore.GetExpiry(obj, s)
+	if err := putObject(s, dst, obj); err != nil {
+		return err
+	}
+	s.Del(src, dstore.WithDelCmd(dstore.Rename))
+	if hasExpiry {
+		s.SetUnixTimeExpiry(obj, expiresAt)
+	}
+	return nil
+}
+
+// copyObject stores a deep copy of obj, read from srcStore, at dst in
+// dstStore, replacing the value of dst. The copy keeps the expiry of obj.
+// The stores may belong to different shards, as the copy shares no state
+// with obj.
+func copyObject(obj *object.Obj, srcStore, dstStore *dstore.Store, dst string) error {
+	c := obj.DeepCopy()
+	if c == nil {
+		return errors.ErrGeneral("value of type '" + obj.Type.String() + "' cannot be copied")
+	}
+	if expiresAt, ok := dstore.GetExpiry(obj, srcStore); ok {
+		dstStore.SetUnixTimeExpiry(c, expiresAt)
+	}
+	return putObject(dstStore, dst, c)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cRENAMENX = &CommandMeta{
+	Name:      "RENAMENX",
+	Syntax:    "RENAMENX key newkey",
+	HelpShort: "RENAMENX renames key to newkey only if newkey does not exist",
+	HelpLong: `
+RENAMENX renames key to newkey, like RENAME, only if newkey does not exist. The keys may live on
+different shards.
+
+Returns 1 if key was renamed, 0 if newkey already exists, or an error if key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> SET k2 v2
+OK
+localhost:7379> RENAMENX k1 k2
+OK 0
+localhost:7379> RENAMENX k1 k3
+OK 1
+	`,
+	Eval:       evalRENAMENX,
+	Execute:    executeRENAMENX,
+	NotifyKeys: renameNotifyKeys,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cRENAMENX)
+}
+
+var (
+	RENAMENXResNilRes = newIntRes(0)
+)
+
+func evalRENAMENX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return RENAMENXResNilRes, errors.ErrWrongArgumentCount("RENAMENX")
+	}
+	return renameNX(c, localStore(s))
+}
+
+func executeRENAMENX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return RENAMENXResNilRes, errors.ErrWrongArgumentCount("RENAMENX")
+	}
+	return renameNX(c, shardStores(sm))
+}
+
+func renameNX(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	moved, err := renameKey(storeForKey, c.C.Args[0], c.C.Args[1], true)
+	if err != nil {
+		return RENAMENXResNilRes, err
+	}
+	if !moved {
+		return RENAMENXResNilRes, nil
+	}
+	return newIntRes(1), nil
+}
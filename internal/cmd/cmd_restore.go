@@ -0,0 +1,143 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cRESTORE = &CommandMeta{
+	Name:      "RESTORE",
+	Syntax:    "RESTORE key ttl serialized-value [REPLACE] [ABSTTL] [IDLETIME seconds]",
+	HelpShort: "RESTORE creates a key from a value serialized by DUMP",
+	HelpLong: `
+RESTORE creates a key holding the value serialized by DUMP in serialized-value.
+
+The key expires after ttl milliseconds, or never if ttl is 0. With ABSTTL, ttl is the unix time
+in milliseconds at which the key expires instead, and a key whose expiry has passed is not
+created. IDLETIME sets how many seconds ago the key was last accessed, which the eviction of
+the least recently used keys goes by.
+
+The command fails if the key exists, unless REPLACE is given, in which case its value is
+replaced. It also fails if the payload is damaged or was serialized by a newer version.
+A vector is added to the vector index it belongs to, which must exist.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> DUMP k1
+OK AQAAAAACdjGuM1L7/x8kYw==
+localhost:7379> RESTORE k1 0 AQAAAAACdjGuM1L7/x8kYw==
+ERR target key name already exists
+localhost:7379> RESTORE k1 10000 AQAAAAACdjGuM1L7/x8kYw== REPLACE
+OK
+localhost:7379> TTL k1
+OK 9
+	`,
+	Eval:    evalRESTORE,
+	Execute: executeRESTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cRESTORE)
+}
+
+var (
+	RESTOREResNilRes = newOKRes()
+)
+
+func evalRESTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return RESTOREResNilRes, errors.ErrWrongArgumentCount("RESTORE")
+	}
+	key := c.C.Args[0]
+	ttl, err := strconv.ParseInt(c.C.Args[1], 10, 64)
+	if err != nil {
+		return RESTOREResNilRes, errors.ErrIntegerOutOfRange
+	}
+	if ttl < 0 {
+		return RESTOREResNilRes, errors.ErrGeneral("invalid TTL value, must be >= 0")
+	}
+
+	replace, absTTL, idleMs := false, false, int64(-1)
+	opts := c.C.Args[3:]
+	for i := 0; i < len(opts); i++ {
+		switch strings.ToUpper(opts[i]) {
+		case "REPLACE":
+			replace = true
+		case "ABSTTL":
+			absTTL = true
+		case "IDLETIME":
+			if i+1 >= len(opts) {
+				return RESTOREResNilRes, errors.ErrInvalidSyntax("RESTORE")
+			}
+			i++
+			idle, err := strconv.ParseInt(opts[i], 10, 64)
+			if err != nil {
+				return RESTOREResNilRes, errors.ErrIntegerOutOfRange
+			}
+			if idle < 0 || idle > (1<<62)/1000 {
+				return RESTOREResNilRes, errors.ErrGeneral("invalid IDLETIME value, must be >= 0")
+			}
+			idleMs = idle * 1000
+		default:
+			return RESTOREResNilRes, errors.ErrInvalidSyntax("RESTORE")
+		}
+	}
+
+	if !replace && s.Get(key) != nil {
+		return RESTOREResNilRes, errors.ErrGeneral("target key name already exists")
+	}
+	data, err := base64.StdEncoding.DecodeString(c.C.Args[2])
+	if err != nil {
+		return RESTOREResNilRes, errors.ErrGeneral(object.ErrDumpPayloadInvalid.Error())
+	}
+	obj, err := object.Restore(data)
+	if err != nil {
+		return RESTOREResNilRes, errors.ErrGeneral(err.Error())
+	}
+
+	now := time.Now().UnixMilli()
+	expiresAt := int64(-1)
+	if ttl > 0 {
+		expiresAt = ttl
+		if !absTTL {
+			expiresAt = now + ttl
+		}
+		// A key that has already expired is not created, but it still
+		// replaces the value of the key.
+		if expiresAt <= now {
+			s.Del(key)
+			return newOKRes(), nil
+		}
+	}
+
+	newObj := s.NewObj(obj.Value, -1, obj.Type)
+	if expiresAt > 0 {
+		s.SetUnixTimeExpiry(newObj, expiresAt)
+	}
+	if err := putObject(s, key, newObj); err != nil {
+		return RESTOREResNilRes, err
+	}
+	if idleMs >= 0 {
+		newObj.LastAccessedAt = now - idleMs
+	}
+	return newOKRes(), nil
+}
+
+func executeRESTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return RESTOREResNilRes, errors.ErrWrongArgumentCount("RESTORE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalRESTORE(c, shard.Thread.Store())
+}
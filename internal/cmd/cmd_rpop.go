@@ -0,0 +1,59 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cRPOP = &CommandMeta{
+	Name:      "RPOP",
+	Syntax:    "RPOP key [count]",
+	HelpShort: "RPOP removes and returns the last elements of the list stored at key",
+	HelpLong: `
+RPOP removes and returns the last element of the list stored at key. The key is deleted once
+the list is empty.
+
+Without count, the popped element is returned as a value, and an empty string if the key does
+not exist. With count, up to count elements are popped and returned as a list.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c
+OK 3
+localhost:7379> RPOP q
+OK c
+localhost:7379> RPOP q 2
+OK
+0) b
+1) a
+	`,
+	Eval:    evalRPOP,
+	Execute: executeRPOP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cRPOP)
+}
+
+var (
+	RPOPResNilRes = newValueRes("")
+)
+
+func evalRPOP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return RPOPResNilRes, errors.ErrWrongArgumentCount("RPOP")
+	}
+	return evalPop(c, s, (*types.Deque).RPop)
+}
+
+func executeRPOP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return RPOPResNilRes, errors.ErrWrongArgumentCount("RPOP")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalRPOP(c, shard.Thread.Store())
+}
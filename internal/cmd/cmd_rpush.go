@@ -0,0 +1,58 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cRPUSH = &CommandMeta{
+	Name:      "RPUSH",
+	Syntax:    "RPUSH key element [element ...]",
+	HelpShort: "RPUSH inserts elements at the tail of the list stored at key",
+	HelpLong: `
+RPUSH inserts all the specified elements at the tail of the list stored at key. Elements are
+inserted one after the other, from the leftmost to the rightmost.
+
+If the key does not exist, an empty list is created before the push. Returns the length of the
+list after the push.
+	`,
+	Examples: `
+localhost:7379> RPUSH q a b c
+OK 3
+localhost:7379> LRANGE q 0 -1
+OK
+0) a
+1) b
+2) c
+	`,
+	Eval:    evalRPUSH,
+	Execute: executeRPUSH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cRPUSH)
+}
+
+var (
+	RPUSHResNilRes = newIntRes(0)
+)
+
+func evalRPUSH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return RPUSHResNilRes, errors.ErrWrongArgumentCount("RPUSH")
+	}
+	return evalPush(c, s, (*types.Deque).RPush)
+}
+
+func executeRPUSH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return RPUSHResNilRes, errors.ErrWrongArgumentCount("RPUSH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalRPUSH(c, shard.Thread.Store())
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"slices"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSADD = &CommandMeta{
+	Name:      "SADD",
+	Syntax:    "SADD key member [member ...]",
+	HelpShort: "SADD adds members to the set stored at key",
+	HelpLong: `
+SADD adds the specified members to the set stored at key. Members already in the set are ignored.
+If the key does not exist, a new set is created.
+
+Returns the number of members that were added to the set.
+	`,
+	Examples: `
+localhost:7379> SADD s a b c
+OK 3
+localhost:7379> SADD s c d
+OK 1
+	`,
+	Eval:    evalSADD,
+	Execute: executeSADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSADD)
+}
+
+var (
+	SADDResNilRes = newIntRes(0)
+)
+
+func evalSADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SADDResNilRes, errors.ErrWrongArgumentCount("SADD")
+	}
+
+	key := c.C.Args[0]
+	obj, err := getSetObj(s, key)
+	if err != nil {
+		return SADDResNilRes, err
+	}
+	if obj == nil {
+		obj = s.NewObj(make(map[string]struct{}, len(c.C.Args)-1), -1, object.ObjTypeSet)
+		s.Put(key, obj)
+	}
+
+	set := obj.Value.(map[string]struct{})
+	var count int64
+	for _, member := range c.C.Args[1:] {
+		if _, ok := set[member]; !ok {
+			set[member] = struct{}{}
+			count++
+		}
+	}
+	return newIntRes(count), nil
+}
+
+func executeSADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SADDResNilRes, errors.ErrWrongArgumentCount("SADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSADD(c, shard.Thread.Store())
+}
+
+// getSetObj returns the set stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getSetObj(s *dstore.Store, key string) (*object.Obj, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeSet {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj, nil
+}
+
+// getSetMembers returns the members of the set stored at key.
+// Returns nil if the key does not exist.
+func getSetMembers(s *dstore.Store, key string) (map[string]struct{}, error) {
+	obj, err := getSetObj(s, key)
+	if err != nil || obj == nil {
+		return nil, err
+	}
+	return obj.Value.(map[string]struct{}), nil
+}
+
+// sortedMembers returns the members of the set in lexicographical order so
+// that replies are stable across calls.
+func sortedMembers(set map[string]struct{}) []string {
+	members := make([]string, 0, len(set))
+	for member := range set {
+		members = append(members, member)
+	}
+	slices.Sort(members)
+	return members
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cSCAN = &CommandMeta{
+	Name:      "SCAN",
+	Syntax:    "SCAN cursor [MATCH pattern] [COUNT count] [TYPE type]",
+	HelpShort: "SCAN incrementally iterates over the keys of the database",
+	HelpLong: `
+SCAN incrementally iterates over the keys of the database, a few at a time, so that
+large databases can be walked without the long pauses and huge replies of KEYS.
+
+Start the iteration with cursor 0 and pass the cursor returned by each call to the next one.
+The iteration is complete when the returned cursor is 0. The cursor holds the shard being
+walked and the position in it, so an iteration can be resumed at any time.
+
+Every key present from the start to the end of the iteration is returned. Keys added or
+deleted during the iteration may or may not be returned, and a key may rarely be returned
+more than once.
+
+COUNT sets how many keys are examined per call (defaults to 10). MATCH only returns the keys
+matching the glob-style pattern and TYPE only returns the keys holding a value of the given
+type, as reported by TYPE. Both are applied after the keys are examined, so a call may return
+fewer keys than COUNT, or none at all, before the iteration is complete.
+
+The first element of the reply is the next cursor, followed by the returned keys.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> SET k2 v2
+OK
+localhost:7379> SET k3 3
+OK
+localhost:7379> SCAN 0 COUNT 2
+OK
+0) 844424930131969
+1) k3
+2) k2
+localhost:7379> SCAN 844424930131969 COUNT 2
+OK
+0) 0
+1) k1
+localhost:7379> SCAN 0 MATCH k[12]
+OK
+0) 0
+1) k2
+2) k1
+localhost:7379> SCAN 0 TYPE int
+OK
+0) 0
+1) k3
+	`,
+	Eval:    evalSCAN,
+	Execute: executeSCAN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSCAN)
+}
+
+var (
+	SCANResNilRes = newListRes([]string{"0"})
+)
+
+// SCAN, HSCAN and ZSCAN walk their collection in the order of the hash of
+// its members, which never changes as members are added and removed, see
+// types.ScanOrder. The cursor is the position of the next member to return,
+// plus one so that 0 starts and ends an iteration, and SCAN keeps the index
+// of the shard it is walking in the bits above the position.
+const scanPositionMask = 1<<types.ScanPositionBits - 1
+
+func encodeScanCursor(shard int, position uint64) uint64 {
+	return (uint64(shard)<<types.ScanPositionBits | position) + 1
+}
+
+func decodeScanCursor(cursor uint64) (shard int, position uint64) {
+	if cursor == 0 {
+		return 0, 0
+	}
+	return int((cursor - 1) >> types.ScanPositionBits), (cursor - 1) & scanPositionMask
+}
+
+type scanOptions struct {
+	cursor  uint64
+	pattern string
+	count   int
+	objType string
+}
+
+// parseScanOptions parses the cursor and the options of SCAN, HSCAN and
+// ZSCAN. The TYPE option is only accepted when allowType is true.
+func parseScanOptions(cmd string, args []string, allowType bool) (*scanOptions, error) {
+	cursor, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, errors.ErrIntegerOutOfRange
+	}
+
+	o := &scanOptions{cursor: cursor, pattern: "*", count: 10}
+	opts := args[1:]
+	for i := 0; i < len(opts); i += 2 {
+		if i+1 >= len(opts) {
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+		switch strings.ToUpper(opts[i]) {
+		case "MATCH":
+			o.pattern = opts[i+1]
+			if _, err := path.Match(o.pattern, ""); err != nil {
+				return nil, errors.ErrGeneral("invalid glob pattern")
+			}
+		case "COUNT":
+			count, err := strconv.ParseInt(opts[i+1], 10, 32)
+			if err != nil || count < 1 {
+				return nil, errors.ErrIntegerOutOfRange
+			}
+			o.count = int(count)
+		case "TYPE":
+			if !allowType {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			o.objType = strings.ToLower(opts[i+1])
+		default:
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+	}
+	return o, nil
+}
+
+func (o *scanOptions) match(member string) bool {
+	ok, _ := path.Match(o.pattern, member)
+	return ok
+}
+
+// scanPager returns a page of a collection in scan order, as returned by
+// types.ScanOrder.Page.
+type scanPager func(from uint64, count int) (members []string, next uint64, more bool)
+
+// scanCollection returns the members of a collection of HSCAN or ZSCAN
+// that the call examines and match the pattern, along with the next cursor.
+func scanCollection(page scanPager, o *scanOptions) ([]string, uint64) {
+	_, from := decodeScanCursor(o.cursor)
+	members, resume, more := page(from, o.count)
+	matched := members[:0]
+	for _, member := range members {
+		if o.match(member) {
+			matched = append(matched, member)
+		}
+	}
+	if !more {
+		return matched, 0
+	}
+	return matched, encodeScanCursor(0, resume)
+}
+
+func evalSCAN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return scan(c, []*dstore.Store{s})
+}
+
+func executeSCAN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	stores := make([]*dstore.Store, 0, len(sm.Shards()))
+	for _, shard := range sm.Shards() {
+		stores = append(stores, shard.Thread.Store())
+	}
+	return scan(c, stores)
+}
+
+// scan walks the stores in order, moving on to the next store when one is
+// exhausted until COUNT keys are examined.
+func scan(c *Cmd, stores []*dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return SCANResNilRes, errors.ErrWrongArgumentCount("SCAN")
+	}
+	o, err := parseScanOptions("SCAN", c.C.Args, true)
+	if err != nil {
+		return SCANResNilRes, err
+	}
+	shard, from := decodeScanCursor(o.cursor)
+	if shard >= len(stores) {
+		return SCANResNilRes, errors.ErrGeneral("invalid cursor")
+	}
+
+	var next uint64
+	values := []string{""}
+	for remaining := o.count; remaining > 0; {
+		s := stores[shard]
+		keys, resume, more := s.ScanKeys(from, remaining)
+		remaining -= len(keys)
+		for _, k := range keys {
+			obj := s.GetNoTouch(k)
+			if obj == nil || (o.objType != "" && obj.Type.String() != o.objType) || !o.match(k) {
+				continue
+			}
+			values = append(values, k)
+		}
+		if more {
+			next = encodeScanCursor(shard, resume)
+			break
+		}
+		if shard, from = shard+1, 0; shard == len(stores) {
+			next = 0
+			break
+		}
+		next = encodeScanCursor(shard, 0)
+	}
+	values[0] = strconv.FormatUint(next, 10)
+	return newListRes(values), nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSCARD = &CommandMeta{
+	Name:      "SCARD",
+	Syntax:    "SCARD key",
+	HelpShort: "SCARD returns the number of members in the set stored at key",
+	HelpLong: `
+SCARD returns the number of members in the set stored at key, and 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SADD s a b
+OK 2
+localhost:7379> SCARD s
+OK 2
+	`,
+	Eval:    evalSCARD,
+	Execute: executeSCARD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSCARD)
+}
+
+var (
+	SCARDResNilRes = newIntRes(0)
+)
+
+func evalSCARD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return SCARDResNilRes, errors.ErrWrongArgumentCount("SCARD")
+	}
+
+	set, err := getSetMembers(s, c.C.Args[0])
+	if err != nil {
+		return SCARDResNilRes, err
+	}
+	return newIntRes(int64(len(set))), nil
+}
+
+func executeSCARD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return SCARDResNilRes, errors.ErrWrongArgumentCount("SCARD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSCARD(c, shard.Thread.Store())
+}
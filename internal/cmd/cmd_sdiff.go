@@ -0,0 +1,84 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSDIFF = &CommandMeta{
+	Name:      "SDIFF",
+	Syntax:    "SDIFF key [key ...]",
+	HelpShort: "SDIFF returns the members of the first set that are not in any of the other sets",
+	HelpLong: `
+SDIFF returns the members of the set stored at the first key that are not in any of the sets stored
+at the other keys, in lexicographical order. Keys that do not exist are treated as empty sets.
+
+The keys may live on different shards.
+	`,
+	Examples: `
+localhost:7379> SADD s1 a b c
+OK 3
+localhost:7379> SADD s2 c d
+OK 2
+localhost:7379> SDIFF s1 s2
+OK
+0) a
+1) b
+	`,
+	Eval:    evalSDIFF,
+	Execute: executeSDIFF,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSDIFF)
+}
+
+var (
+	SDIFFResNilRes = newListRes([]string{})
+)
+
+func evalSDIFF(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return SDIFFResNilRes, errors.ErrWrongArgumentCount("SDIFF")
+	}
+	return sdiff(c.C.Args, localStore(s))
+}
+
+func executeSDIFF(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return SDIFFResNilRes, errors.ErrWrongArgumentCount("SDIFF")
+	}
+	return sdiff(c.C.Args, shardStores(sm))
+}
+
+// sdiff replies with the members of the set stored at the first key that
+// are not in the sets stored at the other keys, reading each key in the
+// store storeForKey returns for it.
+func sdiff(keys []string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	sets, err := getSets(keys, storeForKey)
+	if err != nil {
+		return SDIFFResNilRes, err
+	}
+	return newListRes(sortedMembers(diffAllSets(sets))), nil
+}
+
+// diffAllSets returns the members of the first set that are in none of the
+// others.
+func diffAllSets(sets []map[string]struct{}) map[string]struct{} {
+	return diffSets(sets[0], unionSets(sets[1:]))
+}
+
+// diffSets returns the members of set that are not in other.
+func diffSets(set, other map[string]struct{}) map[string]struct{} {
+	result := make(map[string]struct{}, len(set))
+	for member := range set {
+		if _, ok := other[member]; !ok {
+			result[member] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSDIFFSTORE = &CommandMeta{
+	Name:      "SDIFFSTORE",
+	Syntax:    "SDIFFSTORE destination key [key ...]",
+	HelpShort: "SDIFFSTORE stores the difference of the sets stored at the keys in destination",
+	HelpLong: `
+SDIFFSTORE computes the members of the set stored at the first key that are not in any of the
+sets stored at the other keys, like SDIFF, and stores them as a set in destination. An existing
+destination is overwritten, and deleted if the difference is empty.
+
+The keys and the destination may live on different shards.
+
+Returns the number of members in the resulting set.
+	`,
+	Examples: `
+localhost:7379> SADD s1 a b c
+OK 3
+localhost:7379> SADD s2 b c d
+OK 3
+localhost:7379> SDIFFSTORE dst s1 s2
+OK 1
+	`,
+	Eval:    evalSDIFFSTORE,
+	Execute: executeSDIFFSTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSDIFFSTORE)
+}
+
+var (
+	SDIFFSTOREResNilRes = newIntRes(0)
+)
+
+func evalSDIFFSTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SDIFFSTOREResNilRes, errors.ErrWrongArgumentCount("SDIFFSTORE")
+	}
+	return setStore(c.C.Args, localStore(s), diffAllSets)
+}
+
+func executeSDIFFSTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SDIFFSTOREResNilRes, errors.ErrWrongArgumentCount("SDIFFSTORE")
+	}
+	return setStore(c.C.Args, shardStores(sm), diffAllSets)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cSETBIT = &CommandMeta{
+	Name:      "SETBIT",
+	Syntax:    "SETBIT key offset value",
+	HelpShort: "SETBIT sets or clears the bit at offset in the string value stored at key",
+	HelpLong: `
+SETBIT sets or clears the bit at offset in the string value stored at key. Bits are numbered
+from the most significant bit of the first byte. The value is grown with zero bytes if offset
+is past its end, and a missing key is created.
+
+The offset must be between 0 and 2^32-1, and the value either 0 or 1.
+
+Returns the bit previously stored at offset.
+	`,
+	Examples: `
+localhost:7379> SETBIT users:active 7 1
+OK 0
+localhost:7379> SETBIT users:active 7 0
+OK 1
+	`,
+	Eval:    evalSETBIT,
+	Execute: executeSETBIT,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSETBIT)
+}
+
+var (
+	SETBITResNilRes = newIntRes(0)
+)
+
+// maxBitOffset is the largest bit offset of a bitmap, which limits bitmaps
+// to 512MB.
+const maxBitOffset = 1<<32 - 1
+
+func evalSETBIT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return SETBITResNilRes, errors.ErrWrongArgumentCount("SETBIT")
+	}
+
+	offset, err := parseBitOffset(c.C.Args[1])
+	if err != nil {
+		return SETBITResNilRes, err
+	}
+	if c.C.Args[2] != "0" && c.C.Args[2] != "1" {
+		return SETBITResNilRes, errors.ErrGeneral("bit is not an integer or out of range")
+	}
+
+	ba, err := getOrCreateByteArray(s, c.C.Args[0])
+	if err != nil {
+		return SETBITResNilRes, err
+	}
+	if required := offset/8 + 1; required > int(ba.Length) {
+		ba.IncreaseSize(required)
+	}
+
+	prev := ba.GetBit(offset)
+	ba.SetBit(offset, c.C.Args[2] == "1")
+	if prev {
+		return newIntRes(1), nil
+	}
+	return newIntRes(0), nil
+}
+
+func executeSETBIT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return SETBITResNilRes, errors.ErrWrongArgumentCount("SETBIT")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSETBIT(c, shard.Thread.Store())
+}
+
+// parseBitOffset parses a bit offset of a bitmap.
+func parseBitOffset(arg string) (int, error) {
+	offset, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || offset < 0 || offset > maxBitOffset {
+		return 0, errors.ErrGeneral("bit offset is not an integer or out of range")
+	}
+	return int(offset), nil
+}
+
+// getBitmapBytes returns the bytes of the string value stored at key, which
+// the bitmap commands operate on, or nil if the key does not exist. The
+// returned slice must not be modified.
+func getBitmapBytes(s *dstore.Store, key string) ([]byte, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	switch obj.Type {
+	case object.ObjTypeByteArray:
+		return obj.Value.(*types.ByteArray).Bytes(), nil
+	case object.ObjTypeString:
+		return []byte(obj.Value.(string)), nil
+	case object.ObjTypeInt:
+		return []byte(strconv.FormatInt(obj.Value.(int64), 10)), nil
+	default:
+		return nil, errors.ErrWrongTypeOperation
+	}
+}
+
+// getOrCreateByteArray returns the byte array stored at key for a bitmap
+// command to modify. A missing key is created empty, and a string value is
+// converted in place, so that the key keeps its expiry.
+func getOrCreateByteArray(s *dstore.Store, key string) (*types.ByteArray, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		ba := types.NewByteArray(0)
+		s.Put(key, s.NewObj(ba, -1, object.ObjTypeByteArray))
+		return ba, nil
+	}
+	if obj.Type == object.ObjTypeByteArray {
+		return obj.Value.(*types.ByteArray), nil
+	}
+
+	b, err := getBitmapBytes(s, key)
+	if err != nil {
+		return nil, err
+	}
+	ba := types.NewByteArrayFromBytes(b)
+	obj.Type, obj.Value = object.ObjTypeByteArray, ba
+	return ba, nil
+}
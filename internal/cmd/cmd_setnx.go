@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSETNX = &CommandMeta{
+	Name:      "SETNX",
+	Syntax:    "SETNX key value",
+	HelpShort: "SETNX sets the value of key only if it does not exist",
+	HelpLong: `
+SETNX sets the value of key, like SET, only if the key does not exist.
+
+Returns 1 if the key was set, or 0 if it already existed.
+	`,
+	Examples: `
+localhost:7379> SETNX k v1
+OK 1
+localhost:7379> SETNX k v2
+OK 0
+localhost:7379> GET k
+OK "v1"
+	`,
+	Eval:    evalSETNX,
+	Execute: executeSETNX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSETNX)
+}
+
+var (
+	SETNXResNilRes = newIntRes(0)
+)
+
+func evalSETNX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return SETNXResNilRes, errors.ErrWrongArgumentCount("SETNX")
+	}
+
+	key, value := c.C.Args[0], c.C.Args[1]
+	if s.Get(key) != nil {
+		return SETNXResNilRes, nil
+	}
+	s.Put(key, CreateObjectFromValue(s, value, -1))
+	return newIntRes(1), nil
+}
+
+func executeSETNX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return SETNXResNilRes, errors.ErrWrongArgumentCount("SETNX")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSETNX(c, shard.Thread.Store())
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSETRANGE = &CommandMeta{
+	Name:      "SETRANGE",
+	Syntax:    "SETRANGE key offset value",
+	HelpShort: "SETRANGE overwrites the string stored at key from offset with value",
+	HelpLong: `
+SETRANGE overwrites the string stored at key with value, starting at offset. The string is padded
+with zero bytes up to offset if it is shorter, and a missing key is created as an empty string,
+unless value is empty. The key keeps its expiry.
+
+Returns the length of the string after the write.
+	`,
+	Examples: `
+localhost:7379> SET k "hello world"
+OK
+localhost:7379> SETRANGE k 6 dice
+OK 11
+localhost:7379> GET k
+OK "hello diced"
+	`,
+	Eval:    evalSETRANGE,
+	Execute: executeSETRANGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSETRANGE)
+}
+
+var (
+	SETRANGEResNilRes = newIntRes(0)
+)
+
+func evalSETRANGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return SETRANGEResNilRes, errors.ErrWrongArgumentCount("SETRANGE")
+	}
+
+	key, value := c.C.Args[0], c.C.Args[2]
+	offset, err := strconv.Atoi(c.C.Args[1])
+	if err != nil || offset < 0 {
+		return SETRANGEResNilRes, errors.ErrGeneral("offset is out of range")
+	}
+
+	obj := s.Get(key)
+	current := ""
+	if obj != nil {
+		if current, err = getStringFromObj(obj); err != nil {
+			return SETRANGEResNilRes, err
+		}
+	}
+	if value == "" {
+		return newIntRes(int64(len(current))), nil
+	}
+	if offset > maxStringLength-len(value) {
+		return SETRANGEResNilRes, errors.ErrGeneral("string exceeds maximum allowed size (512MB)")
+	}
+
+	b := []byte(current)
+	if len(b) < offset+len(value) {
+		b = append(b, make([]byte, offset+len(value)-len(b))...)
+	}
+	copy(b[offset:], value)
+	if obj == nil {
+		obj = s.NewObj("", -1, object.ObjTypeString)
+		setStringInPlace(obj, string(b))
+		s.Put(key, obj)
+	} else {
+		setStringInPlace(obj, string(b))
+		s.Reindex(key)
+	}
+	return newIntRes(int64(len(b))), nil
+}
+
+func executeSETRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return SETRANGEResNilRes, errors.ErrWrongArgumentCount("SETRANGE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSETRANGE(c, shard.Thread.Store())
+}
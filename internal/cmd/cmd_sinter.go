@@ -0,0 +1,113 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSINTER = &CommandMeta{
+	Name:      "SINTER",
+	Syntax:    "SINTER key [key ...]",
+	HelpShort: "SINTER returns the members of the intersection of the sets stored at the keys",
+	HelpLong: `
+SINTER returns the members of the intersection of all the sets stored at the given keys, in
+lexicographical order. Keys that do not exist are treated as empty sets, so the intersection is
+empty if any of the keys does not exist.
+
+The keys may live on different shards.
+	`,
+	Examples: `
+localhost:7379> SADD s1 a b c
+OK 3
+localhost:7379> SADD s2 b c d
+OK 3
+localhost:7379> SINTER s1 s2
+OK
+0) b
+1) c
+	`,
+	Eval:    evalSINTER,
+	Execute: executeSINTER,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSINTER)
+}
+
+var (
+	SINTERResNilRes = newListRes([]string{})
+)
+
+func evalSINTER(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return SINTERResNilRes, errors.ErrWrongArgumentCount("SINTER")
+	}
+	return sinter(c.C.Args, localStore(s))
+}
+
+func executeSINTER(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return SINTERResNilRes, errors.ErrWrongArgumentCount("SINTER")
+	}
+	return sinter(c.C.Args, shardStores(sm))
+}
+
+// sinter replies with the intersection of the sets stored at the keys,
+// reading each key in the store storeForKey returns for it.
+func sinter(keys []string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	sets, err := getSets(keys, storeForKey)
+	if err != nil {
+		return SINTERResNilRes, err
+	}
+	return newListRes(sortedMembers(intersectSets(sets))), nil
+}
+
+// getSets returns the members of the sets stored at the keys, reading each
+// key in the store storeForKey returns for it. A key that does not exist
+// is returned as a nil set.
+func getSets(keys []string, storeForKey func(key string) *dstore.Store) ([]map[string]struct{}, error) {
+	sets := make([]map[string]struct{}, 0, len(keys))
+	for _, key := range keys {
+		set, err := getSetMembers(storeForKey(key), key)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// intersectSets returns the members present in all the sets. A nil set
+// stands for a key that does not exist and empties the intersection.
+func intersectSets(sets []map[string]struct{}) map[string]struct{} {
+	result := make(map[string]struct{})
+	if len(sets) == 0 {
+		return result
+	}
+
+	// Iterate over the smallest set to keep the work proportional to it.
+	smallest := sets[0]
+	for _, set := range sets[1:] {
+		if len(set) < len(smallest) {
+			smallest = set
+		}
+	}
+
+	for member := range smallest {
+		inAll := true
+		for _, set := range sets {
+			if _, ok := set[member]; !ok {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			result[member] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSINTERSTORE = &CommandMeta{
+	Name:      "SINTERSTORE",
+	Syntax:    "SINTERSTORE destination key [key ...]",
+	HelpShort: "SINTERSTORE stores the intersection of the sets stored at the keys in destination",
+	HelpLong: `
+SINTERSTORE computes the intersection of all the sets stored at the given keys, like SINTER, and
+stores it as a set in destination. An existing destination is overwritten, and deleted if the
+intersection is empty.
+
+The keys and the destination may live on different shards.
+
+Returns the number of members in the resulting set.
+	`,
+	Examples: `
+localhost:7379> SADD s1 a b c
+OK 3
+localhost:7379> SADD s2 b c d
+OK 3
+localhost:7379> SINTERSTORE dst s1 s2
+OK 2
+	`,
+	Eval:    evalSINTERSTORE,
+	Execute: executeSINTERSTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSINTERSTORE)
+}
+
+var (
+	SINTERSTOREResNilRes = newIntRes(0)
+)
+
+func evalSINTERSTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SINTERSTOREResNilRes, errors.ErrWrongArgumentCount("SINTERSTORE")
+	}
+	return setStore(c.C.Args, localStore(s), intersectSets)
+}
+
+func executeSINTERSTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SINTERSTOREResNilRes, errors.ErrWrongArgumentCount("SINTERSTORE")
+	}
+	return setStore(c.C.Args, shardStores(sm), intersectSets)
+}
+
+// setStore evaluates the "destination key [key ...]" arguments of a STORE
+// command: it combines the sets stored at the keys with op and stores the
+// result at destination, reading and writing each key in the store
+// storeForKey returns for it.
+func setStore(args []string, storeForKey func(key string) *dstore.Store,
+	op func(sets []map[string]struct{}) map[string]struct{}) (*CmdRes, error) {
+	sets, err := getSets(args[1:], storeForKey)
+	if err != nil {
+		return newIntRes(0), err
+	}
+	return storeSetMembers(storeForKey(args[0]), args[0], op(sets)), nil
+}
+
+// storeSetMembers overwrites the key with a set holding the members, or
+// deletes the key if there are none, and replies with the set size.
+func storeSetMembers(s *dstore.Store, key string, members map[string]struct{}) *CmdRes {
+	if len(members) == 0 {
+		s.Del(key)
+		return newIntRes(0)
+	}
+	s.Put(key, s.NewObj(members, -1, object.ObjTypeSet))
+	return newIntRes(int64(len(members)))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSISMEMBER = &CommandMeta{
+	Name:      "SISMEMBER",
+	Syntax:    "SISMEMBER key member",
+	HelpShort: "SISMEMBER checks if member belongs to the set stored at key",
+	HelpLong: `
+SISMEMBER checks if member belongs to the set stored at key.
+
+Returns 1 if the member is in the set, and 0 if it is not or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SADD s a
+OK 1
+localhost:7379> SISMEMBER s a
+OK 1
+localhost:7379> SISMEMBER s b
+OK 0
+	`,
+	Eval:    evalSISMEMBER,
+	Execute: executeSISMEMBER,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSISMEMBER)
+}
+
+var (
+	SISMEMBERResNilRes = newIntRes(0)
+)
+
+func evalSISMEMBER(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return SISMEMBERResNilRes, errors.ErrWrongArgumentCount("SISMEMBER")
+	}
+
+	set, err := getSetMembers(s, c.C.Args[0])
+	if err != nil {
+		return SISMEMBERResNilRes, err
+	}
+	if _, ok := set[c.C.Args[1]]; ok {
+		return newIntRes(1), nil
+	}
+	return SISMEMBERResNilRes, nil
+}
+
+func executeSISMEMBER(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return SISMEMBERResNilRes, errors.ErrWrongArgumentCount("SISMEMBER")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSISMEMBER(c, shard.Thread.Store())
+}
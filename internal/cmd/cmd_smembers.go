@@ -0,0 +1,59 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSMEMBERS = &CommandMeta{
+	Name:      "SMEMBERS",
+	Syntax:    "SMEMBERS key",
+	HelpShort: "SMEMBERS returns all the members of the set stored at key",
+	HelpLong: `
+SMEMBERS returns all the members of the set stored at key, in lexicographical order.
+
+Returns an empty list if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SADD s b a
+OK 2
+localhost:7379> SMEMBERS s
+OK
+0) a
+1) b
+	`,
+	Eval:    evalSMEMBERS,
+	Execute: executeSMEMBERS,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSMEMBERS)
+}
+
+var (
+	SMEMBERSResNilRes = newListRes([]string{})
+)
+
+func evalSMEMBERS(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return SMEMBERSResNilRes, errors.ErrWrongArgumentCount("SMEMBERS")
+	}
+
+	set, err := getSetMembers(s, c.C.Args[0])
+	if err != nil {
+		return SMEMBERSResNilRes, err
+	}
+	return newListRes(sortedMembers(set)), nil
+}
+
+func executeSMEMBERS(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return SMEMBERSResNilRes, errors.ErrWrongArgumentCount("SMEMBERS")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSMEMBERS(c, shard.Thread.Store())
+}
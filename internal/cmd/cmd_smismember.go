@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cSMISMEMBER = &CommandMeta{
+	Name:      "SMISMEMBER",
+	Syntax:    "SMISMEMBER key member [member ...]",
+	HelpShort: "SMISMEMBER checks if each member belongs to the set stored at key",
+	HelpLong: `
+SMISMEMBER checks if each of the members belongs to the set stored at key.
+
+Returns the members, in the order given, each paired with 1 if it is in the set and 0 if it is not.
+	`,
+	Examples: `
+localhost:7379> SADD s a
+OK 1
+localhost:7379> SMISMEMBER s a b
+OK
+0) a="1"
+1) b="0"
+	`,
+	Eval:    evalSMISMEMBER,
+	Execute: executeSMISMEMBER,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSMISMEMBER)
+}
+
+var (
+	SMISMEMBERResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalSMISMEMBER(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SMISMEMBERResNilRes, errors.ErrWrongArgumentCount("SMISMEMBER")
+	}
+
+	set, err := getSetMembers(s, c.C.Args[0])
+	if err != nil {
+		return SMISMEMBERResNilRes, err
+	}
+
+	elements := make([]*wire.HElement, 0, len(c.C.Args)-1)
+	for _, member := range c.C.Args[1:] {
+		value := "0"
+		if _, ok := set[member]; ok {
+			value = "1"
+		}
+		elements = append(elements, &wire.HElement{Key: member, Value: value})
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeSMISMEMBER(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SMISMEMBERResNilRes, errors.ErrWrongArgumentCount("SMISMEMBER")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSMISMEMBER(c, shard.Thread.Store())
+}
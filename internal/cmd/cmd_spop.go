@@ -0,0 +1,111 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSPOP = &CommandMeta{
+	Name:      "SPOP",
+	Syntax:    "SPOP key [count]",
+	HelpShort: "SPOP removes and returns random members from the set stored at key",
+	HelpLong: `
+SPOP removes and returns a random member from the set stored at key. The key is deleted once the
+set is empty.
+
+Without count, the popped member is returned as a value, and an empty string if the key does not
+exist. With count, up to count distinct members are popped and returned as a list.
+	`,
+	Examples: `
+localhost:7379> SADD s a b c
+OK 3
+localhost:7379> SPOP s
+OK b
+localhost:7379> SPOP s 5
+OK
+0) a
+1) c
+	`,
+	Eval:    evalSPOP,
+	Execute: executeSPOP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSPOP)
+}
+
+var (
+	SPOPResNilRes = newValueRes("")
+)
+
+func evalSPOP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return SPOPResNilRes, errors.ErrWrongArgumentCount("SPOP")
+	}
+
+	count := int64(1)
+	if len(c.C.Args) == 2 {
+		var err error
+		count, err = strconv.ParseInt(c.C.Args[1], 10, 64)
+		if err != nil || count < 0 {
+			return SPOPResNilRes, errors.ErrIntegerOutOfRange
+		}
+	}
+
+	key := c.C.Args[0]
+	set, err := getSetMembers(s, key)
+	if err != nil {
+		return SPOPResNilRes, err
+	}
+
+	withCount := len(c.C.Args) == 2
+	members := randomMembers(set, count)
+	for _, member := range members {
+		delete(set, member)
+	}
+	if set != nil && len(set) == 0 {
+		s.Del(key)
+	}
+
+	// The members are picked at random, so the command logged to the WAL
+	// removes the members that were popped, or none, for its replay to
+	// remove the same ones.
+	if len(members) > 0 {
+		c.setWALCommand("SREM", append([]string{key}, members...)...)
+	} else {
+		c.setWALCommand("SPOP", key, "0")
+	}
+
+	if withCount {
+		return newListRes(members), nil
+	}
+	if len(members) == 0 {
+		return SPOPResNilRes, nil
+	}
+	return newValueRes(members[0]), nil
+}
+
+func executeSPOP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return SPOPResNilRes, errors.ErrWrongArgumentCount("SPOP")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSPOP(c, shard.Thread.Store())
+}
+
+// randomMembers returns up to count distinct members picked at random
+// from the set.
+func randomMembers(set map[string]struct{}, count int64) []string {
+	members := sortedMembers(set)
+	rand.Shuffle(len(members), func(i, j int) {
+		members[i], members[j] = members[j], members[i]
+	})
+	return members[:min(count, int64(len(members)))]
+}
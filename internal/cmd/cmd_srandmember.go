@@ -0,0 +1,100 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSRANDMEMBER = &CommandMeta{
+	Name:      "SRANDMEMBER",
+	Syntax:    "SRANDMEMBER key [count]",
+	HelpShort: "SRANDMEMBER returns random members from the set stored at key",
+	HelpLong: `
+SRANDMEMBER returns a random member from the set stored at key without removing it.
+
+Without count, the member is returned as a value, and an empty string if the key does not exist.
+With a positive count, up to count distinct members are returned as a list. With a negative count,
+exactly |count| members are returned and the same member may appear more than once.
+	`,
+	Examples: `
+localhost:7379> SADD s a b c
+OK 3
+localhost:7379> SRANDMEMBER s
+OK c
+localhost:7379> SRANDMEMBER s -4
+OK
+0) a
+1) c
+2) a
+3) b
+	`,
+	Eval:    evalSRANDMEMBER,
+	Execute: executeSRANDMEMBER,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSRANDMEMBER)
+}
+
+var (
+	SRANDMEMBERResNilRes = newValueRes("")
+)
+
+func evalSRANDMEMBER(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return SRANDMEMBERResNilRes, errors.ErrWrongArgumentCount("SRANDMEMBER")
+	}
+
+	count := int64(1)
+	if len(c.C.Args) == 2 {
+		var err error
+		if count, err = strconv.ParseInt(c.C.Args[1], 10, 64); err != nil {
+			return SRANDMEMBERResNilRes, errors.ErrIntegerOutOfRange
+		}
+		if count < math.MinInt32 {
+			return SRANDMEMBERResNilRes, errors.ErrValueOutOfRange
+		}
+	}
+
+	set, err := getSetMembers(s, c.C.Args[0])
+	if err != nil {
+		return SRANDMEMBERResNilRes, err
+	}
+
+	var members []string
+	if count >= 0 {
+		members = randomMembers(set, count)
+	} else if len(set) > 0 {
+		// A negative count allows repeats, so members are picked
+		// independently of each other.
+		all := sortedMembers(set)
+		members = make([]string, -count)
+		for i := range members {
+			members[i] = all[rand.Intn(len(all))]
+		}
+	}
+
+	if len(c.C.Args) == 2 {
+		return newListRes(members), nil
+	}
+	if len(members) == 0 {
+		return SRANDMEMBERResNilRes, nil
+	}
+	return newValueRes(members[0]), nil
+}
+
+func executeSRANDMEMBER(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 || len(c.C.Args) > 2 {
+		return SRANDMEMBERResNilRes, errors.ErrWrongArgumentCount("SRANDMEMBER")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSRANDMEMBER(c, shard.Thread.Store())
+}
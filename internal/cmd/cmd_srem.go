@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSREM = &CommandMeta{
+	Name:      "SREM",
+	Syntax:    "SREM key member [member ...]",
+	HelpShort: "SREM removes members from the set stored at key",
+	HelpLong: `
+SREM removes the specified members from the set stored at key. Members that are not in the set are
+ignored. The key is deleted once the set is empty.
+
+Returns the number of members that were removed from the set.
+	`,
+	Examples: `
+localhost:7379> SADD s a b c
+OK 3
+localhost:7379> SREM s a x
+OK 1
+	`,
+	Eval:    evalSREM,
+	Execute: executeSREM,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSREM)
+}
+
+var (
+	SREMResNilRes = newIntRes(0)
+)
+
+func evalSREM(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SREMResNilRes, errors.ErrWrongArgumentCount("SREM")
+	}
+
+	key := c.C.Args[0]
+	set, err := getSetMembers(s, key)
+	if err != nil || set == nil {
+		return SREMResNilRes, err
+	}
+
+	var count int64
+	for _, member := range c.C.Args[1:] {
+		if _, ok := set[member]; ok {
+			delete(set, member)
+			count++
+		}
+	}
+	if len(set) == 0 {
+		s.Del(key)
+	}
+	return newIntRes(count), nil
+}
+
+func executeSREM(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SREMResNilRes, errors.ErrWrongArgumentCount("SREM")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSREM(c, shard.Thread.Store())
+}
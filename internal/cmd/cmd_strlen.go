@@ -0,0 +1,64 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSTRLEN = &CommandMeta{
+	Name:      "STRLEN",
+	Syntax:    "STRLEN key",
+	HelpShort: "STRLEN returns the length of the string stored at key",
+	HelpLong: `
+STRLEN returns the length of the string stored at key, or of the string form of the integer or
+float stored at key, as returned by GET.
+
+Returns 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> SET k hello
+OK
+localhost:7379> STRLEN k
+OK 5
+localhost:7379> STRLEN k2
+OK 0
+	`,
+	Eval:    evalSTRLEN,
+	Execute: executeSTRLEN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSTRLEN)
+}
+
+var (
+	STRLENResNilRes = newIntRes(0)
+)
+
+func evalSTRLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return STRLENResNilRes, errors.ErrWrongArgumentCount("STRLEN")
+	}
+
+	obj := s.Get(c.C.Args[0])
+	if obj == nil {
+		return STRLENResNilRes, nil
+	}
+	value, err := getStringFromObj(obj)
+	if err != nil {
+		return STRLENResNilRes, err
+	}
+	return newIntRes(int64(len(value))), nil
+}
+
+func executeSTRLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return STRLENResNilRes, errors.ErrWrongArgumentCount("STRLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalSTRLEN(c, shard.Thread.Store())
+}
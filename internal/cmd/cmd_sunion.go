@@ -0,0 +1,78 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSUNION = &CommandMeta{
+	Name:      "SUNION",
+	Syntax:    "SUNION key [key ...]",
+	HelpShort: "SUNION returns the members of the union of the sets stored at the keys",
+	HelpLong: `
+SUNION returns the members of the union of all the sets stored at the given keys, in
+lexicographical order. Keys that do not exist are treated as empty sets.
+
+The keys may live on different shards.
+	`,
+	Examples: `
+localhost:7379> SADD s1 a b
+OK 2
+localhost:7379> SADD s2 b c
+OK 2
+localhost:7379> SUNION s1 s2
+OK
+0) a
+1) b
+2) c
+	`,
+	Eval:    evalSUNION,
+	Execute: executeSUNION,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSUNION)
+}
+
+var (
+	SUNIONResNilRes = newListRes([]string{})
+)
+
+func evalSUNION(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return SUNIONResNilRes, errors.ErrWrongArgumentCount("SUNION")
+	}
+	return sunion(c.C.Args, localStore(s))
+}
+
+func executeSUNION(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return SUNIONResNilRes, errors.ErrWrongArgumentCount("SUNION")
+	}
+	return sunion(c.C.Args, shardStores(sm))
+}
+
+// sunion replies with the union of the sets stored at the keys, reading
+// each key in the store storeForKey returns for it.
+func sunion(keys []string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	sets, err := getSets(keys, storeForKey)
+	if err != nil {
+		return SUNIONResNilRes, err
+	}
+	return newListRes(sortedMembers(unionSets(sets))), nil
+}
+
+// unionSets returns the members present in any of the sets.
+func unionSets(sets []map[string]struct{}) map[string]struct{} {
+	result := make(map[string]struct{})
+	for _, set := range sets {
+		for member := range set {
+			result[member] = struct{}{}
+		}
+	}
+	return result
+}
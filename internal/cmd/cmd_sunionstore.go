@@ -0,0 +1,57 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cSUNIONSTORE = &CommandMeta{
+	Name:      "SUNIONSTORE",
+	Syntax:    "SUNIONSTORE destination key [key ...]",
+	HelpShort: "SUNIONSTORE stores the union of the sets stored at the keys in destination",
+	HelpLong: `
+SUNIONSTORE computes the union of all the sets stored at the given keys, like SUNION, and stores
+it as a set in destination. An existing destination is overwritten, and deleted if the union is
+empty.
+
+The keys and the destination may live on different shards.
+
+Returns the number of members in the resulting set.
+	`,
+	Examples: `
+localhost:7379> SADD s1 a b c
+OK 3
+localhost:7379> SADD s2 b c d
+OK 3
+localhost:7379> SUNIONSTORE dst s1 s2
+OK 4
+	`,
+	Eval:    evalSUNIONSTORE,
+	Execute: executeSUNIONSTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cSUNIONSTORE)
+}
+
+var (
+	SUNIONSTOREResNilRes = newIntRes(0)
+)
+
+func evalSUNIONSTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SUNIONSTOREResNilRes, errors.ErrWrongArgumentCount("SUNIONSTORE")
+	}
+	return setStore(c.C.Args, localStore(s), unionSets)
+}
+
+func executeSUNIONSTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return SUNIONSTOREResNilRes, errors.ErrWrongArgumentCount("SUNIONSTORE")
+	}
+	return setStore(c.C.Args, shardStores(sm), unionSets)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cTDIGESTADD = &CommandMeta{
+	Name:      "TDIGEST.ADD",
+	Syntax:    "TDIGEST.ADD key value [value ...]",
+	HelpShort: "TDIGEST.ADD adds values to the t-digest stored at key",
+	HelpLong: `
+TDIGEST.ADD adds one or more values to the t-digest stored at key. The values must be finite
+numbers, and none is added if one is not.
+
+Returns "OK" if the values were added and an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency
+OK
+localhost:7379> TDIGEST.ADD latency 12.5 8 31 9.25
+OK
+	`,
+	Eval:    evalTDIGESTADD,
+	Execute: executeTDIGESTADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTADD)
+}
+
+var (
+	TDIGESTADDResNilRes = newOKRes()
+)
+
+func evalTDIGESTADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TDIGESTADDResNilRes, errors.ErrWrongArgumentCount("TDIGEST.ADD")
+	}
+
+	td, err := getTDigest(s, c.C.Args[0])
+	if err != nil {
+		return TDIGESTADDResNilRes, err
+	}
+	values, err := parseTDigestValues(c.C.Args[1:])
+	if err != nil {
+		return TDIGESTADDResNilRes, err
+	}
+	td.Add(values...)
+	return newOKRes(), nil
+}
+
+func executeTDIGESTADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TDIGESTADDResNilRes, errors.ErrWrongArgumentCount("TDIGEST.ADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTADD(c, shard.Thread.Store())
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTDIGESTCDF = &CommandMeta{
+	Name:      "TDIGEST.CDF",
+	Syntax:    "TDIGEST.CDF key value [value ...]",
+	HelpShort: "TDIGEST.CDF estimates the fraction of the values of the t-digest stored at key below values",
+	HelpLong: `
+TDIGEST.CDF estimates, for every value, the fraction of the values added to the t-digest stored
+at key that are smaller, counting half of those that are equal. It is the inverse of
+TDIGEST.QUANTILE, so it answers questions such as which fraction of the requests took less than
+100 milliseconds.
+
+Returns the values, in the order given, each paired with its estimate, which is "nan" if the
+digest is empty. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency
+OK
+localhost:7379> TDIGEST.ADD latency 1 2 3 4 5 6 7 8 9 10
+OK
+localhost:7379> TDIGEST.CDF latency 0 5 20
+OK
+0) 0="0"
+1) 5="0.45"
+2) 20="1"
+	`,
+	Eval:    evalTDIGESTCDF,
+	Execute: executeTDIGESTCDF,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTCDF)
+}
+
+var (
+	TDIGESTCDFResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTDIGESTCDF(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TDIGESTCDFResNilRes, errors.ErrWrongArgumentCount("TDIGEST.CDF")
+	}
+
+	td, err := getTDigest(s, c.C.Args[0])
+	if err != nil {
+		return TDIGESTCDFResNilRes, err
+	}
+	values, err := parseTDigestValues(c.C.Args[1:])
+	if err != nil {
+		return TDIGESTCDFResNilRes, err
+	}
+
+	elements := make([]*wire.HElement, len(values))
+	for i, v := range values {
+		elements[i] = &wire.HElement{Key: c.C.Args[i+1], Value: formatTDigestValue(td.CDF(v))}
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeTDIGESTCDF(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TDIGESTCDFResNilRes, errors.ErrWrongArgumentCount("TDIGEST.CDF")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTCDF(c, shard.Thread.Store())
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cTDIGESTCREATE = &CommandMeta{
+	Name:      "TDIGEST.CREATE",
+	Syntax:    "TDIGEST.CREATE key [COMPRESSION compression]",
+	HelpShort: "TDIGEST.CREATE creates an empty t-digest",
+	HelpLong: `
+TDIGEST.CREATE creates an empty t-digest at key.
+
+A t-digest estimates the quantiles of a stream of values, such as the 99th percentile of request
+latencies, with bounded memory. It clusters the values into centroids that are smaller near the
+tails of the distribution, so the extreme quantiles are the most accurate.
+
+- COMPRESSION: How many centroids the digest keeps, roughly, between 1 and 10000, 100 by default.
+  Higher values use more memory and give more accurate estimates.
+
+Returns "OK" if the digest was created and an error if the key already exists.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency COMPRESSION 200
+OK
+localhost:7379> TDIGEST.CREATE latency
+ERR key exists
+	`,
+	Eval:    evalTDIGESTCREATE,
+	Execute: executeTDIGESTCREATE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTCREATE)
+}
+
+var (
+	TDIGESTCREATEResNilRes = newOKRes()
+)
+
+func evalTDIGESTCREATE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 && len(c.C.Args) != 3 {
+		return TDIGESTCREATEResNilRes, errors.ErrWrongArgumentCount("TDIGEST.CREATE")
+	}
+
+	compression := float64(types.DefaultTDigestCompression)
+	if len(c.C.Args) == 3 {
+		if !strings.EqualFold(c.C.Args[1], "COMPRESSION") {
+			return TDIGESTCREATEResNilRes, errors.ErrInvalidSyntax("TDIGEST.CREATE")
+		}
+		var err error
+		if compression, err = parseTDigestCompression(c.C.Args[2]); err != nil {
+			return TDIGESTCREATEResNilRes, err
+		}
+	}
+
+	key := c.C.Args[0]
+	if s.Get(key) != nil {
+		return TDIGESTCREATEResNilRes, errors.ErrKeyExists
+	}
+	s.Put(key, s.NewObj(types.NewTDigest(compression), -1, object.ObjTypeTDigest))
+	return newOKRes(), nil
+}
+
+func executeTDIGESTCREATE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 && len(c.C.Args) != 3 {
+		return TDIGESTCREATEResNilRes, errors.ErrWrongArgumentCount("TDIGEST.CREATE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTCREATE(c, shard.Thread.Store())
+}
+
+func parseTDigestCompression(arg string) (float64, error) {
+	compression, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil || compression < 1 || compression > 10000 {
+		return 0, errors.ErrGeneral("compression must be an integer between 1 and 10000")
+	}
+	return float64(compression), nil
+}
+
+// getTDigest returns the t-digest stored at key. Returns an error if the
+// key does not exist or holds a value of another type.
+func getTDigest(s *dstore.Store, key string) (*types.TDigest, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, errors.ErrKeyNotFound
+	}
+	if obj.Type != object.ObjTypeTDigest {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.TDigest), nil
+}
+
+// formatTDigestValue formats an estimate of a t-digest, which is "nan" when
+// the digest is empty.
+func formatTDigestValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "nan"
+	case math.IsInf(v, 1):
+		return "inf"
+	case math.IsInf(v, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// parseTDigestValues parses the values given to the TDIGEST commands,
+// which must be finite numbers.
+func parseTDigestValues(args []string) ([]float64, error) {
+	values := make([]float64, len(args))
+	for i, arg := range args {
+		v, err := strconv.ParseFloat(arg, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, errors.ErrInvalidNumberFormat
+		}
+		values[i] = v
+	}
+	return values, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cTDIGESTMAX = &CommandMeta{
+	Name:      "TDIGEST.MAX",
+	Syntax:    "TDIGEST.MAX key",
+	HelpShort: "TDIGEST.MAX returns the largest value added to the t-digest stored at key",
+	HelpLong: `
+TDIGEST.MAX returns the largest value added to the t-digest stored at key. Unlike the
+quantiles, it is exact.
+
+Returns the value, or "nan" if the digest is empty. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency
+OK
+localhost:7379> TDIGEST.MAX latency
+OK "nan"
+localhost:7379> TDIGEST.ADD latency 12.5 8 31 9.25
+OK
+localhost:7379> TDIGEST.MAX latency
+OK "31"
+	`,
+	Eval:    evalTDIGESTMAX,
+	Execute: executeTDIGESTMAX,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTMAX)
+}
+
+var (
+	TDIGESTMAXResNilRes = newValueRes("")
+)
+
+func evalTDIGESTMAX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return TDIGESTMAXResNilRes, errors.ErrWrongArgumentCount("TDIGEST.MAX")
+	}
+
+	td, err := getTDigest(s, c.C.Args[0])
+	if err != nil {
+		return TDIGESTMAXResNilRes, err
+	}
+	return newValueRes(formatTDigestValue(td.Max())), nil
+}
+
+func executeTDIGESTMAX(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return TDIGESTMAXResNilRes, errors.ErrWrongArgumentCount("TDIGEST.MAX")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTMAX(c, shard.Thread.Store())
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cTDIGESTMERGE = &CommandMeta{
+	Name:      "TDIGEST.MERGE",
+	Syntax:    "TDIGEST.MERGE destination numkeys source [source ...] [COMPRESSION compression] [OVERRIDE]",
+	HelpShort: "TDIGEST.MERGE merges t-digests into the digest stored at destination",
+	HelpLong: `
+TDIGEST.MERGE merges the values of the numkeys source t-digests into the digest stored at
+destination, which is created if it does not exist. The digests may be stored on any shard, so
+per-shard or per-instance digests can be combined into a global one.
+
+If destination already exists, its own values are merged too, unless OVERRIDE is given, in which
+case they are replaced.
+
+- COMPRESSION: The compression of the merged digest. It defaults to the highest compression of
+  the merged digests.
+
+Returns "OK" if the digests were merged and an error if a source does not exist.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency:eu
+OK
+localhost:7379> TDIGEST.CREATE latency:us
+OK
+localhost:7379> TDIGEST.ADD latency:eu 1 2 3 4 5
+OK
+localhost:7379> TDIGEST.ADD latency:us 6 7 8 9 10
+OK
+localhost:7379> TDIGEST.MERGE latency 2 latency:eu latency:us
+OK
+localhost:7379> TDIGEST.MAX latency
+OK "10"
+	`,
+	Eval:    evalTDIGESTMERGE,
+	Execute: executeTDIGESTMERGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTMERGE)
+}
+
+var (
+	TDIGESTMERGEResNilRes = newOKRes()
+)
+
+func evalTDIGESTMERGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TDIGESTMERGEResNilRes, errors.ErrWrongArgumentCount("TDIGEST.MERGE")
+	}
+	return mergeTDigests(c, localStore(s))
+}
+
+func executeTDIGESTMERGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TDIGESTMERGEResNilRes, errors.ErrWrongArgumentCount("TDIGEST.MERGE")
+	}
+	return mergeTDigests(c, shardStores(sm))
+}
+
+// mergeTDigests evaluates TDIGEST.MERGE reading every digest from the store
+// that storeForKey returns for its key, so the sources can live on
+// different shards than the destination.
+func mergeTDigests(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	numKeys, err := strconv.Atoi(c.C.Args[1])
+	if err != nil || numKeys < 1 {
+		return TDIGESTMERGEResNilRes, errors.ErrIntegerOutOfRange
+	}
+	if numKeys > len(c.C.Args)-2 {
+		return TDIGESTMERGEResNilRes, errors.ErrInvalidSyntax("TDIGEST.MERGE")
+	}
+	keys := c.C.Args[2 : 2+numKeys]
+
+	compression := 0.0
+	override := false
+	for i := 2 + numKeys; i < len(c.C.Args); i++ {
+		switch {
+		case strings.EqualFold(c.C.Args[i], "COMPRESSION") && i+1 < len(c.C.Args):
+			if compression, err = parseTDigestCompression(c.C.Args[i+1]); err != nil {
+				return TDIGESTMERGEResNilRes, err
+			}
+			i++
+		case strings.EqualFold(c.C.Args[i], "OVERRIDE"):
+			override = true
+		default:
+			return TDIGESTMERGEResNilRes, errors.ErrInvalidSyntax("TDIGEST.MERGE")
+		}
+	}
+
+	sources := make([]*types.TDigest, 0, numKeys+1)
+	for _, key := range keys {
+		td, err := getTDigest(storeForKey(key), key)
+		if err != nil {
+			return TDIGESTMERGEResNilRes, err
+		}
+		sources = append(sources, td)
+	}
+
+	destKey := c.C.Args[0]
+	s := storeForKey(destKey)
+	if obj := s.Get(destKey); obj != nil && !override {
+		if obj.Type != object.ObjTypeTDigest {
+			return TDIGESTMERGEResNilRes, errors.ErrWrongTypeOperation
+		}
+		sources = append(sources, obj.Value.(*types.TDigest))
+	}
+
+	if compression == 0 {
+		for _, td := range sources {
+			compression = max(compression, td.Compression())
+		}
+	}
+	merged := types.NewTDigest(compression)
+	merged.Merge(sources)
+	s.Put(destKey, s.NewObj(merged, -1, object.ObjTypeTDigest))
+	return newOKRes(), nil
+}
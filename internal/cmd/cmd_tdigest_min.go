@@ -0,0 +1,62 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cTDIGESTMIN = &CommandMeta{
+	Name:      "TDIGEST.MIN",
+	Syntax:    "TDIGEST.MIN key",
+	HelpShort: "TDIGEST.MIN returns the smallest value added to the t-digest stored at key",
+	HelpLong: `
+TDIGEST.MIN returns the smallest value added to the t-digest stored at key. Unlike the
+quantiles, it is exact.
+
+Returns the value, or "nan" if the digest is empty. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency
+OK
+localhost:7379> TDIGEST.MIN latency
+OK "nan"
+localhost:7379> TDIGEST.ADD latency 12.5 8 31 9.25
+OK
+localhost:7379> TDIGEST.MIN latency
+OK "8"
+	`,
+	Eval:    evalTDIGESTMIN,
+	Execute: executeTDIGESTMIN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTMIN)
+}
+
+var (
+	TDIGESTMINResNilRes = newValueRes("")
+)
+
+func evalTDIGESTMIN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return TDIGESTMINResNilRes, errors.ErrWrongArgumentCount("TDIGEST.MIN")
+	}
+
+	td, err := getTDigest(s, c.C.Args[0])
+	if err != nil {
+		return TDIGESTMINResNilRes, err
+	}
+	return newValueRes(formatTDigestValue(td.Min())), nil
+}
+
+func executeTDIGESTMIN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return TDIGESTMINResNilRes, errors.ErrWrongArgumentCount("TDIGEST.MIN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTMIN(c, shard.Thread.Store())
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTDIGESTQUANTILE = &CommandMeta{
+	Name:      "TDIGEST.QUANTILE",
+	Syntax:    "TDIGEST.QUANTILE key quantile [quantile ...]",
+	HelpShort: "TDIGEST.QUANTILE estimates the values at quantiles of the t-digest stored at key",
+	HelpLong: `
+TDIGEST.QUANTILE estimates, for every quantile, the value below which that fraction of the values
+added to the t-digest stored at key fall. The quantiles must be between 0 and 1, so 0.99 asks
+for the 99th percentile. Quantile 0 is the smallest value added and quantile 1 the largest.
+
+Returns the quantiles, in the order given, each paired with its estimate, which is "nan" if the
+digest is empty. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency
+OK
+localhost:7379> TDIGEST.ADD latency 1 2 3 4 5 6 7 8 9 10
+OK
+localhost:7379> TDIGEST.QUANTILE latency 0.5 0.9
+OK
+0) 0.5="6"
+1) 0.9="10"
+	`,
+	Eval:        evalTDIGESTQUANTILE,
+	Execute:     executeTDIGESTQUANTILE,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTQUANTILE)
+}
+
+var (
+	TDIGESTQUANTILEResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTDIGESTQUANTILE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TDIGESTQUANTILEResNilRes, errors.ErrWrongArgumentCount("TDIGEST.QUANTILE")
+	}
+
+	td, err := getTDigest(s, c.C.Args[0])
+	if err != nil {
+		return TDIGESTQUANTILEResNilRes, err
+	}
+	quantiles, err := parseTDigestValues(c.C.Args[1:])
+	if err != nil {
+		return TDIGESTQUANTILEResNilRes, err
+	}
+	for _, q := range quantiles {
+		if q < 0 || q > 1 {
+			return TDIGESTQUANTILEResNilRes, errors.ErrGeneral("quantile must be between 0 and 1")
+		}
+	}
+
+	elements := make([]*wire.HElement, len(quantiles))
+	for i, q := range quantiles {
+		elements[i] = &wire.HElement{Key: c.C.Args[i+1], Value: formatTDigestValue(td.Quantile(q))}
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeTDIGESTQUANTILE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TDIGESTQUANTILEResNilRes, errors.ErrWrongArgumentCount("TDIGEST.QUANTILE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTQUANTILE(c, shard.Thread.Store())
+}
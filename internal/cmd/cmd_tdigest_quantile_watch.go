@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTDIGESTQUANTILEWATCH = &CommandMeta{
+	Name:      "TDIGEST.QUANTILE.WATCH",
+	Syntax:    "TDIGEST.QUANTILE.WATCH key quantile [quantile ...]",
+	HelpShort: "TDIGEST.QUANTILE.WATCH creates a query subscription over the TDIGEST.QUANTILE command",
+	HelpLong: `
+TDIGEST.QUANTILE.WATCH creates a query subscription over the TDIGEST.QUANTILE command. The client
+invoking the command will receive the output of the TDIGEST.QUANTILE command (not just the
+notification) whenever the estimates of the quantiles change, which makes it suited to live
+percentile panels. Updates that leave the estimates as they were are not pushed.
+	`,
+	Examples: `
+client1:7379> TDIGEST.CREATE latency
+OK
+client1:7379> TDIGEST.QUANTILE.WATCH latency 0.99
+entered the watch mode for TDIGEST.QUANTILE.WATCH latency 0.99
+
+
+client2:7379> TDIGEST.ADD latency 12.5 8 31
+OK
+
+
+client1:7379> ...
+entered the watch mode for TDIGEST.QUANTILE.WATCH latency 0.99
+OK [fingerprint=10164071586777571625]
+0) 0.99="31"
+	`,
+	Eval:           evalTDIGESTQUANTILEWATCH,
+	Execute:        executeTDIGESTQUANTILEWATCH,
+	NotifyOnChange: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTQUANTILEWATCH)
+}
+
+var (
+	TDIGESTQUANTILEWATCHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTDIGESTQUANTILEWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	r, err := evalTDIGESTQUANTILE(c, s)
+	if err != nil {
+		return TDIGESTQUANTILEWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeTDIGESTQUANTILEWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) == 0 {
+		return TDIGESTQUANTILEWATCHResNilRes, errors.ErrWrongArgumentCount("TDIGEST.QUANTILE.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTQUANTILEWATCH(c, shard.Thread.Store())
+}
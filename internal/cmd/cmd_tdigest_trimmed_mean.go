@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cTDIGESTTRIMMEDMEAN = &CommandMeta{
+	Name:      "TDIGEST.TRIMMED_MEAN",
+	Syntax:    "TDIGEST.TRIMMED_MEAN key low_quantile high_quantile",
+	HelpShort: "TDIGEST.TRIMMED_MEAN estimates the mean of the values of the t-digest stored at key between two quantiles",
+	HelpLong: `
+TDIGEST.TRIMMED_MEAN estimates the mean of the values added to the t-digest stored at key that lie
+between the two quantiles, which leaves out the outliers at both ends. The quantiles must be
+between 0 and 1, and low_quantile must be smaller than high_quantile.
+
+Returns the mean, or "nan" if the digest is empty. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TDIGEST.CREATE latency
+OK
+localhost:7379> TDIGEST.ADD latency 1 2 3 4 5 6 7 8 9 1000
+OK
+localhost:7379> TDIGEST.TRIMMED_MEAN latency 0.1 0.9
+OK "5.5"
+	`,
+	Eval:    evalTDIGESTTRIMMEDMEAN,
+	Execute: executeTDIGESTTRIMMEDMEAN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTDIGESTTRIMMEDMEAN)
+}
+
+var (
+	TDIGESTTRIMMEDMEANResNilRes = newValueRes("")
+)
+
+func evalTDIGESTTRIMMEDMEAN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return TDIGESTTRIMMEDMEANResNilRes, errors.ErrWrongArgumentCount("TDIGEST.TRIMMED_MEAN")
+	}
+
+	td, err := getTDigest(s, c.C.Args[0])
+	if err != nil {
+		return TDIGESTTRIMMEDMEANResNilRes, err
+	}
+	quantiles, err := parseTDigestValues(c.C.Args[1:])
+	if err != nil {
+		return TDIGESTTRIMMEDMEANResNilRes, err
+	}
+	low, high := quantiles[0], quantiles[1]
+	if low < 0 || high > 1 || low >= high {
+		return TDIGESTTRIMMEDMEANResNilRes, errors.ErrGeneral("quantiles must be between 0 and 1, the low one smaller than the high one")
+	}
+	return newValueRes(formatTDigestValue(td.TrimmedMean(low, high))), nil
+}
+
+func executeTDIGESTTRIMMEDMEAN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return TDIGESTTRIMMEDMEANResNilRes, errors.ErrWrongArgumentCount("TDIGEST.TRIMMED_MEAN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTDIGESTTRIMMEDMEAN(c, shard.Thread.Store())
+}
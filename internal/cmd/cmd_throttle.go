@@ -0,0 +1,215 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTHROTTLE = &CommandMeta{
+	Name:      "THROTTLE",
+	Syntax:    "THROTTLE key max_burst count_per_period period [quantity]",
+	HelpShort: "THROTTLE rate limits the requests made against key",
+	HelpLong: `
+THROTTLE checks a request against the rate limit of count_per_period requests every period
+seconds, on top of which max_burst requests can be made at once, and records it if it is allowed.
+The check and the update are atomic, so concurrent requests against the same key cannot use the
+same capacity twice.
+
+The limit is enforced with the generic cell rate algorithm, which keeps a single timestamp at key
+instead of a counter per window, so requests are spread evenly over the period rather than
+allowed all at once whenever a window starts. The key holds an integer and expires when the
+limit is fully available again.
+
+- quantity: The number of units the request costs, 1 by default. A quantity of 0 checks the limit
+  without using it.
+
+Returns the outcome as name and value pairs:
+
+- allowed: 1 if the request was allowed and 0 if it was limited
+- limit: the number of requests that can be made at once, max_burst + 1
+- remaining: the number of requests that can still be made at once
+- retry_after: the number of seconds, rounded up, until the request would be allowed, or -1 if it
+  was allowed or can never be because its quantity is above the limit
+- reset_after: the number of seconds, rounded up, until the limit is fully available again
+	`,
+	Examples: `
+localhost:7379> THROTTLE api:alice 2 1 60
+OK
+0) allowed="1"
+1) limit="3"
+2) remaining="2"
+3) retry_after="-1"
+4) reset_after="60"
+localhost:7379> THROTTLE api:alice 2 1 60 2
+OK
+0) allowed="1"
+1) limit="3"
+2) remaining="0"
+3) retry_after="-1"
+4) reset_after="180"
+localhost:7379> THROTTLE api:alice 2 1 60
+OK
+0) allowed="0"
+1) limit="3"
+2) remaining="0"
+3) retry_after="60"
+4) reset_after="180"
+	`,
+	Eval:    evalTHROTTLE,
+	Execute: executeTHROTTLE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTHROTTLE)
+}
+
+var (
+	THROTTLEResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTHROTTLE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if c.IsReplay {
+		return replayTHROTTLE(c, s)
+	}
+	if len(c.C.Args) != 4 && len(c.C.Args) != 5 {
+		return THROTTLEResNilRes, errors.ErrWrongArgumentCount("THROTTLE")
+	}
+
+	limit, quantity, err := parseThrottleArgs(c.C.Args[1:])
+	if err != nil {
+		return THROTTLEResNilRes, err
+	}
+
+	// The stored timestamp is read and updated under the lock of the store
+	// for the concurrent requests against key to be checked one at a time.
+	s.Lock()
+	defer s.Unlock()
+
+	key := c.C.Args[0]
+	var tat time.Time
+	if obj := s.Get(key); obj != nil {
+		if obj.Type != object.ObjTypeInt {
+			return THROTTLEResNilRes, errors.ErrWrongTypeOperation
+		}
+		tat = time.Unix(0, obj.Value.(int64))
+	}
+
+	now := time.Now()
+	res := types.GCRAThrottle(limit, tat, now, quantity)
+	if res.Allowed && res.ResetAfter > 0 {
+		ttl := (res.ResetAfter + time.Millisecond - 1).Milliseconds()
+		s.Put(key, s.NewObj(res.TAT.UnixNano(), ttl, object.ObjTypeInt))
+		setThrottleTAT(c, quantity, res.TAT)
+	}
+
+	allowed := "0"
+	if res.Allowed {
+		allowed = "1"
+	}
+	retryAfter := int64(-1)
+	if res.RetryAfter >= 0 {
+		retryAfter = ceilSeconds(res.RetryAfter)
+	}
+	return newPairsRes([]*wire.HElement{
+		{Key: "allowed", Value: allowed},
+		{Key: "limit", Value: strconv.FormatInt(res.Limit, 10)},
+		{Key: "remaining", Value: strconv.FormatInt(res.Remaining, 10)},
+		{Key: "retry_after", Value: strconv.FormatInt(retryAfter, 10)},
+		{Key: "reset_after", Value: strconv.FormatInt(ceilSeconds(res.ResetAfter), 10)},
+	}), nil
+}
+
+func executeTHROTTLE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if !c.IsReplay && len(c.C.Args) != 4 && len(c.C.Args) != 5 {
+		return THROTTLEResNilRes, errors.ErrWrongArgumentCount("THROTTLE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTHROTTLE(c, shard.Thread.Store())
+}
+
+// setThrottleTAT appends the TAT that THROTTLE stored to its arguments, so
+// that the command logged to the WAL restores the same TAT when it is
+// replayed instead of checking the request again at the time of the replay.
+func setThrottleTAT(c *Cmd, quantity int64, tat time.Time) {
+	c.C.Args = append(c.C.Args[:4], strconv.FormatInt(quantity, 10), strconv.FormatInt(tat.UnixNano(), 10))
+}
+
+// replayTHROTTLE restores the TAT carried by a THROTTLE logged to the WAL,
+// see setThrottleTAT. A logged THROTTLE without one did not change the
+// stored TAT, and the key is deleted if the TAT has passed since.
+func replayTHROTTLE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 6 {
+		return THROTTLEResNilRes, nil
+	}
+	tat, err := strconv.ParseInt(c.C.Args[5], 10, 64)
+	if err != nil {
+		return THROTTLEResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	key := c.C.Args[0]
+	resetAfter := time.Until(time.Unix(0, tat))
+	if resetAfter <= 0 {
+		s.Del(key)
+		return THROTTLEResNilRes, nil
+	}
+	ttl := (resetAfter + time.Millisecond - 1).Milliseconds()
+	s.Put(key, s.NewObj(tat, ttl, object.ObjTypeInt))
+	return THROTTLEResNilRes, nil
+}
+
+// parseThrottleArgs parses "max_burst count_per_period period [quantity]".
+func parseThrottleArgs(args []string) (types.GCRALimit, int64, error) {
+	var limit types.GCRALimit
+	nums := make([]int64, len(args))
+	for i, arg := range args {
+		n, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil || n < 0 {
+			return limit, 0, errors.ErrIntegerOutOfRange
+		}
+		nums[i] = n
+	}
+
+	limit.MaxBurst, limit.Count = nums[0], nums[1]
+	if limit.Count == 0 || nums[2] == 0 {
+		return limit, 0, errors.ErrGeneral("count_per_period and period must be positive")
+	}
+	if nums[2] > math.MaxInt64/int64(time.Second) {
+		return limit, 0, errors.ErrIntegerOutOfRange
+	}
+	limit.Period = time.Duration(nums[2]) * time.Second
+	// The emission interval must be at least a nanosecond, and neither the
+	// tolerance of MaxBurst+1 intervals nor the quantity may overflow when
+	// converted into time.
+	interval := int64(limit.Period) / limit.Count
+	if interval == 0 || limit.MaxBurst == math.MaxInt64 || limit.MaxBurst+1 > math.MaxInt64/interval {
+		return limit, 0, errors.ErrIntegerOutOfRange
+	}
+
+	quantity := int64(1)
+	if len(nums) == 4 {
+		quantity = nums[3]
+		if quantity >= math.MaxInt64/interval {
+			return limit, 0, errors.ErrIntegerOutOfRange
+		}
+	}
+	return limit, quantity, nil
+}
+
+// ceilSeconds returns d in seconds, rounded up.
+func ceilSeconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTOPKADD = &CommandMeta{
+	Name:      "TOPK.ADD",
+	Syntax:    "TOPK.ADD key item [item ...]",
+	HelpShort: "TOPK.ADD adds items to the Top-K sketch stored at key",
+	HelpLong: `
+TOPK.ADD counts one more occurrence of each item in the Top-K sketch stored at key. An item
+enters the top k once its estimated count exceeds the smallest count among them, expelling the
+item with that count.
+
+Returns the items, in the order given, each paired with the item it expelled from the top k, or
+an empty string if it expelled none. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TOPK.RESERVE trending 1
+OK
+localhost:7379> TOPK.ADD trending go rust rust
+OK
+0) go=""
+1) rust=""
+2) rust="go"
+	`,
+	Eval:    evalTOPKADD,
+	Execute: executeTOPKADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOPKADD)
+}
+
+var (
+	TOPKADDResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTOPKADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TOPKADDResNilRes, errors.ErrWrongArgumentCount("TOPK.ADD")
+	}
+
+	topk, err := getTopK(s, c.C.Args[0])
+	if err != nil {
+		return TOPKADDResNilRes, err
+	}
+	items := c.C.Args[1:]
+	increments := make([]uint64, len(items))
+	for i := range increments {
+		increments[i] = 1
+	}
+	return topKIncrBy(topk, items, increments), nil
+}
+
+func executeTOPKADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TOPKADDResNilRes, errors.ErrWrongArgumentCount("TOPK.ADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTOPKADD(c, shard.Thread.Store())
+}
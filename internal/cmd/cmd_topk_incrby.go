@@ -0,0 +1,76 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTOPKINCRBY = &CommandMeta{
+	Name:      "TOPK.INCRBY",
+	Syntax:    "TOPK.INCRBY key item increment [item increment ...]",
+	HelpShort: "TOPK.INCRBY increases the counts of items in the Top-K sketch stored at key",
+	HelpLong: `
+TOPK.INCRBY increases the count of each item by its increment in the Top-K sketch stored at key.
+Increments must be positive integers. No count is changed if any of the increments is invalid.
+
+Returns the items, in the order given, each paired with the item it expelled from the top k, or
+an empty string if it expelled none. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TOPK.RESERVE trending 1
+OK
+localhost:7379> TOPK.INCRBY trending go 3 rust 5
+OK
+0) go=""
+1) rust="go"
+	`,
+	Eval:    evalTOPKINCRBY,
+	Execute: executeTOPKINCRBY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOPKINCRBY)
+}
+
+var (
+	TOPKINCRBYResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTOPKINCRBY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args)%2 == 0 {
+		return TOPKINCRBYResNilRes, errors.ErrWrongArgumentCount("TOPK.INCRBY")
+	}
+
+	topk, err := getTopK(s, c.C.Args[0])
+	if err != nil {
+		return TOPKINCRBYResNilRes, err
+	}
+
+	pairs := c.C.Args[1:]
+	items := make([]string, 0, len(pairs)/2)
+	increments := make([]uint64, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		increment, err := strconv.ParseUint(pairs[i+1], 10, 64)
+		if err != nil || increment == 0 {
+			return TOPKINCRBYResNilRes, errors.ErrIntegerOutOfRange
+		}
+		items = append(items, pairs[i])
+		increments = append(increments, increment)
+	}
+	return topKIncrBy(topk, items, increments), nil
+}
+
+func executeTOPKINCRBY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args)%2 == 0 {
+		return TOPKINCRBYResNilRes, errors.ErrWrongArgumentCount("TOPK.INCRBY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTOPKINCRBY(c, shard.Thread.Store())
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTOPKINFO = &CommandMeta{
+	Name:      "TOPK.INFO",
+	Syntax:    "TOPK.INFO key",
+	HelpShort: "TOPK.INFO returns the parameters of the Top-K sketch stored at key",
+	HelpLong: `
+TOPK.INFO returns the number of items tracked by the Top-K sketch stored at key and the width and
+depth of the Count-Min Sketch that estimates their counts.
+
+Returns the parameters as name-value pairs. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TOPK.RESERVE trending 3
+OK
+localhost:7379> TOPK.INFO trending
+OK
+0) k="3"
+1) width="2000"
+2) depth="5"
+	`,
+	Eval:    evalTOPKINFO,
+	Execute: executeTOPKINFO,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOPKINFO)
+}
+
+var (
+	TOPKINFOResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTOPKINFO(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return TOPKINFOResNilRes, errors.ErrWrongArgumentCount("TOPK.INFO")
+	}
+
+	topk, err := getTopK(s, c.C.Args[0])
+	if err != nil {
+		return TOPKINFOResNilRes, err
+	}
+	return newPairsRes([]*wire.HElement{
+		{Key: "k", Value: strconv.Itoa(topk.K())},
+		{Key: "width", Value: strconv.FormatUint(topk.Width(), 10)},
+		{Key: "depth", Value: strconv.FormatUint(topk.Depth(), 10)},
+	}), nil
+}
+
+func executeTOPKINFO(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return TOPKINFOResNilRes, errors.ErrWrongArgumentCount("TOPK.INFO")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTOPKINFO(c, shard.Thread.Store())
+}
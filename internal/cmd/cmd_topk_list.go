@@ -0,0 +1,93 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTOPKLIST = &CommandMeta{
+	Name:      "TOPK.LIST",
+	Syntax:    "TOPK.LIST key [WITHCOUNT]",
+	HelpShort: "TOPK.LIST returns the top k items of the Top-K sketch stored at key",
+	HelpLong: `
+TOPK.LIST returns the items currently among the top k of the Top-K sketch stored at key, the most
+frequent first. Items with the same estimated count are sorted by name.
+
+- WITHCOUNT: Return the estimated count of every item along with it
+
+Returns the items as a list, or with WITHCOUNT the items and their counts as item-count pairs.
+Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TOPK.RESERVE trending 2
+OK
+localhost:7379> TOPK.INCRBY trending go 3 rust 5 zig 1
+OK
+0) go=""
+1) rust=""
+2) zig=""
+localhost:7379> TOPK.LIST trending
+OK
+0) rust
+1) go
+localhost:7379> TOPK.LIST trending WITHCOUNT
+OK
+0) rust="5"
+1) go="3"
+	`,
+	Eval:        evalTOPKLIST,
+	Execute:     executeTOPKLIST,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOPKLIST)
+}
+
+var (
+	TOPKLISTResNilRes = newListRes([]string{})
+)
+
+func evalTOPKLIST(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 && len(c.C.Args) != 2 {
+		return TOPKLISTResNilRes, errors.ErrWrongArgumentCount("TOPK.LIST")
+	}
+	withCount := len(c.C.Args) == 2
+	if withCount && !strings.EqualFold(c.C.Args[1], "WITHCOUNT") {
+		return TOPKLISTResNilRes, errors.ErrInvalidSyntax("TOPK.LIST")
+	}
+
+	topk, err := getTopK(s, c.C.Args[0])
+	if err != nil {
+		return TOPKLISTResNilRes, err
+	}
+	items := topk.List()
+	if !withCount {
+		names := make([]string, len(items))
+		for i, item := range items {
+			names[i] = item.Item
+		}
+		return newListRes(names), nil
+	}
+	elements := make([]*wire.HElement, len(items))
+	for i, item := range items {
+		elements[i] = &wire.HElement{Key: item.Item, Value: strconv.FormatUint(item.Count, 10)}
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeTOPKLIST(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) == 0 {
+		return TOPKLISTResNilRes, errors.ErrWrongArgumentCount("TOPK.LIST")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTOPKLIST(c, shard.Thread.Store())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cTOPKLISTWATCH = &CommandMeta{
+	Name:      "TOPK.LIST.WATCH",
+	Syntax:    "TOPK.LIST.WATCH key [WITHCOUNT]",
+	HelpShort: "TOPK.LIST.WATCH creates a query subscription over the TOPK.LIST command",
+	HelpLong: `
+TOPK.LIST.WATCH creates a query subscription over the TOPK.LIST command. The client invoking the
+command will receive the output of the TOPK.LIST command (not just the notification) whenever
+the top k items of the sketch stored at the key change: an item enters or leaves them, or their
+order changes. Updates that leave the list as it was are not pushed.
+
+With WITHCOUNT the output holds the counts too, so every change of the count of a top item is
+pushed.
+	`,
+	Examples: `
+client1:7379> TOPK.RESERVE trending 2
+OK
+client1:7379> TOPK.LIST.WATCH trending
+entered the watch mode for TOPK.LIST.WATCH trending
+
+
+client2:7379> TOPK.INCRBY trending go 3
+OK
+0) go=""
+
+
+client1:7379> ...
+entered the watch mode for TOPK.LIST.WATCH trending
+OK [fingerprint=12589995923382098086]
+0) go
+	`,
+	Eval:           evalTOPKLISTWATCH,
+	Execute:        executeTOPKLISTWATCH,
+	NotifyOnChange: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOPKLISTWATCH)
+}
+
+var (
+	TOPKLISTWATCHResNilRes = newListRes([]string{})
+)
+
+func evalTOPKLISTWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	r, err := evalTOPKLIST(c, s)
+	if err != nil {
+		return TOPKLISTWATCHResNilRes, err
+	}
+
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeTOPKLISTWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) == 0 {
+		return TOPKLISTWATCHResNilRes, errors.ErrWrongArgumentCount("TOPK.LIST.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTOPKLISTWATCH(c, shard.Thread.Store())
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTOPKQUERY = &CommandMeta{
+	Name:      "TOPK.QUERY",
+	Syntax:    "TOPK.QUERY key item [item ...]",
+	HelpShort: "TOPK.QUERY checks if items are among the top k of the Top-K sketch stored at key",
+	HelpLong: `
+TOPK.QUERY checks if each of the items is currently among the top k items of the Top-K sketch
+stored at key.
+
+Returns the items, in the order given, each paired with 1 if it is among the top k and 0
+otherwise. Returns an error if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TOPK.INCRBY trending go 3 rust 5
+OK
+0) go=""
+1) rust="go"
+localhost:7379> TOPK.QUERY trending go rust
+OK
+0) go="0"
+1) rust="1"
+	`,
+	Eval:    evalTOPKQUERY,
+	Execute: executeTOPKQUERY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOPKQUERY)
+}
+
+var (
+	TOPKQUERYResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTOPKQUERY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TOPKQUERYResNilRes, errors.ErrWrongArgumentCount("TOPK.QUERY")
+	}
+
+	topk, err := getTopK(s, c.C.Args[0])
+	if err != nil {
+		return TOPKQUERYResNilRes, err
+	}
+	elements := make([]*wire.HElement, 0, len(c.C.Args)-1)
+	for _, item := range c.C.Args[1:] {
+		value := "0"
+		if topk.Contains(item) {
+			value = "1"
+		}
+		elements = append(elements, &wire.HElement{Key: item, Value: value})
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeTOPKQUERY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return TOPKQUERYResNilRes, errors.ErrWrongArgumentCount("TOPK.QUERY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTOPKQUERY(c, shard.Thread.Store())
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTOPKRESERVE = &CommandMeta{
+	Name:      "TOPK.RESERVE",
+	Syntax:    "TOPK.RESERVE key topk [width depth]",
+	HelpShort: "TOPK.RESERVE creates an empty Top-K sketch",
+	HelpLong: `
+TOPK.RESERVE creates an empty Top-K sketch at key that tracks the topk items seen most often.
+
+The counts of the items are estimated by a Count-Min Sketch whose matrix has the given width and
+depth, 2000 and 5 by default, so the memory used does not grow with the number of distinct items.
+A wider matrix makes the estimates more accurate and a deeper one makes large errors less likely.
+
+Returns "OK" if the sketch was created and an error if the key already exists.
+	`,
+	Examples: `
+localhost:7379> TOPK.RESERVE trending 3
+OK
+localhost:7379> TOPK.RESERVE searches 10 4000 7
+OK
+	`,
+	Eval:    evalTOPKRESERVE,
+	Execute: executeTOPKRESERVE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOPKRESERVE)
+}
+
+var (
+	TOPKRESERVEResNilRes = newOKRes()
+)
+
+func evalTOPKRESERVE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 && len(c.C.Args) != 4 {
+		return TOPKRESERVEResNilRes, errors.ErrWrongArgumentCount("TOPK.RESERVE")
+	}
+
+	k, err := strconv.Atoi(c.C.Args[1])
+	if err != nil || k <= 0 {
+		return TOPKRESERVEResNilRes, errors.ErrGeneral("topk must be a positive integer")
+	}
+	width, depth := uint64(2000), uint64(5)
+	if len(c.C.Args) == 4 {
+		var err1, err2 error
+		width, err1 = strconv.ParseUint(c.C.Args[2], 10, 64)
+		depth, err2 = strconv.ParseUint(c.C.Args[3], 10, 64)
+		if err1 != nil || err2 != nil || width == 0 || depth == 0 {
+			return TOPKRESERVEResNilRes, errors.ErrGeneral("width and depth must be positive integers")
+		}
+	}
+
+	key := c.C.Args[0]
+	if s.Get(key) != nil {
+		return TOPKRESERVEResNilRes, errors.ErrKeyExists
+	}
+	s.Put(key, s.NewObj(types.NewTopK(k, width, depth), -1, object.ObjTypeTopK))
+	return newOKRes(), nil
+}
+
+func executeTOPKRESERVE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 && len(c.C.Args) != 4 {
+		return TOPKRESERVEResNilRes, errors.ErrWrongArgumentCount("TOPK.RESERVE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTOPKRESERVE(c, shard.Thread.Store())
+}
+
+// getTopK returns the Top-K sketch stored at key. Returns an error if the
+// key does not exist or holds a value of another type.
+func getTopK(s *dstore.Store, key string) (*types.TopK, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, errors.ErrKeyNotFound
+	}
+	if obj.Type != object.ObjTypeTopK {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.TopK), nil
+}
+
+// topKIncrBy increases the counts of the items by their increments and
+// replies with the items, in the order given, each paired with the item it
+// expelled from the top k, or an empty string if it expelled none.
+func topKIncrBy(topk *types.TopK, items []string, increments []uint64) *CmdRes {
+	elements := make([]*wire.HElement, len(items))
+	for i, item := range items {
+		expelled, _ := topk.IncrBy(item, increments[i])
+		elements[i] = &wire.HElement{Key: item, Value: expelled}
+	}
+	return newPairsRes(elements)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cTOUCH = &CommandMeta{
+	Name:      "TOUCH",
+	Syntax:    "TOUCH key [key ...]",
+	HelpShort: "TOUCH records an access to the given keys",
+	HelpLong: `
+TOUCH records an access to each of the given keys, as a read would, without returning their
+values. It resets their idle time and counts towards their access frequency, as reported by
+OBJECT IDLETIME and OBJECT FREQ, which keeps them from being evicted. The keys may live on
+different shards.
+
+Returns the number of keys that exist.
+	`,
+	Examples: `
+localhost:7379> SET k1 v1
+OK
+localhost:7379> OBJECT IDLETIME k1
+OK 12
+localhost:7379> TOUCH k1 k2
+OK 1
+localhost:7379> OBJECT IDLETIME k1
+OK 0
+	`,
+	Eval:    evalTOUCH,
+	Execute: executeTOUCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTOUCH)
+}
+
+var (
+	TOUCHResNilRes = newIntRes(0)
+)
+
+func evalTOUCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return TOUCHResNilRes, errors.ErrWrongArgumentCount("TOUCH")
+	}
+	return touch(c.C.Args, localStore(s))
+}
+
+func executeTOUCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return TOUCHResNilRes, errors.ErrWrongArgumentCount("TOUCH")
+	}
+	return touch(c.C.Args, shardStores(sm))
+}
+
+// touch reads every key from the store that storeForKey returns for it.
+func touch(keys []string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	var count int64
+	for _, key := range keys {
+		if storeForKey(key).Get(key) != nil {
+			count++
+		}
+	}
+	return newIntRes(count), nil
+}
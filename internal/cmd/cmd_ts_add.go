@@ -0,0 +1,100 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cTSADD = &CommandMeta{
+	Name:      "TS.ADD",
+	Syntax:    "TS.ADD key timestamp | * value [RETENTION retentionPeriod] [DUPLICATE_POLICY policy] [ON_DUPLICATE policy]",
+	HelpShort: "TS.ADD adds a sample to the time series stored at key",
+	HelpLong: `
+TS.ADD adds a sample to the time series stored at key. The timestamp is a unix time in
+milliseconds; * stands for the current time. Samples may be added out of order.
+
+If the key does not exist, a time series is created with the RETENTION and DUPLICATE_POLICY
+options, which are described in TS.CREATE and ignored otherwise.
+
+- ON_DUPLICATE: Override the duplicate policy of the time series for this sample
+
+The sample also updates the time series that the compaction rules of the time series, created
+with TS.CREATERULE, downsample it into.
+
+Returns the timestamp of the sample. Returns an error if the sample is older than the retention
+period or if a sample exists at the timestamp and the duplicate policy is BLOCK.
+	`,
+	Examples: `
+localhost:7379> TS.ADD temperature 1000 21.5
+OK 1000
+localhost:7379> TS.ADD temperature 1000 22 ON_DUPLICATE MAX
+OK 1000
+localhost:7379> TS.RANGE temperature - +
+OK
+0) 1000="22"
+	`,
+	Eval:    evalTSADD,
+	Execute: executeTSADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSADD)
+}
+
+var (
+	TSADDResNilRes = newIntRes(0)
+)
+
+func evalTSADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return tsAdd(c, localStore(s))
+}
+
+func executeTSADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	return tsAdd(c, shardStores(sm))
+}
+
+func tsAdd(c *Cmd, storeForKey func(string) *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TSADDResNilRes, errors.ErrWrongArgumentCount("TS.ADD")
+	}
+
+	opts, err := parseTSOptions("TS.ADD", c.C.Args[3:], true)
+	if err != nil {
+		return TSADDResNilRes, err
+	}
+	timestamp, value, err := parseSample(c.C.Args[1], c.C.Args[2])
+	if err != nil {
+		return TSADDResNilRes, err
+	}
+
+	key := c.C.Args[0]
+	s := storeForKey(key)
+	ts, err := getTimeSeries(s, key)
+	if err != nil {
+		return TSADDResNilRes, err
+	}
+	if ts == nil {
+		ts = types.NewTimeSeries(opts.retention, opts.policy)
+		s.Put(key, s.NewObj(ts, -1, object.ObjTypeTimeSeries))
+	}
+
+	policy := ts.DuplicatePolicy
+	if opts.onDuplicate != nil {
+		policy = *opts.onDuplicate
+	}
+	if err := addSample(c, storeForKey, ts, timestamp, value, policy); err != nil {
+		return TSADDResNilRes, err
+	}
+	// The timestamp replaces * so that the command logged to the WAL adds
+	// the sample at the same time when it is replayed.
+	c.C.Args[1] = strconv.FormatInt(timestamp, 10)
+	return newIntRes(timestamp), nil
+}
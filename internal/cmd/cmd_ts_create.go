@@ -0,0 +1,193 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTSCREATE = &CommandMeta{
+	Name:      "TS.CREATE",
+	Syntax:    "TS.CREATE key [RETENTION retentionPeriod] [DUPLICATE_POLICY policy]",
+	HelpShort: "TS.CREATE creates an empty time series at key",
+	HelpLong: `
+TS.CREATE creates an empty time series at key. A time series holds float values recorded at unix
+times in milliseconds, ordered by time.
+
+- RETENTION: Remove the samples older than retentionPeriod milliseconds, relative to the newest
+  sample. 0, the default, keeps every sample.
+- DUPLICATE_POLICY: What to do when a sample is added at the timestamp of an existing sample.
+  BLOCK, the default, rejects the new sample. FIRST keeps the existing value, LAST keeps the new
+  one, MIN and MAX keep the smaller and the larger one, and SUM adds them.
+
+Returns OK, or an error if the key already exists.
+	`,
+	Examples: `
+localhost:7379> TS.CREATE temperature RETENTION 86400000 DUPLICATE_POLICY LAST
+OK
+localhost:7379> TS.CREATE temperature
+ERR key exists
+	`,
+	Eval:    evalTSCREATE,
+	Execute: executeTSCREATE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSCREATE)
+}
+
+var (
+	TSCREATEResNilRes = newOKRes()
+)
+
+func evalTSCREATE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return TSCREATEResNilRes, errors.ErrWrongArgumentCount("TS.CREATE")
+	}
+
+	opts, err := parseTSOptions("TS.CREATE", c.C.Args[1:], false)
+	if err != nil {
+		return TSCREATEResNilRes, err
+	}
+
+	key := c.C.Args[0]
+	if s.Get(key) != nil {
+		return TSCREATEResNilRes, errors.ErrKeyExists
+	}
+	s.Put(key, s.NewObj(types.NewTimeSeries(opts.retention, opts.policy), -1, object.ObjTypeTimeSeries))
+	return newOKRes(), nil
+}
+
+func executeTSCREATE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return TSCREATEResNilRes, errors.ErrWrongArgumentCount("TS.CREATE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTSCREATE(c, shard.Thread.Store())
+}
+
+// tsOptions holds the options of TS.CREATE and TS.ADD.
+type tsOptions struct {
+	retention   int64
+	policy      types.DuplicatePolicy
+	onDuplicate *types.DuplicatePolicy
+}
+
+// parseTSOptions parses "[RETENTION retentionPeriod] [DUPLICATE_POLICY
+// policy]" and, when allowOnDuplicate is set, "[ON_DUPLICATE policy]".
+func parseTSOptions(cmd string, args []string, allowOnDuplicate bool) (*tsOptions, error) {
+	opts := &tsOptions{policy: types.DuplicatePolicyBlock}
+	for i := 0; i < len(args); i += 2 {
+		option := strings.ToUpper(args[i])
+		if i+1 >= len(args) || (option == "ON_DUPLICATE" && !allowOnDuplicate) {
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+		switch option {
+		case "RETENTION":
+			retention, err := strconv.ParseInt(args[i+1], 10, 64)
+			if err != nil || retention < 0 {
+				return nil, errors.ErrIntegerOutOfRange
+			}
+			opts.retention = retention
+		case "DUPLICATE_POLICY", "ON_DUPLICATE":
+			policy, ok := types.ParseDuplicatePolicy(args[i+1])
+			if !ok {
+				return nil, errors.ErrGeneral("unknown duplicate policy '" + args[i+1] + "'")
+			}
+			if option == "ON_DUPLICATE" {
+				opts.onDuplicate = &policy
+			} else {
+				opts.policy = policy
+			}
+		default:
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+	}
+	return opts, nil
+}
+
+// getTimeSeries returns the time series stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getTimeSeries(s *dstore.Store, key string) (*types.TimeSeries, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeTimeSeries {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.TimeSeries), nil
+}
+
+// parseSample parses the timestamp and the value of a sample. A timestamp
+// of * stands for the current time.
+func parseSample(timestampArg, valueArg string) (int64, float64, error) {
+	timestamp := time.Now().UnixMilli()
+	if timestampArg != "*" {
+		var err error
+		if timestamp, err = strconv.ParseInt(timestampArg, 10, 64); err != nil || timestamp < 0 {
+			return 0, 0, errors.ErrGeneral("invalid timestamp, must be a non-negative integer")
+		}
+	}
+	value, err := strconv.ParseFloat(valueArg, 64)
+	if err != nil || math.IsNaN(value) {
+		return 0, 0, errors.ErrInvalidNumberFormat
+	}
+	return timestamp, value, nil
+}
+
+// addSample adds a sample to the time series ts and updates the buckets of
+// the time series its compaction rules write to, which may be stored on
+// other shards. Every rule recomputes the aggregate of the bucket the
+// sample falls in, so the latest bucket of a downsampled time series is
+// always up to date. The destinations updated are recorded on c for their
+// watchers to be notified.
+func addSample(c *Cmd, storeForKey func(string) *dstore.Store, ts *types.TimeSeries, timestamp int64, value float64, policy types.DuplicatePolicy) error {
+	if err := ts.Add(timestamp, value, policy); err != nil {
+		return errors.ErrGeneral(err.Error())
+	}
+
+	for _, rule := range ts.Rules {
+		dst, err := getTimeSeries(storeForKey(rule.DestKey), rule.DestKey)
+		if err != nil || dst == nil {
+			// The destination was deleted or overwritten since the
+			// rule was created.
+			continue
+		}
+		start := rule.BucketStart(timestamp)
+		bucket := types.Aggregate(ts.Range(start, start+rule.BucketDuration-1), rule.Aggregator, rule.BucketDuration)
+		if len(bucket) == 0 {
+			continue
+		}
+		// A bucket older than the retention period of the destination
+		// is not an error for the sample added to the source.
+		if addSample(c, storeForKey, dst, bucket[0].Timestamp, bucket[0].Value, types.DuplicatePolicyLast) == nil {
+			c.addModifiedKeys(rule.DestKey)
+		}
+	}
+	return nil
+}
+
+// newSamples converts samples to timestamp-value pairs.
+func newSamples(samples []types.Sample) []*wire.HElement {
+	elements := make([]*wire.HElement, len(samples))
+	for i, s := range samples {
+		elements[i] = &wire.HElement{
+			Key:   strconv.FormatInt(s.Timestamp, 10),
+			Value: strconv.FormatFloat(s.Value, 'f', -1, 64),
+		}
+	}
+	return elements
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cTSCREATERULE = &CommandMeta{
+	Name:      "TS.CREATERULE",
+	Syntax:    "TS.CREATERULE sourceKey destKey AGGREGATION aggregator bucketDuration",
+	HelpShort: "TS.CREATERULE downsamples a time series into another one",
+	HelpLong: `
+TS.CREATERULE creates a compaction rule that downsamples the time series stored at sourceKey
+into the time series stored at destKey. From then on, every sample added to the source updates
+the sample of the destination at the start of its bucket of bucketDuration milliseconds with the
+aggregate of the samples of the source in the bucket, as TS.RANGE with AGGREGATION computes it.
+The aggregator is one of avg, min, max, sum, count, first and last.
+
+Both time series must exist, and may live on different shards. The destination cannot be the
+source, cannot have compaction rules of its own and cannot already be the destination of a rule
+of the source. Samples added to the source before the rule is created are not downsampled.
+
+Returns OK.
+	`,
+	Examples: `
+localhost:7379> TS.CREATE temperature
+OK
+localhost:7379> TS.CREATE temperature:avg
+OK
+localhost:7379> TS.CREATERULE temperature temperature:avg AGGREGATION avg 60000
+OK
+localhost:7379> TS.ADD temperature 1000 20
+OK 1000
+localhost:7379> TS.ADD temperature 2000 22
+OK 2000
+localhost:7379> TS.RANGE temperature:avg - +
+OK
+0) 0="21"
+	`,
+	Eval:    evalTSCREATERULE,
+	Execute: executeTSCREATERULE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSCREATERULE)
+}
+
+var (
+	TSCREATERULEResNilRes = newOKRes()
+)
+
+func evalTSCREATERULE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return tsCreateRule(c, localStore(s))
+}
+
+func executeTSCREATERULE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	return tsCreateRule(c, shardStores(sm))
+}
+
+func tsCreateRule(c *Cmd, storeForKey func(string) *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 5 {
+		return TSCREATERULEResNilRes, errors.ErrWrongArgumentCount("TS.CREATERULE")
+	}
+	if !strings.EqualFold(c.C.Args[2], "AGGREGATION") {
+		return TSCREATERULEResNilRes, errors.ErrInvalidSyntax("TS.CREATERULE")
+	}
+	aggregator, duration, err := parseTSAggregation(c.C.Args[3], c.C.Args[4])
+	if err != nil {
+		return TSCREATERULEResNilRes, err
+	}
+
+	srcKey, dstKey := c.C.Args[0], c.C.Args[1]
+	if srcKey == dstKey {
+		return TSCREATERULEResNilRes, errors.ErrGeneral("the source and the destination key cannot be the same")
+	}
+	src, dst, err := getTimeSeriesPair(storeForKey, srcKey, dstKey)
+	if err != nil {
+		return TSCREATERULEResNilRes, err
+	}
+	if len(dst.Rules) > 0 {
+		return TSCREATERULEResNilRes, errors.ErrGeneral("the destination key has compaction rules of its own")
+	}
+	for _, rule := range src.Rules {
+		if rule.DestKey == dstKey {
+			return TSCREATERULEResNilRes, errors.ErrGeneral("a compaction rule into the destination key already exists")
+		}
+	}
+
+	src.Rules = append(src.Rules, types.CompactionRule{
+		DestKey:        dstKey,
+		Aggregator:     aggregator,
+		BucketDuration: duration,
+	})
+	return newOKRes(), nil
+}
+
+// getTimeSeriesPair returns the time series stored at the source and the
+// destination key of a compaction rule, which must both exist.
+func getTimeSeriesPair(storeForKey func(string) *dstore.Store, srcKey, dstKey string) (*types.TimeSeries, *types.TimeSeries, error) {
+	src, err := getTimeSeries(storeForKey(srcKey), srcKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := getTimeSeries(storeForKey(dstKey), dstKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if src == nil || dst == nil {
+		return nil, nil, errors.ErrKeyDoesNotExist
+	}
+	return src, dst, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"slices"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cTSDELETERULE = &CommandMeta{
+	Name:      "TS.DELETERULE",
+	Syntax:    "TS.DELETERULE sourceKey destKey",
+	HelpShort: "TS.DELETERULE removes the compaction rule from a time series into another one",
+	HelpLong: `
+TS.DELETERULE removes the compaction rule, created with TS.CREATERULE, that downsamples the time
+series stored at sourceKey into the time series stored at destKey. The samples already written
+to the destination are kept.
+
+Returns OK, or an error if no such rule exists.
+	`,
+	Examples: `
+localhost:7379> TS.CREATE temperature
+OK
+localhost:7379> TS.CREATE temperature:avg
+OK
+localhost:7379> TS.CREATERULE temperature temperature:avg AGGREGATION avg 60000
+OK
+localhost:7379> TS.DELETERULE temperature temperature:avg
+OK
+localhost:7379> TS.DELETERULE temperature temperature:avg
+ERR compaction rule does not exist
+	`,
+	Eval:    evalTSDELETERULE,
+	Execute: executeTSDELETERULE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSDELETERULE)
+}
+
+var (
+	TSDELETERULEResNilRes = newOKRes()
+)
+
+func evalTSDELETERULE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return tsDeleteRule(c, localStore(s))
+}
+
+func executeTSDELETERULE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	return tsDeleteRule(c, shardStores(sm))
+}
+
+func tsDeleteRule(c *Cmd, storeForKey func(string) *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return TSDELETERULEResNilRes, errors.ErrWrongArgumentCount("TS.DELETERULE")
+	}
+
+	srcKey, dstKey := c.C.Args[0], c.C.Args[1]
+	src, err := getTimeSeries(storeForKey(srcKey), srcKey)
+	if err != nil {
+		return TSDELETERULEResNilRes, err
+	}
+	if src == nil {
+		return TSDELETERULEResNilRes, errors.ErrKeyDoesNotExist
+	}
+
+	i := slices.IndexFunc(src.Rules, func(rule types.CompactionRule) bool {
+		return rule.DestKey == dstKey
+	})
+	if i < 0 {
+		return TSDELETERULEResNilRes, errors.ErrGeneral("compaction rule does not exist")
+	}
+	src.Rules = slices.Delete(src.Rules, i, i+1)
+	return newOKRes(), nil
+}
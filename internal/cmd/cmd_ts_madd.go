@@ -0,0 +1,95 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cTSMADD = &CommandMeta{
+	Name:      "TS.MADD",
+	Syntax:    "TS.MADD key timestamp | * value [key timestamp | * value ...]",
+	HelpShort: "TS.MADD adds samples to one or more time series",
+	HelpLong: `
+TS.MADD adds every sample to the time series stored at its key, as TS.ADD does, using the
+duplicate policy of the time series. The time series must exist and may live on different
+shards.
+
+Every sample is added independently of the others: a sample that cannot be added does not
+prevent the others from being added.
+
+Returns, for every sample, the timestamp it was added at or the error that prevented it from
+being added.
+	`,
+	Examples: `
+localhost:7379> TS.CREATE temperature
+OK
+localhost:7379> TS.CREATE humidity
+OK
+localhost:7379> TS.MADD temperature 1000 21.5 humidity 1000 40 pressure 1000 1013
+OK
+0) 1000
+1) 1000
+2) the key does not exist
+	`,
+	Eval:       evalTSMADD,
+	Execute:    executeTSMADD,
+	NotifyKeys: everyNthKey(3),
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSMADD)
+}
+
+var (
+	TSMADDResNilRes = newListRes([]string{})
+)
+
+func evalTSMADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return tsMAdd(c, localStore(s))
+}
+
+func executeTSMADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	return tsMAdd(c, shardStores(sm))
+}
+
+func tsMAdd(c *Cmd, storeForKey func(string) *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 || len(c.C.Args)%3 != 0 {
+		return TSMADDResNilRes, errors.ErrWrongArgumentCount("TS.MADD")
+	}
+
+	results := make([]string, 0, len(c.C.Args)/3)
+	for i := 0; i < len(c.C.Args); i += 3 {
+		key := c.C.Args[i]
+		timestamp, err := tsMAddSample(c, storeForKey, key, c.C.Args[i+1], c.C.Args[i+2])
+		if err != nil {
+			results = append(results, err.Error())
+			continue
+		}
+		// The timestamp replaces * so that the command logged to the WAL
+		// adds the sample at the same time when it is replayed.
+		c.C.Args[i+1] = strconv.FormatInt(timestamp, 10)
+		results = append(results, c.C.Args[i+1])
+	}
+	return newListRes(results), nil
+}
+
+func tsMAddSample(c *Cmd, storeForKey func(string) *dstore.Store, key, timestampArg, valueArg string) (int64, error) {
+	timestamp, value, err := parseSample(timestampArg, valueArg)
+	if err != nil {
+		return 0, err
+	}
+	ts, err := getTimeSeries(storeForKey(key), key)
+	if err != nil {
+		return 0, err
+	}
+	if ts == nil {
+		return 0, errors.ErrKeyDoesNotExist
+	}
+	return timestamp, addSample(c, storeForKey, ts, timestamp, value, ts.DuplicatePolicy)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTSRANGE = &CommandMeta{
+	Name:      "TS.RANGE",
+	Syntax:    "TS.RANGE key fromTimestamp toTimestamp [COUNT count] [AGGREGATION aggregator bucketDuration]",
+	HelpShort: "TS.RANGE returns the samples of a time series within a time range",
+	HelpLong: `
+TS.RANGE returns the samples of the time series stored at key with a timestamp between
+fromTimestamp and toTimestamp, both inclusive, in ascending order. - and + stand for the
+oldest and the newest possible timestamps.
+
+- AGGREGATION: Group the samples into buckets of bucketDuration milliseconds, aligned to
+  the unix epoch, and return one sample per non-empty bucket, at the start of the bucket,
+  holding the aggregate of its values. The aggregator is one of avg, min, max, sum, count,
+  first and last.
+- COUNT: Return at most count samples, or count buckets with AGGREGATION
+
+Returns the samples as timestamp-value pairs, or an empty list if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TS.ADD temperature 1000 20
+OK 1000
+localhost:7379> TS.ADD temperature 2000 22
+OK 2000
+localhost:7379> TS.ADD temperature 61000 25
+OK 61000
+localhost:7379> TS.RANGE temperature - + COUNT 2
+OK
+0) 1000="20"
+1) 2000="22"
+localhost:7379> TS.RANGE temperature - + AGGREGATION avg 60000
+OK
+0) 0="21"
+1) 60000="25"
+	`,
+	Eval:        evalTSRANGE,
+	Execute:     executeTSRANGE,
+	IsWatchable: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSRANGE)
+}
+
+var (
+	TSRANGEResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTSRANGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return tsRange(c, s, "TS.RANGE", false)
+}
+
+func executeTSRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TSRANGEResNilRes, errors.ErrWrongArgumentCount("TS.RANGE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTSRANGE(c, shard.Thread.Store())
+}
+
+// tsRange implements TS.RANGE and, when rev is set, TS.REVRANGE.
+func tsRange(c *Cmd, s *dstore.Store, cmd string, rev bool) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TSRANGEResNilRes, errors.ErrWrongArgumentCount(cmd)
+	}
+
+	q, err := parseTSRangeQuery(cmd, c.C.Args[1:])
+	if err != nil {
+		return TSRANGEResNilRes, err
+	}
+	ts, err := getTimeSeries(s, c.C.Args[0])
+	if err != nil {
+		return TSRANGEResNilRes, err
+	}
+	if ts == nil {
+		return newPairsRes([]*wire.HElement{}), nil
+	}
+	return newPairsRes(newSamples(q.run(ts, rev))), nil
+}
+
+// tsRangeQuery is a range of a time series as selected by TS.RANGE and
+// TS.REVRANGE.
+type tsRangeQuery struct {
+	from, to       int64
+	count          int // -1 for all the samples
+	aggregator     *types.Aggregator
+	bucketDuration int64
+}
+
+// parseTSRangeQuery parses the "fromTimestamp toTimestamp [COUNT count]
+// [AGGREGATION aggregator bucketDuration]" arguments of cmd.
+func parseTSRangeQuery(cmd string, args []string) (*tsRangeQuery, error) {
+	from, err := parseTSRangeBound(args[0], 0)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseTSRangeBound(args[1], math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &tsRangeQuery{from: from, to: to, count: -1}
+	for i := 2; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "COUNT":
+			if i+1 >= len(args) {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			i++
+			if q.count, err = strconv.Atoi(args[i]); err != nil || q.count < 0 {
+				return nil, errors.ErrIntegerOutOfRange
+			}
+		case "AGGREGATION":
+			if i+2 >= len(args) {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			agg, duration, err := parseTSAggregation(args[i+1], args[i+2])
+			if err != nil {
+				return nil, err
+			}
+			q.aggregator, q.bucketDuration = &agg, duration
+			i += 2
+		default:
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+	}
+	return q, nil
+}
+
+// run returns the samples of ts in the range, or the aggregates of its
+// buckets, from the newest when rev is set.
+func (q *tsRangeQuery) run(ts *types.TimeSeries, rev bool) []types.Sample {
+	samples := ts.Range(q.from, q.to)
+	if q.aggregator != nil {
+		samples = types.Aggregate(samples, *q.aggregator, q.bucketDuration)
+	}
+	if rev {
+		slices.Reverse(samples)
+	}
+	if q.count >= 0 && q.count < len(samples) {
+		samples = samples[:q.count]
+	}
+	return samples
+}
+
+// parseTSRangeBound parses a timestamp bound of a range, where - and +
+// stand for the oldest and the newest possible timestamps. unbounded is
+// the timestamp - or + resolve to.
+func parseTSRangeBound(arg string, unbounded int64) (int64, error) {
+	if arg == "-" || arg == "+" {
+		return unbounded, nil
+	}
+	timestamp, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || timestamp < 0 {
+		return 0, errors.ErrGeneral("invalid timestamp, must be a non-negative integer")
+	}
+	return timestamp, nil
+}
+
+// parseTSAggregation parses the aggregator and the bucket duration of an
+// AGGREGATION clause.
+func parseTSAggregation(aggregatorArg, durationArg string) (types.Aggregator, int64, error) {
+	aggregator, ok := types.ParseAggregator(aggregatorArg)
+	if !ok {
+		return 0, 0, errors.ErrGeneral("unknown aggregator '" + aggregatorArg + "'")
+	}
+	duration, err := strconv.ParseInt(durationArg, 10, 64)
+	if err != nil || duration <= 0 {
+		return 0, 0, errors.ErrGeneral("bucket duration must be a positive integer")
+	}
+	return aggregator, duration, nil
+}
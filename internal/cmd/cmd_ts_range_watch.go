@@ -0,0 +1,90 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTSRANGEWATCH = &CommandMeta{
+	Name:      "TS.RANGE.WATCH",
+	Syntax:    "TS.RANGE.WATCH key fromTimestamp toTimestamp [AGGREGATION aggregator bucketDuration]",
+	HelpShort: "TS.RANGE.WATCH subscribes to the latest bucket of a range of a time series",
+	HelpLong: `
+TS.RANGE.WATCH creates a query subscription over the latest sample of the range of the time series
+stored at the key, as TS.RANGE selects it. With AGGREGATION, it is the aggregate of the latest
+bucket, which every sample added to it updates, so a chart can follow the current bucket as it
+changes without reading the whole range again.
+
+The client invoking the command receives the latest bucket whenever it changes. COUNT is not
+supported, as a single bucket is pushed.
+	`,
+	Examples: `
+client1:7379> TS.CREATE temperature
+OK
+client1:7379> TS.RANGE.WATCH temperature - + AGGREGATION avg 60000
+entered the watch mode for TS.RANGE.WATCH temperature - + AGGREGATION avg 60000
+
+
+client2:7379> TS.ADD temperature 1000 20
+OK 1000
+
+
+client1:7379> ...
+entered the watch mode for TS.RANGE.WATCH temperature - + AGGREGATION avg 60000
+OK [fingerprint=3915282619]
+0) 0="20"
+	`,
+	Eval:           evalTSRANGEWATCH,
+	Execute:        executeTSRANGEWATCH,
+	NotifyOnChange: true,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSRANGEWATCH)
+}
+
+var (
+	TSRANGEWATCHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTSRANGEWATCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TSRANGEWATCHResNilRes, errors.ErrWrongArgumentCount("TS.RANGE.WATCH")
+	}
+
+	q, err := parseTSRangeQuery("TS.RANGE.WATCH", c.C.Args[1:])
+	if err != nil {
+		return TSRANGEWATCHResNilRes, err
+	}
+	if q.count >= 0 {
+		return TSRANGEWATCHResNilRes, errors.ErrInvalidSyntax("TS.RANGE.WATCH")
+	}
+	ts, err := getTimeSeries(s, c.C.Args[0])
+	if err != nil {
+		return TSRANGEWATCHResNilRes, err
+	}
+
+	// The latest bucket is the first of the range read from the newest.
+	samples := []types.Sample{}
+	if ts != nil {
+		q.count = 1
+		samples = q.run(ts, true)
+	}
+	r := newPairsRes(newSamples(samples))
+	r.Rs.Fingerprint64 = c.Fingerprint()
+	return r, nil
+}
+
+func executeTSRANGEWATCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TSRANGEWATCHResNilRes, errors.ErrWrongArgumentCount("TS.RANGE.WATCH")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTSRANGEWATCH(c, shard.Thread.Store())
+}
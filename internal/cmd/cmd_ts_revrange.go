@@ -0,0 +1,62 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cTSREVRANGE = &CommandMeta{
+	Name:      "TS.REVRANGE",
+	Syntax:    "TS.REVRANGE key fromTimestamp toTimestamp [COUNT count] [AGGREGATION aggregator bucketDuration]",
+	HelpShort: "TS.REVRANGE returns the samples of a time series within a time range, newest first",
+	HelpLong: `
+TS.REVRANGE works like TS.RANGE but returns the samples, or the buckets with AGGREGATION, in
+descending order of timestamp. COUNT keeps the newest ones, so TS.REVRANGE key - + COUNT 1
+returns the latest sample.
+
+Returns the samples as timestamp-value pairs, or an empty list if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> TS.ADD temperature 1000 20
+OK 1000
+localhost:7379> TS.ADD temperature 2000 22
+OK 2000
+localhost:7379> TS.ADD temperature 61000 25
+OK 61000
+localhost:7379> TS.REVRANGE temperature - + COUNT 2
+OK
+0) 61000="25"
+1) 2000="22"
+localhost:7379> TS.REVRANGE temperature - + AGGREGATION max 60000
+OK
+0) 60000="25"
+1) 0="22"
+	`,
+	Eval:    evalTSREVRANGE,
+	Execute: executeTSREVRANGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cTSREVRANGE)
+}
+
+var (
+	TSREVRANGEResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalTSREVRANGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return tsRange(c, s, "TS.REVRANGE", true)
+}
+
+func executeTSREVRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return TSREVRANGEResNilRes, errors.ErrWrongArgumentCount("TS.REVRANGE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalTSREVRANGE(c, shard.Thread.Store())
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cVADD = &CommandMeta{
+	Name:      "VADD",
+	Syntax:    "VADD key index component [component ...] [ATTR field value [field value ...]]",
+	HelpShort: "VADD stores a vector at key and adds it to a vector index",
+	HelpLong: `
+VADD stores the vector made of the given components at key and adds it to the vector index named
+index, created with VINDEX.CREATE. The vector must have the dimension of the index. If the key
+already holds a vector, the new one replaces it, in the same or in another index.
+
+- ATTR: Attach field-value attributes to the vector, which VSEARCH can filter on
+
+Deleting the key, or letting it expire, removes the vector from the results of VSEARCH.
+
+Returns OK.
+	`,
+	Examples: `
+localhost:7379> VINDEX.CREATE docs DIM 3 METRIC L2
+OK
+localhost:7379> VADD doc:1 docs 0.1 0.2 0.3 ATTR lang en
+OK
+localhost:7379> VADD doc:2 docs 0.1 0.2
+ERR the vector does not have the dimension of the index
+	`,
+	Eval:    evalVADD,
+	Execute: executeVADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cVADD)
+}
+
+var (
+	VADDResNilRes = newOKRes()
+)
+
+func evalVADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return VADDResNilRes, errors.ErrWrongArgumentCount("VADD")
+	}
+
+	key, name := c.C.Args[0], c.C.Args[1]
+	values, rest, err := parseVector(c.C.Args[2:])
+	if err != nil {
+		return VADDResNilRes, err
+	}
+	attrs := map[string]string{}
+	if len(rest) > 0 {
+		if !strings.EqualFold(rest[0], "ATTR") || len(rest) < 3 || len(rest)%2 != 1 {
+			return VADDResNilRes, errors.ErrInvalidSyntax("VADD")
+		}
+		for i := 1; i < len(rest); i += 2 {
+			attrs[rest[i]] = rest[i+1]
+		}
+	}
+
+	idx, err := getVectorIndex(s, name)
+	if err != nil {
+		return VADDResNilRes, err
+	}
+	if err := idx.Validate(values); err != nil {
+		return VADDResNilRes, errors.ErrGeneral(err.Error())
+	}
+	if obj := s.Get(key); obj != nil && obj.Type != object.ObjTypeVector {
+		return VADDResNilRes, errors.ErrWrongTypeOperation
+	}
+
+	v := &types.Vector{Index: name, Values: values, Attrs: attrs}
+	s.Put(key, s.NewObj(v, -1, object.ObjTypeVector))
+	return newOKRes(), nil
+}
+
+func executeVADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return VADDResNilRes, errors.ErrWrongArgumentCount("VADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalVADD(c, shard.Thread.Store())
+}
+
+// putObject stores obj at key in s after checking that, if obj holds a
+// vector, the vector index it names exists in s and accepts it. It is used by the
+// commands that store an object they did not build, such as COPY and
+// RESTORE.
+func putObject(s *dstore.Store, key string, obj *object.Obj) error {
+	v, ok := obj.Value.(*types.Vector)
+	if !ok {
+		s.Put(key, obj)
+		return nil
+	}
+	idx, err := getVectorIndex(s, v.Index)
+	if err != nil {
+		return err
+	}
+	if err := idx.Validate(v.Values); err != nil {
+		return errors.ErrGeneral(err.Error())
+	}
+	s.Put(key, obj)
+	return nil
+}
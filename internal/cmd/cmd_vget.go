@@ -0,0 +1,75 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cVGET = &CommandMeta{
+	Name:      "VGET",
+	Syntax:    "VGET key",
+	HelpShort: "VGET returns the components of the vector stored at key",
+	HelpLong: `
+VGET returns the components of the vector stored at key, added with VADD.
+
+Returns the components as a list, or an empty list if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> VINDEX.CREATE docs DIM 3
+OK
+localhost:7379> VADD doc:1 docs 0.1 0.2 0.3
+OK
+localhost:7379> VGET doc:1
+OK
+0) 0.1
+1) 0.2
+2) 0.3
+	`,
+	Eval:    evalVGET,
+	Execute: executeVGET,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cVGET)
+}
+
+var (
+	VGETResNilRes = newListRes([]string{})
+)
+
+func evalVGET(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return VGETResNilRes, errors.ErrWrongArgumentCount("VGET")
+	}
+
+	obj := s.Get(c.C.Args[0])
+	if obj == nil {
+		return VGETResNilRes, nil
+	}
+	if obj.Type != object.ObjTypeVector {
+		return VGETResNilRes, errors.ErrWrongTypeOperation
+	}
+
+	v := obj.Value.(*types.Vector)
+	values := make([]string, len(v.Values))
+	for i, x := range v.Values {
+		values[i] = strconv.FormatFloat(float64(x), 'f', -1, 32)
+	}
+	return newListRes(values), nil
+}
+
+func executeVGET(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return VGETResNilRes, errors.ErrWrongArgumentCount("VGET")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalVGET(c, shard.Thread.Store())
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cVINDEXCREATE = &CommandMeta{
+	Name:      "VINDEX.CREATE",
+	Syntax:    "VINDEX.CREATE index DIM dimension [METRIC COSINE | L2 | IP] [M m] [EF_CONSTRUCTION ef]",
+	HelpShort: "VINDEX.CREATE creates a vector index for nearest-neighbour search",
+	HelpLong: `
+VINDEX.CREATE creates an empty vector index named index. Vectors are added to the index with VADD
+and searched with VSEARCH. Every shard holds the part of the index made of the vectors of its keys.
+
+- DIM: The number of components of the vectors of the index
+- METRIC: How the distance between two vectors is measured. COSINE, the default, is 1 minus the
+  cosine of the angle between the vectors, L2 is the euclidean distance and IP is 1 minus the
+  inner product.
+- M: The number of neighbours of every vector in the graph used by approximate searches, 16 by
+  default. More neighbours make searches more accurate and vectors slower to add.
+- EF_CONSTRUCTION: The number of candidate neighbours considered when a vector is added, 200 by
+  default
+
+FLUSHDB deletes the vector indexes along with the keys.
+
+Returns OK, or an error if the index already exists.
+	`,
+	Examples: `
+localhost:7379> VINDEX.CREATE docs DIM 3 METRIC L2
+OK
+localhost:7379> VINDEX.CREATE docs DIM 3
+ERR index 'docs' already exists
+	`,
+	Eval:    evalVINDEXCREATE,
+	Execute: executeVINDEXCREATE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cVINDEXCREATE)
+}
+
+var (
+	VINDEXCREATEResNilRes = newOKRes()
+)
+
+func evalVINDEXCREATE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	idx, err := parseVectorIndex(c.C.Args)
+	if err != nil {
+		return VINDEXCREATEResNilRes, err
+	}
+	if s.GetIndex(idx.Name) != nil {
+		return VINDEXCREATEResNilRes, errors.ErrGeneral("index '" + idx.Name + "' already exists")
+	}
+	s.PutIndex(idx.Name, idx)
+	return newOKRes(), nil
+}
+
+func executeVINDEXCREATE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 1 {
+		return VINDEXCREATEResNilRes, errors.ErrWrongArgumentCount("VINDEX.CREATE")
+	}
+	for _, shard := range sm.Shards() {
+		if shard.Thread.Store().GetIndex(c.C.Args[0]) != nil {
+			return VINDEXCREATEResNilRes, errors.ErrGeneral("index '" + c.C.Args[0] + "' already exists")
+		}
+	}
+	// Every shard gets its own index, so that the graph of a shard only
+	// links the vectors stored on it.
+	for _, shard := range sm.Shards() {
+		if _, err := evalVINDEXCREATE(c, shard.Thread.Store()); err != nil {
+			return VINDEXCREATEResNilRes, err
+		}
+	}
+	return newOKRes(), nil
+}
+
+// vectorKeyIndex is the vector index of a shard. It implements
+// store.KeyIndex, so that a vector is removed from the index as soon as its
+// key is deleted, expires or is overwritten.
+type vectorKeyIndex struct {
+	*types.VectorIndex
+}
+
+func (idx *vectorKeyIndex) IndexKey(key string, obj *object.Obj) {
+	v, ok := obj.Value.(*types.Vector)
+	if !ok || v.Index != idx.Name || idx.Validate(v.Values) != nil {
+		// The key was overwritten with a value the index does not cover.
+		idx.Remove(key)
+		return
+	}
+	idx.Add(key, v)
+}
+
+func (idx *vectorKeyIndex) UnindexKey(key string) {
+	idx.Remove(key)
+}
+
+// parseVectorIndex parses the arguments of VINDEX.CREATE into an empty
+// vector index.
+func parseVectorIndex(args []string) (*vectorKeyIndex, error) {
+	if len(args) < 3 || len(args)%2 != 1 {
+		return nil, errors.ErrWrongArgumentCount("VINDEX.CREATE")
+	}
+
+	dim, m, efConstruction := 0, 16, 200
+	metric := types.VectorMetricCosine
+	for i := 1; i < len(args); i += 2 {
+		switch strings.ToUpper(args[i]) {
+		case "DIM", "M", "EF_CONSTRUCTION":
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				return nil, errors.ErrGeneral(strings.ToUpper(args[i]) + " must be a positive integer")
+			}
+			switch strings.ToUpper(args[i]) {
+			case "DIM":
+				dim = n
+			case "M":
+				m = n
+			default:
+				efConstruction = n
+			}
+		case "METRIC":
+			var ok bool
+			if metric, ok = types.ParseVectorMetric(args[i+1]); !ok {
+				return nil, errors.ErrGeneral("unknown metric '" + args[i+1] + "'")
+			}
+		default:
+			return nil, errors.ErrInvalidSyntax("VINDEX.CREATE")
+		}
+	}
+	if dim == 0 {
+		return nil, errors.ErrInvalidSyntax("VINDEX.CREATE")
+	}
+	return &vectorKeyIndex{types.NewVectorIndex(args[0], dim, metric, m, efConstruction)}, nil
+}
+
+// getVectorIndex returns the vector index named name of the store.
+func getVectorIndex(s *dstore.Store, name string) (*vectorKeyIndex, error) {
+	idx, ok := s.GetIndex(name).(*vectorKeyIndex)
+	if !ok {
+		return nil, errors.ErrGeneral("no such vector index '" + name + "'")
+	}
+	return idx, nil
+}
+
+// parseVector parses the components of a vector from the leading arguments
+// that are numbers, and returns the remaining arguments.
+func parseVector(args []string) ([]float32, []string, error) {
+	values := []float32{}
+	for len(args) > 0 {
+		x, err := strconv.ParseFloat(args[0], 32)
+		if err != nil {
+			break
+		}
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, nil, errors.ErrInvalidNumberFormat
+		}
+		values = append(values, float32(x))
+		args = args[1:]
+	}
+	return values, args, nil
+}
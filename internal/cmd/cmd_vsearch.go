@@ -0,0 +1,194 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cVSEARCH = &CommandMeta{
+	Name:      "VSEARCH",
+	Syntax:    "VSEARCH index k component [component ...] [EXACT] [EF ef] [FILTER field value [field value ...]]",
+	HelpShort: "VSEARCH returns the k vectors of an index nearest to a query vector",
+	HelpLong: `
+VSEARCH returns the k vectors of the vector index named index nearest to the query vector made of
+the given components, according to the metric of the index. Every shard searches its own vectors
+and the nearest ones across all shards are returned.
+
+By default the search walks the graph of the index, which finds the nearest vectors with high
+probability while comparing the query with a fraction of the vectors.
+
+- EXACT: Compare the query with every vector of the index, which always finds the nearest ones
+- EF: The number of candidates the graph search keeps, at least k. Larger values make the search
+  more accurate and slower. Defaults to k.
+- FILTER: Only return the vectors whose attributes, set with VADD, have the given values
+
+Returns the keys of the vectors and their distances to the query as key-distance pairs, nearest
+first.
+	`,
+	Examples: `
+localhost:7379> VINDEX.CREATE docs DIM 2 METRIC L2
+OK
+localhost:7379> VADD doc:1 docs 0 0 ATTR lang en
+OK
+localhost:7379> VADD doc:2 docs 3 4 ATTR lang fr
+OK
+localhost:7379> VADD doc:3 docs 6 8 ATTR lang en
+OK
+localhost:7379> VSEARCH docs 2 0 1
+OK
+0) doc:1="1"
+1) doc:2="4.242640687119285"
+localhost:7379> VSEARCH docs 2 0 1 EXACT FILTER lang en
+OK
+0) doc:1="1"
+1) doc:3="9.219544457292887"
+	`,
+	Eval:    evalVSEARCH,
+	Execute: executeVSEARCH,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cVSEARCH)
+}
+
+var (
+	VSEARCHResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalVSEARCH(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	args, err := parseVSearch(c.C.Args)
+	if err != nil {
+		return VSEARCHResNilRes, err
+	}
+	matches, err := vsearch(s, args)
+	if err != nil {
+		return VSEARCHResNilRes, err
+	}
+	return newVectorMatches(matches), nil
+}
+
+func executeVSEARCH(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	args, err := parseVSearch(c.C.Args)
+	if err != nil {
+		return VSEARCHResNilRes, err
+	}
+
+	// Every shard returns its k nearest vectors, so the k nearest ones
+	// overall are among them.
+	matches := []types.VectorMatch{}
+	for _, shard := range sm.Shards() {
+		shardMatches, err := vsearch(shard.Thread.Store(), args)
+		if err != nil {
+			return VSEARCHResNilRes, err
+		}
+		matches = append(matches, shardMatches...)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Distance < matches[j].Distance
+	})
+	return newVectorMatches(matches[:min(args.k, len(matches))]), nil
+}
+
+// vsearchArgs holds the arguments of VSEARCH.
+type vsearchArgs struct {
+	index  string
+	k      int
+	query  []float32
+	exact  bool
+	ef     int
+	filter map[string]string
+}
+
+func parseVSearch(args []string) (*vsearchArgs, error) {
+	if len(args) < 3 {
+		return nil, errors.ErrWrongArgumentCount("VSEARCH")
+	}
+
+	k, err := strconv.Atoi(args[1])
+	if err != nil || k <= 0 {
+		return nil, errors.ErrGeneral("k must be a positive integer")
+	}
+	query, rest, err := parseVector(args[2:])
+	if err != nil {
+		return nil, err
+	}
+
+	r := &vsearchArgs{index: args[0], k: k, query: query, filter: map[string]string{}}
+	for i := 0; i < len(rest); i++ {
+		switch strings.ToUpper(rest[i]) {
+		case "EXACT":
+			r.exact = true
+		case "EF":
+			if i+1 >= len(rest) {
+				return nil, errors.ErrInvalidSyntax("VSEARCH")
+			}
+			i++
+			if r.ef, err = strconv.Atoi(rest[i]); err != nil || r.ef <= 0 {
+				return nil, errors.ErrGeneral("EF must be a positive integer")
+			}
+		case "FILTER":
+			filter := rest[i+1:]
+			if len(filter) == 0 || len(filter)%2 != 0 {
+				return nil, errors.ErrInvalidSyntax("VSEARCH")
+			}
+			for j := 0; j < len(filter); j += 2 {
+				r.filter[filter[j]] = filter[j+1]
+			}
+			return r, nil
+		default:
+			return nil, errors.ErrInvalidSyntax("VSEARCH")
+		}
+	}
+	return r, nil
+}
+
+// vsearch searches the part of the index held by the store s. The index
+// only holds the vectors still stored at their keys, but a key that expired
+// is only removed once it is deleted, so it is skipped here. The store is
+// read without deleting the expired keys, which would update the index
+// while it is being searched.
+func vsearch(s *dstore.Store, args *vsearchArgs) ([]types.VectorMatch, error) {
+	idx, err := getVectorIndex(s, args.index)
+	if err != nil {
+		return nil, err
+	}
+	if err := idx.Validate(args.query); err != nil {
+		return nil, errors.ErrGeneral(err.Error())
+	}
+
+	now := time.Now().UnixMilli()
+	accept := func(key string, v *types.Vector) bool {
+		obj, ok := s.GetStore().Get(key)
+		if !ok {
+			return false
+		}
+		if exp, ok := dstore.GetExpiry(obj, s); ok && exp <= now {
+			return false
+		}
+		return v.Matches(args.filter)
+	}
+	return idx.Search(args.query, args.k, args.exact, args.ef, accept), nil
+}
+
+// newVectorMatches converts the matches of a search to key-distance pairs.
+func newVectorMatches(matches []types.VectorMatch) *CmdRes {
+	elements := make([]*wire.HElement, len(matches))
+	for i, m := range matches {
+		elements[i] = &wire.HElement{
+			Key:   m.Key,
+			Value: strconv.FormatFloat(m.Distance, 'f', -1, 64),
+		}
+	}
+	return newPairsRes(elements)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cXACK = &CommandMeta{
+	Name:      "XACK",
+	Syntax:    "XACK key group id [id ...]",
+	HelpShort: "XACK acknowledges entries delivered to a consumer group",
+	HelpLong: `
+XACK removes the given IDs from the pending entries of the consumer group of the stream stored at
+key. A consumer acknowledges an entry once it has processed it, so that the entry is no longer
+reported by XPENDING nor claimed by XCLAIM.
+
+Returns the number of entries acknowledged. IDs that are not pending are ignored, and the
+command returns 0 if the key or the group does not exist.
+	`,
+	Examples: `
+localhost:7379> XGROUP CREATE events workers 0 MKSTREAM
+OK
+localhost:7379> XADD events 1-1 type login
+OK 1-1
+localhost:7379> XREADGROUP GROUP workers alice STREAMS events >
+OK
+0) events 1-1 type="login"
+localhost:7379> XACK events workers 1-1
+OK 1
+	`,
+	Eval:    evalXACK,
+	Execute: executeXACK,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXACK)
+}
+
+var (
+	XACKResNilRes = newIntRes(0)
+)
+
+func evalXACK(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return XACKResNilRes, errors.ErrWrongArgumentCount("XACK")
+	}
+
+	ids := make([]types.StreamID, 0, len(c.C.Args)-2)
+	for _, arg := range c.C.Args[2:] {
+		id, err := types.ParseStreamID(arg, 0)
+		if err != nil {
+			return XACKResNilRes, errors.ErrGeneral(err.Error())
+		}
+		ids = append(ids, id)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	stream, err := getStream(s, c.C.Args[0])
+	if err != nil || stream == nil {
+		return XACKResNilRes, err
+	}
+	g := stream.Group(c.C.Args[1])
+	if g == nil {
+		return XACKResNilRes, nil
+	}
+
+	acked := int64(0)
+	for _, id := range ids {
+		if g.Ack(id) {
+			acked++
+		}
+	}
+	return newIntRes(acked), nil
+}
+
+func executeXACK(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return XACKResNilRes, errors.ErrWrongArgumentCount("XACK")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalXACK(c, shard.Thread.Store())
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cXADD = &CommandMeta{
+	Name:      "XADD",
+	Syntax:    "XADD key [NOMKSTREAM] [MAXLEN | MINID [= | ~] threshold] * | id field value [field value ...]",
+	HelpShort: "XADD appends an entry to the stream stored at key",
+	HelpLong: `
+XADD appends an entry made of the given field-value pairs to the stream stored at key, creating
+the stream if the key does not exist.
+
+Every entry is identified by an ID of the form ms-seq, where ms is a unix time in milliseconds
+and seq orders the entries added within the same millisecond. IDs always increase: with * the
+ID is generated from the current time, with ms-* only the sequence number is generated, and an
+explicit ID must be greater than the ID of the last entry ever added to the stream.
+
+- NOMKSTREAM: Do not create the stream if the key does not exist
+- MAXLEN threshold: After adding the entry, remove the oldest entries until at most threshold are left
+- MINID threshold: After adding the entry, remove the entries with an ID smaller than threshold
+
+The = and ~ modifiers are accepted for compatibility; the stream is always trimmed exactly.
+
+Returns the ID of the added entry, or an empty string if NOMKSTREAM is given and the key does not
+exist.
+	`,
+	Examples: `
+localhost:7379> XADD events 1-1 type login user alice
+OK 1-1
+localhost:7379> XADD events 1-* type logout user alice
+OK 1-2
+localhost:7379> XADD events MAXLEN 1 * type login user bob
+OK 1718000000000-0
+localhost:7379> XLEN events
+OK 1
+	`,
+	Eval:    evalXADD,
+	Execute: executeXADD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXADD)
+}
+
+var (
+	XADDResNilRes = newValueRes("")
+)
+
+func evalXADD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return XADDResNilRes, errors.ErrWrongArgumentCount("XADD")
+	}
+
+	key := c.C.Args[0]
+	noMkStream := false
+	maxLen := -1
+	var minID *types.StreamID
+
+	i := 1
+	for ; i < len(c.C.Args); i++ {
+		switch arg := strings.ToUpper(c.C.Args[i]); arg {
+		case "NOMKSTREAM":
+			noMkStream = true
+			continue
+		case "MAXLEN", "MINID":
+			i++
+			if i < len(c.C.Args) && (c.C.Args[i] == "=" || c.C.Args[i] == "~") {
+				i++
+			}
+			if i >= len(c.C.Args) {
+				return XADDResNilRes, errors.ErrInvalidSyntax("XADD")
+			}
+			if arg == "MAXLEN" {
+				n, err := strconv.Atoi(c.C.Args[i])
+				if err != nil || n < 0 {
+					return XADDResNilRes, errors.ErrIntegerOutOfRange
+				}
+				maxLen = n
+				continue
+			}
+			id, err := types.ParseStreamID(c.C.Args[i], 0)
+			if err != nil {
+				return XADDResNilRes, err
+			}
+			minID = &id
+			continue
+		}
+		break
+	}
+
+	fields := c.C.Args[min(i+1, len(c.C.Args)):]
+	if len(fields) == 0 || len(fields)%2 != 0 {
+		return XADDResNilRes, errors.ErrWrongArgumentCount("XADD")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	stream, err := getStream(s, key)
+	if err != nil {
+		return XADDResNilRes, err
+	}
+	if stream == nil {
+		if noMkStream {
+			return XADDResNilRes, nil
+		}
+		stream = types.NewStream()
+		s.Put(key, s.NewObj(stream, -1, object.ObjTypeStream))
+	}
+
+	id, err := stream.Add(c.C.Args[i], append([]string(nil), fields...), time.Now().UnixMilli())
+	if err != nil {
+		return XADDResNilRes, errors.ErrGeneral(err.Error())
+	}
+	// The generated ID replaces the ID argument so that the command logged
+	// to the WAL adds the entry with the same ID when it is replayed.
+	c.C.Args[i] = id.String()
+
+	if maxLen >= 0 {
+		stream.TrimMaxLen(maxLen)
+	}
+	if minID != nil {
+		stream.TrimMinID(*minID)
+	}
+
+	return newValueRes(id.String()), nil
+}
+
+func executeXADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return XADDResNilRes, errors.ErrWrongArgumentCount("XADD")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalXADD(c, shard.Thread.Store())
+}
+
+// streamWaiters tracks the clients blocked by XREAD and XREADGROUP until
+// one of the keys they read is written, by XADD or by a command storing a
+// stream there. Cmd.Execute notifies them.
+var streamWaiters = &blockedClients{
+	waiters: map[string]map[chan struct{}]struct{}{},
+}
+
+// getStream returns the stream stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type. The clients of a shard run on their own io
+// threads, so the callers hold the lock of the store while they use the
+// stream.
+func getStream(s *dstore.Store, key string) (*types.Stream, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeStream {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.Stream), nil
+}
+
+// newStreamEntries converts stream entries to field-value pairs. Every
+// field of an entry becomes a pair whose key is the ID of the entry and
+// the field separated by a space, prefixed with prefix. Stream IDs never
+// contain spaces, so the ID and the field can always be told apart. An
+// entry without fields becomes a single pair holding only its ID.
+func newStreamEntries(prefix string, entries []types.StreamEntry) []*wire.HElement {
+	elements := []*wire.HElement{}
+	for _, e := range entries {
+		id := prefix + e.ID.String()
+		if len(e.Fields) == 0 {
+			elements = append(elements, &wire.HElement{Key: id})
+			continue
+		}
+		for i := 0; i+1 < len(e.Fields); i += 2 {
+			elements = append(elements, &wire.HElement{Key: id + " " + e.Fields[i], Value: e.Fields[i+1]})
+		}
+	}
+	return elements
+}
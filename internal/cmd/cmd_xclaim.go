@@ -0,0 +1,108 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cXCLAIM = &CommandMeta{
+	Name:      "XCLAIM",
+	Syntax:    "XCLAIM key group consumer min-idle-time id [id ...] [JUSTID]",
+	HelpShort: "XCLAIM transfers pending entries of a consumer group to another consumer",
+	HelpLong: `
+XCLAIM transfers the ownership of pending entries of the consumer group of the stream stored at
+key to consumer. It lets a consumer take over the entries of a consumer that failed before
+acknowledging them.
+
+Only the entries among the given IDs that are pending and were last delivered at least
+min-idle-time milliseconds ago are claimed. Claiming an entry counts as delivering it again.
+Pending entries that were since trimmed from the stream are acknowledged instead of claimed.
+
+- JUSTID: Return only the IDs of the claimed entries, without counting them as delivered again
+
+Returns the claimed entries in the same format as XRANGE, or their IDs with JUSTID. Returns an
+error if the key or the group does not exist.
+	`,
+	Examples: `
+localhost:7379> XREADGROUP GROUP workers alice STREAMS events >
+OK
+0) events 1-1 type="login"
+localhost:7379> XCLAIM events workers bob 60000 1-1
+OK
+0) 1-1 type="login"
+	`,
+	Eval:    evalXCLAIM,
+	Execute: executeXCLAIM,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXCLAIM)
+}
+
+var (
+	XCLAIMResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalXCLAIM(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 5 {
+		return XCLAIMResNilRes, errors.ErrWrongArgumentCount("XCLAIM")
+	}
+
+	minIdle, err := strconv.ParseInt(c.C.Args[3], 10, 64)
+	if err != nil || minIdle < 0 {
+		return XCLAIMResNilRes, errors.ErrIntegerOutOfRange
+	}
+
+	idArgs := c.C.Args[4:]
+	justID := strings.ToUpper(idArgs[len(idArgs)-1]) == "JUSTID"
+	if justID {
+		idArgs = idArgs[:len(idArgs)-1]
+	}
+	if len(idArgs) == 0 {
+		return XCLAIMResNilRes, errors.ErrWrongArgumentCount("XCLAIM")
+	}
+	ids := make([]types.StreamID, 0, len(idArgs))
+	for _, arg := range idArgs {
+		id, err := types.ParseStreamID(arg, 0)
+		if err != nil {
+			return XCLAIMResNilRes, errors.ErrGeneral(err.Error())
+		}
+		ids = append(ids, id)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	stream, g, err := getStreamGroup(s, c.C.Args[0], c.C.Args[1])
+	if err != nil {
+		return XCLAIMResNilRes, err
+	}
+
+	claimed := stream.Claim(g, c.C.Args[2], minIdle, ids, time.Now().UnixMilli(), !justID)
+	if justID {
+		result := make([]string, len(claimed))
+		for i, e := range claimed {
+			result[i] = e.ID.String()
+		}
+		return newListRes(result), nil
+	}
+	return newPairsRes(newStreamEntries("", claimed)), nil
+}
+
+func executeXCLAIM(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 5 {
+		return XCLAIMResNilRes, errors.ErrWrongArgumentCount("XCLAIM")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalXCLAIM(c, shard.Thread.Store())
+}
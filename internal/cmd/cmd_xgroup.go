@@ -0,0 +1,137 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cXGROUP = &CommandMeta{
+	Name:      "XGROUP",
+	Syntax:    "XGROUP CREATE key group id | $ [MKSTREAM] | XGROUP DESTROY key group",
+	HelpShort: "XGROUP creates and destroys the consumer groups of the stream stored at key",
+	HelpLong: `
+XGROUP manages the consumer groups of the stream stored at key. A consumer group delivers every
+entry of the stream to a single one of its consumers, and keeps track of the entries delivered
+but not yet acknowledged with XACK.
+
+- CREATE key group id: Create a group that delivers the entries added after id; $ stands for the
+  ID of the last entry of the stream. Returns OK.
+- MKSTREAM: With CREATE, create an empty stream if the key does not exist
+- DESTROY key group: Destroy the group, along with its pending entries. Returns 1 if the group
+  existed and 0 otherwise.
+	`,
+	Examples: `
+localhost:7379> XGROUP CREATE events workers $ MKSTREAM
+OK
+localhost:7379> XGROUP CREATE events workers $
+ERR BUSYGROUP consumer group name already exists
+localhost:7379> XGROUP DESTROY events workers
+OK 1
+	`,
+	Eval:    evalXGROUP,
+	Execute: executeXGROUP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXGROUP)
+}
+
+var (
+	XGROUPResNilRes = newIntRes(0)
+)
+
+func evalXGROUP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return XGROUPResNilRes, errors.ErrWrongArgumentCount("XGROUP")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	key, group := c.C.Args[1], c.C.Args[2]
+	switch strings.ToUpper(c.C.Args[0]) {
+	case "CREATE":
+		if len(c.C.Args) != 4 && len(c.C.Args) != 5 {
+			return XGROUPResNilRes, errors.ErrWrongArgumentCount("XGROUP")
+		}
+		mkStream := len(c.C.Args) == 5
+		if mkStream && strings.ToUpper(c.C.Args[4]) != "MKSTREAM" {
+			return XGROUPResNilRes, errors.ErrInvalidSyntax("XGROUP")
+		}
+		return xgroupCreate(s, key, group, c.C.Args[3], mkStream)
+	case "DESTROY":
+		if len(c.C.Args) != 3 {
+			return XGROUPResNilRes, errors.ErrWrongArgumentCount("XGROUP")
+		}
+		stream, err := getStream(s, key)
+		if err != nil || stream == nil {
+			return XGROUPResNilRes, err
+		}
+		if stream.DestroyGroup(group) {
+			return newIntRes(1), nil
+		}
+		return newIntRes(0), nil
+	default:
+		return XGROUPResNilRes, errors.ErrInvalidSyntax("XGROUP")
+	}
+}
+
+func xgroupCreate(s *dstore.Store, key, group, idArg string, mkStream bool) (*CmdRes, error) {
+	stream, err := getStream(s, key)
+	if err != nil {
+		return XGROUPResNilRes, err
+	}
+
+	var id types.StreamID
+	if idArg != "$" {
+		if id, err = types.ParseStreamID(idArg, 0); err != nil {
+			return XGROUPResNilRes, errors.ErrGeneral(err.Error())
+		}
+	}
+
+	if stream == nil {
+		if !mkStream {
+			return XGROUPResNilRes, errors.ErrGeneral("the XGROUP subcommand requires the key to exist, use MKSTREAM to create an empty stream")
+		}
+		stream = types.NewStream()
+		s.Put(key, s.NewObj(stream, -1, object.ObjTypeStream))
+	}
+	if idArg == "$" {
+		id = stream.LastID()
+	}
+
+	if err := stream.CreateGroup(group, id); err != nil {
+		return XGROUPResNilRes, errors.ErrGeneral(err.Error())
+	}
+	return newOKRes(), nil
+}
+
+func executeXGROUP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return XGROUPResNilRes, errors.ErrWrongArgumentCount("XGROUP")
+	}
+	shard := sm.GetShardForKey(c.C.Args[1])
+	return evalXGROUP(c, shard.Thread.Store())
+}
+
+// getStreamGroup returns the stream stored at key and its consumer group
+// named group. Returns a NOGROUP error if either does not exist.
+func getStreamGroup(s *dstore.Store, key, group string) (*types.Stream, *types.ConsumerGroup, error) {
+	stream, err := getStream(s, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stream == nil || stream.Group(group) == nil {
+		return nil, nil, errors.ErrGeneral(fmt.Sprintf("NOGROUP no such key '%s' or consumer group '%s'", key, group))
+	}
+	return stream, stream.Group(group), nil
+}
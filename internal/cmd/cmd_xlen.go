@@ -0,0 +1,62 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cXLEN = &CommandMeta{
+	Name:      "XLEN",
+	Syntax:    "XLEN key",
+	HelpShort: "XLEN returns the number of entries in the stream stored at key",
+	HelpLong: `
+XLEN returns the number of entries in the stream stored at key.
+
+The command returns 0 if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> XADD events * type login
+OK 1718000000000-0
+localhost:7379> XLEN events
+OK 1
+localhost:7379> XLEN missing
+OK 0
+	`,
+	Eval:    evalXLEN,
+	Execute: executeXLEN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXLEN)
+}
+
+var (
+	XLENResNilRes = newIntRes(0)
+)
+
+func evalXLEN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return XLENResNilRes, errors.ErrWrongArgumentCount("XLEN")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	stream, err := getStream(s, c.C.Args[0])
+	if err != nil || stream == nil {
+		return XLENResNilRes, err
+	}
+	return newIntRes(int64(stream.Len())), nil
+}
+
+func executeXLEN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 1 {
+		return XLENResNilRes, errors.ErrWrongArgumentCount("XLEN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalXLEN(c, shard.Thread.Store())
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cXPENDING = &CommandMeta{
+	Name:      "XPENDING",
+	Syntax:    "XPENDING key group [[IDLE min-idle-time] start end count [consumer]]",
+	HelpShort: "XPENDING returns the entries delivered to a consumer group but not yet acknowledged",
+	HelpLong: `
+XPENDING inspects the pending entries of the consumer group of the stream stored at key, that is
+the entries delivered to one of its consumers and not yet acknowledged with XACK.
+
+Without a range, returns a summary: the number of pending entries, the smallest and the greatest
+pending IDs (empty if there are none), followed by every consumer with pending entries and
+their number.
+
+With a range, returns the pending entries with an ID between start and end, at most count of
+them. Every entry is described by four values: its ID, the consumer it was delivered to, the
+milliseconds elapsed since it was last delivered and the number of times it was delivered.
+
+- IDLE: Return only the entries idle for at least min-idle-time milliseconds
+- consumer: Return only the entries delivered to consumer
+
+Returns an error if the key or the group does not exist.
+	`,
+	Examples: `
+localhost:7379> XGROUP CREATE events workers 0 MKSTREAM
+OK
+localhost:7379> XADD events 1-1 type login
+OK 1-1
+localhost:7379> XREADGROUP GROUP workers alice STREAMS events >
+OK
+0) events 1-1 type="login"
+localhost:7379> XPENDING events workers
+OK
+0) 1
+1) 1-1
+2) 1-1
+3) alice
+4) 1
+localhost:7379> XPENDING events workers - + 10
+OK
+0) 1-1
+1) alice
+2) 5120
+3) 1
+	`,
+	Eval:    evalXPENDING,
+	Execute: executeXPENDING,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXPENDING)
+}
+
+var (
+	XPENDINGResNilRes = newListRes([]string{})
+)
+
+func evalXPENDING(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return XPENDINGResNilRes, errors.ErrWrongArgumentCount("XPENDING")
+	}
+
+	args := c.C.Args[2:]
+	minIdle := int64(0)
+	if len(args) > 0 && strings.ToUpper(args[0]) == "IDLE" {
+		if len(args) < 2 {
+			return XPENDINGResNilRes, errors.ErrInvalidSyntax("XPENDING")
+		}
+		var err error
+		if minIdle, err = strconv.ParseInt(args[1], 10, 64); err != nil || minIdle < 0 {
+			return XPENDINGResNilRes, errors.ErrIntegerOutOfRange
+		}
+		args = args[2:]
+		if len(args) == 0 {
+			return XPENDINGResNilRes, errors.ErrInvalidSyntax("XPENDING")
+		}
+	}
+	if len(args) != 0 && len(args) != 3 && len(args) != 4 {
+		return XPENDINGResNilRes, errors.ErrInvalidSyntax("XPENDING")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	_, g, err := getStreamGroup(s, c.C.Args[0], c.C.Args[1])
+	if err != nil {
+		return XPENDINGResNilRes, err
+	}
+
+	if len(args) == 0 {
+		pending := g.Pending(types.MinStreamID, types.MaxStreamID, -1, "")
+		result := []string{strconv.Itoa(len(pending)), "", ""}
+		if len(pending) > 0 {
+			result[1], result[2] = pending[0].ID.String(), pending[len(pending)-1].ID.String()
+		}
+
+		byConsumer := g.PendingByConsumer()
+		consumers := make([]string, 0, len(byConsumer))
+		for consumer := range byConsumer {
+			consumers = append(consumers, consumer)
+		}
+		sort.Strings(consumers)
+		for _, consumer := range consumers {
+			result = append(result, consumer, strconv.Itoa(byConsumer[consumer]))
+		}
+		return newListRes(result), nil
+	}
+
+	start, startOk, err := parseStreamRangeBound(args[0], true)
+	if err != nil {
+		return XPENDINGResNilRes, err
+	}
+	end, endOk, err := parseStreamRangeBound(args[1], false)
+	if err != nil {
+		return XPENDINGResNilRes, err
+	}
+	count, err := strconv.Atoi(args[2])
+	if err != nil || count < 0 {
+		return XPENDINGResNilRes, errors.ErrIntegerOutOfRange
+	}
+	consumer := ""
+	if len(args) == 4 {
+		consumer = args[3]
+	}
+
+	result := []string{}
+	if !startOk || !endOk {
+		return newListRes(result), nil
+	}
+	now := time.Now().UnixMilli()
+	for _, p := range g.Pending(start, end, -1, consumer) {
+		if len(result) == 4*count {
+			break
+		}
+		idle := now - p.DeliveredAt
+		if idle < minIdle {
+			continue
+		}
+		result = append(result, p.ID.String(), p.Consumer, strconv.FormatInt(idle, 10), strconv.FormatInt(p.Deliveries, 10))
+	}
+	return newListRes(result), nil
+}
+
+func executeXPENDING(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return XPENDINGResNilRes, errors.ErrWrongArgumentCount("XPENDING")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalXPENDING(c, shard.Thread.Store())
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cXRANGE = &CommandMeta{
+	Name:      "XRANGE",
+	Syntax:    "XRANGE key start end [COUNT count]",
+	HelpShort: "XRANGE returns the entries of the stream stored at key within a range of IDs",
+	HelpLong: `
+XRANGE returns the entries of the stream stored at key with an ID between start and end, both
+inclusive, from the oldest to the newest.
+
+- and + stand for the smallest and the greatest possible IDs. An ID without a sequence number
+stands for the first entry of that millisecond as start and for the last one as end. Prefix an
+ID with ( to exclude it from the range.
+
+- COUNT: Return at most count entries
+
+Returns the fields of the entries as field-value pairs. The key of every pair is the ID of the
+entry and the field, separated by a space. Returns an empty list if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> XADD events 1-1 type login user alice
+OK 1-1
+localhost:7379> XADD events 2-1 type logout
+OK 2-1
+localhost:7379> XRANGE events - +
+OK
+0) 1-1 type="login"
+1) 1-1 user="alice"
+2) 2-1 type="logout"
+localhost:7379> XRANGE events (1-1 + COUNT 1
+OK
+0) 2-1 type="logout"
+	`,
+	Eval:    evalXRANGE,
+	Execute: executeXRANGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXRANGE)
+}
+
+var (
+	XRANGEResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalXRANGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return streamRange(c, s, "XRANGE", false)
+}
+
+func executeXRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 && len(c.C.Args) != 5 {
+		return XRANGEResNilRes, errors.ErrWrongArgumentCount("XRANGE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalXRANGE(c, shard.Thread.Store())
+}
+
+// streamRange implements "XRANGE key start end [COUNT count]" and, with
+// rev set, "XREVRANGE key end start [COUNT count]".
+func streamRange(c *Cmd, s *dstore.Store, cmd string, rev bool) (*CmdRes, error) {
+	if len(c.C.Args) != 3 && len(c.C.Args) != 5 {
+		return XRANGEResNilRes, errors.ErrWrongArgumentCount(cmd)
+	}
+
+	startArg, endArg := c.C.Args[1], c.C.Args[2]
+	if rev {
+		startArg, endArg = endArg, startArg
+	}
+	start, startOk, err := parseStreamRangeBound(startArg, true)
+	if err != nil {
+		return XRANGEResNilRes, err
+	}
+	end, endOk, err := parseStreamRangeBound(endArg, false)
+	if err != nil {
+		return XRANGEResNilRes, err
+	}
+
+	count := -1
+	if len(c.C.Args) == 5 {
+		if strings.ToUpper(c.C.Args[3]) != "COUNT" {
+			return XRANGEResNilRes, errors.ErrInvalidSyntax(cmd)
+		}
+		count, err = strconv.Atoi(c.C.Args[4])
+		if err != nil || count < 0 {
+			return XRANGEResNilRes, errors.ErrIntegerOutOfRange
+		}
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	stream, err := getStream(s, c.C.Args[0])
+	if err != nil || stream == nil || !startOk || !endOk {
+		return XRANGEResNilRes, err
+	}
+	return newPairsRes(newStreamEntries("", stream.Range(start, end, count, rev))), nil
+}
+
+// parseStreamRangeBound parses a bound of XRANGE. ok is false when the
+// bound is an exclusive one that no ID can satisfy, such as "(0-0" as
+// the end of a range.
+func parseStreamRangeBound(arg string, isStart bool) (id types.StreamID, ok bool, err error) {
+	switch arg {
+	case "-":
+		return types.MinStreamID, true, nil
+	case "+":
+		return types.MaxStreamID, true, nil
+	}
+
+	exclusive := strings.HasPrefix(arg, "(")
+	defaultSeq := uint64(0)
+	if !isStart {
+		defaultSeq = math.MaxUint64
+	}
+	id, err = types.ParseStreamID(strings.TrimPrefix(arg, "("), defaultSeq)
+	if err != nil {
+		return id, false, errors.ErrGeneral(err.Error())
+	}
+	if !exclusive {
+		return id, true, nil
+	}
+	if isStart {
+		id, ok = id.Next()
+		return id, ok, nil
+	}
+	id, ok = id.Prev()
+	return id, ok, nil
+}
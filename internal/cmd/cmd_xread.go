@@ -0,0 +1,231 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cXREAD = &CommandMeta{
+	Name:      "XREAD",
+	Syntax:    "XREAD [COUNT count] [BLOCK milliseconds] STREAMS key [key ...] id [id ...]",
+	HelpShort: "XREAD returns the entries added to one or more streams after the given IDs",
+	HelpLong: `
+XREAD returns, for every given stream, the entries with an ID greater than the ID given for it.
+The IDs follow the keys, in the same order. $ stands for the ID of the last entry of the stream,
+so that only the entries added after the command is issued are returned. The keys may live on
+different shards.
+
+- COUNT: Return at most count entries per stream
+- BLOCK: If no stream has a matching entry, block until an entry is added to one of them or
+  the timeout in milliseconds expires. A timeout of 0 blocks indefinitely.
+
+Returns the fields of the entries as field-value pairs. The key of every pair is the key of the
+stream, the ID of the entry and the field, separated by spaces. Returns an empty list if no
+stream has a matching entry.
+	`,
+	Examples: `
+localhost:7379> XADD events 1-1 type login
+OK 1-1
+localhost:7379> XADD audit 1-1 user alice
+OK 1-1
+localhost:7379> XREAD STREAMS events audit 0 0
+OK
+0) events 1-1 type="login"
+1) audit 1-1 user="alice"
+localhost:7379> XREAD BLOCK 100 STREAMS events $
+OK
+	`,
+	Eval:    evalXREAD,
+	Execute: executeXREAD,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXREAD)
+}
+
+var (
+	XREADResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalXREAD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return xread(c, localStore(s), false)
+}
+
+func executeXREAD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	// Blocking while the WAL is replayed would stall the replay forever.
+	return xread(c, shardStores(sm), !c.IsReplay)
+}
+
+func xread(c *Cmd, storeForKey func(string) *dstore.Store, canBlock bool) (*CmdRes, error) {
+	args, err := parseStreamRead("XREAD", c.C.Args)
+	if err != nil {
+		return XREADResNilRes, err
+	}
+
+	// $ is resolved once, so that a blocked client is woken up by any entry
+	// added after the command was issued.
+	ids := make([]types.StreamID, len(args.keys))
+	for i := range args.keys {
+		if args.ids[i] == "$" {
+			continue
+		}
+		if ids[i], err = types.ParseStreamID(args.ids[i], 0); err != nil {
+			return XREADResNilRes, errors.ErrGeneral(err.Error())
+		}
+	}
+	if err := resolveLastIDs(args, ids, storeForKey); err != nil {
+		return XREADResNilRes, err
+	}
+
+	read := func() ([]*wire.HElement, error) {
+		defer lockStores(args.keys, storeForKey)()
+		elements := []*wire.HElement{}
+		for i, key := range args.keys {
+			stream, err := getStream(storeForKey(key), key)
+			if err != nil {
+				return nil, err
+			}
+			if stream != nil {
+				elements = append(elements, newStreamEntries(key+" ", stream.After(ids[i], args.count))...)
+			}
+		}
+		return elements, nil
+	}
+	return readStreams(c, args, canBlock, read)
+}
+
+// resolveLastIDs sets the IDs of the keys read from $ to the ID of the last
+// entry of their stream.
+func resolveLastIDs(args *streamReadArgs, ids []types.StreamID, storeForKey func(string) *dstore.Store) error {
+	defer lockStores(args.keys, storeForKey)()
+	for i, key := range args.keys {
+		if args.ids[i] != "$" {
+			continue
+		}
+		stream, err := getStream(storeForKey(key), key)
+		if err != nil {
+			return err
+		}
+		if stream != nil {
+			ids[i] = stream.LastID()
+		}
+	}
+	return nil
+}
+
+// streamReadArgs holds the arguments shared by XREAD and XREADGROUP.
+type streamReadArgs struct {
+	count   int
+	block   bool
+	timeout time.Duration
+	noAck   bool
+	keys    []string
+	ids     []string
+}
+
+// parseStreamRead parses "[COUNT count] [BLOCK milliseconds] [NOACK]
+// STREAMS key [key ...] id [id ...]". NOACK is only accepted by XREADGROUP.
+func parseStreamRead(cmd string, args []string) (*streamReadArgs, error) {
+	r := &streamReadArgs{count: -1}
+	for i := 0; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "COUNT":
+			if i+1 >= len(args) {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			i++
+			count, err := strconv.Atoi(args[i])
+			if err != nil || count < 0 {
+				return nil, errors.ErrIntegerOutOfRange
+			}
+			if count > 0 {
+				r.count = count
+			}
+		case "BLOCK":
+			if i+1 >= len(args) {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			i++
+			ms, err := strconv.ParseInt(args[i], 10, 64)
+			if err != nil || ms < 0 {
+				return nil, errors.ErrInvalidTimeout
+			}
+			r.block = true
+			r.timeout = time.Duration(ms) * time.Millisecond
+		case "NOACK":
+			if cmd != "XREADGROUP" {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			r.noAck = true
+		case "STREAMS":
+			streams := args[i+1:]
+			if len(streams) == 0 || len(streams)%2 != 0 {
+				return nil, errors.ErrGeneral("unbalanced '" + cmd + "' list of streams: for each stream key an ID must be specified")
+			}
+			r.keys, r.ids = streams[:len(streams)/2], streams[len(streams)/2:]
+			return r, nil
+		default:
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+	}
+	return nil, errors.ErrWrongArgumentCount(cmd)
+}
+
+// readStreams calls read and, if it finds no entry and the command asked
+// to block, calls it again every time an entry is added to one of the
+// streams until it finds one or the timeout expires. The client registers
+// as a waiter before the first read so that an entry added between the
+// read and the wait still wakes it up. It stops waiting if the client
+// disconnects.
+func readStreams(c *Cmd, args *streamReadArgs, canBlock bool, read func() ([]*wire.HElement, error)) (*CmdRes, error) {
+	if !args.block || !canBlock {
+		elements, err := read()
+		if err != nil {
+			return XREADResNilRes, err
+		}
+		return newPairsRes(elements), nil
+	}
+
+	ch := streamWaiters.register(args.keys)
+	defer streamWaiters.unregister(args.keys, ch)
+
+	var expired <-chan time.Time
+	if args.timeout > 0 {
+		timer := time.NewTimer(args.timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	var disconnected <-chan struct{}
+	if c.Ctx != nil {
+		disconnected = c.Ctx.Done()
+	}
+
+	for {
+		elements, err := read()
+		if err != nil {
+			return XREADResNilRes, err
+		}
+		if len(elements) > 0 {
+			return newPairsRes(elements), nil
+		}
+
+		select {
+		case <-ch:
+		case <-expired:
+			return newPairsRes([]*wire.HElement{}), nil
+		case <-disconnected:
+			return newPairsRes([]*wire.HElement{}), c.Ctx.Err()
+		}
+	}
+}
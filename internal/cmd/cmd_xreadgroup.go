@@ -0,0 +1,131 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cXREADGROUP = &CommandMeta{
+	Name:      "XREADGROUP",
+	Syntax:    "XREADGROUP GROUP group consumer [COUNT count] [BLOCK milliseconds] [NOACK] STREAMS key [key ...] id [id ...]",
+	HelpShort: "XREADGROUP reads entries from one or more streams on behalf of a consumer of a consumer group",
+	HelpLong: `
+XREADGROUP is the consumer group variant of XREAD. Every stream must have a consumer group named
+group, created with XGROUP CREATE; the consumer is created the first time it reads.
+
+The ID given for a stream selects what is read:
+
+- >: the entries never delivered to any consumer of the group. They are delivered to consumer
+  and added to the pending entries of the group until they are acknowledged with XACK.
+- any other ID: the entries with a greater ID already delivered to consumer and still pending.
+  This lets a consumer that restarts process again the entries it had not acknowledged.
+
+- COUNT: Return at most count entries per stream
+- BLOCK: If no entry is returned and > is given for a stream, block until an entry is added to
+  one of the streams or the timeout in milliseconds expires. A timeout of 0 blocks indefinitely.
+- NOACK: Do not add the delivered entries to the pending entries of the group
+
+Returns the entries in the same format as XREAD. Pending entries that were trimmed from the
+stream are returned as a single pair holding the key and the ID. Returns an error if a key or
+its group does not exist.
+	`,
+	Examples: `
+localhost:7379> XGROUP CREATE events workers $ MKSTREAM
+OK
+localhost:7379> XADD events 1-1 type login
+OK 1-1
+localhost:7379> XREADGROUP GROUP workers alice COUNT 10 STREAMS events >
+OK
+0) events 1-1 type="login"
+localhost:7379> XREADGROUP GROUP workers alice STREAMS events 0
+OK
+0) events 1-1 type="login"
+	`,
+	Eval:    evalXREADGROUP,
+	Execute: executeXREADGROUP,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXREADGROUP)
+}
+
+var (
+	XREADGROUPResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalXREADGROUP(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return xreadgroup(c, localStore(s), false)
+}
+
+func executeXREADGROUP(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	// Blocking while the WAL is replayed would stall the replay forever.
+	return xreadgroup(c, shardStores(sm), !c.IsReplay)
+}
+
+func xreadgroup(c *Cmd, storeForKey func(string) *dstore.Store, canBlock bool) (*CmdRes, error) {
+	if len(c.C.Args) < 6 {
+		return XREADGROUPResNilRes, errors.ErrWrongArgumentCount("XREADGROUP")
+	}
+	if strings.ToUpper(c.C.Args[0]) != "GROUP" {
+		return XREADGROUPResNilRes, errors.ErrInvalidSyntax("XREADGROUP")
+	}
+	group, consumer := c.C.Args[1], c.C.Args[2]
+
+	args, err := parseStreamRead("XREADGROUP", c.C.Args[3:])
+	if err != nil {
+		return XREADGROUPResNilRes, err
+	}
+
+	readsNew := false
+	ids := make([]types.StreamID, len(args.keys))
+	for i := range args.keys {
+		if args.ids[i] == ">" {
+			readsNew = true
+			continue
+		}
+		if ids[i], err = types.ParseStreamID(args.ids[i], 0); err != nil {
+			return XREADGROUPResNilRes, errors.ErrGeneral(err.Error())
+		}
+	}
+
+	read := func() ([]*wire.HElement, error) {
+		defer lockStores(args.keys, storeForKey)()
+		// Every group is checked before any is read, so that a missing one
+		// does not leave the others with entries delivered to nobody.
+		for _, key := range args.keys {
+			if _, _, err := getStreamGroup(storeForKey(key), key, group); err != nil {
+				return nil, err
+			}
+		}
+
+		elements := []*wire.HElement{}
+		now := time.Now().UnixMilli()
+		for i, key := range args.keys {
+			stream, g, err := getStreamGroup(storeForKey(key), key, group)
+			if err != nil {
+				return nil, err
+			}
+			var entries []types.StreamEntry
+			if args.ids[i] == ">" {
+				entries = stream.ReadNew(g, consumer, args.count, args.noAck, now)
+			} else {
+				entries = stream.ReadPending(g, consumer, ids[i], args.count, now)
+			}
+			elements = append(elements, newStreamEntries(key+" ", entries)...)
+		}
+		return elements, nil
+	}
+
+	// Reading the pending entries of a consumer never blocks, since no new
+	// entry can add to them.
+	return readStreams(c, args, canBlock && readsNew, read)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cXREVRANGE = &CommandMeta{
+	Name:      "XREVRANGE",
+	Syntax:    "XREVRANGE key end start [COUNT count]",
+	HelpShort: "XREVRANGE returns the entries of the stream stored at key within a range of IDs, newest first",
+	HelpLong: `
+XREVRANGE is XRANGE with the order reversed: it returns the entries of the stream stored at key
+with an ID between start and end, both inclusive, from the newest to the oldest. Note that the
+end of the range comes first.
+
+- COUNT: Return at most count entries
+
+Returns the fields of the entries as field-value pairs, in the same format as XRANGE. Returns an
+empty list if the key does not exist.
+	`,
+	Examples: `
+localhost:7379> XADD events 1-1 type login
+OK 1-1
+localhost:7379> XADD events 2-1 type logout
+OK 2-1
+localhost:7379> XREVRANGE events + - COUNT 1
+OK
+0) 2-1 type="logout"
+	`,
+	Eval:    evalXREVRANGE,
+	Execute: executeXREVRANGE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cXREVRANGE)
+}
+
+var (
+	XREVRANGEResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalXREVRANGE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	return streamRange(c, s, "XREVRANGE", true)
+}
+
+func executeXREVRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 && len(c.C.Args) != 5 {
+		return XREVRANGEResNilRes, errors.ErrWrongArgumentCount("XREVRANGE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalXREVRANGE(c, shard.Thread.Store())
+}
@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"math"
 	"strconv"
 
 	"github.com/dicedb/dice/internal/errors"
@@ -12,6 +13,8 @@ import (
 	dsstore "github.com/dicedb/dice/internal/store"
 	"github.com/dicedb/dice/internal/types"
 	"github.com/dicedb/dicedb-go/wire"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 )
 
 var cZADD = &CommandMeta{
@@ -21,7 +24,7 @@ var cZADD = &CommandMeta{
 	HelpLong: `
 ZADD adds all the specified members with the specified scores to the sorted set stored at key.
 
-The score of the member should be an integer and two members can have the same score. Here are the options supported by the command:
+The score of the member is a double precision floating point number and two members can have the same score. Here are the options supported by the command:
 
 - NX: Only add new elements and do not update existing elements
 - XX: Only update existing elements and do not add new elements
@@ -30,7 +33,9 @@ The score of the member should be an integer and two members can have the same s
 - CH: Modify the return value from the number of new elements added to the total number of elements changed
 - INCR: When this option is specified, the scores provided are treated as increments to the score of the existing elements
 
-The command by default returns the number of elements added to the sorted set.
+The command by default returns the number of elements added to the sorted set. With INCR, it returns the
+new score of the element truncated to an integer, and sets the exact_score field of the reply to the exact score
+if it is fractional or infinite.
 	`,
 	Examples: `
 localhost:7379> ZADD users 10 u1
@@ -78,7 +83,7 @@ func evalZADD(c *Cmd, s *dsstore.Store) (*CmdRes, error) {
 	}
 
 	key := c.C.Args[0]
-	scores, members := []int64{}, []string{}
+	scores, members := []float64{}, []string{}
 	params, nonParams := parseParams(c.C.Args[1:])
 
 	if len(nonParams)%2 != 0 {
@@ -86,9 +91,9 @@ func evalZADD(c *Cmd, s *dsstore.Store) (*CmdRes, error) {
 	}
 
 	for i := 0; i < len(nonParams); i += 2 {
-		score, err := strconv.ParseInt(nonParams[i], 10, 64)
+		score, err := parseScore(nonParams[i])
 		if err != nil {
-			return ZADDResNilRes, errors.ErrInvalidNumberFormat
+			return ZADDResNilRes, err
 		}
 		scores = append(scores, score)
 		members = append(members, nonParams[i+1])
@@ -111,7 +116,11 @@ func evalZADD(c *Cmd, s *dsstore.Store) (*CmdRes, error) {
 	if err != nil {
 		return ZADDResNilRes, err
 	}
-	return newZADDRes(count), nil
+	res := newZADDRes(wireScore(count))
+	if params[types.INCR] != "" {
+		withExactScore(res.Rs.GetZADDRes(), count)
+	}
+	return res, nil
 }
 
 func executeZADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
@@ -122,3 +131,86 @@ func executeZADD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
 	shard := sm.GetShardForKey(c.C.Args[0])
 	return evalZADD(c, shard.Thread.Store())
 }
+
+// getSortedSet returns the sorted set stored at key.
+// Returns nil if the key does not exist and an error if the key holds
+// a value of another type.
+func getSortedSet(s *dsstore.Store, key string) (*types.SortedSet, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+	if obj.Type != object.ObjTypeSortedSet {
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return obj.Value.(*types.SortedSet), nil
+}
+
+// parseScore parses a sorted set score. Scores are doubles and may be
+// -inf or +inf, but never NaN.
+func parseScore(arg string) (float64, error) {
+	score, err := strconv.ParseFloat(arg, 64)
+	if err != nil || math.IsNaN(score) {
+		return 0, errors.ErrInvalidNumberFormat
+	}
+	return score, nil
+}
+
+// formatScore formats a score the way replies carrying it as a string do.
+func formatScore(score float64) string {
+	switch {
+	case math.IsInf(score, 1):
+		return "inf"
+	case math.IsInf(score, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
+// wireScore converts a score to the integer score carried by wire.ZElement,
+// truncating its fractional part and clamping infinite scores. The exact
+// score is carried next to it by withExactScore.
+func wireScore(score float64) int64 {
+	switch {
+	case score >= math.MaxInt64:
+		return math.MaxInt64
+	case score <= math.MinInt64:
+		return math.MinInt64
+	}
+	return int64(score)
+}
+
+// exactScoreField is the number of the field carrying the exact score of a
+// wire.ZElement, and of the reply to ZADD with INCR, when its integer score
+// is not exact. The wire messages do not declare it, so that the clients
+// built against them skip it and keep reading the integer score, while the
+// clients declaring "optional double exact_score = 15" in those messages
+// read it whenever it is set.
+const exactScoreField protowire.Number = 15
+
+// withExactScore sets the exact score field of m to score if the integer
+// score wireScore converts it to is not exact, and returns m.
+func withExactScore[M proto.Message](m M, score float64) M {
+	if float64(wireScore(score)) == score {
+		return m
+	}
+	b := protowire.AppendTag(nil, exactScoreField, protowire.Fixed64Type)
+	m.ProtoReflect().SetUnknown(protowire.AppendFixed64(b, math.Float64bits(score)))
+	return m
+}
+
+// newZElement returns the wire element of member, carrying its integer
+// score and, if that one is not exact, its exact score.
+func newZElement(member string, score float64, rank int64) *wire.ZElement {
+	return withExactScore(&wire.ZElement{Member: member, Score: wireScore(score), Rank: rank}, score)
+}
+
+// newZElements converts sorted set elements to the wire elements replies
+// carry.
+func newZElements(elements []types.SortedSetElement) []*wire.ZElement {
+	result := make([]*wire.ZElement, len(elements))
+	for i, e := range elements {
+		result[i] = newZElement(e.Member, e.Score, e.Rank)
+	}
+	return result
+}
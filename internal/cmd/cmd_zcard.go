@@ -74,7 +74,7 @@ func evalZCARD(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 	}
 
 	ss = obj.Value.(*types.SortedSet)
-	return newZCARDRes(int64(ss.GetCount())), nil
+	return newZCARDRes(int64(ss.Len())), nil
 }
 
 func executeZCARD(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
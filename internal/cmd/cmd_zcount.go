@@ -4,8 +4,7 @@
 package cmd
 
 import (
-	"math"
-	"strconv"
+	"strings"
 
 	"github.com/dicedb/dice/internal/errors"
 	"github.com/dicedb/dice/internal/object"
@@ -23,6 +22,7 @@ var cZCOUNT = &CommandMeta{
 ZCOUNT counts the number of members in a sorted set between min and max (both inclusive)
 
 If you want to use unbounded ranges, use -inf and +inf for min and max respectively.
+Prefix min or max with ( to exclude it from the range, for example ZCOUNT k (10 20.
 The command returns the count of members in a sorted set between min and max (both inclusive). Returns 0 if the key does not exist.
 `,
 	Examples: `
@@ -74,39 +74,19 @@ var (
 )
 
 func evalZCOUNT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
-	var err error
-	var minVal, maxVal int64
-
 	if len(c.C.Args) != 3 {
 		return ZCOUNTResNilRes, errors.ErrWrongArgumentCount("ZCOUNT")
 	}
 
 	key, minArg, maxArg := c.C.Args[0], c.C.Args[1], c.C.Args[2]
 
-	if minArg == "-inf" {
-		minVal = math.MinInt64
-	} else if minArg == "+inf" {
-		minVal = math.MaxInt64
-	} else {
-		minVal, err = strconv.ParseInt(minArg, 10, 64)
-		if err != nil {
-			return ZCOUNTResNilRes, errors.ErrInvalidNumberFormat
-		}
-	}
-
-	if maxArg == "-inf" {
-		maxVal = math.MinInt64
-	} else if maxArg == "+inf" {
-		maxVal = math.MaxInt64
-	} else {
-		maxVal, err = strconv.ParseInt(maxArg, 10, 64)
-		if err != nil {
-			return ZCOUNTResNilRes, errors.ErrInvalidNumberFormat
-		}
+	minVal, err := parseScoreBound(minArg)
+	if err != nil {
+		return ZCOUNTResNilRes, err
 	}
-
-	if minVal > maxVal {
-		return ZCOUNTRes0, nil
+	maxVal, err := parseScoreBound(maxArg)
+	if err != nil {
+		return ZCOUNTResNilRes, err
 	}
 
 	var ss *types.SortedSet
@@ -122,7 +102,7 @@ func evalZCOUNT(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 
 	ss = obj.Value.(*types.SortedSet)
 
-	count := ss.ZCOUNT(minVal, maxVal)
+	count := ss.CountByScore(minVal, maxVal)
 	return newZCOUNTRes(count), nil
 }
 
@@ -134,3 +114,20 @@ func executeZCOUNT(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
 	shard := sm.GetShardForKey(c.C.Args[0])
 	return evalZCOUNT(c, shard.Thread.Store())
 }
+
+// parseScoreBound parses the end of a score range. The bound is inclusive
+// unless it is prefixed with "(", and -inf and +inf are unbounded.
+func parseScoreBound(arg string) (types.ScoreBound, error) {
+	var bound types.ScoreBound
+	if strings.HasPrefix(arg, "(") {
+		bound.Exclusive = true
+		arg = arg[1:]
+	}
+
+	score, err := parseScore(arg)
+	if err != nil {
+		return bound, err
+	}
+	bound.Value = score
+	return bound, nil
+}
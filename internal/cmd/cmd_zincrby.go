@@ -0,0 +1,79 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cZINCRBY = &CommandMeta{
+	Name:      "ZINCRBY",
+	Syntax:    "ZINCRBY key increment member",
+	HelpShort: "ZINCRBY increments the score of member in the sorted set stored at key",
+	HelpLong: `
+ZINCRBY increments the score of member in the sorted set stored at key by increment.
+If the member does not exist, it is added with increment as its score, and a new sorted set
+is created if the key does not exist.
+
+The increment is a double precision floating point number and may be negative.
+The command returns the new score of the member.
+	`,
+	Examples: `
+localhost:7379> ZADD users 10 alice
+OK 1
+localhost:7379> ZINCRBY users 2.5 alice
+OK "12.5"
+localhost:7379> ZINCRBY users -1 bob
+OK "-1"
+	`,
+	Eval:    evalZINCRBY,
+	Execute: executeZINCRBY,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZINCRBY)
+}
+
+var (
+	ZINCRBYResNilRes = newValueRes("")
+)
+
+func evalZINCRBY(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return ZINCRBYResNilRes, errors.ErrWrongArgumentCount("ZINCRBY")
+	}
+
+	key, member := c.C.Args[0], c.C.Args[2]
+	incr, err := parseScore(c.C.Args[1])
+	if err != nil {
+		return ZINCRBYResNilRes, err
+	}
+
+	ss, err := getSortedSet(s, key)
+	if err != nil {
+		return ZINCRBYResNilRes, err
+	}
+	if ss == nil {
+		ss = types.NewSortedSet()
+		s.Put(key, s.NewObj(ss, -1, object.ObjTypeSortedSet), dstore.WithPutCmd(dstore.ZAdd))
+	}
+
+	score, err := ss.ZINCRBY(member, incr)
+	if err != nil {
+		return ZINCRBYResNilRes, errors.ErrGeneral(err.Error())
+	}
+	return newValueRes(formatScore(score)), nil
+}
+
+func executeZINCRBY(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 3 {
+		return ZINCRBYResNilRes, errors.ErrWrongArgumentCount("ZINCRBY")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalZINCRBY(c, shard.Thread.Store())
+}
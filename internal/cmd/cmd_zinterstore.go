@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cZINTERSTORE = &CommandMeta{
+	Name:      "ZINTERSTORE",
+	Syntax:    "ZINTERSTORE dst numkeys key [key ...] [WEIGHTS weight [weight ...]] [AGGREGATE SUM | MIN | MAX]",
+	HelpShort: "ZINTERSTORE stores the intersection of the sorted sets at the given keys into the sorted set at dst",
+	HelpLong: `
+ZINTERSTORE computes the intersection of the numkeys sorted sets stored at the given keys and stores it in a
+new sorted set at dst. Any value stored at dst is overwritten, and dst is deleted if the intersection is empty.
+The keys may live on different shards. Sets are accepted as input and their members have a score of 1.
+
+WEIGHTS and AGGREGATE behave as they do for ZUNIONSTORE.
+
+The command returns the number of elements stored at dst.
+	`,
+	Examples: `
+localhost:7379> ZADD z1 1 a 2 b
+OK 2
+localhost:7379> ZADD z2 10 b 20 c
+OK 2
+localhost:7379> ZINTERSTORE out 2 z1 z2 AGGREGATE MAX
+OK 1
+localhost:7379> ZRANGE out 1 -1
+OK
+1) 10, b
+	`,
+	Eval:    evalZINTERSTORE,
+	Execute: executeZINTERSTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZINTERSTORE)
+}
+
+var (
+	ZINTERSTOREResNilRes = newIntRes(0)
+)
+
+func evalZINTERSTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return ZINTERSTOREResNilRes, errors.ErrWrongArgumentCount("ZINTERSTORE")
+	}
+	return zSetStore(c, "ZINTERSTORE", localStore(s))
+}
+
+func executeZINTERSTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return ZINTERSTOREResNilRes, errors.ErrWrongArgumentCount("ZINTERSTORE")
+	}
+	return zSetStore(c, "ZINTERSTORE", shardStores(sm))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+var cZMSCORE = &CommandMeta{
+	Name:      "ZMSCORE",
+	Syntax:    "ZMSCORE key member [member ...]",
+	HelpShort: "ZMSCORE returns the scores of the members in the sorted set stored at key",
+	HelpLong: `
+ZMSCORE returns the exact scores of the specified members in the sorted set stored at key.
+
+The command returns one member-score pair per requested member, in the order they were
+requested. Members that do not exist, or all members if the key does not exist, are
+returned with an empty score.
+	`,
+	Examples: `
+localhost:7379> ZADD users 10.5 alice 20 bob
+OK 2
+localhost:7379> ZMSCORE users alice charlie bob
+OK
+0) alice="10.5"
+1) charlie=""
+2) bob="20"
+	`,
+	Eval:    evalZMSCORE,
+	Execute: executeZMSCORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZMSCORE)
+}
+
+var (
+	ZMSCOREResNilRes = newPairsRes([]*wire.HElement{})
+)
+
+func evalZMSCORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return ZMSCOREResNilRes, errors.ErrWrongArgumentCount("ZMSCORE")
+	}
+
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil {
+		return ZMSCOREResNilRes, err
+	}
+
+	members := c.C.Args[1:]
+	elements := make([]*wire.HElement, len(members))
+	for i, member := range members {
+		elements[i] = &wire.HElement{Key: member}
+		if ss == nil {
+			continue
+		}
+		if score, ok := ss.Score(member); ok {
+			elements[i].Value = formatScore(score)
+		}
+	}
+	return newPairsRes(elements), nil
+}
+
+func executeZMSCORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return ZMSCOREResNilRes, errors.ErrWrongArgumentCount("ZMSCORE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalZMSCORE(c, shard.Thread.Store())
+}
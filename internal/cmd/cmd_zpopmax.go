@@ -96,21 +96,8 @@ func evalZPOPMAX(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 	}
 
 	ss = obj.Value.(*types.SortedSet)
-	elements := make([]*wire.ZElement, 0, count)
-	totalElements := ss.SortedSet.GetCount()
-
-	for i := 0; i < count; i++ {
-		n := ss.PopMax()
-		if n == nil {
-			break
-		}
-		elements = append(elements, &wire.ZElement{
-			Member: n.Key(),
-			Score:  int64(n.Score()),
-			Rank:   int64(totalElements) - int64(i),
-		})
-	}
-	return newZPOPMAXRes(elements), nil
+	elements := ss.PopMax(count)
+	return newZPOPMAXRes(newZElements(elements)), nil
 }
 
 // executeZPOPMAX retrieves the appropriate shard for the key and evaluates the ZPOPMAX command.
@@ -95,20 +95,8 @@ func evalZPOPMIN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 	}
 
 	ss = obj.Value.(*types.SortedSet)
-	elements := make([]*wire.ZElement, 0, count)
-
-	for i := 0; i < count; i++ {
-		n := ss.PopMin()
-		if n == nil {
-			break
-		}
-		elements = append(elements, &wire.ZElement{
-			Member: n.Key(),
-			Score:  int64(n.Score()),
-			Rank:   int64(i + 1),
-		})
-	}
-	return newZPOPMINRes(elements), nil
+	elements := ss.PopMin(count)
+	return newZPOPMINRes(newZElements(elements)), nil
 }
 
 func executeZPOPMIN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
@@ -8,7 +8,6 @@ import (
 	"strings"
 
 	"github.com/dicedb/dice/internal/errors"
-	"github.com/dicedb/dice/internal/object"
 	"github.com/dicedb/dice/internal/shardmanager"
 	dsstore "github.com/dicedb/dice/internal/store"
 	"github.com/dicedb/dice/internal/types"
@@ -17,18 +16,29 @@ import (
 
 var cZRANGE = &CommandMeta{
 	Name:      "ZRANGE",
-	Syntax:    "ZRANGE key start stop [BYSCORE | BYRANK]",
+	Syntax:    "ZRANGE key start stop [BYSCORE | BYLEX | BYRANK] [REV] [LIMIT offset count] [WITHSCORES]",
 	HelpShort: "ZRANGE returns the range of elements from the sorted set stored at key.",
 	HelpLong: `
 ZRANGE returns the range of elements from the sorted set stored at key.
 
-The default range is by rank "BYRANK" and this can be changed to "BYSCORE" if you want to range by score spanning the start and stop values.
+The default range is by rank "BYRANK" and this can be changed to "BYSCORE" if you want to range by score spanning the start and stop values,
+or to "BYLEX" if you want to range by member when all the members have the same score.
 The rank is 1-based, which means that the first element is at rank 1 and not rank 0.
 The 1), 2), 3), ... is the rank of the element in the sorted set.
 
-Both the start and stop values are inclusive and hence the elements having either of the values will be included. The
-elements are considered to be ordered from the lowest to the highest. If you want reverse order, consider
-storing score with flipped sign.`,
+Both the start and stop values are inclusive and hence the elements having either of the values will be included. With BYSCORE,
+prefix a score with ( to exclude it and use -inf and +inf for unbounded ranges. With BYLEX, start and stop must be prefixed with
+[ (inclusive) or ( (exclusive), and - and + stand for the lowest and highest member.
+
+The elements are ordered from the lowest to the highest score. REV reverses the order, and the ranks are then counted from
+the highest score. With REV and BYSCORE or BYLEX, start is the upper end of the range and stop the lower end.
+
+LIMIT skips the first offset elements of the range and returns at most count elements, or all of them if count is negative.
+It is only supported with BYSCORE and BYLEX.
+
+The elements of the reply carry their scores as integers, truncating fractional scores, and the elements whose
+scores are fractional or infinite carry their exact scores in their exact_score field. WITHSCORES replies instead
+with the members in order, each paired with its exact score.`,
 	Examples: `
 localhost:7379> ZADD s 10 a 20 b 30 c 40 d 50 e
 OK 5
@@ -50,6 +60,26 @@ OK
 3) 30, c
 4) 40, d
 5) 50, e
+localhost:7379> ZRANGE s 1 2 REV
+OK
+1) 50, e
+2) 40, d
+localhost:7379> ZRANGE s +inf (20 BYSCORE REV LIMIT 1 2
+OK
+2) 40, d
+3) 30, c
+localhost:7379> ZADD l 0 a 0 b 0 c
+OK 3
+localhost:7379> ZRANGE l [b + BYLEX
+OK
+2) 0, b
+3) 0, c
+localhost:7379> ZADD f 1.5 a 2.25 b
+OK 2
+localhost:7379> ZRANGE f 1 2 WITHSCORES
+OK
+0) a="1.5"
+1) b="2.25"
 `,
 	Eval:        evalZRANGE,
 	Execute:     executeZRANGE,
@@ -77,50 +107,159 @@ var (
 )
 
 func evalZRANGE(c *Cmd, s *dsstore.Store) (*CmdRes, error) {
-	if len(c.C.Args) < 3 || len(c.C.Args) > 4 {
+	if len(c.C.Args) < 3 {
 		return ZRANGEResNilRes, errors.ErrWrongArgumentCount("ZRANGE")
 	}
 
-	key := c.C.Args[0]
-	startStr := c.C.Args[1]
-	stopStr := c.C.Args[2]
-
-	var byScore, byRank = false, true
-	if len(c.C.Args) >= 4 {
-		byScore = strings.EqualFold(c.C.Args[3], "BYSCORE")
-		byRank = strings.EqualFold(c.C.Args[3], "BYRANK")
+	q, err := parseZRangeQuery("ZRANGE", c.C.Args[1:], true)
+	if err != nil {
+		return ZRANGEResNilRes, err
 	}
 
-	start, err := strconv.Atoi(startStr)
-	if err != nil {
-		return ZRANGEResNilRes, errors.ErrInvalidNumberFormat
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil || ss == nil {
+		return ZRANGEResNilRes, err
 	}
+	if q.withScores {
+		return newZRANGEWithScoresRes(q.run(ss)), nil
+	}
+	return newZRANGERes(newZElements(q.run(ss))), nil
+}
 
-	stop, err := strconv.Atoi(stopStr)
-	if err != nil {
-		return ZRANGEResNilRes, errors.ErrInvalidNumberFormat
+func executeZRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return ZRANGEResNilRes, errors.ErrWrongArgumentCount("ZRANGE")
 	}
 
-	obj := s.Get(key)
-	if obj == nil {
-		return ZRANGEResNilRes, nil
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalZRANGE(c, shard.Thread.Store())
+}
+
+// newZRANGEWithScoresRes replies with the members of elements paired with
+// their exact scores, which wire.ZElement cannot carry.
+func newZRANGEWithScoresRes(elements []types.SortedSetElement) *CmdRes {
+	pairs := make([]*wire.HElement, len(elements))
+	for i, e := range elements {
+		pairs[i] = &wire.HElement{Key: e.Member, Value: formatScore(e.Score)}
 	}
+	return newPairsRes(pairs)
+}
+
+// zRangeQuery is a range of a sorted set as selected by ZRANGE and
+// ZRANGESTORE.
+type zRangeQuery struct {
+	byScore, byLex bool
+	rev            bool
+	offset, count  int
+	withScores     bool
 
-	if obj.Type != object.ObjTypeSortedSet {
-		return ZRANGEResNilRes, errors.ErrWrongTypeOperation
+	startRank, stopRank int
+	minScore, maxScore  types.ScoreBound
+	minLex, maxLex      types.LexBound
+}
+
+// parseZRangeQuery parses the "start stop [BYSCORE | BYLEX | BYRANK] [REV]
+// [LIMIT offset count]" arguments of cmd. WITHSCORES is only accepted if
+// withOpts is set.
+func parseZRangeQuery(cmd string, args []string, withOpts bool) (*zRangeQuery, error) {
+	q := &zRangeQuery{count: -1}
+	var limit bool
+	for i := 2; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "BYRANK":
+		case "BYSCORE":
+			q.byScore = true
+		case "BYLEX":
+			q.byLex = true
+		case "REV":
+			q.rev = true
+		case "WITHSCORES":
+			if !withOpts {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			q.withScores = true
+		case "LIMIT":
+			if i+2 >= len(args) {
+				return nil, errors.ErrInvalidSyntax(cmd)
+			}
+			offset, err := strconv.Atoi(args[i+1])
+			if err != nil {
+				return nil, errors.ErrIntegerOutOfRange
+			}
+			count, err := strconv.Atoi(args[i+2])
+			if err != nil {
+				return nil, errors.ErrIntegerOutOfRange
+			}
+			q.offset, q.count, limit = offset, count, true
+			i += 2
+		default:
+			return nil, errors.ErrInvalidSyntax(cmd)
+		}
+	}
+	if q.byScore && q.byLex {
+		return nil, errors.ErrInvalidSyntax(cmd)
+	}
+	if limit && !q.byScore && !q.byLex {
+		return nil, errors.ErrGeneral("syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX")
 	}
 
-	ss := obj.Value.(*types.SortedSet)
-	elements := ss.ZRANGE(start, stop, byScore, byRank)
+	// With REV, score and lex ranges are given from the upper end.
+	start, stop := args[0], args[1]
+	if q.rev && (q.byScore || q.byLex) {
+		start, stop = stop, start
+	}
 
-	return newZRANGERes(elements), nil
+	var err error
+	switch {
+	case q.byScore:
+		if q.minScore, err = parseScoreBound(start); err != nil {
+			return nil, err
+		}
+		if q.maxScore, err = parseScoreBound(stop); err != nil {
+			return nil, err
+		}
+	case q.byLex:
+		if q.minLex, err = parseLexBound(start); err != nil {
+			return nil, err
+		}
+		if q.maxLex, err = parseLexBound(stop); err != nil {
+			return nil, err
+		}
+	default:
+		if q.startRank, err = strconv.Atoi(start); err != nil {
+			return nil, errors.ErrInvalidNumberFormat
+		}
+		if q.stopRank, err = strconv.Atoi(stop); err != nil {
+			return nil, errors.ErrInvalidNumberFormat
+		}
+	}
+	return q, nil
 }
 
-func executeZRANGE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
-	if len(c.C.Args) < 3 || len(c.C.Args) > 4 {
-		return ZRANGEResNilRes, errors.ErrWrongArgumentCount("ZRANGE")
+// run returns the elements of ss in the range.
+func (q *zRangeQuery) run(ss *types.SortedSet) []types.SortedSetElement {
+	switch {
+	case q.byScore:
+		return ss.RangeByScore(q.minScore, q.maxScore, q.rev, q.offset, q.count)
+	case q.byLex:
+		return ss.RangeByLex(q.minLex, q.maxLex, q.rev, q.offset, q.count)
 	}
+	return ss.RangeByRank(q.startRank, q.stopRank, q.rev)
+}
 
-	shard := sm.GetShardForKey(c.C.Args[0])
-	return evalZRANGE(c, shard.Thread.Store())
+// parseLexBound parses the end of a lexicographical range: "-" or "+" for
+// the unbounded ends, or a member prefixed with "[" (inclusive) or "("
+// (exclusive).
+func parseLexBound(arg string) (types.LexBound, error) {
+	switch {
+	case arg == "-":
+		return types.LexBound{Inf: -1}, nil
+	case arg == "+":
+		return types.LexBound{Inf: 1}, nil
+	case strings.HasPrefix(arg, "["):
+		return types.LexBound{Value: arg[1:]}, nil
+	case strings.HasPrefix(arg, "("):
+		return types.LexBound{Value: arg[1:], Exclusive: true}, nil
+	}
+	return types.LexBound{}, errors.ErrGeneral("min or max not valid string range item")
 }
@@ -12,7 +12,7 @@ import (
 
 var cZRANGEWATCH = &CommandMeta{
 	Name:      "ZRANGE.WATCH",
-	Syntax:    "ZRANGE.WATCH key start stop [BYSCORE | BYRANK]",
+	Syntax:    "ZRANGE.WATCH key start stop [BYSCORE | BYLEX | BYRANK] [REV] [LIMIT offset count]",
 	HelpShort: "ZRANGE.WATCH creates a query subscription over the ZRANGE command",
 	HelpLong: `
 ZRANGE.WATCH creates a query subscription over the ZRANGE command. The client invoking the command
@@ -0,0 +1,98 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cZRANGESTORE = &CommandMeta{
+	Name:      "ZRANGESTORE",
+	Syntax:    "ZRANGESTORE dst src start stop [BYSCORE | BYLEX | BYRANK] [REV] [LIMIT offset count]",
+	HelpShort: "ZRANGESTORE stores a range of elements from the sorted set at src into the sorted set at dst",
+	HelpLong: `
+ZRANGESTORE selects a range of elements from the sorted set stored at src exactly like ZRANGE does
+and stores them, with their scores, in a new sorted set at dst. Any value stored at dst is overwritten,
+and dst is deleted if the range is empty. dst and src may live on different shards.
+
+The command returns the number of elements stored at dst.
+	`,
+	Examples: `
+localhost:7379> ZADD s 10 a 20 b 30 c 40 d
+OK 4
+localhost:7379> ZRANGESTORE top s 1 2 REV
+OK 2
+localhost:7379> ZRANGE top 1 -1
+OK
+1) 30, c
+2) 40, d
+	`,
+	Eval:    evalZRANGESTORE,
+	Execute: executeZRANGESTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZRANGESTORE)
+}
+
+var (
+	ZRANGESTOREResNilRes = newIntRes(0)
+)
+
+func evalZRANGESTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return ZRANGESTOREResNilRes, errors.ErrWrongArgumentCount("ZRANGESTORE")
+	}
+	return zRangeStore(c, localStore(s))
+}
+
+func executeZRANGESTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 4 {
+		return ZRANGESTOREResNilRes, errors.ErrWrongArgumentCount("ZRANGESTORE")
+	}
+	return zRangeStore(c, shardStores(sm))
+}
+
+// zRangeStore evaluates ZRANGESTORE reading and writing every key in the
+// store that storeForKey returns for it.
+func zRangeStore(c *Cmd, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	dst, src := c.C.Args[0], c.C.Args[1]
+
+	q, err := parseZRangeQuery("ZRANGESTORE", c.C.Args[2:], false)
+	if err != nil {
+		return ZRANGESTOREResNilRes, err
+	}
+
+	ss, err := getSortedSet(storeForKey(src), src)
+	if err != nil {
+		return ZRANGESTOREResNilRes, err
+	}
+
+	var elements []types.SortedSetElement
+	if ss != nil {
+		elements = q.run(ss)
+	}
+	return storeSortedSetElements(storeForKey(dst), dst, elements), nil
+}
+
+// storeSortedSetElements replaces the value at key with a sorted set of the
+// elements, or deletes key if there are none. Returns the number of elements
+// stored.
+func storeSortedSetElements(s *dstore.Store, key string, elements []types.SortedSetElement) *CmdRes {
+	if len(elements) == 0 {
+		s.Del(key)
+		return newIntRes(0)
+	}
+
+	ss := types.NewSortedSet()
+	for _, e := range elements {
+		ss.Upsert(e.Member, e.Score)
+	}
+	s.Put(key, s.NewObj(ss, -1, object.ObjTypeSortedSet), dstore.WithPutCmd(dstore.ZAdd))
+	return newIntRes(int64(ss.Len()))
+}
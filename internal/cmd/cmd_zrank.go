@@ -84,21 +84,8 @@ func evalZRANK(c *Cmd, s *dstore.Store) (*CmdRes, error) {
 
 	ss := obj.Value.(*types.SortedSet)
 
-	node := ss.GetByKey(member)
-	rank := ss.FindRank(member)
-	if node == nil || rank == 0 {
-		return newZRANKRes(&wire.ZElement{
-			Rank:   0,
-			Score:  0,
-			Member: member,
-		}), nil
-	}
-
-	return newZRANKRes(&wire.ZElement{
-		Rank:   int64(rank),
-		Score:  int64(node.Score()),
-		Member: node.Key(),
-	}), nil
+	score, _ := ss.Score(member)
+	return newZRANKRes(newZElement(member, score, ss.Rank(member, false))), nil
 }
 
 func executeZRANK(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
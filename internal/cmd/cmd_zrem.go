@@ -88,8 +88,7 @@ func evalZREM(c *Cmd, s *dsstore.Store) (*CmdRes, error) {
 
 	countRem := int64(0)
 	for i := 1; i < len(c.C.Args); i++ {
-		n := ss.Remove(c.C.Args[i])
-		if n != nil {
+		if ss.Remove(c.C.Args[i]) {
 			countRem++
 		}
 	}
@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cZREVRANK = &CommandMeta{
+	Name:      "ZREVRANK",
+	Syntax:    "ZREVRANK key member",
+	HelpShort: "ZREVRANK returns the rank of a member in a sorted set, ordered from high to low scores.",
+	HelpLong: `
+ZREVRANK returns the rank of a member in a sorted set, ordered from high to low scores.
+
+The rank is 1-based which means that the member with the highest score has rank 1, the next lower has rank 2, and so on.
+The command returns the element - rank, score, and member, just like ZRANK does.
+
+If the member is not a member of the sorted set, the command returns a valid response with a rank of 0 and
+score of 0. If the key does not exist, the command returns a valid response with a rank of 0, score of 0,
+and the member as "".
+	`,
+	Examples: `
+localhost:7379> ZADD users 10 alice 20 bob 30 charlie
+OK 3
+localhost:7379> ZREVRANK users alice
+OK 3) 10, alice
+localhost:7379> ZREVRANK users charlie
+OK 1) 30, charlie
+localhost:7379> ZREVRANK users daniel
+OK 0) 0, daniel
+	`,
+	Eval:    evalZREVRANK,
+	Execute: executeZREVRANK,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZREVRANK)
+}
+
+var (
+	ZREVRANKResNilRes = newZRANKRes(nil)
+)
+
+func evalZREVRANK(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return ZREVRANKResNilRes, errors.ErrWrongArgumentCount("ZREVRANK")
+	}
+
+	key, member := c.C.Args[0], c.C.Args[1]
+	ss, err := getSortedSet(s, key)
+	if err != nil || ss == nil {
+		return ZREVRANKResNilRes, err
+	}
+
+	score, _ := ss.Score(member)
+	return newZRANKRes(newZElement(member, score, ss.Rank(member, true))), nil
+}
+
+func executeZREVRANK(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return ZREVRANKResNilRes, errors.ErrWrongArgumentCount("ZREVRANK")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalZREVRANK(c, shard.Thread.Store())
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cZSCAN = &CommandMeta{
+	Name:      "ZSCAN",
+	Syntax:    "ZSCAN key cursor [MATCH pattern] [COUNT count]",
+	HelpShort: "ZSCAN incrementally iterates over the members of the sorted set stored at key",
+	HelpLong: `
+ZSCAN incrementally iterates over the members of the sorted set stored at key.
+
+Start the iteration with cursor 0 and pass the cursor returned by each call to the next one.
+The iteration is complete when the returned cursor is 0. Like SCAN, every member present from
+the start to the end of the iteration is returned, even if the sorted set is modified in
+between. The members are not returned in the order of their scores.
+
+COUNT sets how many members are examined per call (defaults to 10), and MATCH only returns
+the members matching the glob-style pattern among them.
+
+The first element of the reply is the next cursor, followed by the member and score of
+every returned member.
+	`,
+	Examples: `
+localhost:7379> ZADD k1 1 m1 2 m2 3 m3
+OK 3
+localhost:7379> ZSCAN k1 0 COUNT 2
+OK
+0) 241344391202643
+1) m1
+2) 1
+3) m3
+4) 3
+localhost:7379> ZSCAN k1 241344391202643 COUNT 2
+OK
+0) 0
+1) m2
+2) 2
+	`,
+	Eval:    evalZSCAN,
+	Execute: executeZSCAN,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZSCAN)
+}
+
+var (
+	ZSCANResNilRes = newListRes([]string{"0"})
+)
+
+func evalZSCAN(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return ZSCANResNilRes, errors.ErrWrongArgumentCount("ZSCAN")
+	}
+	o, err := parseScanOptions("ZSCAN", c.C.Args[1:], false)
+	if err != nil {
+		return ZSCANResNilRes, err
+	}
+	if shard, _ := decodeScanCursor(o.cursor); shard != 0 {
+		return ZSCANResNilRes, errors.ErrGeneral("invalid cursor")
+	}
+
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil || ss == nil {
+		return ZSCANResNilRes, err
+	}
+
+	members, next := scanCollection(ss.ScanMembers, o)
+	values := make([]string, 0, 1+2*len(members))
+	values = append(values, strconv.FormatUint(next, 10))
+	for _, member := range members {
+		score, _ := ss.Score(member)
+		values = append(values, member, formatScore(score))
+	}
+	return newListRes(values), nil
+}
+
+func executeZSCAN(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 2 {
+		return ZSCANResNilRes, errors.ErrWrongArgumentCount("ZSCAN")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalZSCAN(c, shard.Thread.Store())
+}
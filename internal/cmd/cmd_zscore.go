@@ -0,0 +1,64 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+)
+
+var cZSCORE = &CommandMeta{
+	Name:      "ZSCORE",
+	Syntax:    "ZSCORE key member",
+	HelpShort: "ZSCORE returns the score of member in the sorted set stored at key",
+	HelpLong: `
+ZSCORE returns the exact score of member in the sorted set stored at key.
+
+The command returns an empty value if the member or the key does not exist.
+	`,
+	Examples: `
+localhost:7379> ZADD users 10.5 alice
+OK 1
+localhost:7379> ZSCORE users alice
+OK "10.5"
+localhost:7379> ZSCORE users bob
+OK ""
+	`,
+	Eval:    evalZSCORE,
+	Execute: executeZSCORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZSCORE)
+}
+
+var (
+	ZSCOREResNilRes = newValueRes("")
+)
+
+func evalZSCORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return ZSCOREResNilRes, errors.ErrWrongArgumentCount("ZSCORE")
+	}
+
+	ss, err := getSortedSet(s, c.C.Args[0])
+	if err != nil || ss == nil {
+		return ZSCOREResNilRes, err
+	}
+
+	score, ok := ss.Score(c.C.Args[1])
+	if !ok {
+		return ZSCOREResNilRes, nil
+	}
+	return newValueRes(formatScore(score)), nil
+}
+
+func executeZSCORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) != 2 {
+		return ZSCOREResNilRes, errors.ErrWrongArgumentCount("ZSCORE")
+	}
+	shard := sm.GetShardForKey(c.C.Args[0])
+	return evalZSCORE(c, shard.Thread.Store())
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/shardmanager"
+	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
+)
+
+var cZUNIONSTORE = &CommandMeta{
+	Name:      "ZUNIONSTORE",
+	Syntax:    "ZUNIONSTORE dst numkeys key [key ...] [WEIGHTS weight [weight ...]] [AGGREGATE SUM | MIN | MAX]",
+	HelpShort: "ZUNIONSTORE stores the union of the sorted sets at the given keys into the sorted set at dst",
+	HelpLong: `
+ZUNIONSTORE computes the union of the numkeys sorted sets stored at the given keys and stores it in a new
+sorted set at dst. Any value stored at dst is overwritten, and dst is deleted if the union is empty.
+The keys may live on different shards. Sets are accepted as input and their members have a score of 1.
+
+WEIGHTS sets a multiplication factor for the scores of every input, defaulting to 1.
+AGGREGATE sets how the weighted scores of a member found in several inputs are combined:
+their sum (the default), their minimum or their maximum.
+
+The command returns the number of elements stored at dst.
+	`,
+	Examples: `
+localhost:7379> ZADD z1 1 a 2 b
+OK 2
+localhost:7379> ZADD z2 10 b 20 c
+OK 2
+localhost:7379> ZUNIONSTORE out 2 z1 z2 WEIGHTS 1 0.5
+OK 3
+localhost:7379> ZRANGE out 1 -1
+OK
+1) 1, a
+2) 7, b
+3) 10, c
+	`,
+	Eval:    evalZUNIONSTORE,
+	Execute: executeZUNIONSTORE,
+}
+
+func init() {
+	CommandRegistry.AddCommand(cZUNIONSTORE)
+}
+
+var (
+	ZUNIONSTOREResNilRes = newIntRes(0)
+)
+
+func evalZUNIONSTORE(c *Cmd, s *dstore.Store) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return ZUNIONSTOREResNilRes, errors.ErrWrongArgumentCount("ZUNIONSTORE")
+	}
+	return zSetStore(c, "ZUNIONSTORE", localStore(s))
+}
+
+func executeZUNIONSTORE(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error) {
+	if len(c.C.Args) < 3 {
+		return ZUNIONSTOREResNilRes, errors.ErrWrongArgumentCount("ZUNIONSTORE")
+	}
+	return zSetStore(c, "ZUNIONSTORE", shardStores(sm))
+}
+
+// zSetStore evaluates ZUNIONSTORE and ZINTERSTORE reading and writing every
+// key in the store that storeForKey returns for it.
+//
+//nolint:gocyclo
+func zSetStore(c *Cmd, cmd string, storeForKey func(key string) *dstore.Store) (*CmdRes, error) {
+	dst := c.C.Args[0]
+	numKeys, err := strconv.Atoi(c.C.Args[1])
+	if err != nil {
+		return ZUNIONSTOREResNilRes, errors.ErrIntegerOutOfRange
+	}
+	if numKeys < 1 {
+		return ZUNIONSTOREResNilRes, errors.ErrGeneral("at least 1 input key is needed for '" + cmd + "' command")
+	}
+	if numKeys > len(c.C.Args)-2 {
+		return ZUNIONSTOREResNilRes, errors.ErrInvalidSyntax(cmd)
+	}
+	keys, opts := c.C.Args[2:2+numKeys], c.C.Args[2+numKeys:]
+
+	weights := make([]float64, numKeys)
+	for i := range weights {
+		weights[i] = 1
+	}
+	aggregate := "SUM"
+	for i := 0; i < len(opts); i++ {
+		switch strings.ToUpper(opts[i]) {
+		case "WEIGHTS":
+			if i+numKeys >= len(opts) {
+				return ZUNIONSTOREResNilRes, errors.ErrInvalidSyntax(cmd)
+			}
+			for j := range weights {
+				w, err := strconv.ParseFloat(opts[i+1+j], 64)
+				if err != nil || math.IsNaN(w) {
+					return ZUNIONSTOREResNilRes, errors.ErrGeneral("weight value is not a float")
+				}
+				weights[j] = w
+			}
+			i += numKeys
+		case "AGGREGATE":
+			if i+1 >= len(opts) {
+				return ZUNIONSTOREResNilRes, errors.ErrInvalidSyntax(cmd)
+			}
+			aggregate = strings.ToUpper(opts[i+1])
+			if aggregate != "SUM" && aggregate != "MIN" && aggregate != "MAX" {
+				return ZUNIONSTOREResNilRes, errors.ErrInvalidSyntax(cmd)
+			}
+			i++
+		default:
+			return ZUNIONSTOREResNilRes, errors.ErrInvalidSyntax(cmd)
+		}
+	}
+
+	inter := cmd == "ZINTERSTORE"
+	scores := map[string]float64{}
+	counts := map[string]int{}
+	for i, key := range keys {
+		members, err := getWeightedScores(storeForKey(key), key, weights[i])
+		if err != nil {
+			return ZUNIONSTOREResNilRes, err
+		}
+		for member, score := range members {
+			current, ok := scores[member]
+			switch {
+			case !ok:
+				scores[member] = score
+			case aggregate == "MIN":
+				scores[member] = math.Min(current, score)
+			case aggregate == "MAX":
+				scores[member] = math.Max(current, score)
+			default:
+				// inf + -inf has no meaningful sum, so it counts as 0.
+				if sum := current + score; !math.IsNaN(sum) {
+					scores[member] = sum
+				} else {
+					scores[member] = 0
+				}
+			}
+			counts[member]++
+		}
+	}
+
+	elements := make([]types.SortedSetElement, 0, len(scores))
+	for member, score := range scores {
+		if inter && counts[member] != len(keys) {
+			continue
+		}
+		elements = append(elements, types.SortedSetElement{Member: member, Score: score})
+	}
+	return storeSortedSetElements(storeForKey(dst), dst, elements), nil
+}
+
+// getWeightedScores returns the scores of the members of the sorted set or
+// set stored at key multiplied by weight. Set members have a score of 1.
+func getWeightedScores(s *dstore.Store, key string, weight float64) (map[string]float64, error) {
+	obj := s.Get(key)
+	if obj == nil {
+		return nil, nil
+	}
+
+	weigh := func(score float64) float64 {
+		// 0 * inf has no meaningful product, so it counts as 0.
+		if w := score * weight; !math.IsNaN(w) {
+			return w
+		}
+		return 0
+	}
+
+	scores := map[string]float64{}
+	switch obj.Type {
+	case object.ObjTypeSortedSet:
+		for _, e := range obj.Value.(*types.SortedSet).RangeByRank(1, -1, false) {
+			scores[e.Member] = weigh(e.Score)
+		}
+	case object.ObjTypeSet:
+		for member := range obj.Value.(map[string]struct{}) {
+			scores[member] = weigh(1)
+		}
+	default:
+		return nil, errors.ErrWrongTypeOperation
+	}
+	return scores, nil
+}
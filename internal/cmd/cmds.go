@@ -4,8 +4,10 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +28,15 @@ type Cmd struct {
 	ClientID string
 	Mode     string
 	Meta     *CommandMeta
+	// Ctx is canceled when the client that sent the command disconnects.
+	// It is nil for the commands no client waits on, such as WAL replays.
+	Ctx context.Context
+
+	// modifiedKeys are the keys the command found it modified while it
+	// executed, on top of those its NotifyKeys tells from its arguments.
+	modifiedKeys []string
+	// walC is the command logged to the WAL in place of C, see WALCommand.
+	walC *wire.Command
 }
 
 func (c *Cmd) String() string {
@@ -43,6 +54,43 @@ func (c *Cmd) Key() string {
 	return ""
 }
 
+// NotifyKeys returns the keys whose watchers are notified after the command
+// succeeds: the keys it modifies, or its first argument by default, and
+// the keys it recorded with addModifiedKeys.
+func (c *Cmd) NotifyKeys() []string {
+	keys := []string{c.Key()}
+	if c.Meta != nil && c.Meta.NotifyKeys != nil {
+		keys = c.Meta.NotifyKeys(c)
+	}
+	if len(c.modifiedKeys) == 0 {
+		return keys
+	}
+	return slices.Concat(keys, c.modifiedKeys)
+}
+
+// addModifiedKeys records keys the command modified that cannot be told
+// from its arguments, such as the destinations of the compaction rules of
+// a time series, for their watchers to be notified too.
+func (c *Cmd) addModifiedKeys(keys ...string) {
+	c.modifiedKeys = append(c.modifiedKeys, keys...)
+}
+
+// WALCommand returns the command to log to the WAL for the command to be
+// replayed: C, unless the command rewrote it with setWALCommand.
+func (c *Cmd) WALCommand() *wire.Command {
+	if c.walC != nil {
+		return c.walC
+	}
+	return c.C
+}
+
+// setWALCommand makes the command log cmd to the WAL instead of itself,
+// for commands whose effect its replay could not reproduce, such as those
+// that pick their results at random.
+func (c *Cmd) setWALCommand(cmd string, args ...string) {
+	c.walC = &wire.Command{Cmd: cmd, Args: args}
+}
+
 func (c *Cmd) Execute(sm *shardmanager.ShardManager) (*CmdRes, error) {
 	res := &CmdRes{
 		Rs: &wire.Result{},
@@ -59,6 +107,13 @@ func (c *Cmd) Execute(sm *shardmanager.ShardManager) (*CmdRes, error) {
 	}
 
 	res, err = c.Meta.Execute(c, sm)
+	if err == nil {
+		// Any write may store a list or a stream at a key a client is
+		// blocked on, be it a push, an XADD, a RENAME or a RESTORE.
+		keys := c.NotifyKeys()
+		listWaiters.notify(keys...)
+		streamWaiters.notify(keys...)
+	}
 	slog.Debug("command executed",
 		slog.Any("cmd", c.String()),
 		slog.String("client_id", c.ClientID),
@@ -79,8 +134,16 @@ type CommandMeta struct {
 	Examples    string
 	HelpLong    string
 	IsWatchable bool
-	Eval        func(c *Cmd, s *store.Store) (*CmdRes, error)
-	Execute     func(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error)
+	// NotifyOnChange makes a .WATCH command push its result to the watchers
+	// only when it differs from the result they last got, instead of after
+	// every write to the key.
+	NotifyOnChange bool
+	// NotifyKeys returns the keys a command modifies, for the commands that
+	// modify other keys than their first argument, so that the watchers of
+	// every one of them are notified.
+	NotifyKeys func(c *Cmd) []string
+	Eval       func(c *Cmd, s *store.Store) (*CmdRes, error)
+	Execute    func(c *Cmd, sm *shardmanager.ShardManager) (*CmdRes, error)
 }
 
 type CmdRegistry struct {
@@ -155,3 +218,141 @@ func (cmd *DiceDBCmd) Key() string {
 	}
 	return c
 }
+
+// everyNthKey returns a NotifyKeys function for the commands whose
+// arguments are groups of n arguments starting with a key, such as the key
+// value pairs of MSET.
+func everyNthKey(n int) func(c *Cmd) []string {
+	return func(c *Cmd) []string {
+		keys := make([]string, 0, len(c.C.Args)/n+1)
+		for i := 0; i < len(c.C.Args); i += n {
+			keys = append(keys, c.C.Args[i])
+		}
+		return keys
+	}
+}
+
+// nthKey returns a NotifyKeys function for the commands whose key is the
+// argument at index i rather than the first one, such as the destkey of
+// BITOP.
+func nthKey(i int) func(c *Cmd) []string {
+	return func(c *Cmd) []string {
+		if len(c.C.Args) <= i {
+			return []string{c.Key()}
+		}
+		return c.C.Args[i : i+1]
+	}
+}
+
+// shardStores returns a function that maps every key to the store of the
+// shard that owns it. Commands that combine values stored at several keys
+// use it to read values that live on other shards.
+func shardStores(sm *shardmanager.ShardManager) func(key string) *store.Store {
+	return func(key string) *store.Store {
+		return sm.GetShardForKey(key).Thread.Store()
+	}
+}
+
+// lockStores locks the stores that storeForKey returns for keys, each once
+// and in the order of their shard IDs, so that the commands locking several
+// stores cannot deadlock one another. Returns the function unlocking them.
+func lockStores(keys []string, storeForKey func(key string) *store.Store) func() {
+	stores := make([]*store.Store, 0, len(keys))
+	for _, key := range keys {
+		if s := storeForKey(key); !slices.Contains(stores, s) {
+			stores = append(stores, s)
+		}
+	}
+	slices.SortFunc(stores, func(a, b *store.Store) int {
+		return a.ShardID - b.ShardID
+	})
+	for _, s := range stores {
+		s.Lock()
+	}
+	return func() {
+		for _, s := range stores {
+			s.Unlock()
+		}
+	}
+}
+
+// localStore returns a function that maps every key to s. It is the
+// single-store counterpart of shardStores used by the Eval functions.
+func localStore(s *store.Store) func(key string) *store.Store {
+	return func(string) *store.Store {
+		return s
+	}
+}
+
+// The wire protocol carries a dedicated response message only for the
+// commands it originally shipped with. Commands without one reply with the
+// existing message that matches the shape of their result:
+//
+//   - a plain acknowledgement uses SETRes
+//   - a single string (or float) value uses GETRes
+//   - a single integer value uses INCRBYRes
+//   - a list of strings uses KEYSRes
+//   - a list of field-value pairs uses HGETALLRes
+//   - a list of scored members uses ZRANGERes
+//   - a single ranked member uses ZRANKRes
+//
+// The helpers below build these replies so that every command reusing a
+// message constructs it the same way.
+
+func newOKRes() *CmdRes {
+	return &CmdRes{
+		Rs: &wire.Result{
+			Message:  "OK",
+			Status:   wire.Status_OK,
+			Response: &wire.Result_SETRes{SETRes: &wire.SETRes{}},
+		},
+	}
+}
+
+func newValueRes(value string) *CmdRes {
+	return &CmdRes{
+		Rs: &wire.Result{
+			Message: "OK",
+			Status:  wire.Status_OK,
+			Response: &wire.Result_GETRes{
+				GETRes: &wire.GETRes{Value: value},
+			},
+		},
+	}
+}
+
+func newIntRes(value int64) *CmdRes {
+	return &CmdRes{
+		Rs: &wire.Result{
+			Message: "OK",
+			Status:  wire.Status_OK,
+			Response: &wire.Result_INCRBYRes{
+				INCRBYRes: &wire.INCRBYRes{Value: value},
+			},
+		},
+	}
+}
+
+func newListRes(values []string) *CmdRes {
+	return &CmdRes{
+		Rs: &wire.Result{
+			Message: "OK",
+			Status:  wire.Status_OK,
+			Response: &wire.Result_KEYSRes{
+				KEYSRes: &wire.KEYSRes{Keys: values},
+			},
+		},
+	}
+}
+
+func newPairsRes(elements []*wire.HElement) *CmdRes {
+	return &CmdRes{
+		Rs: &wire.Result{
+			Message: "OK",
+			Status:  wire.Status_OK,
+			Response: &wire.Result_HGETALLRes{
+				HGETALLRes: &wire.HGETALLRes{Elements: elements},
+			},
+		},
+	}
+}
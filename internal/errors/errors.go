@@ -62,6 +62,8 @@ var (
 	ErrKeyDoesNotExist            = errors.New("could not perform this operation on a key that doesn't exist")
 	ErrKeyExists                  = errors.New("key exists")
 	ErrUnknownObjectType          = errors.New("unknown object type")
+	ErrIndexOutOfRange            = errors.New("index out of range")                     // Indicates that an index is beyond the bounds of a list.
+	ErrInvalidTimeout             = errors.New("timeout is not a float or out of range") // Signals an invalid timeout for a blocking command.
 
 	ErrInvalidValue = func(command, param string) error {
 		return fmt.Errorf("invalid value for a parameter in '%s' command for %s parameter", strings.ToUpper(command), strings.ToUpper(param))
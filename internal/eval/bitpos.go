@@ -11,6 +11,7 @@ import (
 
 	diceerrors "github.com/dicedb/dice/internal/errors"
 	"github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
 )
 
 type RespType int
@@ -91,7 +92,7 @@ func evalBITPOS(args []string, st *store.Store) *EvalResponse {
 		}
 	}
 
-	result := getBitPos(byteSlice, bitToFind, start, end, rangeType, endRangeProvided)
+	result := types.BitPos(byteSlice, bitToFind, start, end, rangeType == BIT, endRangeProvided)
 
 	return &EvalResponse{
 		Result: result,
@@ -139,72 +140,3 @@ func parseOptionalParams(args []string, byteLen int) (start, end int, rangeType
 	}
 	return start, end, rangeType, endRangeProvided, err
 }
-
-func getBitPos(byteSlice []byte, bitToFind byte, start, end int, rangeType string, endRangeProvided bool) int {
-	byteLen := len(byteSlice)
-	bitLen := len(byteSlice) * 8
-
-	var result int
-
-	if rangeType == BYTE {
-		// Adjust start and end for both BYTE and BIT ranges
-		// This handles negative indices and ensures we're within bounds
-		start, end = adjustBitPosSearchRange(start, end, byteLen)
-
-		// If start is beyond end or byteLen, we can't find anything
-		if start > end || start >= byteLen {
-			return -1
-		}
-
-		result = getBitPosWithBitRange(byteSlice, bitToFind, start*8, end*8+7)
-	} else {
-		// Adjust start and end for both BYTE and BIT ranges
-		// This handles negative indices and ensures we're within bounds
-		start, end = adjustBitPosSearchRange(start, end, bitLen)
-
-		// If start is beyond end or byteLen, we can't find anything
-		if start > end || start >= bitLen {
-			return -1
-		}
-
-		result = getBitPosWithBitRange(byteSlice, bitToFind, start, end)
-	}
-
-	// Special case: if we're looking for a 0 bit, didn't find it,
-	// and no end range was provided, we return the first bit position
-	// that's not part of the byte slice (i.e., the total bit length)
-	if bitToFind == 0 && result == -1 && !endRangeProvided {
-		return bitLen
-	}
-
-	return result
-}
-
-func adjustBitPosSearchRange(start, end, byteLen int) (newStart, newEnd int) {
-	if start < 0 {
-		start += byteLen
-	}
-	if end < 0 {
-		end += byteLen
-	}
-	start = max(0, start)
-	end = min(byteLen-1, end)
-
-	return start, end
-}
-
-func getBitPosWithBitRange(byteSlice []byte, bitToFind byte, start, end int) int {
-	for i := start; i <= end; i++ {
-		// Calculate which byte and bit we're looking at
-		byteIndex := i / 8
-		// 7 - (i % 8) because we count bits from left to right in each byte
-		bitIndex := 7 - (i % 8)
-
-		// Check if this bit matches what we're looking for
-		if ((byteSlice[byteIndex] >> bitIndex) & 1) == bitToFind {
-			return i
-		}
-	}
-	// Bit not found in the range
-	return -1
-}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/dicedb/dice/internal/eval/sortedset"
 	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/types"
 )
 
 func rdbDeserialize(data []byte) (*object.Obj, error) {
@@ -50,22 +51,25 @@ func rdbDeserialize(data []byte) (*object.Obj, error) {
 		if err != nil {
 			return nil, err
 		}
-		byteArray := &ByteArray{
-			Length: valueRaw.(int64),
-			data:   make([]byte, valueRaw.(int64)),
-		}
-		if _, err := buf.Read(byteArray.data); err != nil {
+		data := make([]byte, valueRaw.(int64))
+		if _, err := buf.Read(data); err != nil {
 			return nil, err
 		}
-		value = byteArray
+		value = types.NewByteArrayFromBytes(data)
 	case object.ObjTypeDequeue: // Byte list type (Deque)
-		value, err = DeserializeDeque(buf)
+		value, err = types.DeserializeDeque(buf)
 	case object.ObjTypeBF: // Bloom filter type
-		value, err = DeserializeBloom(buf)
+		value, err = types.DeserializeBloom(buf)
 	case object.ObjTypeSortedSet:
 		value, err = sortedset.DeserializeSortedSet(buf)
 	case object.ObjTypeCountMinSketch:
-		value, err = DeserializeCMS(buf)
+		value, err = types.DeserializeCMS(buf)
+	case object.ObjTypeCuckoo:
+		value, err = types.DeserializeCuckoo(buf)
+	case object.ObjTypeTDigest:
+		value, err = types.DeserializeTDigest(buf)
+	case object.ObjTypeLock:
+		value, err = types.DeserializeLock(buf)
 	default:
 		return nil, errors.New("unsupported object type")
 	}
@@ -151,14 +155,14 @@ func rdbSerialize(obj *object.Obj) ([]byte, error) {
 			return nil, err
 		}
 	case object.ObjTypeByteArray:
-		byteArray, ok := obj.Value.(*ByteArray)
+		byteArray, ok := obj.Value.(*types.ByteArray)
 		if !ok {
 			return nil, errors.New("invalid byte array value")
 		}
 		writeInt(&buf, byteArray.Length)
-		buf.Write(byteArray.data)
+		buf.Write(byteArray.Bytes())
 	case object.ObjTypeDequeue:
-		deque, ok := obj.Value.(*Deque)
+		deque, ok := obj.Value.(*types.Deque)
 		if !ok {
 			return nil, errors.New("invalid byte list value")
 		}
@@ -166,7 +170,7 @@ func rdbSerialize(obj *object.Obj) ([]byte, error) {
 			return nil, err
 		}
 	case object.ObjTypeBF:
-		bitSet, ok := obj.Value.(*Bloom)
+		bitSet, ok := obj.Value.(*types.Bloom)
 		if !ok {
 			return nil, errors.New("invalid bloom filter value")
 		}
@@ -182,11 +186,35 @@ func rdbSerialize(obj *object.Obj) ([]byte, error) {
 			return nil, err
 		}
 	case object.ObjTypeCountMinSketch:
-		cms, ok := obj.Value.(*CountMinSketch)
+		cms, ok := obj.Value.(*types.CountMinSketch)
 		if !ok {
 			return nil, errors.New("invalid countminsketch value")
 		}
-		if err := cms.serialize(&buf); err != nil {
+		if err := cms.Serialize(&buf); err != nil {
+			return nil, err
+		}
+	case object.ObjTypeCuckoo:
+		cf, ok := obj.Value.(*types.Cuckoo)
+		if !ok {
+			return nil, errors.New("invalid cuckoo filter value")
+		}
+		if err := cf.Serialize(&buf); err != nil {
+			return nil, err
+		}
+	case object.ObjTypeTDigest:
+		td, ok := obj.Value.(*types.TDigest)
+		if !ok {
+			return nil, errors.New("invalid t-digest value")
+		}
+		if err := td.Serialize(&buf); err != nil {
+			return nil, err
+		}
+	case object.ObjTypeLock:
+		l, ok := obj.Value.(*types.Lock)
+		if !ok {
+			return nil, errors.New("invalid lock value")
+		}
+		if err := l.Serialize(&buf); err != nil {
 			return nil, err
 		}
 	default:
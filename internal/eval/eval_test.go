@@ -24,6 +24,7 @@ import (
 	"github.com/dicedb/dice/internal/object"
 	"github.com/dicedb/dice/internal/server/utils"
 	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
 	"github.com/ohler55/ojg/jp"
 	"github.com/stretchr/testify/assert"
 )
@@ -5862,7 +5863,7 @@ func testEvalGETRANGE(t *testing.T, store *dstore.Store) {
 		"GETRANGE against byte array with valid range: 0 4": {
 			setup: func() {
 				key := "BYTEARRAY_KEY"
-				store.Put(key, store.NewObj(&ByteArray{data: []byte{0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64}}, maxExDuration, object.ObjTypeByteArray))
+				store.Put(key, store.NewObj(types.NewByteArrayFromBytes([]byte{0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64}), maxExDuration, object.ObjTypeByteArray))
 			},
 			input:          []string{"BYTEARRAY_KEY", "0", "4"},
 			migratedOutput: EvalResponse{Result: "hello", Error: nil},
@@ -5870,7 +5871,7 @@ func testEvalGETRANGE(t *testing.T, store *dstore.Store) {
 		"GETRANGE against byte array with valid range: 6 -1": {
 			setup: func() {
 				key := "BYTEARRAY_KEY"
-				store.Put(key, store.NewObj(&ByteArray{data: []byte{0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64}}, maxExDuration, object.ObjTypeByteArray))
+				store.Put(key, store.NewObj(types.NewByteArrayFromBytes([]byte{0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64}), maxExDuration, object.ObjTypeByteArray))
 			},
 			input:          []string{"BYTEARRAY_KEY", "6", "-1"},
 			migratedOutput: EvalResponse{Result: "world", Error: nil},
@@ -5878,7 +5879,7 @@ func testEvalGETRANGE(t *testing.T, store *dstore.Store) {
 		"GETRANGE against byte array with invalid range: 20 30": {
 			setup: func() {
 				key := "BYTEARRAY_KEY"
-				store.Put(key, store.NewObj(&ByteArray{data: []byte{0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64}}, maxExDuration, object.ObjTypeByteArray))
+				store.Put(key, store.NewObj(types.NewByteArrayFromBytes([]byte{0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6c, 0x64}), maxExDuration, object.ObjTypeByteArray))
 			},
 			input:          []string{"BYTEARRAY_KEY", "20", "30"},
 			migratedOutput: EvalResponse{Result: "", Error: nil},
@@ -6678,9 +6679,9 @@ func testEvalAPPEND(t *testing.T, store *dstore.Store) {
 				key := "listKey"
 				value := "val"
 				// Create a new list object
-				obj := store.NewObj(NewDeque(), -1, object.ObjTypeDequeue)
+				obj := store.NewObj(types.NewDeque(), -1, object.ObjTypeDequeue)
 				store.Put(key, obj)
-				obj.Value.(*Deque).LPush(value)
+				obj.Value.(*types.Deque).LPush(value)
 			},
 			input:          []string{"listKey", "val"},
 			migratedOutput: EvalResponse{Result: nil, Error: diceerrors.ErrWrongTypeOperation},
@@ -6717,13 +6718,13 @@ func testEvalAPPEND(t *testing.T, store *dstore.Store) {
 			setup: func() {
 				key := "bitKey"
 				// Create a new byte array object
-				initialByteArray := NewByteArray(2) // Initialize with 2 byte
-				initialByteArray.SetBit(2, true)    // Set the third bit to 1
-				initialByteArray.SetBit(3, true)    // Set the fourth bit to 1
-				initialByteArray.SetBit(5, true)    // Set the sixth bit to 1
-				initialByteArray.SetBit(10, true)   // Set the eleventh bit to 1
-				initialByteArray.SetBit(11, true)   // Set the twelfth bit to 1
-				initialByteArray.SetBit(14, true)   // Set the fifteenth bit to 1
+				initialByteArray := types.NewByteArray(2) // Initialize with 2 byte
+				initialByteArray.SetBit(2, true)          // Set the third bit to 1
+				initialByteArray.SetBit(3, true)          // Set the fourth bit to 1
+				initialByteArray.SetBit(5, true)          // Set the sixth bit to 1
+				initialByteArray.SetBit(10, true)         // Set the eleventh bit to 1
+				initialByteArray.SetBit(11, true)         // Set the twelfth bit to 1
+				initialByteArray.SetBit(14, true)         // Set the fifteenth bit to 1
 				obj := store.NewObj(initialByteArray, -1, object.ObjTypeByteArray)
 				store.Put(key, obj)
 			},
@@ -16,6 +16,12 @@ const earthRadius float64 = 6372797.560856
 // Bit precision for geohash
 const bitPrecision = 52
 
+// Maximum number of bits per coordinate used by a geohash with bitPrecision
+const maxStep = bitPrecision / 2
+
+// Half the length of the equator in meters
+const mercatorMax = 20037726.37
+
 // Bit precision for geohash string
 const bitPrecisionString = 10
 
@@ -69,6 +75,80 @@ func DecodeInt(hash float64) (lat, lon float64) {
 	return lat, lon
 }
 
+// ScoreRange is a range of geohash scores [Min, Max) that holds all the
+// points inside one geohash cell.
+type ScoreRange struct {
+	Min, Max float64
+}
+
+// SearchRanges returns the score ranges of the geohash cells that together
+// cover every point within radius meters of (lat, lon). The ranges cover
+// more than the circle, so candidates must still be checked against the
+// actual distance.
+func SearchRanges(lat, lon, radius float64) []ScoreRange {
+	step := searchStep(lat, radius)
+	bits := 2 * step
+	shift := bitPrecision - bits
+
+	box := geohash.BoundingBoxIntWithPrecision(geohash.EncodeIntWithPrecision(lat, lon, bits), bits)
+	centerLat, centerLon := box.Center()
+	latDelta := box.MaxLat - box.MinLat
+	lonDelta := box.MaxLng - box.MinLng
+
+	// Visit the cell holding the point and its 8 neighbors. Neighbors past
+	// a pole do not exist and neighbors past the antimeridian wrap around.
+	seen := make(map[uint64]struct{}, 9)
+	ranges := make([]ScoreRange, 0, 9)
+	for dLat := -1.0; dLat <= 1; dLat++ {
+		cellLat := centerLat + dLat*latDelta
+		if cellLat <= -90 || cellLat >= 90 {
+			continue
+		}
+		for dLon := -1.0; dLon <= 1; dLon++ {
+			cellLon := math.Mod(centerLon+dLon*lonDelta+540, 360) - 180
+			cell := geohash.EncodeIntWithPrecision(cellLat, cellLon, bits)
+			if _, ok := seen[cell]; ok {
+				continue
+			}
+			seen[cell] = struct{}{}
+			ranges = append(ranges, ScoreRange{
+				Min: float64(cell << shift),
+				Max: float64((cell + 1) << shift),
+			})
+		}
+	}
+	return ranges
+}
+
+// searchStep returns the number of bits per coordinate of the largest
+// geohash cells that are still at least radius meters high and wide
+// everywhere within radius meters of latitude lat, so that the cell holding
+// a point and its neighbors cover the circle around it.
+func searchStep(lat, radius float64) uint {
+	if radius == 0 {
+		return maxStep
+	}
+
+	step := 1
+	for r := radius; r < mercatorMax; r *= 2 {
+		step++
+	}
+	step -= 2
+
+	// Cells get narrower towards the poles, so measure their width at the
+	// edge of the circle that is closest to a pole.
+	edgeLat := math.Min(90, math.Abs(lat)+RadToDeg(radius/earthRadius))
+	for step > 1 {
+		height := math.Pi * earthRadius / math.Exp2(float64(step))
+		width := 2 * height * math.Cos(DegToRad(edgeLat))
+		if height >= radius && width >= radius {
+			break
+		}
+		step--
+	}
+	return uint(max(1, min(step, maxStep)))
+}
+
 func EncodeString(lat, lon float64) string {
 	return geohash.EncodeWithPrecision(lat, lon, bitPrecisionString)
 }
@@ -91,3 +171,19 @@ func ConvertDistance(
 		return 0, errors.NewErrWithMessage("ERR unsupported unit provided. please use m, km, ft, mi")
 	}
 }
+
+// UnitToMeters returns the number of meters in one unit of distance.
+func UnitToMeters(unit string) (float64, error) {
+	switch unit {
+	case "m":
+		return 1, nil
+	case "km":
+		return 1000, nil
+	case "mi":
+		return 1609.34, nil
+	case "ft":
+		return 0.3048, nil
+	default:
+		return 0, errors.ErrGeneral("unsupported unit provided. please use m, km, ft, mi")
+	}
+}
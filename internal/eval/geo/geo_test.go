@@ -0,0 +1,50 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSearchRangesCoverRadius(t *testing.T) {
+	centers := [][2]float64{{0, 0}, {38.115556, 13.361389}, {-33.9, 151.2}, {80, -179.99}, {-85, 179.99}}
+	radii := []float64{0, 1, 150, 5000, 120000, 2500000}
+
+	for _, c := range centers {
+		for _, radius := range radii {
+			ranges := SearchRanges(c[0], c[1], radius)
+			// Walk the circle at just under the radius and check that every
+			// point falls into one of the ranges.
+			for bearing := 0.0; bearing < 360; bearing += 7.5 {
+				lat, lon := destination(c[0], c[1], bearing, radius*0.999)
+				if lat < -85.05112878 || lat > 85.05112878 {
+					continue
+				}
+				hash := EncodeInt(lat, lon)
+				covered := false
+				for _, r := range ranges {
+					if hash >= r.Min && hash < r.Max {
+						covered = true
+						break
+					}
+				}
+				if !covered {
+					t.Fatalf("point (%f, %f) at %f m from (%f, %f) is not covered", lat, lon, radius, c[0], c[1])
+				}
+			}
+		}
+	}
+}
+
+// destination returns the point at distance meters from (lat, lon) along
+// the given bearing.
+func destination(lat, lon, bearing, distance float64) (float64, float64) {
+	d := distance / earthRadius
+	b := DegToRad(bearing)
+	lat1, lon1 := DegToRad(lat), DegToRad(lon)
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(d) + math.Cos(lat1)*math.Sin(d)*math.Cos(b))
+	lon2 := lon1 + math.Atan2(math.Sin(b)*math.Sin(d)*math.Cos(lat1), math.Cos(d)-math.Sin(lat1)*math.Sin(lat2))
+	return RadToDeg(lat2), math.Mod(RadToDeg(lon2)+540, 360) - 180
+}
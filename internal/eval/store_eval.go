@@ -27,6 +27,7 @@ import (
 	"github.com/dicedb/dice/internal/object"
 	"github.com/dicedb/dice/internal/server/utils"
 	dstore "github.com/dicedb/dice/internal/store"
+	"github.com/dicedb/dice/internal/types"
 	"github.com/gobwas/glob"
 	"github.com/ohler55/ojg/jp"
 	"github.com/rs/xid"
@@ -220,8 +221,8 @@ func evalGETRANGE(args []string, store *dstore.Store) *EvalResponse {
 	case object.ObjTypeInt:
 		str = strconv.FormatInt(obj.Value.(int64), 10)
 	case object.ObjTypeByteArray:
-		if val, ok := obj.Value.(*ByteArray); ok {
-			str = string(val.data)
+		if val, ok := obj.Value.(*types.ByteArray); ok {
+			str = string(val.Bytes())
 		} else {
 			return &EvalResponse{
 				Result: nil,
@@ -2190,7 +2191,7 @@ func evalBFRESERVE(args []string, store *dstore.Store) *EvalResponse {
 		return makeEvalError(diceerrors.ErrWrongArgumentCount("BF.RESERVE"))
 	}
 
-	opts, err := newBloomOpts(args[1:])
+	opts, err := types.NewBloomOpts(args[1:])
 	if err != nil {
 		return makeEvalError(err)
 	}
@@ -2221,12 +2222,14 @@ func evalBFADD(args []string, store *dstore.Store) *EvalResponse {
 		return makeEvalError(err)
 	}
 
-	result, err := bf.add(args[1])
+	added, err := bf.Add(args[1])
 	if err != nil {
 		return makeEvalError(err)
 	}
-
-	return makeEvalResult(result)
+	if added {
+		return makeEvalResult(IntegerOne)
+	}
+	return makeEvalResult(IntegerZero)
 }
 
 // evalBFEXISTS evaluates the BF.EXISTS command responsible for checking existence of an element in a bloom filter.
@@ -2243,11 +2246,14 @@ func evalBFEXISTS(args []string, store *dstore.Store) *EvalResponse {
 		return makeEvalResult(IntegerZero)
 	}
 
-	result, err := bf.exists(args[1])
+	exists, err := bf.Exists(args[1])
 	if err != nil {
 		return makeEvalError(err)
 	}
-	return makeEvalResult(result)
+	if exists {
+		return makeEvalResult(IntegerOne)
+	}
+	return makeEvalResult(IntegerZero)
 }
 
 // evalBFINFO evaluates the BF.INFO command responsible for returning the
@@ -2267,7 +2273,7 @@ func evalBFINFO(args []string, store *dstore.Store) *EvalResponse {
 		opt = args[1]
 	}
 
-	result, err := bf.info(opt)
+	result, err := bf.Info(opt)
 	if err != nil {
 		return makeEvalError(err)
 	}
@@ -2449,7 +2455,7 @@ func evalLPUSH(args []string, store *dstore.Store) *EvalResponse {
 
 	obj := store.Get(args[0])
 	if obj == nil {
-		obj = store.NewObj(NewDeque(), -1, object.ObjTypeDequeue)
+		obj = store.NewObj(types.NewDeque(), -1, object.ObjTypeDequeue)
 	}
 
 	if err := object.AssertType(obj.Type, object.ObjTypeDequeue); err != nil {
@@ -2461,10 +2467,10 @@ func evalLPUSH(args []string, store *dstore.Store) *EvalResponse {
 
 	store.Put(args[0], obj)
 	for i := 1; i < len(args); i++ {
-		obj.Value.(*Deque).LPush(args[i])
+		obj.Value.(*types.Deque).LPush(args[i])
 	}
 
-	deq := obj.Value.(*Deque)
+	deq := obj.Value.(*types.Deque)
 
 	return &EvalResponse{
 		Result: deq.Length,
@@ -2487,7 +2493,7 @@ func evalRPUSH(args []string, store *dstore.Store) *EvalResponse {
 
 	obj := store.Get(args[0])
 	if obj == nil {
-		obj = store.NewObj(NewDeque(), -1, object.ObjTypeDequeue)
+		obj = store.NewObj(types.NewDeque(), -1, object.ObjTypeDequeue)
 	}
 
 	if err := object.AssertType(obj.Type, object.ObjTypeDequeue); err != nil {
@@ -2499,10 +2505,10 @@ func evalRPUSH(args []string, store *dstore.Store) *EvalResponse {
 
 	store.Put(args[0], obj)
 	for i := 1; i < len(args); i++ {
-		obj.Value.(*Deque).RPush(args[i])
+		obj.Value.(*types.Deque).RPush(args[i])
 	}
 
-	deq := obj.Value.(*Deque)
+	deq := obj.Value.(*types.Deque)
 
 	return &EvalResponse{
 		Result: deq.Length,
@@ -2568,14 +2574,14 @@ func evalLPOP(args []string, store *dstore.Store) *EvalResponse {
 		}
 	}
 
-	deq := obj.Value.(*Deque)
+	deq := obj.Value.(*types.Deque)
 
 	// holds the elements popped
 	var elements []string
 	for iter := 0; iter < popNumber; iter++ {
 		x, err := deq.LPop()
 		if err != nil {
-			if errors.Is(err, ErrDequeEmpty) {
+			if errors.Is(err, types.ErrDequeEmpty) {
 				break
 			}
 		}
@@ -2630,10 +2636,10 @@ func evalRPOP(args []string, store *dstore.Store) *EvalResponse {
 		}
 	}
 
-	deq := obj.Value.(*Deque)
+	deq := obj.Value.(*types.Deque)
 	x, err := deq.RPop()
 	if err != nil {
-		if errors.Is(err, ErrDequeEmpty) {
+		if errors.Is(err, types.ErrDequeEmpty) {
 			return &EvalResponse{
 				Result: NIL,
 				Error:  nil,
@@ -2673,7 +2679,7 @@ func evalLLEN(args []string, store *dstore.Store) *EvalResponse {
 		}
 	}
 
-	deq := obj.Value.(*Deque)
+	deq := obj.Value.(*types.Deque)
 	return &EvalResponse{
 		Result: deq.Length,
 		Error:  nil,
@@ -4054,7 +4060,7 @@ func evalLRANGE(args []string, store *dstore.Store) *EvalResponse {
 		return makeEvalError(errors.New(diceerrors.WrongTypeErr))
 	}
 
-	q := obj.Value.(*Deque)
+	q := obj.Value.(*types.Deque)
 	res, err := q.LRange(start, stop)
 	if err != nil {
 		return makeEvalError(err)
@@ -4087,7 +4093,7 @@ func evalLINSERT(args []string, store *dstore.Store) *EvalResponse {
 		return makeEvalError(errors.New(diceerrors.WrongTypeErr))
 	}
 
-	q := obj.Value.(*Deque)
+	q := obj.Value.(*types.Deque)
 	res, err := q.LInsert(pivot, element, beforeAfter)
 	if err != nil {
 		return makeEvalError(err)
@@ -4127,19 +4133,19 @@ func evalSETBIT(args []string, store *dstore.Store) *EvalResponse {
 	requiredByteArraySize := offset>>3 + 1
 
 	if obj == nil {
-		obj = store.NewObj(NewByteArray(int(requiredByteArraySize)), -1, object.ObjTypeByteArray)
+		obj = store.NewObj(types.NewByteArray(int(requiredByteArraySize)), -1, object.ObjTypeByteArray)
 		store.Put(args[0], obj)
 	}
 
 	if object.AssertType(obj.Type, object.ObjTypeByteArray) == nil ||
 		object.AssertType(obj.Type, object.ObjTypeString) == nil ||
 		object.AssertType(obj.Type, object.ObjTypeInt) == nil {
-		var byteArray *ByteArray
+		var byteArray *types.ByteArray
 		oType := obj.Type
 
 		switch oType {
 		case object.ObjTypeByteArray:
-			byteArray = obj.Value.(*ByteArray)
+			byteArray = obj.Value.(*types.ByteArray)
 		case object.ObjTypeString, object.ObjTypeInt:
 			byteArray, err = NewByteArrayFromObj(obj)
 			if err != nil {
@@ -4168,7 +4174,7 @@ func evalSETBIT(args []string, store *dstore.Store) *EvalResponse {
 
 		// We are returning newObject here so it is thread-safe
 		// Old will be removed by GC
-		newObj, err := ByteSliceToObj(store, obj, byteArray.data, oType)
+		newObj, err := ByteSliceToObj(store, obj, byteArray.Bytes(), oType)
 		if err != nil {
 			return &EvalResponse{
 				Result: nil,
@@ -4240,7 +4246,7 @@ func evalGETBIT(args []string, store *dstore.Store) *EvalResponse {
 			Error:  diceerrors.ErrWrongTypeOperation,
 		}
 	case object.ObjTypeByteArray:
-		byteArray := obj.Value.(*ByteArray)
+		byteArray := obj.Value.(*types.ByteArray)
 		byteArrayLength := byteArray.Length
 
 		// check whether offset, length exists or not
@@ -4330,8 +4336,8 @@ func evalBITCOUNT(args []string, store *dstore.Store) *EvalResponse {
 
 	switch {
 	case object.AssertType(obj.Type, object.ObjTypeByteArray) == nil:
-		byteArray := obj.Value.(*ByteArray)
-		value = byteArray.data
+		byteArray := obj.Value.(*types.ByteArray)
+		value = byteArray.Bytes()
 		valueLength = byteArray.Length
 	case object.AssertType(obj.Type, object.ObjTypeString) == nil:
 		value = []byte(obj.Value.(string))
@@ -4476,15 +4482,15 @@ func bitfieldEvalGeneric(args []string, store *dstore.Store, isReadOnly bool) *E
 	key := args[0]
 	obj := store.Get(key)
 	if obj == nil {
-		obj = store.NewObj(NewByteArray(1), -1, object.ObjTypeByteArray)
+		obj = store.NewObj(types.NewByteArray(1), -1, object.ObjTypeByteArray)
 		store.Put(args[0], obj)
 	}
-	var value *ByteArray
+	var value *types.ByteArray
 	var err error
 
 	switch oType := obj.Type; oType {
 	case object.ObjTypeByteArray:
-		value = obj.Value.(*ByteArray)
+		value = obj.Value.(*types.ByteArray)
 	case object.ObjTypeString, object.ObjTypeInt:
 		value, err = NewByteArrayFromObj(obj)
 		if err != nil {
@@ -5556,7 +5562,7 @@ func evalPERSIST(args []string, store *dstore.Store) *EvalResponse {
 
 // 		switch oType, _ := obj.Type; oType {
 // 		case object.ObjTypeByteArray:
-// 			byteArray := obj.Value.(*ByteArray)
+// 			byteArray := obj.Value.(*types.ByteArray)
 // 			byteArrayObject := *byteArray
 // 			value = byteArrayObject.data
 // 			// perform the operation
@@ -5617,7 +5623,7 @@ func evalPERSIST(args []string, store *dstore.Store) *EvalResponse {
 // 			// handle the case when it is byte array
 // 			switch oType, _ := obj.Type; oType {
 // 			case object.ObjTypeByteArray:
-// 				byteArray := obj.Value.(*ByteArray)
+// 				byteArray := obj.Value.(*types.ByteArray)
 // 				byteArrayObject := *byteArray
 // 				values[i] = byteArrayObject.data
 // 			case object.ObjTypeString:
@@ -6022,20 +6028,20 @@ func adjustIndices(start, stop, length int) (adjustedStart, adjustedStop int) {
 }
 
 // This method executes each operation, contained in ops array, based on commands used.
-func executeBitfieldOps(value *ByteArray, ops []utils.BitFieldOp) []interface{} {
+func executeBitfieldOps(value *types.ByteArray, ops []utils.BitFieldOp) []interface{} {
 	overflowType := WRAP
 	var result []interface{}
 	for _, op := range ops {
 		switch op.Kind {
 		case GET:
-			res := value.getBits(int(op.Offset), int(op.EVal), op.EType == SIGNED)
+			res := value.GetBits(int(op.Offset), int(op.EVal), op.EType == SIGNED)
 			result = append(result, res)
 		case SET:
-			prevValue := value.getBits(int(op.Offset), int(op.EVal), op.EType == SIGNED)
-			value.setBits(int(op.Offset), int(op.EVal), op.Value)
+			prevValue := value.GetBits(int(op.Offset), int(op.EVal), op.EType == SIGNED)
+			value.SetBits(int(op.Offset), int(op.EVal), op.Value)
 			result = append(result, prevValue)
 		case INCRBY:
-			res, err := value.incrByBits(int(op.Offset), int(op.EVal), op.Value, overflowType, op.EType == SIGNED)
+			res, err := value.IncrByBits(int(op.Offset), int(op.EVal), op.Value, overflowType, op.EType == SIGNED)
 			if err != nil {
 				result = append(result, nil)
 			} else {
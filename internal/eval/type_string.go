@@ -8,6 +8,7 @@ import (
 
 	diceerrors "github.com/dicedb/dice/internal/errors"
 	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/types"
 )
 
 type String struct {
@@ -48,11 +49,11 @@ func convertValueToString(obj *object.Obj, oType object.ObjectType) (string, err
 		// Use the string value directly
 		currentValueStr = obj.Value.(string)
 	case object.ObjTypeByteArray:
-		val, ok := obj.Value.(*ByteArray)
+		val, ok := obj.Value.(*types.ByteArray)
 		if !ok {
 			return "", diceerrors.ErrWrongTypeOperation
 		}
-		currentValueStr = string(val.data)
+		currentValueStr = string(val.Bytes())
 	default:
 		return "", diceerrors.ErrWrongTypeOperation
 	}
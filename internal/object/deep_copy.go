@@ -4,22 +4,34 @@
 package object
 
 import (
+	"maps"
+
+	"github.com/axiomhq/hyperloglog"
 	"github.com/bytedance/sonic"
+	"github.com/dicedb/dice/internal/types"
 )
 
+// DeepCopyable is implemented by the values whose package cannot be
+// imported here, because it imports this one, to copy themselves.
 type DeepCopyable interface {
 	DeepCopy() interface{}
 }
 
+// DeepCopy returns a copy of obj whose value shares no state with the value
+// of obj, or nil if the value cannot be copied. The expiry of the key is kept
+// by the store and is not part of the copy.
 func (obj *Obj) DeepCopy() *Obj {
 	newObj := &Obj{
 		Type:           obj.Type,
+		Frequency:      obj.Frequency,
 		LastAccessedAt: obj.LastAccessedAt,
 	}
 
 	// Use the DeepCopyable interface to deep copy the value
 	if copier, ok := obj.Value.(DeepCopyable); ok {
 		newObj.Value = copier.DeepCopy()
+	} else if value := deepCopyValue(obj.Value); value != nil {
+		newObj.Value = value
 	} else {
 		// Handle types that are not DeepCopyable
 		sourceType := obj.Type
@@ -48,3 +60,42 @@ func (obj *Obj) DeepCopy() *Obj {
 
 	return newObj
 }
+
+// deepCopyValue copies the values of the types package and of the plain Go
+// types the objects hold. Returns nil for the other values.
+func deepCopyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int64, float64:
+		return v
+	case map[string]struct{}:
+		return maps.Clone(v)
+	case *hyperloglog.Sketch:
+		return v.Clone()
+	case *types.ByteArray:
+		return v.DeepCopy()
+	case *types.SortedSet:
+		return v.DeepCopy()
+	case *types.Deque:
+		return v.DeepCopy()
+	case *types.CountMinSketch:
+		return v.DeepCopy()
+	case *types.Bloom:
+		return v.DeepCopy()
+	case *types.Stream:
+		return v.DeepCopy()
+	case *types.TimeSeries:
+		return v.DeepCopy()
+	case *types.Vector:
+		return v.DeepCopy()
+	case *types.TopK:
+		return v.DeepCopy()
+	case *types.Cuckoo:
+		return v.DeepCopy()
+	case *types.TDigest:
+		return v.DeepCopy()
+	case *types.Lock:
+		return v.DeepCopy()
+	default:
+		return nil
+	}
+}
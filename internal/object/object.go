@@ -30,6 +30,11 @@ type Obj struct {
 	// Type holds the type of the object (e.g., string, int, complex structure)
 	Type ObjectType
 
+	// Frequency is a logarithmic counter of the accesses to the object,
+	// decayed as time passes without any. It fits in the padding between
+	// Type and LastAccessedAt, so it takes no memory.
+	Frequency uint8
+
 	// LastAccessedAt stores the last access timestamp of the object.
 	// It helps track when the object was last accessed and may be used for cache eviction or freshness tracking.
 	LastAccessedAt int64
@@ -94,6 +99,13 @@ const (
 	ObjTypeDequeue
 	ObjTypeHLL
 	ObjTypeFloat
+	ObjTypeStream
+	ObjTypeTimeSeries
+	ObjTypeVector
+	ObjTypeTopK
+	ObjTypeCuckoo
+	ObjTypeTDigest
+	ObjTypeLock
 )
 
 // String returns the name of the object type as a string
@@ -113,6 +125,13 @@ func (ot ObjectType) String() string {
 		"dequeue",
 		"hll",
 		"float",
+		"stream",
+		"timeseries",
+		"vector",
+		"topk",
+		"cuckoo",
+		"tdigest",
+		"lock",
 	}
 
 	if ot < ObjectType(len(names)) {
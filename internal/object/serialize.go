@@ -0,0 +1,239 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package object
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc64"
+	"maps"
+	"slices"
+
+	"github.com/axiomhq/hyperloglog"
+	"github.com/bytedance/sonic"
+	"github.com/dicedb/dice/internal/types"
+)
+
+// DumpVersion is the version of the format Dump encodes objects in. It is
+// increased whenever the encoding of a type changes, so that a payload is
+// never decoded by an instance that does not understand it.
+const DumpVersion = 1
+
+var (
+	ErrDumpPayloadInvalid = errors.New("DUMP payload version or checksum are wrong")
+	ErrDumpBadData        = errors.New("bad data format")
+)
+
+var crc64Table = crc64.MakeTable(crc64.ECMA)
+
+// Serializer encodes the values of an object type for Dump and decodes
+// them for Restore.
+type Serializer interface {
+	Serialize(buf *bytes.Buffer, value interface{}) error
+	Deserialize(buf *bytes.Reader) (interface{}, error)
+}
+
+type serializer[T any] struct {
+	serialize   func(buf *bytes.Buffer, value T) error
+	deserialize func(buf *bytes.Reader) (T, error)
+}
+
+func (s serializer[T]) Serialize(buf *bytes.Buffer, value interface{}) error {
+	v, ok := value.(T)
+	if !ok {
+		return ErrDumpBadData
+	}
+	return s.serialize(buf, v)
+}
+
+func (s serializer[T]) Deserialize(buf *bytes.Reader) (interface{}, error) {
+	return s.deserialize(buf)
+}
+
+// NewSerializer returns a Serializer for the values of type T from the
+// functions encoding and decoding them.
+func NewSerializer[T any](serialize func(buf *bytes.Buffer, value T) error,
+	deserialize func(buf *bytes.Reader) (T, error)) Serializer {
+	return serializer[T]{serialize: serialize, deserialize: deserialize}
+}
+
+// method returns the encoding function of the types whose values encode
+// themselves.
+func method[T interface{ Serialize(*bytes.Buffer) error }]() func(*bytes.Buffer, T) error {
+	return func(buf *bytes.Buffer, value T) error {
+		return value.Serialize(buf)
+	}
+}
+
+var serializers = map[ObjectType]Serializer{
+	ObjTypeString:         NewSerializer(types.WriteString, types.ReadString),
+	ObjTypeInt:            NewSerializer(writeNumber[int64], readNumber[int64]),
+	ObjTypeFloat:          NewSerializer(writeNumber[float64], readNumber[float64]),
+	ObjTypeSet:            NewSerializer(writeSet, readSet),
+	ObjTypeJSON:           NewSerializer(writeJSON, readJSON),
+	ObjTypeByteArray:      NewSerializer(writeByteArray, readByteArray),
+	ObjTypeHLL:            NewSerializer(writeHLL, readHLL),
+	ObjTypeSortedSet:      NewSerializer(method[*types.SortedSet](), types.DeserializeSortedSet),
+	ObjTypeDequeue:        NewSerializer(method[*types.Deque](), types.DeserializeDeque),
+	ObjTypeCountMinSketch: NewSerializer(method[*types.CountMinSketch](), types.DeserializeCMS),
+	ObjTypeBF:             NewSerializer(method[*types.Bloom](), types.DeserializeBloom),
+	ObjTypeStream:         NewSerializer(method[*types.Stream](), types.DeserializeStream),
+	ObjTypeTimeSeries:     NewSerializer(method[*types.TimeSeries](), types.DeserializeTimeSeries),
+	ObjTypeVector:         NewSerializer(method[*types.Vector](), types.DeserializeVector),
+	ObjTypeTopK:           NewSerializer(method[*types.TopK](), types.DeserializeTopK),
+	ObjTypeCuckoo:         NewSerializer(method[*types.Cuckoo](), types.DeserializeCuckoo),
+	ObjTypeTDigest:        NewSerializer(method[*types.TDigest](), types.DeserializeTDigest),
+	ObjTypeLock:           NewSerializer(method[*types.Lock](), types.DeserializeLock),
+}
+
+// RegisterSerializer sets the Serializer of the values of type t. It is
+// called from init by the packages whose values cannot be encoded here,
+// because they import this one.
+func RegisterSerializer(t ObjectType, s Serializer) {
+	serializers[t] = s
+}
+
+// Dump encodes obj into a payload that Restore decodes back into a copy of
+// obj. The payload is laid out as:
+//
+//	+---------+------+-------------+----------------+
+//	| version | type | value       | CRC64          |
+//	| 1 byte  | 1    | variable    | 8, big endian  |
+//	+---------+------+-------------+----------------+
+//
+// The checksum covers every byte before it. The expiry and the access time
+// of the key are not part of the payload.
+func Dump(obj *Obj) ([]byte, error) {
+	s, ok := serializers[obj.Type]
+	if !ok {
+		return nil, errors.New("value of type '" + obj.Type.String() + "' cannot be dumped")
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(DumpVersion)
+	buf.WriteByte(byte(obj.Type))
+	if err := s.Serialize(&buf, obj.Value); err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint64(buf.Bytes(), crc64.Checksum(buf.Bytes(), crc64Table)), nil
+}
+
+// Restore decodes a payload encoded by Dump. It returns
+// ErrDumpPayloadInvalid if the payload is damaged or comes from a newer
+// version, and ErrDumpBadData if its value cannot be decoded.
+func Restore(data []byte) (*Obj, error) {
+	if len(data) < 10 {
+		return nil, ErrDumpPayloadInvalid
+	}
+	body, checksum := data[:len(data)-8], binary.BigEndian.Uint64(data[len(data)-8:])
+	if body[0] > DumpVersion || crc64.Checksum(body, crc64Table) != checksum {
+		return nil, ErrDumpPayloadInvalid
+	}
+	objType := ObjectType(body[1])
+	s, ok := serializers[objType]
+	if !ok {
+		return nil, ErrDumpBadData
+	}
+
+	buf := bytes.NewReader(body[2:])
+	value, err := s.Deserialize(buf)
+	if err != nil || buf.Len() != 0 {
+		return nil, ErrDumpBadData
+	}
+	return &Obj{Type: objType, Value: value}, nil
+}
+
+type number interface {
+	int64 | float64
+}
+
+func writeNumber[T number](buf *bytes.Buffer, value T) error {
+	return binary.Write(buf, binary.BigEndian, value)
+}
+
+func readNumber[T number](buf *bytes.Reader) (T, error) {
+	var value T
+	err := binary.Read(buf, binary.BigEndian, &value)
+	return value, err
+}
+
+func writeSet(buf *bytes.Buffer, set map[string]struct{}) error {
+	if err := types.WriteLength(buf, len(set)); err != nil {
+		return err
+	}
+	for _, member := range slices.Sorted(maps.Keys(set)) {
+		if err := types.WriteString(buf, member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSet(buf *bytes.Reader) (map[string]struct{}, error) {
+	n, err := types.ReadLength(buf, 4)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]struct{}, n)
+	for i := 0; i < n; i++ {
+		member, err := types.ReadString(buf)
+		if err != nil {
+			return nil, err
+		}
+		set[member] = struct{}{}
+	}
+	return set, nil
+}
+
+func writeJSON(buf *bytes.Buffer, value interface{}) error {
+	s, err := sonic.MarshalString(value)
+	if err != nil {
+		return err
+	}
+	return types.WriteString(buf, s)
+}
+
+func readJSON(buf *bytes.Reader) (interface{}, error) {
+	s, err := types.ReadString(buf)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := sonic.UnmarshalString(s, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func writeByteArray(buf *bytes.Buffer, value *types.ByteArray) error {
+	return types.WriteString(buf, string(value.Bytes()))
+}
+
+func readByteArray(buf *bytes.Reader) (*types.ByteArray, error) {
+	s, err := types.ReadString(buf)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewByteArrayFromBytes([]byte(s)), nil
+}
+
+func writeHLL(buf *bytes.Buffer, value *hyperloglog.Sketch) error {
+	data, err := value.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return types.WriteString(buf, string(data))
+}
+
+func readHLL(buf *bytes.Reader) (*hyperloglog.Sketch, error) {
+	s, err := types.ReadString(buf)
+	if err != nil {
+		return nil, err
+	}
+	sketch := hyperloglog.New()
+	if err := sketch.UnmarshalBinary([]byte(s)); err != nil {
+		return nil, err
+	}
+	return sketch, nil
+}
@@ -44,20 +44,32 @@ func NewIOThread(clientFD int) (*IOThread, error) {
 }
 
 func (t *IOThread) Start(ctx context.Context, shardManager *shardmanager.ShardManager, watchManager *WatchManager) error {
-	for {
-		var c *wire.Command
-		recvCh := make(chan *wire.Command, 1)
-		errCh := make(chan error, 1)
-
-		go func() {
+	// The commands are received while the previous one executes, so that a
+	// command blocking the io-thread, such as BLPOP, notices through connCtx
+	// that the client disconnected.
+	connCtx, disconnect := context.WithCancel(ctx)
+	defer disconnect()
+	recvCh := make(chan *wire.Command)
+	errCh := make(chan error, 1)
+
+	go func() {
+		for {
 			tmpC, err := t.serverWire.Receive()
 			if err != nil {
 				errCh <- err.Unwrap()
+				disconnect()
+				return
+			}
+			select {
+			case recvCh <- tmpC:
+			case <-connCtx.Done():
 				return
 			}
-			recvCh <- tmpC
-		}()
+		}
+	}()
 
+	for {
+		var c *wire.Command
 		select {
 		case <-ctx.Done():
 			slog.Debug("io-thread context canceled, shutting down receive loop")
@@ -72,9 +84,14 @@ func (t *IOThread) Start(ctx context.Context, shardManager *shardmanager.ShardMa
 			C:        c,
 			ClientID: t.ClientID,
 			Mode:     t.Mode,
+			Ctx:      connCtx,
 		}
 
 		res, err := _c.Execute(shardManager)
+		if err != nil && connCtx.Err() != nil && ctx.Err() == nil {
+			// The client disconnected while the command was blocked.
+			return <-errCh
+		}
 		if err != nil {
 			res = &cmd.CmdRes{
 				Rs: &wire.Result{
@@ -96,7 +113,7 @@ func (t *IOThread) Start(ctx context.Context, shardManager *shardmanager.ShardMa
 
 		// Log command to WAL if enabled and not a replay
 		if wal.DefaultWAL != nil && !_c.IsReplay {
-			if err := wal.DefaultWAL.LogCommand(_c.C); err != nil {
+			if err := wal.DefaultWAL.LogCommand(_c.WALCommand()); err != nil {
 				slog.Error("failed to log command to WAL", slog.Any("error", err))
 			}
 		}
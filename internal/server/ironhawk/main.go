@@ -28,6 +28,11 @@ type Server struct {
 }
 
 func NewServer(shardManager *shardmanager.ShardManager, ioThreadManager *IOThreadManager, watchManager *WatchManager) *Server {
+	for _, shard := range shardManager.Shards() {
+		shard.Thread.Store().SetExpiryListener(func(key string) {
+			watchManager.NotifyExpiry(key, shardManager)
+		})
+	}
 	return &Server{
 		Host:            config.Config.Host,
 		Port:            config.Config.Port,
@@ -12,6 +12,8 @@ import (
 
 	"github.com/dicedb/dice/internal/cmd"
 	"github.com/dicedb/dice/internal/shardmanager"
+	"github.com/dicedb/dicedb-go/wire"
+	"google.golang.org/protobuf/proto"
 )
 
 type WatchManager struct {
@@ -21,6 +23,10 @@ type WatchManager struct {
 	keyFPMap    map[string]map[uint64]bool
 	fpClientMap map[uint64]map[string]bool
 	fpCmdMap    map[uint64]*cmd.Cmd
+
+	// fpLastResMap holds the result last pushed for the fingerprints of the
+	// commands that notify their watchers only when it changes.
+	fpLastResMap sync.Map
 }
 
 func NewWatchManager() *WatchManager {
@@ -99,6 +105,7 @@ func (w *WatchManager) HandleUnwatch(c *cmd.Cmd, t *IOThread) {
 
 		// If we have deleted the fingerprint, delete the command from the map
 		delete(w.fpCmdMap, fp)
+		w.fpLastResMap.Delete(fp)
 	}
 
 	// Delete the mapping where we have the key <--> [command fingerprint]
@@ -134,45 +141,70 @@ func (w *WatchManager) NotifyWatchers(c *cmd.Cmd, shardManager *shardmanager.Sha
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	key := c.Key()
-	for fp := range w.keyFPMap[key] {
-		_c := w.fpCmdMap[fp]
-		if _c == nil {
-			// TODO: Not having a command for a fingerprint is a bug.
-			continue
-		}
-
-		r, err := _c.Execute(shardManager)
-		if err != nil {
-			slog.Error("failed to execute command as part of watch notification",
-				slog.Any("cmd", _c.String()),
-				slog.Any("error", err))
-			continue
-		}
-
-		for clientID := range w.fpClientMap[fp] {
-			thread := w.clientWatchThreadMap[clientID]
-			if thread == nil {
-				// if there is no thread against the client, delete the client from the map
-				delete(w.clientWatchThreadMap, clientID)
+	for _, key := range c.NotifyKeys() {
+		for fp := range w.keyFPMap[key] {
+			_c := w.fpCmdMap[fp]
+			if _c == nil {
+				// TODO: Not having a command for a fingerprint is a bug.
 				continue
 			}
 
-			// If this is first time a client is connecting it'd be sending a .WATCH command
-			// in that case we don't need to notify all other clients subscribed to the key
-			if strings.HasSuffix(c.C.Cmd, ".WATCH") && t.ClientID != clientID {
+			r, err := _c.Execute(shardManager)
+			if err != nil {
+				slog.Error("failed to execute command as part of watch notification",
+					slog.Any("cmd", _c.String()),
+					slog.Any("error", err))
 				continue
 			}
 
-			err := thread.serverWire.Send(context.Background(), r.Rs)
-			if err != nil {
-				slog.Error("failed to write response to thread",
-					slog.Any("client_id", thread.ClientID),
-					slog.String("mode", thread.Mode),
-					slog.Any("error", err))
+			// A new subscription always gets the current result; the existing
+			// ones skip it if it did not change.
+			isWatchCmd := strings.HasSuffix(c.C.Cmd, ".WATCH")
+			if _c.Meta != nil && _c.Meta.NotifyOnChange {
+				last, ok := w.fpLastResMap.Load(fp)
+				if ok && !isWatchCmd && proto.Equal(last.(*wire.Result), r.Rs) {
+					continue
+				}
+				w.fpLastResMap.Store(fp, r.Rs)
+			}
+
+			for clientID := range w.fpClientMap[fp] {
+				thread := w.clientWatchThreadMap[clientID]
+				if thread == nil {
+					// if there is no thread against the client, delete the client from the map
+					delete(w.clientWatchThreadMap, clientID)
+					continue
+				}
+
+				// If this is first time a client is connecting it'd be sending a .WATCH command
+				// in that case we don't need to notify all other clients subscribed to the key
+				if isWatchCmd && t.ClientID != clientID {
+					continue
+				}
+
+				// An expiry is not a response to any command, so a client in
+				// command mode would read it as the response to its next one.
+				if t == nil && thread.Mode != "watch" {
+					continue
+				}
+
+				err := thread.serverWire.Send(context.Background(), r.Rs)
+				if err != nil {
+					slog.Error("failed to write response to thread",
+						slog.Any("client_id", thread.ClientID),
+						slog.String("mode", thread.Mode),
+						slog.Any("error", err))
+				}
 			}
-		}
 
-		slog.Debug("notifying watchers for key", slog.String("key", key), slog.Int("watchers", len(w.fpClientMap[fp])))
+			slog.Debug("notifying watchers for key", slog.String("key", key), slog.Int("watchers", len(w.fpClientMap[fp])))
+		}
 	}
 }
+
+// NotifyExpiry notifies the watchers of a key that expired, or whose lease
+// expired, outside of any command.
+func (w *WatchManager) NotifyExpiry(key string, shardManager *shardmanager.ShardManager) {
+	c := &cmd.Cmd{C: &wire.Command{Cmd: "DEL", Args: []string{key}}}
+	w.NotifyWatchers(c, shardManager, nil)
+}
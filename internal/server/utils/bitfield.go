@@ -23,6 +23,14 @@ type BitFieldOp struct {
 func parseBitfieldEncodingAndOffset(args []string) (eType, eVal, offset interface{}, err error) {
 	encodingRaw := args[0]
 	offsetRaw := args[1]
+	if encodingRaw == "" {
+		err = diceerrors.ErrGeneral("Invalid bitfield type. Use something like i16 u8. Note that u64 is not supported but i64 is")
+		return eType, eVal, offset, err
+	}
+	if offsetRaw == "" {
+		err = diceerrors.ErrGeneral("bit offset is not an integer or out of range")
+		return eType, eVal, offset, err
+	}
 	switch encodingRaw[0] {
 	case 'i':
 		eType = SIGNED
@@ -37,12 +37,31 @@ func DelExpiry(obj *object.Obj, store *Store) {
 
 // TODO: Optimize
 func deleteAllExpiredKeys(store *Store) {
+	store.Lock()
+	now := time.Now().UnixMilli()
+	var expired, leased []string
 	store.store.All(func(keyPtr string, obj *object.Obj) bool {
 		if hasExpired(obj, store) {
-			store.DelByPtr(keyPtr, WithDelCmd(Del))
+			expired = append(expired, keyPtr)
+		} else if lease, ok := obj.Value.(Lease); ok && lease.ExpireLease(now) {
+			leased = append(leased, keyPtr)
 		}
 		return true
 	})
+
+	for _, keyPtr := range expired {
+		store.DelByPtr(keyPtr, WithDelCmd(Del))
+	}
+	// The store is unlocked before notifying the changes, as the watchers
+	// re-run their commands, which may lock it.
+	listener := store.expiryListener
+	store.Unlock()
+
+	if listener != nil {
+		for _, key := range append(expired, leased...) {
+			listener(key)
+		}
+	}
 }
 
 // DeleteExpiredKeys deletes all the expired keys - the active way
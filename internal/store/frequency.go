@@ -0,0 +1,45 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package store
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/dicedb/dice/internal/object"
+)
+
+// The access frequency of an object is a logarithmic counter, as kept by
+// Redis for its LFU eviction policies. Every access increments it with a
+// probability that falls as it grows, so that its 8 bits count up to about
+// a million accesses, and it is decremented for every minute passed
+// without an access. New objects start from InitialFrequency so that they
+// are not taken for the least frequently used right away.
+const (
+	InitialFrequency   = 5
+	frequencyLogFactor = 10
+	frequencyDecayMs   = 60 * 1000
+)
+
+// GetFrequency returns the access frequency of obj at nowMs.
+func GetFrequency(obj *object.Obj, nowMs int64) uint8 {
+	periods := (nowMs - obj.LastAccessedAt) / frequencyDecayMs
+	if periods >= int64(obj.Frequency) {
+		return 0
+	}
+	return obj.Frequency - uint8(max(periods, 0))
+}
+
+// recordAccess records an access to obj at nowMs.
+func recordAccess(obj *object.Obj, nowMs int64) {
+	f := GetFrequency(obj, nowMs)
+	if f < math.MaxUint8 {
+		base := float64(max(int(f)-InitialFrequency, 0))
+		if rand.Float64() < 1/(base*frequencyLogFactor+1) {
+			f++
+		}
+	}
+	obj.Frequency = f
+	obj.LastAccessedAt = nowMs
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/dicedb/dice/internal/object"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrequencyGrowsLogarithmically(t *testing.T) {
+	obj := &object.Obj{Frequency: InitialFrequency}
+	for i := 0; i < 1000; i++ {
+		recordAccess(obj, 0)
+	}
+	// A thousand accesses take the counter to about 5 + sqrt(2*1000/10),
+	// far from the thousand accesses a linear counter would reach.
+	assert.Greater(t, obj.Frequency, uint8(InitialFrequency+5))
+	assert.Less(t, obj.Frequency, uint8(InitialFrequency+40))
+}
+
+func TestFrequencyDecays(t *testing.T) {
+	obj := &object.Obj{Frequency: 10, LastAccessedAt: 0}
+	assert.Equal(t, uint8(10), GetFrequency(obj, frequencyDecayMs-1))
+	assert.Equal(t, uint8(7), GetFrequency(obj, 3*frequencyDecayMs))
+	assert.Equal(t, uint8(0), GetFrequency(obj, 20*frequencyDecayMs))
+
+	recordAccess(obj, 3*frequencyDecayMs)
+	assert.Equal(t, int64(3*frequencyDecayMs), obj.LastAccessedAt)
+	assert.GreaterOrEqual(t, obj.Frequency, uint8(7))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/dicedb/dice/internal/common"
 	"github.com/dicedb/dice/internal/object"
+	"github.com/dicedb/dice/internal/types"
 )
 
 func NewStoreRegMap() common.ITable[string, *object.Obj] {
@@ -24,6 +25,12 @@ func NewExpireRegMap() common.ITable[*object.Obj, int64] {
 	}
 }
 
+func NewIndexRegMap() common.ITable[string, any] {
+	return &common.RegMap[string, any]{
+		M: sync.Map{},
+	}
+}
+
 func NewStoreMap() common.ITable[string, *object.Obj] {
 	return NewStoreRegMap()
 }
@@ -48,19 +55,45 @@ type CmdWatchEvent struct {
 	AffectedKey string
 }
 
+// KeyIndex is an index of a store that is kept up to date with the keys it
+// covers. The store calls IndexKey whenever a key is written and UnindexKey
+// whenever a key is deleted, expired or evicted. Indexes that do not
+// implement KeyIndex are maintained by the commands that use them.
+type KeyIndex interface {
+	IndexKey(key string, obj *object.Obj)
+	UnindexKey(key string)
+}
+
+// Lease is a value that expires on its own while its key stays, such as
+// the lease of a lock. DeleteExpiredKeys ends the leases that expired.
+type Lease interface {
+	// ExpireLease ends the lease if it expired at nowMs and reports whether
+	// it did.
+	ExpireLease(nowMs int64) bool
+}
+
 type Store struct {
 	store            common.ITable[string, *object.Obj]
 	expires          common.ITable[*object.Obj, int64] // Does not need to be thread-safe as it is only accessed by a single thread.
 	numKeys          int
 	cmdWatchChan     chan CmdWatchEvent
 	evictionStrategy EvictionStrategy
+	indexes          common.ITable[string, any] // secondary indexes built over the keys of the store, by name
+	expiryListener   func(key string)           // guarded by mu
 	ShardID          int
+
+	keyOrderMu sync.Mutex
+	keyOrder   *types.ScanOrder // the keys of the store in the order SCAN walks them, guarded by keyOrderMu
+
+	mu sync.Mutex // serializes the read-modify-writes that must be atomic, see Lock
 }
 
 func NewStore(cmdWatchChan chan CmdWatchEvent, evictionStrategy EvictionStrategy, shardID int) *Store {
 	store := &Store{
 		store:            NewStoreRegMap(),
 		expires:          NewExpireRegMap(),
+		indexes:          NewIndexRegMap(),
+		keyOrder:         types.NewScanOrder(),
 		cmdWatchChan:     cmdWatchChan,
 		evictionStrategy: evictionStrategy,
 		ShardID:          shardID,
@@ -76,6 +109,8 @@ func Reset(store *Store) *Store {
 	store.numKeys = 0
 	store.store = NewStoreMap()
 	store.expires = NewExpireMap()
+	store.indexes = NewIndexRegMap()
+	store.resetKeyOrder()
 
 	return store
 }
@@ -84,6 +119,7 @@ func (store *Store) NewObj(value interface{}, expDurationMs int64, oType object.
 	obj := &object.Obj{
 		Value:          value,
 		Type:           oType,
+		Frequency:      InitialFrequency,
 		LastAccessedAt: time.Now().UnixMilli(),
 	}
 	if expDurationMs >= 0 {
@@ -96,6 +132,106 @@ func (store *Store) ResetStore() {
 	store.numKeys = 0
 	store.store = NewStoreMap()
 	store.expires = NewExpireMap()
+	store.indexes = NewIndexRegMap()
+	store.resetKeyOrder()
+}
+
+func (store *Store) resetKeyOrder() {
+	store.keyOrderMu.Lock()
+	defer store.keyOrderMu.Unlock()
+	store.keyOrder = types.NewScanOrder()
+}
+
+// ScanKeys returns a page of the keys of the store in the order SCAN walks
+// them, as returned by ScanOrder.Page. The keys that expired but were not
+// deleted yet are included.
+func (store *Store) ScanKeys(from uint64, count int) (keys []string, next uint64, more bool) {
+	store.keyOrderMu.Lock()
+	defer store.keyOrderMu.Unlock()
+	return store.keyOrder.Page(from, count)
+}
+
+func (store *Store) addKeyOrder(k string) {
+	store.keyOrderMu.Lock()
+	defer store.keyOrderMu.Unlock()
+	store.keyOrder.Add(k)
+}
+
+func (store *Store) removeKeyOrder(k string) {
+	store.keyOrderMu.Lock()
+	defer store.keyOrderMu.Unlock()
+	store.keyOrder.Remove(k)
+}
+
+// SetExpiryListener sets the function that DeleteExpiredKeys calls with
+// every key it deletes or whose lease it ends, so that the changes made
+// outside of any command can be notified to the watchers of the keys. It
+// may be set while the shard is running.
+func (store *Store) SetExpiryListener(listener func(key string)) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.expiryListener = listener
+}
+
+// Lock locks the store for a read-modify-write that must be atomic with
+// respect to the other clients of the shard, such as acquiring a lock.
+// Commands run on the io thread of their client, so only the commands that
+// lock the store are serialized with one another and with the deletion of
+// the expired keys and leases.
+func (store *Store) Lock() {
+	store.mu.Lock()
+}
+
+// Unlock unlocks the store locked by Lock.
+func (store *Store) Unlock() {
+	store.mu.Unlock()
+}
+
+// GetIndex returns the index named name, or nil if the store has none.
+// The caller asserts the concrete type of the index it expects.
+func (store *Store) GetIndex(name string) any {
+	index, ok := store.indexes.Get(name)
+	if !ok {
+		return nil
+	}
+	return index
+}
+
+// PutIndex adds index to the store under name, replacing any index with
+// the same name.
+func (store *Store) PutIndex(name string, index any) {
+	store.indexes.Put(name, index)
+}
+
+// DelIndex removes the index named name from the store.
+func (store *Store) DelIndex(name string) {
+	store.indexes.Delete(name)
+}
+
+// Reindex updates the indexes of the store after the value stored at k was
+// modified in place rather than replaced with Put.
+func (store *Store) Reindex(k string) {
+	if obj, ok := store.store.Get(k); ok {
+		store.indexKey(k, obj)
+	}
+}
+
+func (store *Store) indexKey(k string, obj *object.Obj) {
+	store.indexes.All(func(_ string, index any) bool {
+		if ki, ok := index.(KeyIndex); ok {
+			ki.IndexKey(k, obj)
+		}
+		return true
+	})
+}
+
+func (store *Store) unindexKey(k string) {
+	store.indexes.All(func(_ string, index any) bool {
+		if ki, ok := index.(KeyIndex); ok {
+			ki.UnindexKey(k)
+		}
+		return true
+	})
 }
 
 func (store *Store) Put(k string, obj *object.Obj, opts ...PutOption) {
@@ -127,7 +263,9 @@ func (store *Store) putHelper(k string, obj *object.Obj, opts ...PutOption) {
 		optApplier(options)
 	}
 
-	obj.LastAccessedAt = time.Now().UnixMilli()
+	now := time.Now().UnixMilli()
+	obj.Frequency = GetFrequency(obj, now)
+	obj.LastAccessedAt = now
 	currentObject, ok := store.store.Get(k)
 	if ok {
 		v, ok1 := store.expires.Get(currentObject)
@@ -146,10 +284,12 @@ func (store *Store) putHelper(k string, obj *object.Obj, opts ...PutOption) {
 			store.evict(evictCount)
 		}
 		store.numKeys++
+		store.addKeyOrder(k)
 	}
 
 	store.store.Put(k, obj)
 	store.evictionStrategy.OnAccess(k, obj, AccessSet)
+	store.indexKey(k, obj)
 
 	if store.cmdWatchChan != nil {
 		store.notifyWatchManager(options.PutCmd, k)
@@ -166,7 +306,7 @@ func (store *Store) getHelper(k string, touch bool) *object.Obj {
 			store.deleteKey(k, obj)
 			obj = nil
 		} else if touch {
-			obj.LastAccessedAt = time.Now().UnixMilli()
+			recordAccess(obj, time.Now().UnixMilli())
 			store.evictionStrategy.OnAccess(k, obj, AccessGet)
 		}
 	}
@@ -182,7 +322,7 @@ func (store *Store) GetAll(keys []string) []*object.Obj {
 				store.deleteKey(k, v)
 				response = append(response, nil)
 			} else {
-				v.LastAccessedAt = time.Now().UnixMilli()
+				recordAccess(v, time.Now().UnixMilli())
 				response = append(response, v)
 			}
 		} else {
@@ -250,6 +390,8 @@ func (store *Store) Rename(sourceKey, destKey string) bool {
 	// Remove the source key
 	store.store.Delete(sourceKey)
 	store.numKeys--
+	store.removeKeyOrder(sourceKey)
+	store.unindexKey(sourceKey)
 
 	if store.cmdWatchChan != nil {
 		store.notifyWatchManager(Rename, sourceKey)
@@ -298,7 +440,9 @@ func (store *Store) deleteKey(k string, obj *object.Obj, opts ...DelOption) bool
 		store.store.Delete(k)
 		store.expires.Delete(obj)
 		store.numKeys--
+		store.removeKeyOrder(k)
 		store.evictionStrategy.OnAccess(k, obj, AccessDel)
+		store.unindexKey(k)
 		if store.cmdWatchChan != nil {
 			store.notifyWatchManager(options.DelCmd, k)
 		}
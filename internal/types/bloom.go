@@ -0,0 +1,392 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash"
+	"io"
+	"log/slog"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+
+	diceerrors "github.com/dicedb/dice/internal/errors"
+	"github.com/dicedb/dice/internal/server/utils"
+	"github.com/twmb/murmur3"
+)
+
+const (
+	defaultErrorRate float64 = 0.01
+	defaultCapacity  uint64  = 1024
+)
+
+var (
+	ln2      = math.Log(2)
+	ln2Power = ln2 * ln2
+)
+
+var (
+	errInvalidRangeErrorRateType = diceerrors.ErrGeneral("(0 < error rate range < 1) ")
+	errInvalidErrorRate          = diceerrors.ErrGeneral("bad error rate")
+	errInvalidCapacityType       = diceerrors.ErrGeneral("bad capacity")
+	errNonPositiveCapacity       = diceerrors.ErrGeneral("(capacity should be larger than 0)")
+
+	errEmptyValue              = diceerrors.ErrGeneral("empty value provided")
+	errUnableToHash            = diceerrors.ErrGeneral("unable to hash given value")
+	errInvalidInformationValue = diceerrors.ErrGeneral("Invalid information value")
+)
+
+type BloomOpts struct {
+	errorRate float64 // desired error rate (the false positive rate) of the filter
+	capacity  uint64  // number of expected entries to be added to the filter
+
+	bits    uint64        // total number of bits reserved for the filter
+	hashFns []hash.Hash64 // array of hash functions
+	bpe     float64       // bits per element
+
+	// indexes slice will hold the indexes, representing bits to be set/read and
+	// is under the assumption that it's consumed at only 1 place at a time. Add
+	// a lock when multiple clients can be supported.
+	indexes []uint64
+
+	hashFnsSeeds []uint64 // seed for hash functions
+}
+
+type Bloom struct {
+	opts   *BloomOpts // options for the bloom filter
+	bitset []byte     // underlying bit representation
+	cnt    uint64     // number of elements in the bloom
+}
+
+// DefaultBloomOpts returns the options used for filters that are created
+// implicitly, for example by BF.ADD on a key that does not exist.
+func DefaultBloomOpts() *BloomOpts {
+	return &BloomOpts{errorRate: defaultErrorRate, capacity: defaultCapacity}
+}
+
+// NewBloomOpts extracts the error rate and the capacity from `args` and
+// creates and returns the options for bloom filter.
+func NewBloomOpts(args []string) (*BloomOpts, error) {
+	errorRate, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return nil, errInvalidErrorRate
+	}
+
+	if errorRate <= 0 || errorRate >= 1.0 {
+		return nil, errInvalidRangeErrorRateType
+	}
+
+	capacity, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return nil, errInvalidCapacityType
+	}
+
+	if capacity <= 0 {
+		return nil, errNonPositiveCapacity
+	}
+
+	capacityUint, _ := strconv.ParseUint(args[1], 10, 64)
+
+	return &BloomOpts{errorRate: errorRate, capacity: capacityUint}, nil
+}
+
+// newBloomFilter creates and returns a new filter. It is responsible for initializing the
+// underlying bit array.
+func NewBloomFilter(opts *BloomOpts) *Bloom {
+	// Calculate bits per element
+	// 		bpe = -log(errorRate)/ln(2)^2
+	num := -1 * math.Log(opts.errorRate)
+	opts.bpe = num / ln2Power
+
+	// Calculate the number of hash functions to be used
+	// 		k = ceil(ln(2) * bpe)
+	k := math.Ceil(ln2 * opts.bpe)
+	opts.hashFns = make([]hash.Hash64, int(k))
+	opts.hashFnsSeeds = make([]uint64, int(k))
+	// Initialize hash functions with random seeds
+	for i := 0; i < int(k); i++ {
+		opts.hashFnsSeeds[i] = rand.Uint64() //nolint:gosec
+		opts.hashFns[i] = murmur3.SeedNew64(opts.hashFnsSeeds[i])
+	}
+
+	// initialize the common slice for storing indexes of bits to be set
+	opts.indexes = make([]uint64, len(opts.hashFns))
+
+	// Calculate the number of bytes to be used
+	// 		bits = k * entries / ln(2)
+	//		bytes = bits * 8
+	bits := uint64(math.Ceil((k * float64(opts.capacity)) / ln2))
+	var bytesNeeded uint64
+	if bits%8 == 0 {
+		bytesNeeded = bits / 8
+	} else {
+		bytesNeeded = (bits / 8) + 1
+	}
+	opts.bits = bytesNeeded * 8
+
+	bitset := make([]byte, bytesNeeded)
+
+	return &Bloom{opts, bitset, 0}
+}
+
+// Count returns the number of elements added to the filter.
+func (b *Bloom) Count() uint64 {
+	return b.cnt
+}
+
+// Info returns the parameters and metadata of the filter as name, value
+// pairs. If `opt` is not empty, only the requested parameter is returned.
+func (b *Bloom) Info(opt string) ([]interface{}, error) {
+	result := make([]interface{}, 0, 10)
+	if strings.EqualFold(opt, "") {
+		result = append(result,
+			"Capacity", b.opts.capacity,
+			"Size", b.opts.bits,
+			"Number of filters", len(b.opts.hashFns),
+			"Number of items inserted", b.cnt,
+			"Expansion rate", 2,
+		)
+	} else {
+		switch Param(strings.ToUpper(opt)) {
+		case BloomCapacity:
+			result = append(result, "Capacity", b.opts.capacity)
+		case BloomSize:
+			result = append(result, "Size", b.opts.bits)
+		case BloomFilters:
+			result = append(result, "Number of filters", len(b.opts.hashFns))
+		case BloomItems:
+			result = append(result, "Number of items inserted", b.cnt)
+		case BloomExpansion:
+			result = append(result, "Expansion rate", 2)
+		default:
+			return nil, errInvalidInformationValue
+		}
+	}
+	return result, nil
+}
+
+// Add adds a new entry for `value` in the filter. It hashes the given
+// value and sets the bit of the underlying bitset. Returns false if all
+// the bits were already set and true if at least 1 new bit was set.
+func (b *Bloom) Add(value string) (bool, error) {
+	// We're sure that empty values will be handled upper functions itself.
+	// This is just a property check for the bloom struct.
+	if value == utils.EmptyStr {
+		return false, errEmptyValue
+	}
+
+	// Update the indexes where bits are supposed to be set
+	err := b.opts.updateIndexes(value)
+	if err != nil {
+		slog.Error("failed to hash bloom filter value", slog.String("value", value), slog.Any("error", err))
+		return false, errUnableToHash
+	}
+
+	// Set the bits and keep a count of already set ones
+	count := 0
+	for _, v := range b.opts.indexes {
+		if isBitSet(b.bitset, v) {
+			count++
+		} else {
+			setBit(b.bitset, v)
+		}
+	}
+
+	if count == len(b.opts.indexes) {
+		// All the bits were already set, return false in that case.
+		return false, nil
+	}
+	b.cnt++
+	return true, nil
+}
+
+// Exists checks if the given `value` exists in the filter or not.
+// It hashes the given value and checks if the bits are set or not in
+// the underlying bitset. Returns false if the element surely does not
+// exist in the filter, and true if the element may or may not exist
+// in the filter.
+func (b *Bloom) Exists(value string) (bool, error) {
+	// We're sure that empty values will be handled upper functions itself.
+	// This is just a property check for the bloom struct.
+	if value == utils.EmptyStr {
+		return true, errEmptyValue
+	}
+
+	// Update the indexes where bits are supposed to be set
+	err := b.opts.updateIndexes(value)
+	if err != nil {
+		slog.Error("failed to hash bloom filter value", slog.String("value", value), slog.Any("error", err))
+		return false, errUnableToHash
+	}
+
+	// Check if all the bits at given indexes are set or not
+	// Ideally if the element is present, we should find all set bits.
+	for _, v := range b.opts.indexes {
+		if !isBitSet(b.bitset, v) {
+			// Return with false as we found one non-set bit (which is enough to conclude)
+			return false, nil
+		}
+	}
+
+	// We reached here, which means the element may exist in the filter. Return true now.
+	return true, nil
+}
+
+// DeepCopy creates a deep copy of the Bloom struct
+func (b *Bloom) DeepCopy() *Bloom {
+	if b == nil {
+		return nil
+	}
+
+	// Copy the BloomOpts
+	copyOpts := &BloomOpts{
+		errorRate: b.opts.errorRate,
+		capacity:  b.opts.capacity,
+		bits:      b.opts.bits,
+		bpe:       b.opts.bpe,
+		hashFns:   make([]hash.Hash64, len(b.opts.hashFns)),
+		indexes:   make([]uint64, len(b.opts.indexes)),
+	}
+
+	// Deep copy the hash functions (assuming they are shallow copyable)
+	copy(copyOpts.hashFns, b.opts.hashFns)
+
+	// Deep copy the indexes slice
+	copy(copyOpts.indexes, b.opts.indexes)
+
+	// Deep copy the bitset
+	copyBitset := make([]byte, len(b.bitset))
+	copy(copyBitset, b.bitset)
+
+	return &Bloom{
+		opts:   copyOpts,
+		bitset: copyBitset,
+		cnt:    b.cnt,
+	}
+}
+
+// updateIndexes updates the list with indexes where bits are supposed to be
+// set (to 1) or read in/from the underlying array. It uses the set hash function
+// against the given `value` and caps the index with the total number of bits.
+func (opts *BloomOpts) updateIndexes(value string) error {
+	// Iterate through the hash functions and get indexes
+	for i := 0; i < len(opts.hashFns); i++ {
+		fn := opts.hashFns[i]
+		fn.Reset()
+
+		if _, err := fn.Write([]byte(value)); err != nil {
+			return err
+		}
+
+		// Save the index capped by total number of bits in the underlying array
+		opts.indexes[i] = fn.Sum64() % opts.bits
+	}
+
+	return nil
+}
+
+func (b *Bloom) Serialize(buf *bytes.Buffer) error {
+	// Serialize the Bloom struct
+	if err := binary.Write(buf, binary.BigEndian, b.cnt); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, b.opts.errorRate); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, b.opts.capacity); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, b.opts.bits); err != nil {
+		return err
+	}
+
+	// Serialize the number of seeds and the seeds themselves
+	numSeeds := uint64(len(b.opts.hashFnsSeeds))
+	if err := binary.Write(buf, binary.BigEndian, numSeeds); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, b.opts.hashFnsSeeds); err != nil {
+		return err
+	}
+
+	// Serialize the number of indexes and the indexes themselves
+	numIndexes := uint64(len(b.opts.indexes))
+	if err := binary.Write(buf, binary.BigEndian, numIndexes); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, b.opts.indexes); err != nil {
+		return err
+	}
+
+	// Serialize the bitset
+	if _, err := buf.Write(b.bitset); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func DeserializeBloom(buf *bytes.Reader) (*Bloom, error) {
+	bloom := &Bloom{
+		opts: &BloomOpts{}, // Initialize the opts field to prevent nil pointer dereference
+	}
+
+	// Deserialize the Bloom struct
+	if err := binary.Read(buf, binary.BigEndian, &bloom.cnt); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &bloom.opts.errorRate); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &bloom.opts.capacity); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &bloom.opts.bits); err != nil {
+		return nil, err
+	}
+
+	// Deserialize hash function seeds
+	numSeeds, err := ReadLength(buf, 8)
+	if err != nil {
+		return nil, err
+	}
+	bloom.opts.hashFnsSeeds = make([]uint64, numSeeds)
+	if err := binary.Read(buf, binary.BigEndian, &bloom.opts.hashFnsSeeds); err != nil {
+		return nil, err
+	}
+
+	// Deserialize indexes
+	numIndexes, err := ReadLength(buf, 8)
+	if err != nil {
+		return nil, err
+	}
+	if numIndexes != numSeeds {
+		return nil, ErrInvalidSerialization
+	}
+	bloom.opts.indexes = make([]uint64, numIndexes)
+	if err := binary.Read(buf, binary.BigEndian, &bloom.opts.indexes); err != nil {
+		return nil, err
+	}
+
+	// Deserialize bitset, which holds bits/8 bytes
+	if bloom.opts.bits == 0 || bloom.opts.bits%8 != 0 || bloom.opts.bits/8 > uint64(buf.Len()) {
+		return nil, ErrInvalidSerialization
+	}
+	bloom.bitset = make([]byte, bloom.opts.bits/8)
+	if _, err := io.ReadFull(buf, bloom.bitset); err != nil {
+		return nil, err
+	}
+
+	// Recalculate derived values
+	bloom.opts.bpe = -1 * math.Log(bloom.opts.errorRate) / math.Ln2
+	bloom.opts.hashFns = make([]hash.Hash64, len(bloom.opts.hashFnsSeeds))
+	for i := 0; i < len(bloom.opts.hashFnsSeeds); i++ {
+		bloom.opts.hashFns[i] = murmur3.SeedNew64(bloom.opts.hashFnsSeeds[i])
+	}
+
+	return bloom, nil
+}
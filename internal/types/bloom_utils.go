@@ -1,7 +1,7 @@
 // Copyright (c) 2022-present, DiceDB contributors
 // All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
 
-package eval
+package types
 
 // setBit sets the bit at index `b` to "1" in `buf`.
 func setBit(buf []byte, b uint64) {
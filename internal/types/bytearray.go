@@ -0,0 +1,346 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"errors"
+
+	"github.com/dicedb/dice/internal/server/utils"
+)
+
+type ByteArray struct {
+	data   []byte
+	Length int64
+}
+
+// NewByteArray initializes a new ByteArray with the given size
+func NewByteArray(size int) *ByteArray {
+	return &ByteArray{
+		data:   make([]byte, size),
+		Length: int64(size),
+	}
+}
+
+// NewByteArrayFromBytes initializes a new ByteArray holding b. The
+// ByteArray takes ownership of b.
+func NewByteArrayFromBytes(b []byte) *ByteArray {
+	return &ByteArray{
+		data:   b,
+		Length: int64(len(b)),
+	}
+}
+
+// Bytes returns the bytes held by the ByteArray
+func (b *ByteArray) Bytes() []byte {
+	return b.data
+}
+
+// SetBit sets the bit at the given position to the specified value
+func (b *ByteArray) SetBit(pos int, value bool) {
+	byteIndex := pos / 8
+	bitIndex := 7 - uint(pos%8)
+
+	if value {
+		b.data[byteIndex] |= 1 << bitIndex
+	} else {
+		b.data[byteIndex] &^= 1 << bitIndex
+	}
+}
+
+// GetBit gets the bit at the given position
+func (b *ByteArray) GetBit(pos int) bool {
+	byteIndex := pos / 8
+	bitIndex := 7 - uint(pos%8)
+
+	return (b.data[byteIndex] & (1 << bitIndex)) != 0
+}
+
+// BitCount counts the number of bits set to 1
+func (b *ByteArray) BitCount() int {
+	count := 0
+	for _, byteVal := range b.data {
+		count += int(popcount(byteVal))
+	}
+	return count
+}
+
+func (b *ByteArray) IncreaseSize(increaseSizeTo int) *ByteArray {
+	currentByteArray := b.data
+	currentByteArraySize := len(currentByteArray)
+
+	// Input is decreasing the size
+	if currentByteArraySize >= increaseSizeTo {
+		return b
+	}
+
+	sizeDifference := increaseSizeTo - currentByteArraySize
+	currentByteArray = append(currentByteArray, make([]byte, sizeDifference)...)
+
+	b.data = currentByteArray
+	b.Length = int64(increaseSizeTo)
+
+	return b
+}
+
+func (b *ByteArray) ResizeIfNecessary() *ByteArray {
+	byteArrayLength := b.Length
+	decreaseLengthBy := 0
+	for i := byteArrayLength - 1; i >= 0; i-- {
+		if b.data[i] == 0x0 {
+			decreaseLengthBy++
+		} else {
+			break
+		}
+	}
+
+	if decreaseLengthBy == 0 {
+		return b
+	}
+
+	// Decrease the size of the slice to n elements
+	// and create a new slice with reduced capacity
+	capacityReducedSlice := make([]byte, byteArrayLength-int64(decreaseLengthBy))
+	copy(capacityReducedSlice, b.data[:byteArrayLength-int64(decreaseLengthBy)])
+
+	b.data = capacityReducedSlice
+	b.Length = int64(len(capacityReducedSlice))
+
+	return b
+}
+
+// DeepCopy creates a deep copy of the ByteArray
+func (b *ByteArray) DeepCopy() *ByteArray {
+	if b == nil {
+		return nil
+	}
+
+	copyArray := NewByteArray(int(b.Length))
+
+	// Copy the data from the original to the new ByteArray
+	copy(copyArray.data, b.data)
+	return copyArray
+}
+
+// GetBits returns the width bits starting at offset as an integer. Bits
+// past the end of the array read as zeros.
+func (b *ByteArray) GetBits(offset, width int, signed bool) int64 {
+	var value uint64
+	for i := 0; i < width; i++ {
+		value <<= 1
+		pos := offset + i
+		if pos < int(b.Length)*8 && b.GetBit(pos) {
+			value |= 1
+		}
+	}
+	if signed && width < 64 && value&(1<<(width-1)) != 0 {
+		// Sign-extend the value to 64 bits
+		value |= ^uint64(0) << width
+	}
+	return int64(value)
+}
+
+// SetBits stores the lowest width bits of value starting at offset, most
+// significant bit first, growing the array if needed.
+func (b *ByteArray) SetBits(offset, width int, value int64) {
+	if offset+width > int(b.Length)*8 {
+		newSize := (offset + width + 7) / 8
+		b.IncreaseSize(newSize)
+	}
+	for i := 0; i < width; i++ {
+		b.SetBit(offset+i, value&(1<<(width-1-i)) != 0)
+	}
+}
+
+// IncrByBits increments the width bits starting at offset by increment and
+// returns the new value. overflow decides what happens if the result does
+// not fit: WRAP wraps it around, SAT saturates it to the minimum or maximum
+// value, and FAIL leaves the bits unchanged and returns an error.
+func (b *ByteArray) IncrByBits(offset, width int, increment int64, overflow string, signed bool) (int64, error) {
+	value := b.GetBits(offset, width, signed)
+	newValue := value + increment
+
+	minVal, maxVal := BitfieldRange(width, signed)
+
+	// The addition itself may overflow 64 bits, in which case the result
+	// is out of range on the side of the increment.
+	overflowed64 := (increment > 0 && newValue < value) || (increment < 0 && newValue > value)
+	tooBig := newValue > maxVal || (overflowed64 && increment > 0)
+	tooSmall := newValue < minVal || (overflowed64 && increment < 0)
+
+	switch overflow {
+	case utils.WRAP:
+		// Keeping the lowest width bits wraps the value around, and
+		// reading it back sign-extends it if needed.
+	case utils.SAT:
+		if tooBig {
+			newValue = maxVal
+		} else if tooSmall {
+			newValue = minVal
+		}
+	case utils.FAIL:
+		if tooBig || tooSmall {
+			return value, errors.New("overflow detected")
+		}
+	default:
+		return value, errors.New("invalid overflow type")
+	}
+
+	b.SetBits(offset, width, newValue)
+	return b.GetBits(offset, width, signed), nil
+}
+
+// BitfieldRange returns the smallest and largest values of an integer field
+// of width bits.
+func BitfieldRange(width int, signed bool) (minVal, maxVal int64) {
+	if signed {
+		maxVal = int64(uint64(1)<<(width-1) - 1)
+		return -maxVal - 1, maxVal
+	}
+	return 0, int64(uint64(1)<<width - 1)
+}
+
+// CountBits counts the bits set to 1 between start and end, both inclusive.
+// start and end are byte offsets, or bit offsets if bitUnit is set, and
+// negative ones count from the end of the data.
+func CountBits(data []byte, start, end int64, bitUnit bool) int {
+	length := int64(len(data))
+	if !bitUnit {
+		if start < 0 {
+			start += length
+		}
+		if end < 0 {
+			end += length
+		}
+		start = max(start, 0)
+		if start > end || start >= length {
+			return 0
+		}
+		end = min(end, length-1)
+		count := 0
+		for i := start; i <= end; i++ {
+			count += int(popcount(data[i]))
+		}
+		return count
+	}
+
+	if start < 0 {
+		start += length * 8
+	}
+	if end < 0 {
+		end += length * 8
+	}
+	start = max(start, 0)
+	end = min(end, length*8-1)
+	if start > end {
+		return 0
+	}
+	count := 0
+	for i := start; i <= end; i++ {
+		if data[i/8]&(1<<(7-i%8)) != 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// BitPos returns the position of the first bit set to bit between start and
+// end, both inclusive, or -1 if there is none. start and end are byte
+// offsets, or bit offsets if bitUnit is set, and negative ones count from
+// the end of the data.
+//
+// When looking for a 0 bit without an explicit end, the data is considered
+// to be padded with zeros, so the first bit past its end is returned if all
+// its bits are set.
+func BitPos(data []byte, bit byte, start, end int, bitUnit, endGiven bool) int {
+	byteLen := len(data)
+	bitLen := len(data) * 8
+
+	var result int
+
+	if !bitUnit {
+		// Adjust start and end for both BYTE and BIT ranges
+		// This handles negative indices and ensures we're within bounds
+		start, end = adjustBitPosSearchRange(start, end, byteLen)
+
+		// If start is beyond end or byteLen, we can't find anything
+		if start > end || start >= byteLen {
+			return -1
+		}
+
+		result = getBitPosWithBitRange(data, bit, start*8, end*8+7)
+	} else {
+		// Adjust start and end for both BYTE and BIT ranges
+		// This handles negative indices and ensures we're within bounds
+		start, end = adjustBitPosSearchRange(start, end, bitLen)
+
+		// If start is beyond end or byteLen, we can't find anything
+		if start > end || start >= bitLen {
+			return -1
+		}
+
+		result = getBitPosWithBitRange(data, bit, start, end)
+	}
+
+	// Special case: if we're looking for a 0 bit, didn't find it,
+	// and no end range was provided, we return the first bit position
+	// that's not part of the byte slice (i.e., the total bit length)
+	if bit == 0 && result == -1 && !endGiven {
+		return bitLen
+	}
+
+	return result
+}
+
+func adjustBitPosSearchRange(start, end, byteLen int) (newStart, newEnd int) {
+	if start < 0 {
+		start += byteLen
+	}
+	if end < 0 {
+		end += byteLen
+	}
+	start = max(0, start)
+	end = min(byteLen-1, end)
+
+	return start, end
+}
+
+func getBitPosWithBitRange(byteSlice []byte, bitToFind byte, start, end int) int {
+	for i := start; i <= end; i++ {
+		// Calculate which byte and bit we're looking at
+		byteIndex := i / 8
+		// 7 - (i % 8) because we count bits from left to right in each byte
+		bitIndex := 7 - (i % 8)
+
+		// Check if this bit matches what we're looking for
+		if ((byteSlice[byteIndex] >> bitIndex) & 1) == bitToFind {
+			return i
+		}
+	}
+	// Bit not found in the range
+	return -1
+}
+
+// population counting, counts the number of set bits in a byte
+// Using: https://en.wikipedia.org/wiki/Hamming_weight
+func popcount(x byte) byte {
+	// pairing bits and counting them in pairs
+	x -= (x >> 1) & 0x55
+	// counting bits in groups of four
+	x = (x & 0x33) + ((x >> 2) & 0x33)
+	// isolates the lower four bits
+	// which now contain the total count of set bits in the original byte
+	return (x + (x >> 4)) & 0x0F
+}
+
+// reverseByte reverses the order of bits in a single byte.
+
+//nolint:unused
+func reverseByte(b byte) byte {
+	var reversed byte = 0
+	for i := 0; i < 8; i++ {
+		reversed = (reversed << 1) | (b & 1)
+		b >>= 1
+	}
+	return reversed
+}
@@ -1,11 +1,12 @@
 // Copyright (c) 2022-present, DiceDB contributors
 // All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
 
-package eval
+package types
 
 import (
 	"testing"
 
+	"github.com/dicedb/dice/internal/server/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -152,3 +153,80 @@ func TestDeepCopy(t *testing.T) {
 	original.data[1] = 8
 	assert.True(t, deepCopy.data[1] != original.data[1], "ByteArray DeepCopy did not create an independent deepCopy, original and deepCopy data are linked")
 }
+
+func TestBitfieldGetSet(t *testing.T) {
+	byteArray := NewByteArray(0)
+
+	byteArray.SetBits(0, 4, 1)
+	assert.Equal(t, int64(1), byteArray.GetBits(0, 4, false))
+	assert.Equal(t, []byte{0b00010000}, byteArray.Bytes())
+
+	byteArray.SetBits(4, 8, -56)
+	assert.Equal(t, int64(-56), byteArray.GetBits(4, 8, true))
+	assert.Equal(t, int64(200), byteArray.GetBits(4, 8, false))
+	assert.Equal(t, int64(2), byteArray.Length)
+
+	// Bits past the end read as zeros
+	assert.Equal(t, int64(0), byteArray.GetBits(100, 16, false))
+
+	byteArray.SetBits(0, 64, -1)
+	assert.Equal(t, int64(-1), byteArray.GetBits(0, 64, true))
+	assert.Equal(t, int64(1<<63-1), byteArray.GetBits(1, 63, false))
+}
+
+func TestBitfieldIncrBy(t *testing.T) {
+	byteArray := NewByteArray(0)
+
+	v, err := byteArray.IncrByBits(0, 8, 200, utils.WRAP, false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), v)
+
+	v, err = byteArray.IncrByBits(0, 8, 100, utils.WRAP, false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(44), v)
+
+	v, err = byteArray.IncrByBits(0, 8, -100, utils.WRAP, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-56), v)
+
+	v, err = byteArray.IncrByBits(0, 8, 1000, utils.SAT, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(127), v)
+
+	v, err = byteArray.IncrByBits(0, 8, -1000, utils.SAT, false)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), v)
+
+	_, err = byteArray.IncrByBits(0, 8, -1, utils.FAIL, false)
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(0), byteArray.GetBits(0, 8, false))
+
+	byteArray.SetBits(0, 64, 1<<63-1)
+	v, err = byteArray.IncrByBits(0, 64, 1, utils.SAT, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1<<63-1), v)
+
+	v, err = byteArray.IncrByBits(0, 64, 1, utils.WRAP, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1<<63), v)
+}
+
+func TestCountBitsAndBitPos(t *testing.T) {
+	data := []byte("foobar")
+
+	assert.Equal(t, 26, CountBits(data, 0, -1, false))
+	assert.Equal(t, 4, CountBits(data, 0, 0, false))
+	assert.Equal(t, 6, CountBits(data, 1, 1, false))
+	assert.Equal(t, 17, CountBits(data, 5, 30, true))
+	assert.Equal(t, 26, CountBits(data, -100, 100, false))
+	assert.Equal(t, 0, CountBits(data, 4, 2, false))
+
+	data = []byte{0xff, 0xf0, 0x00}
+	assert.Equal(t, 12, BitPos(data, 0, 0, -1, false, false))
+	assert.Equal(t, 0, BitPos(data, 1, 0, -1, false, false))
+	assert.Equal(t, 8, BitPos(data, 1, 1, -1, false, true))
+	assert.Equal(t, -1, BitPos(data, 1, 2, -1, false, true))
+	assert.Equal(t, 24, BitPos([]byte{0xff, 0xff, 0xff}, 0, 0, -1, false, false))
+	assert.Equal(t, -1, BitPos([]byte{0xff, 0xff, 0xff}, 0, 0, -1, false, true))
+	assert.Equal(t, 5, BitPos([]byte{0x00, 0xf0}, 0, 5, 10, true, true))
+}
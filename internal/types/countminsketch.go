@@ -0,0 +1,318 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"hash/fnv"
+	"math"
+	"strconv"
+
+	diceerrors "github.com/dicedb/dice/internal/errors"
+)
+
+type CountMinSketchOpts struct {
+	depth  uint64      // depth of the count min sketch matrix
+	width  uint64      // width of the count min sketch matrix
+	hasher hash.Hash64 // the hash function used to hash the key
+}
+
+// CountMinSketch implements a Count-Min Sketch as described by Cormode and
+// Muthukrishnan in their paper:
+// "An Improved Data Stream Summary: The Count-Min Sketch and its Applications"
+// (http://dimacs.rutgers.edu/~graham/pubs/papers/cm-full.pdf).
+//
+// A Count-Min Sketch (CMS) is a space-efficient, probabilistic data structure
+// for approximating the frequency of events in a data stream. Instead of using
+// large space like a hash map, it trades accuracy for space by allowing a configurable
+// error margin. Similar to Counting Bloom filters, each item is hashed into multiple
+// buckets, and the item's frequency is estimated by taking the minimum count across
+// those buckets.
+//
+// CMS is particularly useful for tracking event frequencies in large or unbounded
+// data streams where storing all data or maintaining a counter for each event
+// in memory is infeasible. It provides an efficient solution for real-time processing
+// with minimal memory usage.
+type CountMinSketch struct {
+	opts *CountMinSketchOpts
+
+	matrix [][]uint64 // the underlying matrix that stores the counts
+	count  uint64     // total number of occurrences seen by the sketch
+}
+
+// NewCountMinSketchOpts extracts the depth and width of the matrix when these values
+// are provided by the user. depth and width must be positive integers. It returns the
+// options used to create a new Count Min Sketch.
+func NewCountMinSketchOpts(args []string) (*CountMinSketchOpts, error) {
+	width, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil || width <= 0 {
+		return nil, diceerrors.NewErr("invalid width")
+	}
+
+	depth, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil || depth <= 0 {
+		return nil, diceerrors.NewErr("invalid depth")
+	}
+
+	return &CountMinSketchOpts{depth: depth, width: width, hasher: fnv.New64()}, nil
+}
+
+// NewCountMinSketchOptsWithDims returns the options for a Count Min Sketch
+// whose matrix has the given width and depth, which must be positive.
+func NewCountMinSketchOptsWithDims(width, depth uint64) *CountMinSketchOpts {
+	return &CountMinSketchOpts{depth: depth, width: width, hasher: fnv.New64()}
+}
+
+// NewCountMinSketchOptsWithErrorRate calculates the depth and width of the matrix based
+// on the given permissible error rate (ε) and probability (δ). Both the values must lie
+// between zero and one. A given error rate (ε) means the count estimate may exceed
+// the actual count by at most ε * N, where N is the total number of elements processed,
+// and the probability (1 - δ) guarantees this bound holds with at least (1 - δ) confidence.
+// It returns the options used to create a new Count Min Sketch.
+func NewCountMinSketchOptsWithErrorRate(args []string) (*CountMinSketchOpts, error) {
+	errorRate, err := strconv.ParseFloat(args[0], 64)
+	if err != nil || errorRate <= 0 || errorRate >= 1.0 {
+		return nil, diceerrors.NewErr("invalid overestimation value")
+	}
+
+	probability, err := strconv.ParseFloat(args[1], 64)
+	if err != nil || probability <= 0 || probability >= 1.0 {
+		return nil, diceerrors.NewErr("invalid prob value")
+	}
+
+	// These formulas are taken from the original paper that introduced Count Min Sketch.
+	// Link to paper - http://dimacs.rutgers.edu/~graham/pubs/papers/cm-full.pdf
+	width := uint64(math.Ceil(math.Exp(1) / errorRate))
+	depth := uint64(math.Ceil(math.Log(1 / probability)))
+
+	return &CountMinSketchOpts{depth: depth, width: width, hasher: fnv.New64()}, nil
+}
+
+// NewCountMinSketch creates a new Count Min Sketch with given options.
+// It also initializes the underlying matrix.
+func NewCountMinSketch(opts *CountMinSketchOpts) *CountMinSketch {
+	cms := &CountMinSketch{
+		opts: opts,
+	}
+
+	cms.matrix = make([][]uint64, opts.depth)
+	flatMatrix := make([]uint64, opts.depth*opts.width) // single memory allocation
+	for row := uint64(0); row < opts.depth; row++ {
+		cms.matrix[row] = flatMatrix[row*opts.width : (row+1)*opts.width : (row+1)*opts.width]
+	}
+
+	return cms
+}
+
+// Width returns the number of columns of the underlying matrix.
+func (c *CountMinSketch) Width() uint64 {
+	return c.opts.width
+}
+
+// Depth returns the number of rows of the underlying matrix.
+func (c *CountMinSketch) Depth() uint64 {
+	return c.opts.depth
+}
+
+// Count returns the total number of occurrences seen by the sketch.
+func (c *CountMinSketch) Count() uint64 {
+	return c.count
+}
+
+// Info returns information about the underlying matrix for the given Count Min Sketch.
+func (c *CountMinSketch) Info() []interface{} {
+	info := make([]interface{}, 0, 3)
+
+	info = append(info, "width", c.opts.width, "depth", c.opts.depth, "count", c.count)
+
+	return info
+}
+
+// this function computes the base hash values which are then used to generate
+// other hash values for the given key.
+func (c *CountMinSketch) baseHashes(key []byte) (hash1, hash2 uint32) {
+	c.opts.hasher.Reset()
+	c.opts.hasher.Write(key)
+
+	sum := c.opts.hasher.Sum(nil)
+
+	upper := sum[0:4]
+	lower := sum[4:8]
+
+	hash1 = binary.BigEndian.Uint32(upper)
+	hash2 = binary.BigEndian.Uint32(lower)
+
+	return
+}
+
+// returns the positions in the matrix where the count of the given key
+// should be updated.
+func (c *CountMinSketch) matrixPositions(key []byte) (positions []uint64) {
+	positions = make([]uint64, c.opts.depth)
+
+	hash1, hash2 := c.baseHashes(key)
+
+	uintHash1 := uint64(hash1)
+	uintHash2 := uint64(hash2)
+
+	for row := uint64(0); row < c.opts.depth; row++ {
+		positions[row] = (uintHash1 + uintHash2*row) % c.opts.width
+	}
+	return
+}
+
+// UpdateMatrix updates the underlying matrix for the given key by count.
+func (c *CountMinSketch) UpdateMatrix(key string, count uint64) {
+	for row, col := range c.matrixPositions([]byte(key)) {
+		c.matrix[row][col] += count
+	}
+	c.count += count
+}
+
+// EstimateCount is used to query the sketch for the value of a key.
+// The estimated count is the minimum of the values present at the
+// positons for a given key.
+func (c *CountMinSketch) EstimateCount(key string) uint64 {
+	var count uint64 = math.MaxUint64
+	for row, col := range c.matrixPositions([]byte(key)) {
+		if c.matrix[row][col] < count {
+			count = c.matrix[row][col]
+		}
+	}
+
+	return count
+}
+
+// returns a deep copy of the Count Min Sketch
+func (c *CountMinSketch) DeepCopy() *CountMinSketch {
+	if c == nil {
+		return nil
+	}
+
+	copyOpts := &CountMinSketchOpts{
+		depth:  c.opts.depth,
+		width:  c.opts.width,
+		hasher: c.opts.hasher,
+	}
+
+	// Deep copy the matrix
+	matrix := make([][]uint64, c.opts.depth)
+	flatMatrix := make([]uint64, c.opts.depth*c.opts.width) // single memory allocation
+	for row := uint64(0); row < c.opts.depth; row++ {
+		matrix[row] = flatMatrix[row*c.opts.width : (row+1)*c.opts.width : (row+1)*c.opts.width]
+		copy(matrix[row], c.matrix[row])
+	}
+
+	return &CountMinSketch{
+		opts:   copyOpts,
+		matrix: matrix,
+		count:  c.count,
+	}
+}
+
+// Merge combines two or more Count Min Sketches and puts the result in this
+// sketch. The merging is done based on the weights assigned to each sketch and
+// the counts stored in this sketch are ignored. The sources might include this
+// sketch too, so the weighted sums are computed before the matrix is replaced.
+// All the sources must have the same dimensions as this sketch.
+func (c *CountMinSketch) Merge(sources []*CountMinSketch, weights []uint64) {
+	merged := NewCountMinSketch(c.opts)
+
+	// for every row and column, take the weighted sum of the source sketches.
+	for row := uint64(0); row < c.opts.depth; row++ {
+		for col := uint64(0); col < c.opts.width; col++ {
+			for i, cms := range sources {
+				merged.matrix[row][col] += weights[i] * cms.matrix[row][col]
+			}
+		}
+	}
+
+	for i, cms := range sources {
+		merged.count += weights[i] * cms.count
+	}
+
+	c.matrix = merged.matrix
+	c.count = merged.count
+}
+
+// Serialize encodes the CountMinSketch into a byte slice.
+func (c *CountMinSketch) Serialize(buffer *bytes.Buffer) error {
+	if c == nil {
+		return errors.New("cannot serialize a nil CountMinSketch")
+	}
+
+	// Write depth, width, and count
+	if err := binary.Write(buffer, binary.BigEndian, c.opts.depth); err != nil {
+		return err
+	}
+	if err := binary.Write(buffer, binary.BigEndian, c.opts.width); err != nil {
+		return err
+	}
+	if err := binary.Write(buffer, binary.BigEndian, c.count); err != nil {
+		return err
+	}
+
+	// Write matrix
+	for i := 0; i < len(c.matrix); i++ {
+		for j := 0; j < len(c.matrix[i]); j++ {
+			if err := binary.Write(buffer, binary.BigEndian, c.matrix[i][j]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deserialize reconstructs a CountMinSketch from a byte slice.
+func DeserializeCMS(buffer *bytes.Reader) (*CountMinSketch, error) {
+	if buffer.Len() < 24 { // Minimum size for depth, width, and count
+		return nil, errors.New("insufficient data for deserialization")
+	}
+
+	var depth, width, count uint64
+
+	// Read depth, width, and count
+	if err := binary.Read(buffer, binary.BigEndian, &depth); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buffer, binary.BigEndian, &width); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buffer, binary.BigEndian, &count); err != nil {
+		return nil, err
+	}
+	// Validate data size, each uint64 of the matrix taking 8 bytes, before
+	// allocating it
+	if depth == 0 || width == 0 || width > uint64(buffer.Len()/8) || depth > uint64(buffer.Len()/8)/width {
+		return nil, errors.New("data size mismatch with expected matrix size")
+	}
+
+	// Read matrix
+	matrix := make([][]uint64, depth)
+	flatMatrix := make([]uint64, depth*width) // single memory allocation
+	for i := 0; i < int(depth); i++ {
+		matrix[i] = flatMatrix[i*int(width) : (i+1)*int(width) : (i+1)*int(width)]
+		for j := 0; j < int(width); j++ {
+			if err := binary.Read(buffer, binary.BigEndian, &matrix[i][j]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	opts := &CountMinSketchOpts{
+		depth:  depth,
+		width:  width,
+		hasher: fnv.New64(), // Default hasher
+	}
+
+	return &CountMinSketch{
+		opts:   opts,
+		matrix: matrix,
+		count:  count,
+	}, nil
+}
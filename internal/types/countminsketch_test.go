@@ -0,0 +1,34 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountMinSketchMerge(t *testing.T) {
+	opts, err := NewCountMinSketchOpts([]string{"100", "4"})
+	assert.Nil(t, err)
+
+	destination := NewCountMinSketch(opts)
+	destination.UpdateMatrix("a", 1)
+
+	other := NewCountMinSketch(opts)
+	other.UpdateMatrix("a", 2)
+	other.UpdateMatrix("b", 5)
+
+	// The destination is one of the sources, so its previous counts must be
+	// read before they are replaced.
+	destination.Merge([]*CountMinSketch{destination, other}, []uint64{2, 3})
+
+	assert.Equal(t, uint64(8), destination.EstimateCount("a"))
+	assert.Equal(t, uint64(15), destination.EstimateCount("b"))
+	assert.Equal(t, uint64(23), destination.Count())
+
+	// The sources are left untouched.
+	assert.Equal(t, uint64(2), other.EstimateCount("a"))
+	assert.Equal(t, uint64(7), other.Count())
+}
@@ -0,0 +1,373 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+var ErrCuckooFull = errors.New("the cuckoo filter is full")
+
+const (
+	DefaultCuckooCapacity      = 1024
+	DefaultCuckooBucketSize    = 2
+	DefaultCuckooMaxIterations = 20
+	DefaultCuckooExpansion     = 1
+
+	// The largest options a filter can be created with.
+	MaxCuckooBucketSize    = 255
+	MaxCuckooMaxIterations = 65535
+	MaxCuckooExpansion     = 32768
+
+	// cuckooSeed seeds the generator choosing the fingerprints to evict, so
+	// that replaying the same commands builds the same filter.
+	cuckooSeed = 0x9e3779b97f4a7c15
+)
+
+// CuckooOpts are the parameters of a cuckoo filter.
+type CuckooOpts struct {
+	Capacity      uint64 // the number of items the first sub-filter holds
+	BucketSize    uint64 // the number of fingerprints in a bucket
+	MaxIterations uint64 // the number of evictions tried before the filter expands
+	Expansion     uint64 // the growth factor of every new sub-filter, 0 to never expand
+}
+
+// DefaultCuckooOpts returns the options of the filters created on the
+// first insertion into a missing key.
+func DefaultCuckooOpts() CuckooOpts {
+	return CuckooOpts{
+		Capacity:      DefaultCuckooCapacity,
+		BucketSize:    DefaultCuckooBucketSize,
+		MaxIterations: DefaultCuckooMaxIterations,
+		Expansion:     DefaultCuckooExpansion,
+	}
+}
+
+// cuckooSubFilter is a table of buckets, each holding BucketSize 16-bit
+// fingerprints, where 0 marks an empty slot.
+type cuckooSubFilter struct {
+	numBuckets uint64 // a power of two
+	bucketSize uint64
+	slots      []uint16
+}
+
+func newCuckooSubFilter(capacity, bucketSize uint64) *cuckooSubFilter {
+	numBuckets := uint64(1)
+	for numBuckets*bucketSize < capacity {
+		numBuckets <<= 1
+	}
+	return &cuckooSubFilter{
+		numBuckets: numBuckets,
+		bucketSize: bucketSize,
+		slots:      make([]uint16, numBuckets*bucketSize),
+	}
+}
+
+func (f *cuckooSubFilter) capacity() uint64 {
+	return f.numBuckets * f.bucketSize
+}
+
+func (f *cuckooSubFilter) bucket(i uint64) []uint16 {
+	return f.slots[i*f.bucketSize : (i+1)*f.bucketSize]
+}
+
+// indexes returns the two buckets an item with hash h and fingerprint fp
+// may be stored in.
+func (f *cuckooSubFilter) indexes(h uint64, fp uint16) (i1, i2 uint64) {
+	i1 = h & (f.numBuckets - 1)
+	return i1, f.altIndex(i1, fp)
+}
+
+// altIndex returns the other bucket of the fingerprint fp stored in bucket
+// i. It only depends on i and fp, so a fingerprint can be moved without the
+// item it comes from.
+func (f *cuckooSubFilter) altIndex(i uint64, fp uint16) uint64 {
+	return (i ^ (uint64(fp) * 0x5bd1e995)) & (f.numBuckets - 1)
+}
+
+func (f *cuckooSubFilter) insertInto(i uint64, fp uint16) bool {
+	b := f.bucket(i)
+	for j := range b {
+		if b[j] == 0 {
+			b[j] = fp
+			return true
+		}
+	}
+	return false
+}
+
+func (f *cuckooSubFilter) count(i uint64, fp uint16) uint64 {
+	n := uint64(0)
+	for _, slot := range f.bucket(i) {
+		if slot == fp {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *cuckooSubFilter) remove(i uint64, fp uint16) bool {
+	b := f.bucket(i)
+	for j := range b {
+		if b[j] == fp {
+			b[j] = 0
+			return true
+		}
+	}
+	return false
+}
+
+// Cuckoo is a cuckoo filter, as described by Fan et al. in "Cuckoo Filter:
+// Practically Better Than Bloom" (https://www.cs.cmu.edu/~dga/papers/cuckoo-conext2014.pdf).
+//
+// Like a Bloom filter it answers whether an item may have been added, with
+// false positives but no false negatives. Unlike a Bloom filter it stores a
+// fingerprint of every item in one of two buckets, so items can be counted
+// and deleted. An item is inserted by evicting fingerprints to their other
+// bucket until one finds a free slot; when none does, a new and larger
+// sub-filter is chained to the filter.
+//
+// Deleting an item that was never added may delete the fingerprint of
+// another item that shares it, which then stops being found.
+type Cuckoo struct {
+	opts     CuckooOpts
+	filters  []*cuckooSubFilter
+	inserted uint64
+	deleted  uint64
+	rng      uint64
+}
+
+func NewCuckoo(opts CuckooOpts) *Cuckoo {
+	return &Cuckoo{
+		opts:    opts,
+		filters: []*cuckooSubFilter{newCuckooSubFilter(opts.Capacity, opts.BucketSize)},
+		rng:     cuckooSeed,
+	}
+}
+
+// cuckooHash returns the hash of an item and its fingerprint, which is
+// never 0.
+func cuckooHash(item string) (h uint64, fp uint16) {
+	h = xxhash.Sum64String(item)
+	fp = uint16(h >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+	return h, fp
+}
+
+// random returns the next number of a xorshift64* generator.
+func (c *Cuckoo) random() uint64 {
+	c.rng ^= c.rng >> 12
+	c.rng ^= c.rng << 25
+	c.rng ^= c.rng >> 27
+	return c.rng * 2685821657736338717
+}
+
+// Add adds an item to the filter, which may then hold it more than once.
+// Returns ErrCuckooFull if the filter cannot expand and has no room left.
+func (c *Cuckoo) Add(item string) error {
+	h, fp := cuckooHash(item)
+	for _, f := range c.filters {
+		i1, i2 := f.indexes(h, fp)
+		if f.insertInto(i1, fp) || f.insertInto(i2, fp) {
+			c.inserted++
+			return nil
+		}
+	}
+
+	last := c.filters[len(c.filters)-1]
+	if c.kickInsert(last, h, fp) {
+		c.inserted++
+		return nil
+	}
+	if c.opts.Expansion == 0 {
+		return ErrCuckooFull
+	}
+	f := newCuckooSubFilter(last.capacity()*c.opts.Expansion, c.opts.BucketSize)
+	c.filters = append(c.filters, f)
+	i1, _ := f.indexes(h, fp)
+	f.insertInto(i1, fp)
+	c.inserted++
+	return nil
+}
+
+// kickInsert inserts fp into a full bucket of f by evicting fingerprints
+// to their other bucket, up to MaxIterations times. If no evicted
+// fingerprint finds a free slot, the evictions are undone and false is
+// returned.
+func (c *Cuckoo) kickInsert(f *cuckooSubFilter, h uint64, fp uint16) bool {
+	type eviction struct {
+		bucket uint64
+		slot   uint64
+		fp     uint16
+	}
+
+	i1, i2 := f.indexes(h, fp)
+	i := i1
+	if c.random()%2 == 1 {
+		i = i2
+	}
+	evictions := make([]eviction, 0, c.opts.MaxIterations)
+	for n := uint64(0); n < c.opts.MaxIterations; n++ {
+		slot := c.random() % f.bucketSize
+		b := f.bucket(i)
+		evictions = append(evictions, eviction{bucket: i, slot: slot, fp: b[slot]})
+		fp, b[slot] = b[slot], fp
+		i = f.altIndex(i, fp)
+		if f.insertInto(i, fp) {
+			return true
+		}
+	}
+	for n := len(evictions) - 1; n >= 0; n-- {
+		e := evictions[n]
+		f.bucket(e.bucket)[e.slot] = e.fp
+	}
+	return false
+}
+
+// Exists reports whether the item may have been added to the filter. It
+// returns false if the item certainly was not.
+func (c *Cuckoo) Exists(item string) bool {
+	h, fp := cuckooHash(item)
+	for _, f := range c.filters {
+		i1, i2 := f.indexes(h, fp)
+		if f.count(i1, fp) > 0 || f.count(i2, fp) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of times the item may have been added to the
+// filter and not deleted since. It never undercounts.
+func (c *Cuckoo) Count(item string) uint64 {
+	h, fp := cuckooHash(item)
+	n := uint64(0)
+	for _, f := range c.filters {
+		i1, i2 := f.indexes(h, fp)
+		n += f.count(i1, fp)
+		if i2 != i1 {
+			n += f.count(i2, fp)
+		}
+	}
+	return n
+}
+
+// Delete removes one occurrence of the item from the filter, looking in
+// the newest sub-filters first. Returns false if the item is not found.
+func (c *Cuckoo) Delete(item string) bool {
+	h, fp := cuckooHash(item)
+	for n := len(c.filters) - 1; n >= 0; n-- {
+		f := c.filters[n]
+		i1, i2 := f.indexes(h, fp)
+		if f.remove(i1, fp) || f.remove(i2, fp) {
+			c.deleted++
+			return true
+		}
+	}
+	return false
+}
+
+// Info returns the parameters and metadata of the filter as name, value
+// pairs.
+func (c *Cuckoo) Info() []interface{} {
+	buckets := uint64(0)
+	for _, f := range c.filters {
+		buckets += f.numBuckets
+	}
+	return []interface{}{
+		"Size", buckets * c.opts.BucketSize * 2,
+		"Number of buckets", buckets,
+		"Number of filters", len(c.filters),
+		"Number of items inserted", c.inserted - c.deleted,
+		"Number of items deleted", c.deleted,
+		"Bucket size", c.opts.BucketSize,
+		"Expansion rate", c.opts.Expansion,
+		"Max iterations", c.opts.MaxIterations,
+	}
+}
+
+// DeepCopy returns a copy of the filter that shares no state with c.
+func (c *Cuckoo) DeepCopy() *Cuckoo {
+	filters := make([]*cuckooSubFilter, len(c.filters))
+	for i, f := range c.filters {
+		filters[i] = &cuckooSubFilter{
+			numBuckets: f.numBuckets,
+			bucketSize: f.bucketSize,
+			slots:      append([]uint16(nil), f.slots...),
+		}
+	}
+	return &Cuckoo{
+		opts:     c.opts,
+		filters:  filters,
+		inserted: c.inserted,
+		deleted:  c.deleted,
+		rng:      c.rng,
+	}
+}
+
+// Serialize encodes the filter into buf.
+func (c *Cuckoo) Serialize(buf *bytes.Buffer) error {
+	header := []uint64{
+		c.opts.Capacity, c.opts.BucketSize, c.opts.MaxIterations, c.opts.Expansion,
+		c.inserted, c.deleted, c.rng, uint64(len(c.filters)),
+	}
+	if err := binary.Write(buf, binary.BigEndian, header); err != nil {
+		return err
+	}
+	for _, f := range c.filters {
+		if err := binary.Write(buf, binary.BigEndian, f.numBuckets); err != nil {
+			return err
+		}
+		if err := binary.Write(buf, binary.BigEndian, f.slots); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeserializeCuckoo decodes a filter encoded by Serialize from buf.
+func DeserializeCuckoo(buf *bytes.Reader) (*Cuckoo, error) {
+	header := make([]uint64, 8)
+	if err := binary.Read(buf, binary.BigEndian, header); err != nil {
+		return nil, err
+	}
+	c := &Cuckoo{
+		opts: CuckooOpts{
+			Capacity:      header[0],
+			BucketSize:    header[1],
+			MaxIterations: header[2],
+			Expansion:     header[3],
+		},
+		inserted: header[4],
+		deleted:  header[5],
+		rng:      header[6],
+	}
+	if c.opts.BucketSize == 0 || c.opts.BucketSize > MaxCuckooBucketSize ||
+		c.opts.MaxIterations > MaxCuckooMaxIterations || c.opts.Expansion > MaxCuckooExpansion ||
+		header[7] == 0 || header[7] > uint64(buf.Len()) {
+		return nil, errors.New("invalid cuckoo filter")
+	}
+
+	for n := uint64(0); n < header[7]; n++ {
+		f := &cuckooSubFilter{bucketSize: c.opts.BucketSize}
+		if err := binary.Read(buf, binary.BigEndian, &f.numBuckets); err != nil {
+			return nil, err
+		}
+		if f.numBuckets == 0 || f.numBuckets&(f.numBuckets-1) != 0 || f.numBuckets > uint64(buf.Len()/2)/f.bucketSize {
+			return nil, errors.New("invalid cuckoo filter")
+		}
+		f.slots = make([]uint16, f.numBuckets*f.bucketSize)
+		if err := binary.Read(buf, binary.BigEndian, f.slots); err != nil {
+			return nil, err
+		}
+		c.filters = append(c.filters, f)
+	}
+	return c, nil
+}
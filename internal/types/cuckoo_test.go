@@ -0,0 +1,90 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCuckooAddDelete(t *testing.T) {
+	cf := types.NewCuckoo(types.DefaultCuckooOpts())
+
+	assert.NoError(t, cf.Add("a"))
+	assert.NoError(t, cf.Add("a"))
+	assert.True(t, cf.Exists("a"))
+	assert.False(t, cf.Exists("b"))
+	assert.Equal(t, uint64(2), cf.Count("a"))
+
+	assert.True(t, cf.Delete("a"))
+	assert.True(t, cf.Exists("a"))
+	assert.True(t, cf.Delete("a"))
+	assert.False(t, cf.Exists("a"))
+	assert.False(t, cf.Delete("a"))
+}
+
+func TestCuckooExpansion(t *testing.T) {
+	cf := types.NewCuckoo(types.CuckooOpts{Capacity: 64, BucketSize: 2, MaxIterations: 20, Expansion: 2})
+	for i := 0; i < 1000; i++ {
+		assert.NoError(t, cf.Add(strconv.Itoa(i)))
+	}
+	// No item is lost while fingerprints move and filters are chained.
+	for i := 0; i < 1000; i++ {
+		assert.True(t, cf.Exists(strconv.Itoa(i)), i)
+	}
+	info := cf.Info()
+	assert.Equal(t, "Number of filters", info[4])
+	assert.Greater(t, info[5], 1)
+
+	for i := 0; i < 1000; i++ {
+		assert.True(t, cf.Delete(strconv.Itoa(i)), i)
+	}
+	info = cf.Info()
+	assert.Equal(t, uint64(0), info[7])
+	assert.Equal(t, uint64(1000), info[9])
+}
+
+func TestCuckooFull(t *testing.T) {
+	cf := types.NewCuckoo(types.CuckooOpts{Capacity: 8, BucketSize: 2, MaxIterations: 10, Expansion: 0})
+	var err error
+	added := 0
+	for i := 0; i < 100 && err == nil; i++ {
+		if err = cf.Add(strconv.Itoa(i)); err == nil {
+			added++
+		}
+	}
+	assert.ErrorIs(t, err, types.ErrCuckooFull)
+	assert.LessOrEqual(t, added, 8)
+	// A failed insertion leaves the items already added in place.
+	for i := 0; i < added; i++ {
+		assert.True(t, cf.Exists(strconv.Itoa(i)), i)
+	}
+}
+
+func TestCuckooSerialize(t *testing.T) {
+	cf := types.NewCuckoo(types.CuckooOpts{Capacity: 16, BucketSize: 2, MaxIterations: 20, Expansion: 1})
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, cf.Add(strconv.Itoa(i)))
+	}
+	cf.Delete("0")
+
+	var buf bytes.Buffer
+	assert.NoError(t, cf.Serialize(&buf))
+	restored, err := types.DeserializeCuckoo(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, cf.Info(), restored.Info())
+
+	// Both filters move the same fingerprints on the next insertions.
+	for i := 100; i < 200; i++ {
+		assert.NoError(t, cf.Add(strconv.Itoa(i)))
+		assert.NoError(t, restored.Add(strconv.Itoa(i)))
+	}
+	assert.Equal(t, cf, restored)
+	assert.NotSame(t, cf, cf.DeepCopy())
+	assert.Equal(t, cf, cf.DeepCopy())
+}
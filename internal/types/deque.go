@@ -1,19 +1,23 @@
 // Copyright (c) 2022-present, DiceDB contributors
 // All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
 
-package eval
+package types
 
 import (
 	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 
 	"github.com/dicedb/dice/internal/dencoding"
 )
 
-var ErrDequeEmpty = errors.New("deque is empty")
+var (
+	ErrDequeEmpty           = errors.New("deque is empty")
+	ErrDequeIndexOutOfRange = errors.New("index out of range")
+)
 
 type DequeI interface {
 	GetLength() int64
@@ -245,6 +249,15 @@ func NewDeque() *Deque {
 	}
 }
 
+// DeepCopy returns a copy of the deque that shares no state with q.
+func (q *Deque) DeepCopy() *Deque {
+	return &Deque{
+		Length:  q.Length,
+		list:    q.list.DeepCopy(),
+		leftIdx: q.leftIdx,
+	}
+}
+
 func (q *Deque) GetLength() int64 {
 	return q.Length
 }
@@ -562,6 +575,61 @@ func (q *Deque) LRange(start, stop int64) ([]string, error) {
 	return res, nil
 }
 
+// Returns the element at index. Negative indices count from the end of the Deque.
+func (q *Deque) LIndex(index int64) (string, error) {
+	if index < 0 {
+		index += q.Length
+	}
+	if index < 0 || index >= q.Length {
+		return "", ErrDequeIndexOutOfRange
+	}
+
+	qIterator := q.NewIterator()
+	for qIterator.ElementsTraversed < index {
+		if _, err := qIterator.Next(); err != nil {
+			return "", err
+		}
+	}
+	return qIterator.Next()
+}
+
+// Replaces the element at index. Negative indices count from the end of the Deque.
+// Since entries are variable length, the Deque is re-encoded with the new element.
+func (q *Deque) LSet(index int64, element string) error {
+	if index < 0 {
+		index += q.Length
+	}
+	if index < 0 || index >= q.Length {
+		return ErrDequeIndexOutOfRange
+	}
+
+	elements, err := q.LRange(0, -1)
+	if err != nil {
+		return err
+	}
+	elements[index] = element
+	q.reset(elements)
+	return nil
+}
+
+// Trims the Deque so that it only holds the elements between start and stop, both inclusive.
+func (q *Deque) LTrim(start, stop int64) error {
+	elements, err := q.LRange(start, stop)
+	if err != nil {
+		return err
+	}
+	q.reset(elements)
+	return nil
+}
+
+// Replaces the contents of the Deque with the elements, in order.
+func (q *Deque) reset(elements []string) {
+	*q = *NewDeque()
+	for _, x := range elements {
+		q.RPush(x)
+	}
+}
+
 type DequeIterator struct {
 	deque             *Deque
 	CurrentNode       *byteListNode
@@ -974,8 +1042,11 @@ func deserializeByteList(buf *bytes.Reader) (*byteList, error) {
 		}
 
 		// Read the buffer data
+		if bufSize < 0 || int(bufSize) > buf.Len() {
+			return nil, ErrInvalidSerialization
+		}
 		nodeBuf := make([]byte, bufSize)
-		_, err = buf.Read(nodeBuf)
+		_, err = io.ReadFull(buf, nodeBuf)
 		if err != nil {
 			return nil, err
 		}
@@ -1,7 +1,7 @@
 // Copyright (c) 2022-present, DiceDB contributors
 // All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
 
-package eval_test
+package types_test
 
 import (
 	"fmt"
@@ -11,7 +11,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/dicedb/dice/internal/eval"
+	"github.com/dicedb/dice/internal/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -72,24 +72,24 @@ func TestDeqEncodeEntryString(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		x, _ := eval.DecodeDeqEntry(eval.EncodeDeqEntry(tc))
+		x, _ := types.DecodeDeqEntry(types.EncodeDeqEntry(tc))
 		assert.Equal(t, tc, x)
 	}
 }
 
-func dequeRPushIntStrMany(howmany int, deq eval.DequeI) {
+func dequeRPushIntStrMany(howmany int, deq types.DequeI) {
 	for i := 0; i < howmany; i++ {
 		deq.RPush(strconv.FormatInt(int64(i), 10))
 	}
 }
 
-func dequeLPushIntStrMany(howmany int, deq eval.DequeI) {
+func dequeLPushIntStrMany(howmany int, deq types.DequeI) {
 	for i := 0; i < howmany; i++ {
 		deq.LPush(strconv.FormatInt(int64(i), 10))
 	}
 }
 
-func dequeLInsertIntStrMany(howMany int, beforeAfter string, deq eval.DequeI) {
+func dequeLInsertIntStrMany(howMany int, beforeAfter string, deq types.DequeI) {
 	const pivot string = "10"
 	const element string = "50"
 	deq.LPush(pivot)
@@ -100,107 +100,107 @@ func dequeLInsertIntStrMany(howMany int, beforeAfter string, deq eval.DequeI) {
 
 func BenchmarkBasicDequeLInsertBefore2000(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeLInsertIntStrMany(2000, "before", eval.NewBasicDeque())
+		dequeLInsertIntStrMany(2000, "before", types.NewBasicDeque())
 	}
 }
 
 func BenchmarkBasicDequeLInsertAfter2000(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeLInsertIntStrMany(2000, "after", eval.NewBasicDeque())
+		dequeLInsertIntStrMany(2000, "after", types.NewBasicDeque())
 	}
 }
 
 func BenchmarkDequeLInsertBefore2000(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeLInsertIntStrMany(2000, "before", eval.NewDeque())
+		dequeLInsertIntStrMany(2000, "before", types.NewDeque())
 	}
 }
 
 func BenchmarkDequeLInsertAfter2000(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeLInsertIntStrMany(2000, "after", eval.NewDeque())
+		dequeLInsertIntStrMany(2000, "after", types.NewDeque())
 	}
 }
 
 func BenchmarkBasicDequeRPush20(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeRPushIntStrMany(20, eval.NewBasicDeque())
+		dequeRPushIntStrMany(20, types.NewBasicDeque())
 	}
 }
 
 func BenchmarkBasicDequeRPush200(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeRPushIntStrMany(200, eval.NewBasicDeque())
+		dequeRPushIntStrMany(200, types.NewBasicDeque())
 	}
 }
 
 func BenchmarkBasicDequeRPush2000(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeRPushIntStrMany(2000, eval.NewBasicDeque())
+		dequeRPushIntStrMany(2000, types.NewBasicDeque())
 	}
 }
 
 func BenchmarkDequeRPush20(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeRPushIntStrMany(20, eval.NewDeque())
+		dequeRPushIntStrMany(20, types.NewDeque())
 	}
 }
 
 func BenchmarkDequeRPush200(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeRPushIntStrMany(200, eval.NewDeque())
+		dequeRPushIntStrMany(200, types.NewDeque())
 	}
 }
 
 func BenchmarkDequeRPush2000(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeRPushIntStrMany(2000, eval.NewDeque())
+		dequeRPushIntStrMany(2000, types.NewDeque())
 	}
 }
 
 func BenchmarkDequeLPush20(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeLPushIntStrMany(20, eval.NewDeque())
+		dequeLPushIntStrMany(20, types.NewDeque())
 	}
 }
 
 func BenchmarkDequeLPush200(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeLPushIntStrMany(200, eval.NewDeque())
+		dequeLPushIntStrMany(200, types.NewDeque())
 	}
 }
 
 func BenchmarkDequeLPush2000(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		dequeLPushIntStrMany(2000, eval.NewDeque())
+		dequeLPushIntStrMany(2000, types.NewDeque())
 	}
 }
 
 func TestLRange(t *testing.T) {
 	testCases := []struct {
 		name           string
-		dq             eval.DequeI
+		dq             types.DequeI
 		input          []string
 		expectedOutput []string
 		start          int64
 		stop           int64
 	}{
-		{"DequeWithStartStopPositiveAndInRange", eval.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 2},
-		{"DequeWhereStopIsOutOfRange", eval.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 20},
-		{"DequeWhereStartIsOutOfRange", eval.NewDeque(), []string{"a", "b", "c"}, []string{}, 10, 2},
-		{"DequeWhereStartIsNegative", eval.NewDeque(), []string{"a", "b", "c"}, []string{"b", "a"}, -2, 2},
-		{"DequeWhereStartIsNegativeOutOfRange", eval.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, -20, 2},
-		{"DequeWhereStopIsNegative", eval.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b"}, 0, -2},
-		{"DequeWhereStopIsNegativeOutOfRange", eval.NewDeque(), []string{"a", "b", "c"}, []string{}, 0, -4},
-		{"DequeWhereStartGreaterThanStop", eval.NewDeque(), []string{"a", "b", "c"}, []string{}, 2, 0},
-		{"BasicDequeWithStartStopPositiveAndInRange", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 2},
-		{"BasicDequeWhereStopIsOutOfRange", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 20},
-		{"BasicDequeWhereStartIsOutOfRange", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{}, 10, 2},
-		{"BasicDequeWhereStartIsNegative", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{"b", "a"}, -2, 2},
-		{"BasicDequeWhereStartIsNegativeOutOfRange", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, -20, 2},
-		{"BasicDequeWhereStopIsNegative", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b"}, 0, -2},
-		{"BasicDequeWhereStopIsNegativeOutOfRange", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{}, 0, -4},
-		{"BasicDequeWhereStartGreaterThanStop", eval.NewBasicDeque(), []string{"a", "b", "c"}, []string{}, 2, 0},
+		{"DequeWithStartStopPositiveAndInRange", types.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 2},
+		{"DequeWhereStopIsOutOfRange", types.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 20},
+		{"DequeWhereStartIsOutOfRange", types.NewDeque(), []string{"a", "b", "c"}, []string{}, 10, 2},
+		{"DequeWhereStartIsNegative", types.NewDeque(), []string{"a", "b", "c"}, []string{"b", "a"}, -2, 2},
+		{"DequeWhereStartIsNegativeOutOfRange", types.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, -20, 2},
+		{"DequeWhereStopIsNegative", types.NewDeque(), []string{"a", "b", "c"}, []string{"c", "b"}, 0, -2},
+		{"DequeWhereStopIsNegativeOutOfRange", types.NewDeque(), []string{"a", "b", "c"}, []string{}, 0, -4},
+		{"DequeWhereStartGreaterThanStop", types.NewDeque(), []string{"a", "b", "c"}, []string{}, 2, 0},
+		{"BasicDequeWithStartStopPositiveAndInRange", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 2},
+		{"BasicDequeWhereStopIsOutOfRange", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, 0, 20},
+		{"BasicDequeWhereStartIsOutOfRange", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{}, 10, 2},
+		{"BasicDequeWhereStartIsNegative", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{"b", "a"}, -2, 2},
+		{"BasicDequeWhereStartIsNegativeOutOfRange", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b", "a"}, -20, 2},
+		{"BasicDequeWhereStopIsNegative", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{"c", "b"}, 0, -2},
+		{"BasicDequeWhereStopIsNegativeOutOfRange", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{}, 0, -4},
+		{"BasicDequeWhereStartGreaterThanStop", types.NewBasicDeque(), []string{"a", "b", "c"}, []string{}, 2, 0},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -214,13 +214,68 @@ func TestLRange(t *testing.T) {
 	}
 }
 
+func TestDequeLIndex(t *testing.T) {
+	dq := types.NewDeque()
+	dequeRPushIntStrMany(1000, dq)
+
+	testCases := []struct {
+		index    int64
+		expected string
+		err      error
+	}{
+		{0, "0", nil},
+		{999, "999", nil},
+		{500, "500", nil},
+		{-1, "999", nil},
+		{-1000, "0", nil},
+		{1000, "", types.ErrDequeIndexOutOfRange},
+		{-1001, "", types.ErrDequeIndexOutOfRange},
+	}
+	for _, tc := range testCases {
+		x, err := dq.LIndex(tc.index)
+		assert.Equal(t, tc.err, err)
+		assert.Equal(t, tc.expected, x)
+	}
+}
+
+func TestDequeLSet(t *testing.T) {
+	deqTestInit()
+	dq := types.NewDeque()
+	dequeRPushIntStrMany(100, dq)
+
+	assert.Nil(t, dq.LSet(0, "first"))
+	assert.Nil(t, dq.LSet(-1, deqRandStr(1<<12)))
+	assert.Nil(t, dq.LSet(50, "-9223372036854775808"))
+	assert.Equal(t, types.ErrDequeIndexOutOfRange, dq.LSet(100, "x"))
+
+	assert.Equal(t, int64(100), dq.GetLength())
+	x, _ := dq.LIndex(0)
+	assert.Equal(t, "first", x)
+	x, _ = dq.LIndex(50)
+	assert.Equal(t, "-9223372036854775808", x)
+	x, _ = dq.LIndex(51)
+	assert.Equal(t, "51", x)
+}
+
+func TestDequeLTrim(t *testing.T) {
+	dq := types.NewDeque()
+	dequeRPushIntStrMany(10, dq)
+
+	assert.Nil(t, dq.LTrim(2, -3))
+	output, _ := dq.LRange(0, -1)
+	assert.Equal(t, []string{"2", "3", "4", "5", "6", "7"}, output)
+
+	assert.Nil(t, dq.LTrim(5, 1))
+	assert.Equal(t, int64(0), dq.GetLength())
+}
+
 func TestLInsertOnInvalidOperationTypeReturnsError(t *testing.T) {
 	testCases := []struct {
 		name string
-		dq   eval.DequeI
+		dq   types.DequeI
 	}{
-		{"WithDeque", eval.NewDeque()},
-		{"WithBasicDeque", eval.NewBasicDeque()},
+		{"WithDeque", types.NewDeque()},
+		{"WithBasicDeque", types.NewBasicDeque()},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -239,7 +294,7 @@ func TestLInsertOnInvalidOperationTypeReturnsError(t *testing.T) {
 }
 
 func TestLInsertBasicDeque(t *testing.T) {
-	dq := eval.NewBasicDeque()
+	dq := types.NewBasicDeque()
 	dq.RPush("a")
 	dq.RPush("b")
 	dq.RPush("c")
@@ -283,13 +338,13 @@ func TestLInsertBasicDeque(t *testing.T) {
 }
 
 type DequeLInsertFixture struct {
-	dq                   *eval.Deque
+	dq                   *types.Deque
 	initialElements      []string
 	elementsToBeInserted []string
 }
 
 func newDequeLInsertFixture() *DequeLInsertFixture {
-	dq := eval.NewDeque()
+	dq := types.NewDeque()
 	initElements := []string{deqRandStr(10), deqRandStr(100), deqRandStr(250), deqRandStr(150), deqRandStr(200)}
 	for _, elem := range initElements {
 		dq.LPush(elem)
@@ -454,3 +509,22 @@ func TestLInsertAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestDequeDeepCopy(t *testing.T) {
+	q := types.NewDeque()
+	for _, x := range []string{"a", "b", "c"} {
+		q.RPush(x)
+	}
+
+	c := q.DeepCopy()
+	_, err := c.LPop()
+	assert.Nil(t, err)
+	c.RPush("d")
+
+	values, err := q.LRange(0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+	values, err = c.LRange(0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b", "c", "d"}, values)
+}
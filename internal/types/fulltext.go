@@ -0,0 +1,666 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	ErrFTQuerySyntax = errors.New("syntax error in full-text query")
+	ErrFTQueryEmpty  = errors.New("the full-text query has no terms")
+)
+
+// BM25 parameters: bm25K1 limits how much repeating a term raises the score
+// of a document and bm25B how much longer documents are penalized.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Token is a term of a text and the byte offsets of the word it comes from.
+type Token struct {
+	Term       string
+	Start, End int
+}
+
+// Tokenize splits text into words, made of letters and digits, and
+// normalizes every word into a term: lowercased and without diacritics, so
+// that "Café" and "cafe" match. Words are not stemmed.
+func Tokenize(text string) []Token {
+	tokens := []Token{}
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = appendToken(tokens, text, start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = appendToken(tokens, text, start, len(text))
+	}
+	return tokens
+}
+
+func appendToken(tokens []Token, text string, start, end int) []Token {
+	if term := normalizeTerm(text[start:end]); term != "" {
+		tokens = append(tokens, Token{Term: term, Start: start, End: end})
+	}
+	return tokens
+}
+
+func normalizeTerm(word string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(word) {
+		if !unicode.Is(unicode.Mn, r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// FullTextField is a field of the documents of a full-text index. The
+// occurrences of a term in a field count Weight times towards the score.
+type FullTextField struct {
+	Name   string
+	Weight float64
+}
+
+// ftPosting records the occurrences of a term in a document.
+type ftPosting struct {
+	freq      float64 // the occurrences weighted by the weight of their field
+	positions []int
+}
+
+// ftDoc is what the index keeps about a document to remove it.
+type ftDoc struct {
+	length float64
+	terms  []string
+}
+
+// FullTextIndex is an inverted index mapping every term to the documents it
+// occurs in, with the positions of its occurrences for phrase queries.
+// Documents are ranked with BM25.
+type FullTextIndex struct {
+	Name   string
+	Fields []FullTextField
+
+	mu          sync.RWMutex
+	postings    map[string]map[string]*ftPosting
+	docs        map[string]ftDoc
+	totalLength float64
+}
+
+func NewFullTextIndex(name string, fields []FullTextField) *FullTextIndex {
+	return &FullTextIndex{
+		Name:     name,
+		Fields:   fields,
+		postings: map[string]map[string]*ftPosting{},
+		docs:     map[string]ftDoc{},
+	}
+}
+
+// Len returns the number of documents in the index.
+func (idx *FullTextIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Update indexes the text of every field of the document stored at key,
+// replacing what was previously indexed for it. texts maps the name of a
+// field to its text; fields the document does not have are absent.
+func (idx *FullTextIndex) Update(key string, texts map[string]string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(key)
+	doc := ftDoc{}
+	position := 0
+	for _, f := range idx.Fields {
+		text, ok := texts[f.Name]
+		if !ok {
+			continue
+		}
+		for _, t := range Tokenize(text) {
+			docs := idx.postings[t.Term]
+			if docs == nil {
+				docs = map[string]*ftPosting{}
+				idx.postings[t.Term] = docs
+			}
+			p := docs[key]
+			if p == nil {
+				p = &ftPosting{}
+				docs[key] = p
+				doc.terms = append(doc.terms, t.Term)
+			}
+			p.freq += f.Weight
+			p.positions = append(p.positions, position)
+			doc.length += f.Weight
+			position++
+		}
+		// Skipping a position keeps a phrase from matching across fields.
+		position++
+	}
+	if len(doc.terms) == 0 {
+		return
+	}
+	idx.docs[key] = doc
+	idx.totalLength += doc.length
+}
+
+// Remove removes the document stored at key from the index.
+func (idx *FullTextIndex) Remove(key string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(key)
+}
+
+func (idx *FullTextIndex) remove(key string) {
+	doc, ok := idx.docs[key]
+	if !ok {
+		return
+	}
+	for _, term := range doc.terms {
+		delete(idx.postings[term], key)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.docs, key)
+	idx.totalLength -= doc.length
+}
+
+// FTStats are the corpus statistics BM25 ranks documents with. Every shard
+// computes them over its own documents and the sum is used to rank the
+// documents of all shards, so that their scores are comparable.
+type FTStats struct {
+	Docs        int
+	TotalLength float64
+	DocFreq     map[string]int // the number of documents every term of the query occurs in
+}
+
+// Merge adds the statistics of another part of the corpus to s.
+func (s *FTStats) Merge(o FTStats) {
+	s.Docs += o.Docs
+	s.TotalLength += o.TotalLength
+	if s.DocFreq == nil {
+		s.DocFreq = map[string]int{}
+	}
+	for term, n := range o.DocFreq {
+		s.DocFreq[term] += n
+	}
+}
+
+// Stats returns the statistics of the documents of the index for the terms
+// of the query.
+func (idx *FullTextIndex) Stats(q *FTQuery) FTStats {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	stats := FTStats{Docs: len(idx.docs), TotalLength: idx.totalLength, DocFreq: map[string]int{}}
+	for _, term := range idx.scoredTerms(q) {
+		stats.DocFreq[term] = len(idx.postings[term])
+	}
+	return stats
+}
+
+// FTMatch is a document matching a query and its BM25 score.
+type FTMatch struct {
+	Key   string
+	Score float64
+}
+
+// Search returns the documents matching the query, ranked with BM25 over
+// the statistics stats, best first. Documents with the same score are
+// sorted by key.
+func (idx *FullTextIndex) Search(q *FTQuery, stats FTStats) []FTMatch {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	terms := idx.scoredTerms(q)
+	avgLength := 0.0
+	if stats.Docs > 0 {
+		avgLength = stats.TotalLength / float64(stats.Docs)
+	}
+
+	matches := []FTMatch{}
+	for key := range idx.match(q.root) {
+		doc := idx.docs[key]
+		score := 0.0
+		for _, term := range terms {
+			p := idx.postings[term][key]
+			if p == nil {
+				continue
+			}
+			n := float64(stats.DocFreq[term])
+			idf := math.Log(1 + (float64(stats.Docs)-n+0.5)/(n+0.5))
+			lengthNorm := 1.0
+			if avgLength > 0 {
+				lengthNorm = 1 - bm25B + bm25B*doc.length/avgLength
+			}
+			score += idf * p.freq * (bm25K1 + 1) / (p.freq + bm25K1*lengthNorm)
+		}
+		matches = append(matches, FTMatch{Key: key, Score: score})
+	}
+	SortFTMatches(matches)
+	return matches
+}
+
+// SortFTMatches sorts matches by score, best first, and then by key.
+func SortFTMatches(matches []FTMatch) {
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Key < matches[j].Key
+	})
+}
+
+// scoredTerms returns the terms of the index that the positive parts of the
+// query refer to, with prefixes expanded.
+func (idx *FullTextIndex) scoredTerms(q *FTQuery) []string {
+	seen := map[string]bool{}
+	terms := []string{}
+	add := func(term string) {
+		if !seen[term] {
+			seen[term] = true
+			terms = append(terms, term)
+		}
+	}
+	for _, t := range q.terms {
+		if !t.prefix {
+			add(t.term)
+			continue
+		}
+		for term := range idx.postings {
+			if strings.HasPrefix(term, t.term) {
+				add(term)
+			}
+		}
+	}
+	sort.Strings(terms)
+	return terms
+}
+
+// match returns the keys of the documents matching a node of the query.
+func (idx *FullTextIndex) match(node ftNode) map[string]struct{} {
+	keys := map[string]struct{}{}
+	switch n := node.(type) {
+	case *ftTerm:
+		if !n.prefix {
+			for key := range idx.postings[n.term] {
+				keys[key] = struct{}{}
+			}
+			break
+		}
+		for term, docs := range idx.postings {
+			if strings.HasPrefix(term, n.term) {
+				for key := range docs {
+					keys[key] = struct{}{}
+				}
+			}
+		}
+	case *ftPhrase:
+		for key := range idx.postings[n.terms[0]] {
+			if idx.hasPhrase(key, n.terms) {
+				keys[key] = struct{}{}
+			}
+		}
+	case *ftOr:
+		for _, child := range n.children {
+			for key := range idx.match(child) {
+				keys[key] = struct{}{}
+			}
+		}
+	case *ftAnd:
+		var include map[string]struct{}
+		for _, child := range n.children {
+			if _, ok := child.(*ftNot); ok {
+				continue
+			}
+			childKeys := idx.match(child)
+			if include == nil {
+				include = childKeys
+				continue
+			}
+			for key := range include {
+				if _, ok := childKeys[key]; !ok {
+					delete(include, key)
+				}
+			}
+		}
+		if include == nil {
+			// Only negations: they exclude documents from all of them.
+			include = idx.allKeys()
+		}
+		for _, child := range n.children {
+			if not, ok := child.(*ftNot); ok {
+				for key := range idx.match(not.child) {
+					delete(include, key)
+				}
+			}
+		}
+		keys = include
+	case *ftNot:
+		keys = idx.allKeys()
+		for key := range idx.match(n.child) {
+			delete(keys, key)
+		}
+	}
+	return keys
+}
+
+func (idx *FullTextIndex) allKeys() map[string]struct{} {
+	keys := make(map[string]struct{}, len(idx.docs))
+	for key := range idx.docs {
+		keys[key] = struct{}{}
+	}
+	return keys
+}
+
+// hasPhrase reports whether the terms occur one after the other in the
+// document stored at key.
+func (idx *FullTextIndex) hasPhrase(key string, terms []string) bool {
+	for _, start := range idx.postings[terms[0]][key].positions {
+		found := true
+		for i, term := range terms[1:] {
+			p := idx.postings[term][key]
+			if p == nil {
+				return false
+			}
+			j := sort.SearchInts(p.positions, start+i+1)
+			if j == len(p.positions) || p.positions[j] != start+i+1 {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// ftNode is a node of a parsed full-text query.
+type ftNode interface{}
+
+type ftTerm struct {
+	term   string
+	prefix bool
+}
+
+type ftPhrase struct {
+	terms []string
+}
+
+type ftAnd struct {
+	children []ftNode
+}
+
+type ftOr struct {
+	children []ftNode
+}
+
+type ftNot struct {
+	child ftNode
+}
+
+// FTQuery is a parsed full-text query.
+type FTQuery struct {
+	root ftNode
+	// terms are the terms of the query that are not negated, which score
+	// and highlight the documents.
+	terms []*ftTerm
+}
+
+// ParseFTQuery parses a full-text query. Words separated by spaces must all
+// occur in a document, in any order:
+//
+//	word           a document with the word
+//	wor*           a document with a word starting with wor
+//	"two words"    a document with the words one after the other
+//	a | b, a OR b  a document with a or b
+//	a AND b        the same as a b
+//	-a, NOT a      a document without a
+//	(a | b) c      parentheses group expressions
+//
+// A query needs a word that is not negated, which ranks the documents.
+func ParseFTQuery(query string) (*FTQuery, error) {
+	p := &ftParser{tokens: lexFTQuery(query)}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, ErrFTQuerySyntax
+	}
+	q := &FTQuery{root: root}
+	q.collectTerms(root, false)
+	if len(q.terms) == 0 {
+		return nil, ErrFTQueryEmpty
+	}
+	return q, nil
+}
+
+func (q *FTQuery) collectTerms(node ftNode, negated bool) {
+	switch n := node.(type) {
+	case *ftTerm:
+		if !negated {
+			q.terms = append(q.terms, n)
+		}
+	case *ftPhrase:
+		if !negated {
+			for _, term := range n.terms {
+				q.terms = append(q.terms, &ftTerm{term: term})
+			}
+		}
+	case *ftAnd:
+		for _, child := range n.children {
+			q.collectTerms(child, negated)
+		}
+	case *ftOr:
+		for _, child := range n.children {
+			q.collectTerms(child, negated)
+		}
+	case *ftNot:
+		q.collectTerms(n.child, !negated)
+	}
+}
+
+// Highlight wraps the words of text that match the terms of the query that
+// are not negated between open and close.
+func (q *FTQuery) Highlight(text, open, close string) string {
+	var b strings.Builder
+	last := 0
+	for _, t := range Tokenize(text) {
+		if !q.highlights(t.Term) {
+			continue
+		}
+		b.WriteString(text[last:t.Start])
+		b.WriteString(open)
+		b.WriteString(text[t.Start:t.End])
+		b.WriteString(close)
+		last = t.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+func (q *FTQuery) highlights(term string) bool {
+	for _, t := range q.terms {
+		if term == t.term || (t.prefix && strings.HasPrefix(term, t.term)) {
+			return true
+		}
+	}
+	return false
+}
+
+// lexFTQuery splits a query into parentheses, pipes, minus signs, quoted
+// phrases, which keep their quotes, and words.
+func lexFTQuery(query string) []string {
+	tokens := []string{}
+	for i := 0; i < len(query); {
+		r, size := utf8.DecodeRuneInString(query[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+		case r == '(' || r == ')' || r == '|':
+			tokens = append(tokens, string(r))
+			i += size
+		case r == '-':
+			// Words are read whole, so a minus sign here starts a word and
+			// negates it; within a word it separates terms.
+			tokens = append(tokens, "-")
+			i += size
+		case r == '"':
+			end := strings.IndexByte(query[i+1:], '"')
+			if end < 0 {
+				tokens = append(tokens, query[i:])
+				return tokens
+			}
+			tokens = append(tokens, query[i:i+end+2])
+			i += end + 2
+		default:
+			j := i
+			for j < len(query) && isFTWordByte(query[j]) {
+				j++
+			}
+			tokens = append(tokens, query[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+func isFTWordByte(c byte) bool {
+	return c != ' ' && c != '\t' && c != '\n' && c != '(' && c != ')' && c != '|' && c != '"'
+}
+
+type ftParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *ftParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *ftParser) parseOr() (ftNode, error) {
+	children := []ftNode{}
+	for {
+		child, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+		if tok := p.peek(); tok != "|" && tok != "OR" {
+			break
+		}
+		p.pos++
+	}
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return &ftOr{children: children}, nil
+}
+
+func (p *ftParser) parseAnd() (ftNode, error) {
+	children := []ftNode{}
+	for {
+		tok := p.peek()
+		if tok == "" || tok == ")" || tok == "|" || tok == "OR" {
+			break
+		}
+		if tok == "AND" {
+			p.pos++
+			continue
+		}
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if child != nil {
+			children = append(children, child)
+		}
+	}
+	switch len(children) {
+	case 0:
+		return nil, ErrFTQuerySyntax
+	case 1:
+		return children[0], nil
+	}
+	return &ftAnd{children: children}, nil
+}
+
+// parseUnary parses a negation or an atom. It returns a nil node for a word
+// without any term, such as a lone punctuation mark.
+func (p *ftParser) parseUnary() (ftNode, error) {
+	tok := p.peek()
+	p.pos++
+	switch {
+	case tok == "-" || tok == "NOT":
+		child, err := p.parseUnary()
+		if err != nil || child == nil {
+			return nil, ErrFTQuerySyntax
+		}
+		return &ftNot{child: child}, nil
+	case tok == "(":
+		child, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, ErrFTQuerySyntax
+		}
+		p.pos++
+		return child, nil
+	case strings.HasPrefix(tok, `"`):
+		if len(tok) < 2 || !strings.HasSuffix(tok, `"`) {
+			return nil, ErrFTQuerySyntax
+		}
+		return newFTPhrase(tok[1 : len(tok)-1]), nil
+	case strings.HasSuffix(tok, "*"):
+		tokens := Tokenize(strings.TrimSuffix(tok, "*"))
+		if len(tokens) != 1 {
+			return nil, ErrFTQuerySyntax
+		}
+		return &ftTerm{term: tokens[0].Term, prefix: true}, nil
+	}
+	return newFTPhrase(tok), nil
+}
+
+// newFTPhrase returns the node matching the terms of text one after the
+// other: a term if text has a single one.
+func newFTPhrase(text string) ftNode {
+	tokens := Tokenize(text)
+	switch len(tokens) {
+	case 0:
+		return nil
+	case 1:
+		return &ftTerm{term: tokens[0].Term}
+	}
+	phrase := &ftPhrase{}
+	for _, t := range tokens {
+		phrase.terms = append(phrase.terms, t.Term)
+	}
+	return phrase
+}
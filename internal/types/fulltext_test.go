@@ -0,0 +1,116 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var articles = map[string]string{
+	"a1": "The quick brown fox jumps over the lazy dog",
+	"a2": "A quick brown dog",
+	"a3": "Foxes are quick; the fox is quicker than the dog",
+	"a4": "Café au lait",
+}
+
+func newArticlesIndex(keys ...string) *types.FullTextIndex {
+	idx := types.NewFullTextIndex("articles", []types.FullTextField{{Name: "body", Weight: 1}})
+	for _, key := range keys {
+		idx.Update(key, map[string]string{"body": articles[key]})
+	}
+	return idx
+}
+
+func search(idx *types.FullTextIndex, query string) []string {
+	q, err := types.ParseFTQuery(query)
+	if err != nil {
+		return nil
+	}
+	keys := []string{}
+	for _, m := range idx.Search(q, idx.Stats(q)) {
+		keys = append(keys, m.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestTokenize(t *testing.T) {
+	tokens := types.Tokenize("Crème brûlée, 2 ÉCLAIRS!")
+	terms := []string{}
+	for _, tok := range tokens {
+		terms = append(terms, tok.Term)
+	}
+	assert.Equal(t, []string{"creme", "brulee", "2", "eclairs"}, terms)
+	assert.Equal(t, "brûlée", "Crème brûlée, 2 ÉCLAIRS!"[tokens[1].Start:tokens[1].End])
+}
+
+func TestFullTextIndexMatch(t *testing.T) {
+	idx := newArticlesIndex("a1", "a2", "a3", "a4")
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"fox", []string{"a1", "a3"}},
+		{"FOX dog", []string{"a1", "a3"}},
+		{"fox AND lazy", []string{"a1"}},
+		{"fox | cafe", []string{"a1", "a3", "a4"}},
+		{"lazy OR lait", []string{"a1", "a4"}},
+		{"dog -fox", []string{"a2"}},
+		{"dog NOT (lazy | quicker)", []string{"a2"}},
+		{"quick*", []string{"a1", "a2", "a3"}},
+		{"fox*", []string{"a1", "a3"}},
+		{`"brown dog"`, []string{"a2"}},
+		{`"quick brown" -lazy`, []string{"a2"}},
+		{`"dog quick"`, []string{}},
+		{"unicorn", []string{}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, search(idx, tt.query), tt.query)
+	}
+
+	idx.Update("a2", map[string]string{"body": "A slow grey cat"})
+	idx.Remove("a1")
+	assert.Equal(t, []string{"a3"}, search(idx, "dog"))
+	assert.Equal(t, 3, idx.Len())
+}
+
+func TestFullTextIndexRanking(t *testing.T) {
+	idx := newArticlesIndex("a1", "a2", "a3", "a4")
+	q, _ := types.ParseFTQuery("fox")
+	matches := idx.Search(q, idx.Stats(q))
+	assert.Equal(t, 2, len(matches))
+	// a1 has fox among fewer words than a3.
+	assert.Equal(t, "a1", matches[0].Key)
+	assert.Greater(t, matches[0].Score, matches[1].Score)
+
+	// Splitting the documents over two indexes and merging their statistics
+	// gives the scores of a single index.
+	left, right := newArticlesIndex("a1", "a4"), newArticlesIndex("a2", "a3")
+	stats := left.Stats(q)
+	stats.Merge(right.Stats(q))
+	merged := append(left.Search(q, stats), right.Search(q, stats)...)
+	types.SortFTMatches(merged)
+	assert.Equal(t, len(matches), len(merged))
+	for i := range matches {
+		assert.Equal(t, matches[i].Key, merged[i].Key)
+		assert.InDelta(t, matches[i].Score, merged[i].Score, 1e-12)
+	}
+}
+
+func TestFullTextQueryHighlightAndErrors(t *testing.T) {
+	q, err := types.ParseFTQuery(`quick* "lazy dog" -cat`)
+	assert.NoError(t, err)
+	assert.Equal(t, "The [Quick] fox, the [quicker] cat and the [lazy] [dog]",
+		q.Highlight("The Quick fox, the quicker cat and the lazy dog", "[", "]"))
+
+	for _, query := range []string{"", "(fox", "fox)", `"fox`, "fox |", "-", "-dog", "!!"} {
+		_, err := types.ParseFTQuery(query)
+		assert.Error(t, err, query)
+	}
+}
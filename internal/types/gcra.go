@@ -0,0 +1,62 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import "time"
+
+// GCRALimit is a rate limit of Count requests per Period, on top of which
+// MaxBurst requests can be made at once.
+type GCRALimit struct {
+	MaxBurst int64
+	Count    int64
+	Period   time.Duration
+}
+
+// GCRAResult is the outcome of a request checked against a GCRALimit.
+type GCRAResult struct {
+	Allowed    bool
+	Limit      int64         // the number of requests that can be made at once
+	Remaining  int64         // the number of requests that can still be made at once
+	RetryAfter time.Duration // the time until the request would be allowed, -1 if it was
+	ResetAfter time.Duration // the time until the limit is fully available again
+	TAT        time.Time     // the theoretical arrival time to keep for the next request
+}
+
+// GCRAThrottle checks a request for quantity units against the limit with
+// the generic cell rate algorithm, as used to police ATM traffic.
+//
+// Instead of counting the requests of a window, the algorithm keeps a
+// single timestamp, the theoretical arrival time (TAT) at which the limit
+// is fully available again. Every unit pushes it forward by the emission
+// interval, Period / Count, and a request is allowed as long as the TAT it
+// would set is less than MaxBurst + 1 intervals ahead of now. tat is the
+// value returned by the previous request, or the zero time if there was
+// none.
+func GCRAThrottle(l GCRALimit, tat, now time.Time, quantity int64) GCRAResult {
+	interval := l.Period / time.Duration(l.Count)
+	tolerance := interval * time.Duration(l.MaxBurst+1)
+	if tat.Before(now) {
+		tat = now
+	}
+
+	increment := interval * time.Duration(quantity)
+	newTAT := tat.Add(increment)
+	res := GCRAResult{Limit: l.MaxBurst + 1, RetryAfter: -1, TAT: tat}
+	if diff := now.Sub(newTAT.Add(-tolerance)); diff < 0 {
+		// A request larger than the burst is never allowed, so it has no
+		// time to retry after.
+		if increment <= tolerance {
+			res.RetryAfter = -diff
+		}
+	} else {
+		res.Allowed = true
+		res.TAT = newTAT
+	}
+
+	res.ResetAfter = res.TAT.Sub(now)
+	if next := tolerance - res.ResetAfter; next > 0 {
+		res.Remaining = int64(next / interval)
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGCRAThrottle(t *testing.T) {
+	// 1 request per second with bursts of 2 more.
+	limit := types.GCRALimit{MaxBurst: 2, Count: 1, Period: time.Second}
+	now := time.Unix(1000, 0)
+
+	var tat time.Time
+	for i := int64(0); i < 3; i++ {
+		res := types.GCRAThrottle(limit, tat, now, 1)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, int64(3), res.Limit)
+		assert.Equal(t, 2-i, res.Remaining)
+		assert.Equal(t, time.Duration(-1), res.RetryAfter)
+		assert.Equal(t, time.Duration(i+1)*time.Second, res.ResetAfter)
+		tat = res.TAT
+	}
+
+	res := types.GCRAThrottle(limit, tat, now, 1)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, int64(0), res.Remaining)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, 3*time.Second, res.ResetAfter)
+	assert.Equal(t, tat, res.TAT)
+
+	// Half an interval later the request is still limited, a full one
+	// later it is allowed.
+	res = types.GCRAThrottle(limit, tat, now.Add(500*time.Millisecond), 1)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+	res = types.GCRAThrottle(limit, tat, now.Add(time.Second), 1)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, int64(0), res.Remaining)
+
+	// Once the TAT is past, the whole burst is available again.
+	res = types.GCRAThrottle(limit, tat, now.Add(time.Minute), 0)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, int64(3), res.Remaining)
+	assert.Equal(t, time.Duration(0), res.ResetAfter)
+}
+
+func TestGCRAThrottleQuantity(t *testing.T) {
+	limit := types.GCRALimit{MaxBurst: 9, Count: 10, Period: time.Second}
+	now := time.Unix(1000, 0)
+
+	res := types.GCRAThrottle(limit, time.Time{}, now, 4)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, int64(6), res.Remaining)
+	assert.Equal(t, 400*time.Millisecond, res.ResetAfter)
+
+	res = types.GCRAThrottle(limit, res.TAT, now, 7)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 100*time.Millisecond, res.RetryAfter)
+
+	// A request larger than the burst can never be allowed.
+	res = types.GCRAThrottle(limit, time.Time{}, now, 11)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Duration(-1), res.RetryAfter)
+}
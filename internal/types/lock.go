@@ -0,0 +1,135 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Lock is a lease on a key, held by an owner until it releases the lock or
+// the lease expires.
+//
+// Every acquisition gets a fencing token greater than all the previous
+// ones. The owner passes it along with its writes to the resources the
+// lock protects, which reject the writes carrying a token lower than one
+// they have seen. An owner that stalls past the end of its lease, and
+// does not know it lost the lock, cannot then overwrite the writes of the
+// next owner.
+//
+// The lock keeps its token once released, so the tokens keep increasing
+// for as long as the key exists.
+type Lock struct {
+	owner     string // "" while the lock is free
+	token     uint64
+	expiresAt int64 // in unix milliseconds
+}
+
+func NewLock() *Lock {
+	return &Lock{}
+}
+
+// Owner returns the owner holding the lock at nowMs, or "" if it is free.
+func (l *Lock) Owner(nowMs int64) string {
+	if l.owner == "" || l.expiresAt <= nowMs {
+		return ""
+	}
+	return l.owner
+}
+
+// Token returns the fencing token of the last acquisition, or 0 if the
+// lock was never acquired.
+func (l *Lock) Token() uint64 {
+	return l.token
+}
+
+// TTL returns the number of milliseconds left before the lease expires,
+// or 0 if the lock is free.
+func (l *Lock) TTL(nowMs int64) int64 {
+	if l.Owner(nowMs) == "" {
+		return 0
+	}
+	return l.expiresAt - nowMs
+}
+
+// Acquire gives the lock to owner for ttlMs milliseconds, unless another
+// owner holds it, and returns the new fencing token. An owner acquiring a
+// lock it already holds gets a new token too. force acquires the lock
+// whoever holds it.
+func (l *Lock) Acquire(owner string, ttlMs, nowMs int64, force bool) (uint64, bool) {
+	if holder := l.Owner(nowMs); holder != "" && holder != owner && !force {
+		return 0, false
+	}
+	l.owner = owner
+	l.token++
+	l.expiresAt = nowMs + ttlMs
+	return l.token, true
+}
+
+// Renew extends the lease of owner to ttlMs milliseconds from nowMs.
+// Returns false if owner does not hold the lock. force renews the lease of
+// the last owner even if it expired.
+func (l *Lock) Renew(owner string, ttlMs, nowMs int64, force bool) bool {
+	if l.Owner(nowMs) != owner && (!force || l.owner != owner) {
+		return false
+	}
+	l.expiresAt = nowMs + ttlMs
+	return true
+}
+
+// Release frees the lock if owner holds it, or held it until its lease
+// expired. Returns false if owner did not hold the lock at nowMs.
+func (l *Lock) Release(owner string, nowMs int64) bool {
+	if l.owner != owner {
+		return false
+	}
+	held := l.Owner(nowMs) != ""
+	l.owner = ""
+	l.expiresAt = 0
+	return held
+}
+
+// ExpireLease frees the lock if its lease expired at nowMs and reports
+// whether it did. It implements store.Lease.
+func (l *Lock) ExpireLease(nowMs int64) bool {
+	if l.owner == "" || l.expiresAt > nowMs {
+		return false
+	}
+	l.owner = ""
+	l.expiresAt = 0
+	return true
+}
+
+// DeepCopy returns a copy of the lock that shares no state with l.
+func (l *Lock) DeepCopy() *Lock {
+	c := *l
+	return &c
+}
+
+// Serialize encodes the lock into buf.
+func (l *Lock) Serialize(buf *bytes.Buffer) error {
+	if err := WriteString(buf, l.owner); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, l.token); err != nil {
+		return err
+	}
+	return binary.Write(buf, binary.BigEndian, l.expiresAt)
+}
+
+// DeserializeLock decodes a lock encoded by Serialize from buf.
+func DeserializeLock(buf *bytes.Reader) (*Lock, error) {
+	owner, err := ReadString(buf)
+	if err != nil {
+		return nil, err
+	}
+	l := &Lock{owner: owner}
+	if err := binary.Read(buf, binary.BigEndian, &l.token); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &l.expiresAt); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFencingTokens(t *testing.T) {
+	l := types.NewLock()
+
+	token, ok := l.Acquire("a", 100, 1000, false)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), token)
+	_, ok = l.Acquire("b", 100, 1050, false)
+	assert.False(t, ok)
+	assert.Equal(t, "a", l.Owner(1050))
+	assert.Equal(t, int64(50), l.TTL(1050))
+
+	// Once the lease of a expires, b takes over with a greater token and a
+	// can neither renew nor release the lock.
+	assert.Equal(t, "", l.Owner(1100))
+	token, ok = l.Acquire("b", 100, 1100, false)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), token)
+	assert.False(t, l.Renew("a", 100, 1110, false))
+	assert.False(t, l.Renew("a", 100, 1110, true))
+	assert.False(t, l.Release("a", 1110))
+
+	assert.True(t, l.Renew("b", 500, 1150, false))
+	assert.Equal(t, int64(500), l.TTL(1150))
+	assert.False(t, l.Renew("b", 500, 1700, false))
+	assert.True(t, l.Renew("b", 500, 1700, true))
+	assert.Equal(t, int64(500), l.TTL(1700))
+	assert.True(t, l.Release("b", 1800))
+	assert.Equal(t, "", l.Owner(1800))
+
+	// The token survives the release.
+	token, _ = l.Acquire("a", 100, 1300, false)
+	assert.Equal(t, uint64(3), token)
+	token, ok = l.Acquire("c", 100, 1300, true)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(4), token)
+}
+
+func TestLockExpireLease(t *testing.T) {
+	l := types.NewLock()
+	l.Acquire("a", 100, 1000, false)
+
+	assert.False(t, l.ExpireLease(1099))
+	assert.True(t, l.ExpireLease(1100))
+	assert.False(t, l.ExpireLease(1200))
+	assert.Equal(t, uint64(1), l.Token())
+	assert.False(t, l.Release("a", 1200))
+}
+
+func TestLockSerialize(t *testing.T) {
+	l := types.NewLock()
+	l.Acquire("owner-1", 100, 1000, false)
+
+	var buf bytes.Buffer
+	assert.NoError(t, l.Serialize(&buf))
+	restored, err := types.DeserializeLock(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, l, restored)
+	assert.Equal(t, l, l.DeepCopy())
+}
@@ -21,3 +21,12 @@ const (
 
 	PERSIST Param = "PERSIST"
 )
+
+// Parameters accepted by BF.INFO.
+const (
+	BloomCapacity  Param = "CAPACITY"
+	BloomSize      Param = "SIZE"
+	BloomFilters   Param = "FILTERS"
+	BloomItems     Param = "ITEMS"
+	BloomExpansion Param = "EXPANSION"
+)
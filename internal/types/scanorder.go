@@ -0,0 +1,83 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/btree"
+)
+
+// ScanPositionBits is the number of bits of the position of a member in a
+// ScanOrder.
+const ScanPositionBits = 48
+
+// ScanPosition returns the position of member in a ScanOrder, the top bits
+// of its hash.
+func ScanPosition(member string) uint64 {
+	return xxhash.Sum64String(member) >> (64 - ScanPositionBits)
+}
+
+type scanEntry struct {
+	position uint64
+	member   string
+}
+
+func scanEntryLess(a, b scanEntry) bool {
+	if a.position != b.position {
+		return a.position < b.position
+	}
+	return a.member < b.member
+}
+
+// ScanOrder keeps the members of a collection ordered by position, the
+// order in which SCAN, HSCAN and ZSCAN walk collections. The position of a
+// member never changes as other members are added and removed, so a cursor
+// holding a position resumes a walk where it stopped. Members are kept in a
+// B-tree, so that a page of count members is found in O(log N + count).
+type ScanOrder struct {
+	tree *btree.BTreeG[scanEntry]
+}
+
+func NewScanOrder() *ScanOrder {
+	return &ScanOrder{tree: btree.NewG(32, scanEntryLess)}
+}
+
+// Len returns the number of members in the order.
+func (o *ScanOrder) Len() int {
+	return o.tree.Len()
+}
+
+// Add adds member to the order if it is not in it.
+func (o *ScanOrder) Add(member string) {
+	o.tree.ReplaceOrInsert(scanEntry{position: ScanPosition(member), member: member})
+}
+
+// Remove removes member from the order if it is in it.
+func (o *ScanOrder) Remove(member string) {
+	o.tree.Delete(scanEntry{position: ScanPosition(member), member: member})
+}
+
+// Clone returns a copy of the order that shares no state with o.
+func (o *ScanOrder) Clone() *ScanOrder {
+	return &ScanOrder{tree: o.tree.Clone()}
+}
+
+// Page returns the count members with the smallest positions from the
+// given one, in increasing positions, along with the position of the next
+// member and whether there is one. The members sharing the position of the
+// last one are all returned, even if there are more than count, so that
+// the next page starts past them.
+func (o *ScanOrder) Page(from uint64, count int) (members []string, next uint64, more bool) {
+	last := uint64(0)
+	o.tree.AscendGreaterOrEqual(scanEntry{position: from}, func(e scanEntry) bool {
+		if len(members) >= count && e.position != last {
+			next, more = e.position, true
+			return false
+		}
+		members = append(members, e.member)
+		last = e.position
+		return true
+	})
+	return members, next, more
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// scanAll walks the whole order a page of count members at a time.
+func scanAll(t *testing.T, o *types.ScanOrder, count int) []string {
+	var members []string
+	from := uint64(0)
+	for {
+		page, next, more := o.Page(from, count)
+		assert.LessOrEqual(t, len(page), count)
+		members = append(members, page...)
+		if !more {
+			return members
+		}
+		from = next
+	}
+}
+
+func TestScanOrderPage(t *testing.T) {
+	o := types.NewScanOrder()
+	var want []string
+	for i := 0; i < 1000; i++ {
+		member := "m" + strconv.Itoa(i)
+		o.Add(member)
+		want = append(want, member)
+	}
+	o.Add("m0")
+	assert.Equal(t, 1000, o.Len())
+
+	slices.SortFunc(want, func(a, b string) int {
+		return cmp.Compare(types.ScanPosition(a), types.ScanPosition(b))
+	})
+	for _, count := range []int{1, 7, 100, 2000} {
+		assert.Equal(t, want, scanAll(t, o, count))
+	}
+
+	page, next, more := o.Page(0, 3)
+	assert.Equal(t, want[:3], page)
+	assert.True(t, more)
+	assert.Equal(t, types.ScanPosition(want[3]), next)
+}
+
+func TestScanOrderRemove(t *testing.T) {
+	o := types.NewScanOrder()
+	for i := 0; i < 100; i++ {
+		o.Add("m" + strconv.Itoa(i))
+	}
+	c := o.Clone()
+
+	// A walk resumed after members were removed returns the ones left past
+	// the cursor.
+	page, next, _ := o.Page(0, 50)
+	for _, member := range page {
+		o.Remove(member)
+	}
+	o.Remove("missing")
+	assert.Equal(t, 50, o.Len())
+	rest, _, more := o.Page(next, 100)
+	assert.Len(t, rest, 50)
+	assert.False(t, more)
+	for _, member := range rest {
+		assert.NotContains(t, page, member)
+	}
+
+	assert.Equal(t, 100, c.Len())
+}
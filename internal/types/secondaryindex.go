@@ -0,0 +1,334 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// IndexFieldType is the kind of values a field of a secondary index holds,
+// which decides how the field is indexed and queried.
+type IndexFieldType uint8
+
+const (
+	IndexFieldNumeric IndexFieldType = iota // a number, queried by range
+	IndexFieldTag                           // a comma separated list of tags, queried by exact match
+)
+
+var indexFieldTypeNames = [...]string{"NUMERIC", "TAG"}
+
+// ParseIndexFieldType parses the name of a field type, ignoring case.
+func ParseIndexFieldType(name string) (IndexFieldType, bool) {
+	for i, n := range indexFieldTypeNames {
+		if strings.EqualFold(name, n) {
+			return IndexFieldType(i), true
+		}
+	}
+	return 0, false
+}
+
+func (t IndexFieldType) String() string {
+	return indexFieldTypeNames[t]
+}
+
+// IndexField is a field of the documents covered by a secondary index.
+// Path locates the value of the field in a document and Name is the name
+// queries refer to it by.
+type IndexField struct {
+	Path string
+	Name string
+	Type IndexFieldType
+}
+
+// IndexDoc holds the values of the fields of a document: a float64 for a
+// numeric field and a []string of normalized tags for a tag field. Fields
+// the document does not have are absent.
+type IndexDoc map[string]any
+
+// NormalizeTags splits a tag value on commas and returns its tags, trimmed
+// and lowercased so that matching ignores case and surrounding spaces.
+func NormalizeTags(value string) []string {
+	tags := []string{}
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// numericEntry is the value of a numeric field of a document.
+type numericEntry struct {
+	value float64
+	key   string
+}
+
+func (e numericEntry) less(o numericEntry) bool {
+	return e.value < o.value || (e.value == o.value && e.key < o.key)
+}
+
+// SecondaryIndex indexes the fields of a set of documents, each identified
+// by a key, so that the documents matching a query are found without
+// scanning all of them. Numeric fields are kept in slices sorted by value
+// and tag fields in maps from every tag to the keys that have it.
+type SecondaryIndex struct {
+	Name   string
+	Fields []IndexField
+
+	mu      sync.RWMutex
+	docs    map[string]IndexDoc
+	numeric map[string][]numericEntry
+	tags    map[string]map[string]map[string]struct{}
+}
+
+func NewSecondaryIndex(name string, fields []IndexField) *SecondaryIndex {
+	idx := &SecondaryIndex{
+		Name:    name,
+		Fields:  fields,
+		docs:    map[string]IndexDoc{},
+		numeric: map[string][]numericEntry{},
+		tags:    map[string]map[string]map[string]struct{}{},
+	}
+	for _, f := range fields {
+		if f.Type == IndexFieldTag {
+			idx.tags[f.Name] = map[string]map[string]struct{}{}
+		}
+	}
+	return idx
+}
+
+// Len returns the number of documents in the index.
+func (idx *SecondaryIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Update indexes the fields of the document stored at key, replacing the
+// values previously indexed for it.
+func (idx *SecondaryIndex) Update(key string, doc IndexDoc) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(key)
+	idx.docs[key] = doc
+	for _, f := range idx.Fields {
+		switch v := doc[f.Name].(type) {
+		case float64:
+			entries := idx.numeric[f.Name]
+			e := numericEntry{value: v, key: key}
+			i := sort.Search(len(entries), func(i int) bool { return !entries[i].less(e) })
+			entries = append(entries, numericEntry{})
+			copy(entries[i+1:], entries[i:])
+			entries[i] = e
+			idx.numeric[f.Name] = entries
+		case []string:
+			for _, tag := range v {
+				if idx.tags[f.Name][tag] == nil {
+					idx.tags[f.Name][tag] = map[string]struct{}{}
+				}
+				idx.tags[f.Name][tag][key] = struct{}{}
+			}
+		}
+	}
+}
+
+// Remove removes the document stored at key from the index.
+func (idx *SecondaryIndex) Remove(key string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(key)
+}
+
+func (idx *SecondaryIndex) remove(key string) {
+	doc, ok := idx.docs[key]
+	if !ok {
+		return
+	}
+	delete(idx.docs, key)
+	for _, f := range idx.Fields {
+		switch v := doc[f.Name].(type) {
+		case float64:
+			entries := idx.numeric[f.Name]
+			e := numericEntry{value: v, key: key}
+			i := sort.Search(len(entries), func(i int) bool { return !entries[i].less(e) })
+			if i < len(entries) && entries[i] == e {
+				idx.numeric[f.Name] = append(entries[:i], entries[i+1:]...)
+			}
+		case []string:
+			for _, tag := range v {
+				delete(idx.tags[f.Name][tag], key)
+				if len(idx.tags[f.Name][tag]) == 0 {
+					delete(idx.tags[f.Name], tag)
+				}
+			}
+		}
+	}
+}
+
+// IndexClause restricts the documents matched by a query to those whose
+// field Field has a value within [Min, Max], for a numeric field, or one of
+// the tags Tags, for a tag field.
+type IndexClause struct {
+	Field        string
+	Type         IndexFieldType
+	Min, Max     float64
+	MinExclusive bool
+	MaxExclusive bool
+	Tags         []string
+}
+
+// IndexQuery matches the documents that satisfy all of its clauses. A query
+// without clauses matches every document.
+type IndexQuery struct {
+	Clauses []IndexClause
+}
+
+// ParseIndexQuery parses a query made of clauses separated by spaces:
+//
+//	@field:[min max]   a numeric range; a bound prefixed with ( is exclusive
+//	                   and -inf and +inf leave the range unbounded
+//	@field:{a | b}     a document with any of the tags
+//	*                  every document
+func ParseIndexQuery(query string) (*IndexQuery, error) {
+	q := &IndexQuery{}
+	rest := strings.TrimSpace(query)
+	if rest == "*" {
+		return q, nil
+	}
+	for rest != "" {
+		if rest[0] != '@' {
+			return nil, fmt.Errorf("syntax error in query at '%s'", rest)
+		}
+		colon := strings.IndexByte(rest, ':')
+		if colon < 2 || colon+1 >= len(rest) {
+			return nil, fmt.Errorf("syntax error in query at '%s'", rest)
+		}
+		clause := IndexClause{Field: rest[1:colon]}
+
+		closing := map[byte]byte{'[': ']', '{': '}'}[rest[colon+1]]
+		end := strings.IndexByte(rest[colon+1:], closing)
+		if closing == 0 || end < 0 {
+			return nil, fmt.Errorf("syntax error in query at '%s'", rest)
+		}
+		body := rest[colon+2 : colon+1+end]
+		rest = strings.TrimSpace(rest[colon+2+end:])
+
+		if closing == '}' {
+			clause.Type = IndexFieldTag
+			for _, tag := range strings.Split(body, "|") {
+				clause.Tags = append(clause.Tags, NormalizeTags(tag)...)
+			}
+			if len(clause.Tags) == 0 {
+				return nil, fmt.Errorf("empty tag list for field '%s'", clause.Field)
+			}
+		} else {
+			clause.Type = IndexFieldNumeric
+			bounds := strings.Fields(body)
+			if len(bounds) != 2 {
+				return nil, fmt.Errorf("a numeric range of field '%s' needs a minimum and a maximum", clause.Field)
+			}
+			var err error
+			if clause.Min, clause.MinExclusive, err = parseRangeBound(bounds[0]); err != nil {
+				return nil, err
+			}
+			if clause.Max, clause.MaxExclusive, err = parseRangeBound(bounds[1]); err != nil {
+				return nil, err
+			}
+		}
+		q.Clauses = append(q.Clauses, clause)
+	}
+	return q, nil
+}
+
+func parseRangeBound(bound string) (float64, bool, error) {
+	exclusive := strings.HasPrefix(bound, "(")
+	value, err := strconv.ParseFloat(strings.TrimPrefix(bound, "("), 64)
+	if err != nil || math.IsNaN(value) {
+		return 0, false, fmt.Errorf("invalid numeric bound '%s'", bound)
+	}
+	return value, exclusive, nil
+}
+
+// Search returns the keys of the documents matching the query, sorted.
+func (idx *SecondaryIndex) Search(q *IndexQuery) ([]string, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var matches map[string]struct{}
+	if len(q.Clauses) == 0 {
+		matches = make(map[string]struct{}, len(idx.docs))
+		for key := range idx.docs {
+			matches[key] = struct{}{}
+		}
+	}
+	for _, clause := range q.Clauses {
+		keys, err := idx.match(clause)
+		if err != nil {
+			return nil, err
+		}
+		if matches == nil {
+			matches = keys
+			continue
+		}
+		for key := range matches {
+			if _, ok := keys[key]; !ok {
+				delete(matches, key)
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(matches))
+	for key := range matches {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// match returns the keys of the documents matching a single clause.
+func (idx *SecondaryIndex) match(clause IndexClause) (map[string]struct{}, error) {
+	field := -1
+	for i, f := range idx.Fields {
+		if f.Name == clause.Field {
+			field = i
+		}
+	}
+	if field < 0 {
+		return nil, fmt.Errorf("unknown field '%s'", clause.Field)
+	}
+	if idx.Fields[field].Type != clause.Type {
+		return nil, fmt.Errorf("field '%s' is not a %s field", clause.Field, clause.Type)
+	}
+
+	keys := map[string]struct{}{}
+	if clause.Type == IndexFieldTag {
+		for _, tag := range clause.Tags {
+			for key := range idx.tags[clause.Field][tag] {
+				keys[key] = struct{}{}
+			}
+		}
+		return keys, nil
+	}
+
+	entries := idx.numeric[clause.Field]
+	i := sort.Search(len(entries), func(i int) bool {
+		if clause.MinExclusive {
+			return entries[i].value > clause.Min
+		}
+		return entries[i].value >= clause.Min
+	})
+	for ; i < len(entries); i++ {
+		if entries[i].value > clause.Max || (clause.MaxExclusive && entries[i].value == clause.Max) {
+			break
+		}
+		keys[entries[i].key] = struct{}{}
+	}
+	return keys, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newUsersIndex() *types.SecondaryIndex {
+	idx := types.NewSecondaryIndex("users", []types.IndexField{
+		{Path: "age", Name: "age", Type: types.IndexFieldNumeric},
+		{Path: "city", Name: "city", Type: types.IndexFieldTag},
+	})
+	idx.Update("u1", types.IndexDoc{"age": 25.0, "city": types.NormalizeTags("Berlin")})
+	idx.Update("u2", types.IndexDoc{"age": 35.0, "city": types.NormalizeTags("berlin, Paris")})
+	idx.Update("u3", types.IndexDoc{"age": 18.0, "city": types.NormalizeTags("Paris")})
+	idx.Update("u4", types.IndexDoc{"city": types.NormalizeTags("Rome")})
+	return idx
+}
+
+func TestSecondaryIndexSearch(t *testing.T) {
+	idx := newUsersIndex()
+
+	tests := []struct {
+		query    string
+		expected []string
+	}{
+		{"*", []string{"u1", "u2", "u3", "u4"}},
+		{"@age:[18 30]", []string{"u1", "u3"}},
+		{"@age:[(18 +inf]", []string{"u1", "u2"}},
+		{"@age:[-inf (35]", []string{"u1", "u3"}},
+		{"@city:{BERLIN}", []string{"u1", "u2"}},
+		{"@city:{rome | paris}", []string{"u2", "u3", "u4"}},
+		{"@age:[18 30] @city:{berlin}", []string{"u1"}},
+		{"@age:[40 50]", []string{}},
+	}
+	for _, tt := range tests {
+		q, err := types.ParseIndexQuery(tt.query)
+		assert.NoError(t, err, tt.query)
+		keys, err := idx.Search(q)
+		assert.NoError(t, err, tt.query)
+		assert.Equal(t, tt.expected, keys, tt.query)
+	}
+}
+
+func TestSecondaryIndexUpdateAndRemove(t *testing.T) {
+	idx := newUsersIndex()
+
+	idx.Update("u1", types.IndexDoc{"age": 40.0})
+	idx.Remove("u3")
+	idx.Remove("missing")
+	assert.Equal(t, 3, idx.Len())
+
+	q, _ := types.ParseIndexQuery("@age:[18 30]")
+	keys, _ := idx.Search(q)
+	assert.Equal(t, []string{}, keys)
+	q, _ = types.ParseIndexQuery("@city:{berlin}")
+	keys, _ = idx.Search(q)
+	assert.Equal(t, []string{"u2"}, keys)
+}
+
+func TestSecondaryIndexInvalidQueries(t *testing.T) {
+	idx := newUsersIndex()
+
+	for _, query := range []string{"age:[1 2]", "@age:[1]", "@age:[a 2]", "@city:{}", "@age:[1 2", "@:{a}"} {
+		_, err := types.ParseIndexQuery(query)
+		assert.Error(t, err, query)
+	}
+
+	q, _ := types.ParseIndexQuery("@name:{bob}")
+	_, err := idx.Search(q)
+	assert.EqualError(t, err, "unknown field 'name'")
+	q, _ = types.ParseIndexQuery("@city:[1 2]")
+	_, err = idx.Search(q)
+	assert.EqualError(t, err, "field 'city' is not a NUMERIC field")
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+)
+
+// ErrInvalidSerialization is returned when decoding data that was not
+// encoded by the matching Serialize method.
+var ErrInvalidSerialization = errors.New("invalid serialized value")
+
+// WriteString encodes s into buf, prefixed with its length.
+func WriteString(buf *bytes.Buffer, s string) error {
+	if err := binary.Write(buf, binary.BigEndian, uint32(len(s))); err != nil {
+		return err
+	}
+	buf.WriteString(s)
+	return nil
+}
+
+// ReadString decodes a string encoded by WriteString from buf.
+func ReadString(buf *bytes.Reader) (string, error) {
+	var n uint32
+	if err := binary.Read(buf, binary.BigEndian, &n); err != nil {
+		return "", err
+	}
+	if int64(n) > int64(buf.Len()) {
+		return "", ErrInvalidSerialization
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(buf, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// WriteLength encodes the number of items of a collection into buf.
+func WriteLength(buf *bytes.Buffer, n int) error {
+	return binary.Write(buf, binary.BigEndian, uint64(n))
+}
+
+// ReadLength decodes a number of items encoded by WriteLength from buf.
+// Every item taking at least minSize bytes, a number of items that buf is
+// too short to hold is rejected before anything is allocated for them.
+func ReadLength(buf *bytes.Reader, minSize int) (int, error) {
+	var n uint64
+	if err := binary.Read(buf, binary.BigEndian, &n); err != nil {
+		return 0, err
+	}
+	if n > uint64(buf.Len()/max(minSize, 1)) {
+		return 0, ErrInvalidSerialization
+	}
+	return int(n), nil
+}
+
+// WriteStringMap encodes m into buf, with its keys sorted so that equal
+// maps are encoded alike.
+func WriteStringMap(buf *bytes.Buffer, m map[string]string) error {
+	if err := WriteLength(buf, len(m)); err != nil {
+		return err
+	}
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		if err := WriteString(buf, k); err != nil {
+			return err
+		}
+		if err := WriteString(buf, m[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadStringMap decodes a map encoded by WriteStringMap from buf.
+func ReadStringMap(buf *bytes.Reader) (map[string]string, error) {
+	n, err := ReadLength(buf, 8)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		k, err := ReadString(buf)
+		if err != nil {
+			return nil, err
+		}
+		if m[k], err = ReadString(buf); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
@@ -4,23 +4,205 @@
 package types
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
+	"math"
+	"math/rand"
+)
 
-	"github.com/dicedb/dicedb-go/wire"
-	"github.com/wangjia184/sortedset"
+const (
+	sortedSetMaxLevel = 32
+	sortedSetP        = 0.25
 )
 
+// SortedSetElement is a member of a sorted set along with its score and its
+// 1-based rank in the order it was read, so it counts from the highest score
+// for reverse reads.
+type SortedSetElement struct {
+	Member string
+	Score  float64
+	Rank   int64
+}
+
+// ScoreBound is the lower or upper end of a score range.
+type ScoreBound struct {
+	Value     float64
+	Exclusive bool
+}
+
+// LexBound is the lower or upper end of a lexicographical range. Inf is -1
+// for the "-" bound, 1 for the "+" bound and 0 otherwise.
+type LexBound struct {
+	Value     string
+	Exclusive bool
+	Inf       int
+}
+
+type sortedSetLevel struct {
+	forward *sortedSetNode
+	span    int
+}
+
+type sortedSetNode struct {
+	member   string
+	score    float64
+	backward *sortedSetNode
+	level    []sortedSetLevel
+}
+
+// before reports whether n is ordered before the element (score, member).
+func (n *sortedSetNode) before(score float64, member string) bool {
+	return n.score < score || (n.score == score && n.member < member)
+}
+
+// SortedSet keeps unique members ordered by score, with ties broken by
+// member. Members are kept in a skip list whose links record how many
+// elements they span, so that ranks and ranges are found in O(log N), in a
+// map from member to node for O(1) score lookups, and in the order ZSCAN
+// walks them.
 type SortedSet struct {
-	*sortedset.SortedSet
+	header *sortedSetNode
+	level  int
+	dict   map[string]*sortedSetNode
+	order  *ScanOrder
 }
 
 func NewSortedSet() *SortedSet {
 	return &SortedSet{
-		SortedSet: sortedset.New(),
+		header: &sortedSetNode{level: make([]sortedSetLevel, sortedSetMaxLevel)},
+		level:  1,
+		dict:   make(map[string]*sortedSetNode),
+		order:  NewScanOrder(),
+	}
+}
+
+// Len returns the number of members in the sorted set.
+func (s *SortedSet) Len() int {
+	return len(s.dict)
+}
+
+// ScanMembers returns a page of the members of the sorted set in the order
+// ZSCAN walks them, as returned by ScanOrder.Page.
+func (s *SortedSet) ScanMembers(from uint64, count int) ([]string, uint64, bool) {
+	return s.order.Page(from, count)
+}
+
+// DeepCopy returns a copy of the sorted set that shares no state with s.
+func (s *SortedSet) DeepCopy() *SortedSet {
+	c := NewSortedSet()
+	for member, n := range s.dict {
+		c.Upsert(member, n.score)
+	}
+	return c
+}
+
+// Serialize encodes the sorted set into buf, from the lowest score.
+func (s *SortedSet) Serialize(buf *bytes.Buffer) error {
+	if err := WriteLength(buf, s.Len()); err != nil {
+		return err
+	}
+	for n := s.header.level[0].forward; n != nil; n = n.level[0].forward {
+		if err := WriteString(buf, n.member); err != nil {
+			return err
+		}
+		if err := binary.Write(buf, binary.BigEndian, n.score); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeserializeSortedSet decodes a sorted set encoded by Serialize from buf.
+func DeserializeSortedSet(buf *bytes.Reader) (*SortedSet, error) {
+	n, err := ReadLength(buf, 12)
+	if err != nil {
+		return nil, err
+	}
+	s := NewSortedSet()
+	for i := 0; i < n; i++ {
+		member, err := ReadString(buf)
+		if err != nil {
+			return nil, err
+		}
+		var score float64
+		if err := binary.Read(buf, binary.BigEndian, &score); err != nil {
+			return nil, err
+		}
+		if math.IsNaN(score) {
+			return nil, ErrInvalidSerialization
+		}
+		s.Upsert(member, score)
+	}
+	return s, nil
+}
+
+// Score returns the score of member and whether it is in the sorted set.
+func (s *SortedSet) Score(member string) (float64, bool) {
+	n, ok := s.dict[member]
+	if !ok {
+		return 0, false
+	}
+	return n.score, true
+}
+
+// Upsert sets the score of member, adding it if it is not in the sorted set.
+// Returns true if the member was added.
+func (s *SortedSet) Upsert(member string, score float64) bool {
+	n, ok := s.dict[member]
+	if ok {
+		if n.score == score {
+			return false
+		}
+		s.delete(n.score, member)
+	} else {
+		s.order.Add(member)
+	}
+	s.dict[member] = s.insert(score, member)
+	return !ok
+}
+
+// Remove removes member from the sorted set.
+// Returns false if the member was not in the sorted set.
+func (s *SortedSet) Remove(member string) bool {
+	n, ok := s.dict[member]
+	if !ok {
+		return false
+	}
+	s.delete(n.score, member)
+	delete(s.dict, member)
+	s.order.Remove(member)
+	return true
+}
+
+// Rank returns the 1-based rank of member ordered from the lowest score,
+// or from the highest score if rev is set. Returns 0 if the member is not
+// in the sorted set.
+func (s *SortedSet) Rank(member string, rev bool) int64 {
+	n, ok := s.dict[member]
+	if !ok {
+		return 0
+	}
+
+	rank := 0
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && x.level[i].forward.before(n.score, n.member) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+	rank++
+	if rev {
+		return int64(s.Len() - rank + 1)
 	}
+	return int64(rank)
 }
 
-func (s *SortedSet) ZADD(scores []int64, members []string, params map[Param]string) (int64, error) {
+// ZADD adds the members with their scores honoring the NX, XX, GT, LT, CH
+// and INCR params. It returns the number of members added (or changed with
+// CH), or the new score of the member with INCR.
+func (s *SortedSet) ZADD(scores []float64, members []string, params map[Param]string) (float64, error) {
 	addedCount, updatedCount := 0, 0
 
 	if params[XX] != "" && params[NX] != "" {
@@ -37,20 +219,11 @@ func (s *SortedSet) ZADD(scores []int64, members []string, params map[Param]stri
 
 	for i := range scores {
 		score, member := scores[i], members[i]
-		n := s.GetByKey(member)
-		exists := n != nil
-		currentScore := sortedset.SCORE(0)
-		if exists {
-			currentScore = n.Score()
-		}
+		currentScore, exists := s.Score(member)
 
 		// Handle INCR option
 		if params[INCR] != "" {
-			if exists {
-				score = int64(currentScore) + score
-			}
-			s.AddOrUpdate(member, sortedset.SCORE(score), nil)
-			return score, nil
+			return s.ZINCRBY(member, score)
 		}
 
 		// Skip based on NX/XX flags
@@ -60,57 +233,322 @@ func (s *SortedSet) ZADD(scores []int64, members []string, params map[Param]stri
 
 		// Skip based on GT/LT conditions
 		if exists {
-			if params[GT] != "" && sortedset.SCORE(score) <= currentScore {
+			if params[GT] != "" && score <= currentScore {
 				continue
 			}
-			if params[LT] != "" && sortedset.SCORE(score) >= currentScore {
+			if params[LT] != "" && score >= currentScore {
 				continue
 			}
 		}
 
 		// Add or update the member
-		wasInserted := s.AddOrUpdate(member, sortedset.SCORE(score), nil)
-		if wasInserted && !exists {
+		if s.Upsert(member, score) {
 			addedCount++
-		} else if exists && sortedset.SCORE(score) != currentScore {
+		} else if exists && score != currentScore {
 			updatedCount++
 		}
 	}
 
 	// Return appropriate count based on CH flag
 	if params[CH] != "" {
-		return int64(addedCount + updatedCount), nil
+		return float64(addedCount + updatedCount), nil
+	}
+	return float64(addedCount), nil
+}
+
+// ZINCRBY increments the score of member by incr, adding the member with
+// score incr if it is not in the sorted set. Returns the new score.
+func (s *SortedSet) ZINCRBY(member string, incr float64) (float64, error) {
+	score, _ := s.Score(member)
+	score += incr
+	if math.IsNaN(score) {
+		return 0, errors.New("resulting score is not a number (NaN)")
+	}
+	s.Upsert(member, score)
+	return score, nil
+}
+
+// CountByScore returns the number of members with scores between min and max.
+func (s *SortedSet) CountByScore(minScore, maxScore ScoreBound) int64 {
+	gteMin, lteMax := scoreRange(minScore, maxScore)
+	first, firstRank := s.firstInRange(gteMin, lteMax)
+	if first == nil {
+		return 0
+	}
+	_, lastRank := s.lastInRange(gteMin, lteMax)
+	return int64(lastRank - firstRank + 1)
+}
+
+// RangeByRank returns the members between the 1-based ranks start and stop,
+// both inclusive. Negative ranks count from the end, -1 being the last
+// member, and ranks below 1 are treated as 1. With rev, ranks are counted
+// from the highest score.
+func (s *SortedSet) RangeByRank(start, stop int, rev bool) []SortedSetElement {
+	length := s.Len()
+	if start < 0 {
+		start += length + 1
+	}
+	if stop < 0 {
+		stop += length + 1
+	}
+	start, stop = max(start, 1), min(stop, length)
+	if start > stop {
+		return []SortedSetElement{}
+	}
+
+	// Both directions walk the list forward from the lowest rank, so the
+	// reverse ranks are mapped to ranks from the lowest score first.
+	first := start
+	if rev {
+		first = length - stop + 1
+	}
+	elements := make([]SortedSetElement, 0, stop-start+1)
+	for n, rank := s.nodeByRank(first), first; n != nil && len(elements) < stop-start+1; n, rank = n.level[0].forward, rank+1 {
+		elements = append(elements, SortedSetElement{Member: n.member, Score: n.score, Rank: int64(rank)})
 	}
-	return int64(addedCount), nil
+	if rev {
+		for i, j := 0, len(elements)-1; i < j; i, j = i+1, j-1 {
+			elements[i], elements[j] = elements[j], elements[i]
+		}
+		for i := range elements {
+			elements[i].Rank = int64(length) - elements[i].Rank + 1
+		}
+	}
+	return elements
+}
+
+// RangeByScore returns the members with scores between min and max, ordered
+// from the lowest score or from the highest if rev is set. The first offset
+// members are skipped and at most count members are returned, or all of
+// them if count is negative.
+func (s *SortedSet) RangeByScore(minScore, maxScore ScoreBound, rev bool, offset, count int) []SortedSetElement {
+	gteMin, lteMax := scoreRange(minScore, maxScore)
+	return s.rangeBy(gteMin, lteMax, rev, offset, count)
 }
 
-func (s *SortedSet) ZCOUNT(minScore, maxScore int64) int64 {
-	return int64(len(s.SortedSet.GetByScoreRange(sortedset.SCORE(minScore), sortedset.SCORE(maxScore), nil)))
+// RangeByLex returns the members between min and max in lexicographical
+// order, or in reverse if rev is set. The range is only meaningful when all
+// the members have the same score. offset and count behave as they do for
+// RangeByScore.
+func (s *SortedSet) RangeByLex(minMember, maxMember LexBound, rev bool, offset, count int) []SortedSetElement {
+	gteMin, lteMax := lexRange(minMember, maxMember)
+	return s.rangeBy(gteMin, lteMax, rev, offset, count)
 }
 
-func (s *SortedSet) ZRANGE(start, stop int, byScore, byRank bool) []*wire.ZElement {
-	var nodes []*sortedset.SortedSetNode
-	if byScore {
-		nodes = s.SortedSet.GetByScoreRange(
-			sortedset.SCORE(start),
-			sortedset.SCORE(stop),
-			&sortedset.GetByScoreRangeOptions{})
+// PopMin removes and returns up to count members with the lowest scores.
+// Each element carries the rank it had before it was removed.
+func (s *SortedSet) PopMin(count int) []SortedSetElement {
+	elements := s.RangeByRank(1, count, false)
+	for _, e := range elements {
+		s.Remove(e.Member)
+	}
+	return elements
+}
+
+// PopMax removes and returns up to count members with the highest scores,
+// highest first. Each element carries the rank, counted from the lowest
+// score, it had before it was removed.
+func (s *SortedSet) PopMax(count int) []SortedSetElement {
+	length := int64(s.Len())
+	elements := s.RangeByRank(1, count, true)
+	for i := range elements {
+		elements[i].Rank = length - elements[i].Rank + 1
+		s.Remove(elements[i].Member)
+	}
+	return elements
+}
+
+func (s *SortedSet) rangeBy(gteMin, lteMax func(n *sortedSetNode) bool, rev bool, offset, count int) []SortedSetElement {
+	elements := []SortedSetElement{}
+	if offset < 0 || count == 0 {
+		return elements
+	}
+
+	var n *sortedSetNode
+	var rank int
+	if rev {
+		n, rank = s.lastInRange(gteMin, lteMax)
+		rank = s.Len() - rank + 1
 	} else {
-		nodes = s.SortedSet.GetByRankRange(start, stop, false)
+		n, rank = s.firstInRange(gteMin, lteMax)
+	}
+
+	next := func(n *sortedSetNode) *sortedSetNode {
+		if rev {
+			return n.backward
+		}
+		return n.level[0].forward
+	}
+	for ; n != nil && offset > 0; offset-- {
+		n, rank = next(n), rank+1
+	}
+	for ; n != nil && count != 0 && gteMin(n) && lteMax(n); count-- {
+		elements = append(elements, SortedSetElement{Member: n.member, Score: n.score, Rank: int64(rank)})
+		n, rank = next(n), rank+1
+	}
+	return elements
+}
+
+// firstInRange returns the first node in the range and its 1-based rank,
+// or nil if the range is empty. gteMin and lteMax report whether a node is
+// not below and not above the range.
+func (s *SortedSet) firstInRange(gteMin, lteMax func(n *sortedSetNode) bool) (*sortedSetNode, int) {
+	rank := 0
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !gteMin(x.level[i].forward) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+	x = x.level[0].forward
+	if x == nil || !lteMax(x) {
+		return nil, 0
+	}
+	return x, rank + 1
+}
+
+// lastInRange returns the last node in the range and its 1-based rank, or
+// nil if the range is empty.
+func (s *SortedSet) lastInRange(gteMin, lteMax func(n *sortedSetNode) bool) (*sortedSetNode, int) {
+	rank := 0
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && lteMax(x.level[i].forward) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+	}
+	if x == s.header || !gteMin(x) {
+		return nil, 0
+	}
+	return x, rank
+}
+
+// nodeByRank returns the node at the 1-based rank, or nil if there is none.
+func (s *SortedSet) nodeByRank(rank int) *sortedSetNode {
+	traversed := 0
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && traversed+x.level[i].span <= rank {
+			traversed += x.level[i].span
+			x = x.level[i].forward
+		}
+		if traversed == rank {
+			return x
+		}
+	}
+	return nil
+}
+
+func (s *SortedSet) insert(score float64, member string) *sortedSetNode {
+	var update [sortedSetMaxLevel]*sortedSetNode
+	var rank [sortedSetMaxLevel]int
+
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.level[i].forward != nil && x.level[i].forward.before(score, member) {
+			rank[i] += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+
+	level := randomSortedSetLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.header
+			update[i].level[i].span = s.Len()
+		}
+		s.level = level
+	}
+
+	x = &sortedSetNode{member: member, score: score, level: make([]sortedSetLevel, level)}
+	for i := 0; i < level; i++ {
+		x.level[i].forward = update[i].level[i].forward
+		update[i].level[i].forward = x
+		x.level[i].span = update[i].level[i].span - (rank[0] - rank[i])
+		update[i].level[i].span = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].level[i].span++
+	}
+
+	if update[0] != s.header {
+		x.backward = update[0]
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x
+	}
+	return x
+}
+
+func (s *SortedSet) delete(score float64, member string) {
+	var update [sortedSetMaxLevel]*sortedSetNode
+
+	x := s.header
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && x.level[i].forward.before(score, member) {
+			x = x.level[i].forward
+		}
+		update[i] = x
 	}
 
-	var rank int64 = 0
-	if len(nodes) > 0 {
-		rank = int64(s.SortedSet.FindRank(nodes[0].Key()))
+	x = x.level[0].forward
+	if x == nil || x.member != member {
+		return
 	}
 
-	result := make([]*wire.ZElement, len(nodes))
-	for i, node := range nodes {
-		result[i] = &wire.ZElement{
-			Member: node.Key(),
-			Score:  int64(node.Score()),
-			Rank:   rank + int64(i),
+	for i := 0; i < s.level; i++ {
+		if update[i].level[i].forward == x {
+			update[i].level[i].span += x.level[i].span - 1
+			update[i].level[i].forward = x.level[i].forward
+		} else {
+			update[i].level[i].span--
+		}
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x.backward
+	}
+	for s.level > 1 && s.header.level[s.level-1].forward == nil {
+		s.level--
+	}
+}
+
+func randomSortedSetLevel() int {
+	level := 1
+	for level < sortedSetMaxLevel && rand.Float64() < sortedSetP {
+		level++
+	}
+	return level
+}
+
+func scoreRange(minScore, maxScore ScoreBound) (gteMin, lteMax func(n *sortedSetNode) bool) {
+	gteMin = func(n *sortedSetNode) bool {
+		return n.score > minScore.Value || (!minScore.Exclusive && n.score == minScore.Value)
+	}
+	lteMax = func(n *sortedSetNode) bool {
+		return n.score < maxScore.Value || (!maxScore.Exclusive && n.score == maxScore.Value)
+	}
+	return gteMin, lteMax
+}
+
+func lexRange(minMember, maxMember LexBound) (gteMin, lteMax func(n *sortedSetNode) bool) {
+	gteMin = func(n *sortedSetNode) bool {
+		if minMember.Inf != 0 {
+			return minMember.Inf < 0
+		}
+		return n.member > minMember.Value || (!minMember.Exclusive && n.member == minMember.Value)
+	}
+	lteMax = func(n *sortedSetNode) bool {
+		if maxMember.Inf != 0 {
+			return maxMember.Inf > 0
 		}
+		return n.member < maxMember.Value || (!maxMember.Exclusive && n.member == maxMember.Value)
 	}
-	return result
+	return gteMin, lteMax
 }
@@ -0,0 +1,176 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// sortedReference returns the members of scores ordered by score and member.
+func sortedReference(scores map[string]float64) []types.SortedSetElement {
+	elements := make([]types.SortedSetElement, 0, len(scores))
+	for member, score := range scores {
+		elements = append(elements, types.SortedSetElement{Member: member, Score: score})
+	}
+	sort.Slice(elements, func(i, j int) bool {
+		if elements[i].Score != elements[j].Score {
+			return elements[i].Score < elements[j].Score
+		}
+		return elements[i].Member < elements[j].Member
+	})
+	for i := range elements {
+		elements[i].Rank = int64(i + 1)
+	}
+	return elements
+}
+
+func TestSortedSetMatchesReference(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ss := types.NewSortedSet()
+	scores := map[string]float64{}
+
+	for i := 0; i < 5000; i++ {
+		member := "m" + strconv.Itoa(r.Intn(300))
+		if r.Intn(4) == 0 {
+			_, ok := scores[member]
+			assert.Equal(t, ok, ss.Remove(member))
+			delete(scores, member)
+			continue
+		}
+		score := float64(r.Intn(50)) / 4
+		_, ok := scores[member]
+		assert.Equal(t, !ok, ss.Upsert(member, score))
+		scores[member] = score
+	}
+
+	ref := sortedReference(scores)
+	assert.Equal(t, len(ref), ss.Len())
+	assert.Equal(t, ref, ss.RangeByRank(1, -1, false))
+	for _, e := range ref {
+		assert.Equal(t, e.Rank, ss.Rank(e.Member, false))
+		assert.Equal(t, int64(len(ref))-e.Rank+1, ss.Rank(e.Member, true))
+	}
+
+	minScore, maxScore := types.ScoreBound{Value: 2.5}, types.ScoreBound{Value: 7, Exclusive: true}
+	var want []types.SortedSetElement
+	for _, e := range ref {
+		if e.Score >= 2.5 && e.Score < 7 {
+			want = append(want, e)
+		}
+	}
+	assert.Equal(t, int64(len(want)), ss.CountByScore(minScore, maxScore))
+	assert.Equal(t, want[3:13], ss.RangeByScore(minScore, maxScore, false, 3, 10))
+
+	got := ss.RangeByScore(minScore, maxScore, true, 0, 5)
+	for i, e := range got {
+		w := want[len(want)-1-i]
+		assert.Equal(t, w.Member, e.Member)
+		assert.Equal(t, int64(len(ref))-w.Rank+1, e.Rank)
+	}
+}
+
+func TestSortedSetRangeByRank(t *testing.T) {
+	ss := types.NewSortedSet()
+	for i, member := range []string{"a", "b", "c", "d", "e"} {
+		ss.Upsert(member, float64(i+1)*10)
+	}
+
+	members := func(elements []types.SortedSetElement) []string {
+		result := []string{}
+		for _, e := range elements {
+			result = append(result, e.Member)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"a", "b"}, members(ss.RangeByRank(0, 2, false)))
+	assert.Equal(t, []string{"d", "e"}, members(ss.RangeByRank(-2, -1, false)))
+	assert.Equal(t, []string{"e", "d"}, members(ss.RangeByRank(1, 2, true)))
+	assert.Equal(t, []string{}, members(ss.RangeByRank(4, 2, false)))
+	assert.Equal(t, []string{"c", "d", "e"}, members(ss.RangeByRank(3, 100, false)))
+
+	popped := ss.PopMax(2)
+	assert.Equal(t, []types.SortedSetElement{{Member: "e", Score: 50, Rank: 5}, {Member: "d", Score: 40, Rank: 4}}, popped)
+	popped = ss.PopMin(1)
+	assert.Equal(t, []types.SortedSetElement{{Member: "a", Score: 10, Rank: 1}}, popped)
+	assert.Equal(t, 2, ss.Len())
+}
+
+func TestSortedSetRangeByLex(t *testing.T) {
+	ss := types.NewSortedSet()
+	for _, member := range []string{"a", "b", "c", "d", "e"} {
+		ss.Upsert(member, 0)
+	}
+
+	members := func(elements []types.SortedSetElement) []string {
+		result := []string{}
+		for _, e := range elements {
+			result = append(result, e.Member)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, members(ss.RangeByLex(types.LexBound{Inf: -1}, types.LexBound{Inf: 1}, false, 0, -1)))
+	assert.Equal(t, []string{"b", "c"}, members(ss.RangeByLex(types.LexBound{Value: "b"}, types.LexBound{Value: "d", Exclusive: true}, false, 0, -1)))
+	assert.Equal(t, []string{"d", "c"}, members(ss.RangeByLex(types.LexBound{Value: "b", Exclusive: true}, types.LexBound{Inf: 1}, true, 1, 2)))
+	assert.Equal(t, []string{}, members(ss.RangeByLex(types.LexBound{Inf: 1}, types.LexBound{Inf: -1}, false, 0, -1)))
+}
+
+func TestSortedSetZINCRBY(t *testing.T) {
+	ss := types.NewSortedSet()
+
+	score, err := ss.ZINCRBY("a", 1.5)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, score)
+
+	score, err = ss.ZINCRBY("a", -0.25)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.25, score)
+
+	_, err = ss.ZINCRBY("b", math.Inf(1))
+	assert.NoError(t, err)
+	_, err = ss.ZINCRBY("b", math.Inf(-1))
+	assert.EqualError(t, err, "resulting score is not a number (NaN)")
+
+	score, _ = ss.Score("b")
+	assert.Equal(t, math.Inf(1), score)
+}
+
+func TestSortedSetDeepCopy(t *testing.T) {
+	ss := types.NewSortedSet()
+	ss.Upsert("a", 1)
+	ss.Upsert("b", 2)
+
+	c := ss.DeepCopy()
+	c.Upsert("a", 3)
+	c.Remove("b")
+	assert.Equal(t, []types.SortedSetElement{{Member: "a", Score: 1, Rank: 1}, {Member: "b", Score: 2, Rank: 2}}, ss.RangeByRank(0, -1, false))
+	assert.Equal(t, []types.SortedSetElement{{Member: "a", Score: 3, Rank: 1}}, c.RangeByRank(0, -1, false))
+}
+
+func TestSortedSetSerialize(t *testing.T) {
+	ss := types.NewSortedSet()
+	ss.Upsert("a", 1)
+	ss.Upsert("b", math.Inf(-1))
+	ss.Upsert("c", 1)
+
+	var buf bytes.Buffer
+	assert.NoError(t, ss.Serialize(&buf))
+	r := bytes.NewReader(buf.Bytes())
+	c, err := types.DeserializeSortedSet(r)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, ss.RangeByRank(0, -1, false), c.RangeByRank(0, -1, false))
+
+	_, err = types.DeserializeSortedSet(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	assert.Error(t, err)
+}
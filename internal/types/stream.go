@@ -0,0 +1,634 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidStreamID   = errors.New("invalid stream ID specified as stream command argument")
+	ErrStreamIDTooSmall  = errors.New("the ID specified in XADD is equal or smaller than the target stream top item")
+	ErrStreamIDZero      = errors.New("the ID specified in XADD must be greater than 0-0")
+	ErrStreamExhausted   = errors.New("the stream has exhausted the last possible ID, unable to add more items")
+	ErrStreamGroupExists = errors.New("BUSYGROUP consumer group name already exists")
+)
+
+// StreamID identifies an entry of a stream. It is made of the millisecond
+// timestamp at which the entry was added and a sequence number that orders
+// the entries added within the same millisecond.
+type StreamID struct {
+	Ms  uint64
+	Seq uint64
+}
+
+var (
+	MinStreamID = StreamID{}
+	MaxStreamID = StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}
+)
+
+// ParseStreamID parses an ID of the form "ms-seq". When the sequence number
+// is omitted it defaults to defaultSeq, so that "5" can mean "5-0" as the
+// start of a range and "5-<max>" as its end.
+func ParseStreamID(s string, defaultSeq uint64) (StreamID, error) {
+	msPart, seqPart, hasSeq := strings.Cut(s, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return StreamID{}, ErrInvalidStreamID
+	}
+	if !hasSeq {
+		return StreamID{Ms: ms, Seq: defaultSeq}, nil
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return StreamID{}, ErrInvalidStreamID
+	}
+	return StreamID{Ms: ms, Seq: seq}, nil
+}
+
+func (id StreamID) String() string {
+	return strconv.FormatUint(id.Ms, 10) + "-" + strconv.FormatUint(id.Seq, 10)
+}
+
+// Compare returns -1, 0 or 1 depending on whether id sorts before, equal to
+// or after other.
+func (id StreamID) Compare(other StreamID) int {
+	switch {
+	case id.Ms < other.Ms || (id.Ms == other.Ms && id.Seq < other.Seq):
+		return -1
+	case id == other:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// Next returns the smallest ID greater than id. ok is false if id is the
+// largest possible ID.
+func (id StreamID) Next() (next StreamID, ok bool) {
+	switch {
+	case id.Seq < math.MaxUint64:
+		return StreamID{Ms: id.Ms, Seq: id.Seq + 1}, true
+	case id.Ms < math.MaxUint64:
+		return StreamID{Ms: id.Ms + 1}, true
+	default:
+		return id, false
+	}
+}
+
+// Prev returns the largest ID smaller than id. ok is false if id is 0-0.
+func (id StreamID) Prev() (prev StreamID, ok bool) {
+	switch {
+	case id.Seq > 0:
+		return StreamID{Ms: id.Ms, Seq: id.Seq - 1}, true
+	case id.Ms > 0:
+		return StreamID{Ms: id.Ms - 1, Seq: math.MaxUint64}, true
+	default:
+		return id, false
+	}
+}
+
+// StreamEntry is a single entry of a stream. Fields holds the field-value
+// pairs of the entry flattened in the order they were added.
+type StreamEntry struct {
+	ID     StreamID
+	Fields []string
+}
+
+// PendingEntry is an entry delivered to a consumer of a group that the
+// consumer has not acknowledged yet.
+type PendingEntry struct {
+	ID          StreamID
+	Consumer    string
+	DeliveredAt int64 // unix time in milliseconds of the last delivery
+	Deliveries  int64
+}
+
+// ConsumerGroup tracks the entries of a stream delivered to a group of
+// consumers. Every entry is delivered to a single consumer of the group and
+// stays in the pending entries list (PEL) of the group until it is
+// acknowledged.
+type ConsumerGroup struct {
+	Name            string
+	LastDeliveredID StreamID
+
+	pending   map[StreamID]*PendingEntry
+	consumers map[string]map[StreamID]*PendingEntry
+}
+
+// Stream is an append-only log of entries ordered by their IDs. Entries are
+// kept in a slice sorted by ID, so appends are cheap and ranges are found by
+// binary search.
+type Stream struct {
+	entries []StreamEntry
+	lastID  StreamID
+	groups  map[string]*ConsumerGroup
+}
+
+func NewStream() *Stream {
+	return &Stream{
+		groups: make(map[string]*ConsumerGroup),
+	}
+}
+
+// Len returns the number of entries in the stream.
+func (s *Stream) Len() int {
+	return len(s.entries)
+}
+
+// LastID returns the ID of the last entry ever added to the stream, even if
+// the entry has since been trimmed.
+func (s *Stream) LastID() StreamID {
+	return s.lastID
+}
+
+// Add appends an entry to the stream and returns its ID. spec is the ID
+// argument of XADD: "*" generates the ID from nowMs, "ms-*" generates only
+// the sequence number and "ms-seq" (or "ms") is used as given. The ID must
+// be greater than the ID of every entry added before.
+func (s *Stream) Add(spec string, fields []string, nowMs int64) (StreamID, error) {
+	id, err := s.nextID(spec, nowMs)
+	if err != nil {
+		return StreamID{}, err
+	}
+
+	s.entries = append(s.entries, StreamEntry{ID: id, Fields: fields})
+	s.lastID = id
+	return id, nil
+}
+
+func (s *Stream) nextID(spec string, nowMs int64) (StreamID, error) {
+	if spec == "*" {
+		if uint64(nowMs) > s.lastID.Ms {
+			return StreamID{Ms: uint64(nowMs)}, nil
+		}
+		id, ok := s.lastID.Next()
+		if !ok {
+			return StreamID{}, ErrStreamExhausted
+		}
+		return id, nil
+	}
+
+	if msPart, ok := strings.CutSuffix(spec, "-*"); ok {
+		ms, err := strconv.ParseUint(msPart, 10, 64)
+		if err != nil {
+			return StreamID{}, ErrInvalidStreamID
+		}
+		switch {
+		case ms > s.lastID.Ms:
+			return StreamID{Ms: ms}, nil
+		case ms < s.lastID.Ms || s.lastID.Seq == math.MaxUint64:
+			return StreamID{}, ErrStreamIDTooSmall
+		}
+		return StreamID{Ms: ms, Seq: s.lastID.Seq + 1}, nil
+	}
+
+	id, err := ParseStreamID(spec, 0)
+	if err != nil {
+		return StreamID{}, err
+	}
+	if id == MinStreamID {
+		return StreamID{}, ErrStreamIDZero
+	}
+	if id.Compare(s.lastID) <= 0 {
+		return StreamID{}, ErrStreamIDTooSmall
+	}
+	return id, nil
+}
+
+// TrimMaxLen removes the oldest entries until at most maxLen are left and
+// returns the number of entries removed.
+func (s *Stream) TrimMaxLen(maxLen int) int {
+	if len(s.entries) <= maxLen {
+		return 0
+	}
+	return s.trim(len(s.entries) - maxLen)
+}
+
+// TrimMinID removes the entries with an ID smaller than minID and returns
+// the number of entries removed.
+func (s *Stream) TrimMinID(minID StreamID) int {
+	return s.trim(s.search(minID))
+}
+
+func (s *Stream) trim(n int) int {
+	if n == 0 {
+		return 0
+	}
+	// Copy the remaining entries so that the backing array of the trimmed
+	// ones can be garbage collected.
+	s.entries = append([]StreamEntry(nil), s.entries[n:]...)
+	return n
+}
+
+// search returns the index of the first entry with an ID not smaller than id.
+func (s *Stream) search(id StreamID) int {
+	return sort.Search(len(s.entries), func(i int) bool {
+		return s.entries[i].ID.Compare(id) >= 0
+	})
+}
+
+// Entry returns the entry with the given ID.
+func (s *Stream) Entry(id StreamID) (StreamEntry, bool) {
+	i := s.search(id)
+	if i < len(s.entries) && s.entries[i].ID == id {
+		return s.entries[i], true
+	}
+	return StreamEntry{}, false
+}
+
+// Range returns the entries with an ID between start and end, both
+// inclusive, in ascending order or in descending order when rev is set.
+// At most count entries are returned; a negative count returns them all.
+func (s *Stream) Range(start, end StreamID, count int, rev bool) []StreamEntry {
+	result := []StreamEntry{}
+	if start.Compare(end) > 0 {
+		return result
+	}
+
+	lo := s.search(start)
+	hi := sort.Search(len(s.entries), func(i int) bool {
+		return s.entries[i].ID.Compare(end) > 0
+	})
+	for i := lo; i < hi && count != 0; i++ {
+		j := i
+		if rev {
+			j = hi - 1 - (i - lo)
+		}
+		result = append(result, s.entries[j])
+		count--
+	}
+	return result
+}
+
+// After returns at most count entries with an ID greater than id in
+// ascending order. A negative count returns them all.
+func (s *Stream) After(id StreamID, count int) []StreamEntry {
+	start, ok := id.Next()
+	if !ok {
+		return []StreamEntry{}
+	}
+	return s.Range(start, MaxStreamID, count, false)
+}
+
+// CreateGroup creates a consumer group that delivers the entries added
+// after lastDeliveredID.
+func (s *Stream) CreateGroup(name string, lastDeliveredID StreamID) error {
+	if _, ok := s.groups[name]; ok {
+		return ErrStreamGroupExists
+	}
+	s.groups[name] = &ConsumerGroup{
+		Name:            name,
+		LastDeliveredID: lastDeliveredID,
+		pending:         make(map[StreamID]*PendingEntry),
+		consumers:       make(map[string]map[StreamID]*PendingEntry),
+	}
+	return nil
+}
+
+// DestroyGroup removes a consumer group and reports whether it existed.
+func (s *Stream) DestroyGroup(name string) bool {
+	if _, ok := s.groups[name]; !ok {
+		return false
+	}
+	delete(s.groups, name)
+	return true
+}
+
+// Group returns the consumer group with the given name, or nil.
+func (s *Stream) Group(name string) *ConsumerGroup {
+	return s.groups[name]
+}
+
+// ReadNew delivers to consumer at most count entries that were never
+// delivered to the group and advances the last delivered ID of the group.
+// Unless noAck is set the entries are added to the PEL of the group.
+func (s *Stream) ReadNew(g *ConsumerGroup, consumer string, count int, noAck bool, nowMs int64) []StreamEntry {
+	g.ensureConsumer(consumer)
+	entries := s.After(g.LastDeliveredID, count)
+	for _, e := range entries {
+		g.LastDeliveredID = e.ID
+		if noAck {
+			continue
+		}
+		g.setOwner(&PendingEntry{ID: e.ID, DeliveredAt: nowMs, Deliveries: 1}, consumer)
+	}
+	return entries
+}
+
+// ReadPending returns at most count entries pending for consumer with an ID
+// greater than id, and counts them as delivered again. Entries that were
+// trimmed from the stream since they were delivered are returned without
+// fields so that the consumer can still acknowledge them.
+func (s *Stream) ReadPending(g *ConsumerGroup, consumer string, id StreamID, count int, nowMs int64) []StreamEntry {
+	g.ensureConsumer(consumer)
+	result := []StreamEntry{}
+	for _, p := range sortedPending(g.consumers[consumer]) {
+		if count == 0 {
+			break
+		}
+		if p.ID.Compare(id) <= 0 {
+			continue
+		}
+		p.DeliveredAt = nowMs
+		p.Deliveries++
+		e, _ := s.Entry(p.ID)
+		result = append(result, StreamEntry{ID: p.ID, Fields: e.Fields})
+		count--
+	}
+	return result
+}
+
+// Claim transfers to consumer the pending entries among ids that have been
+// idle for at least minIdleMs and returns them. Claimed entries count as
+// delivered again when countDelivery is set. Pending entries that no
+// longer exist in the stream are removed from the PEL instead of being
+// claimed.
+func (s *Stream) Claim(g *ConsumerGroup, consumer string, minIdleMs int64, ids []StreamID, nowMs int64, countDelivery bool) []StreamEntry {
+	g.ensureConsumer(consumer)
+	result := []StreamEntry{}
+	for _, id := range ids {
+		p, ok := g.pending[id]
+		if !ok || nowMs-p.DeliveredAt < minIdleMs {
+			continue
+		}
+		e, ok := s.Entry(id)
+		if !ok {
+			g.Ack(id)
+			continue
+		}
+		g.setOwner(p, consumer)
+		p.DeliveredAt = nowMs
+		if countDelivery {
+			p.Deliveries++
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+func (g *ConsumerGroup) ensureConsumer(consumer string) {
+	if _, ok := g.consumers[consumer]; !ok {
+		g.consumers[consumer] = make(map[StreamID]*PendingEntry)
+	}
+}
+
+// setOwner adds p to the PEL of the group, owned by consumer.
+func (g *ConsumerGroup) setOwner(p *PendingEntry, consumer string) {
+	if old, ok := g.pending[p.ID]; ok {
+		delete(g.consumers[old.Consumer], p.ID)
+	}
+	p.Consumer = consumer
+	g.pending[p.ID] = p
+	g.consumers[consumer][p.ID] = p
+}
+
+// Ack removes id from the PEL of the group and reports whether it was
+// pending.
+func (g *ConsumerGroup) Ack(id StreamID) bool {
+	p, ok := g.pending[id]
+	if !ok {
+		return false
+	}
+	delete(g.pending, id)
+	delete(g.consumers[p.Consumer], id)
+	return true
+}
+
+// PendingCount returns the number of entries in the PEL of the group.
+func (g *ConsumerGroup) PendingCount() int {
+	return len(g.pending)
+}
+
+// Pending returns the entries of the PEL with an ID between start and end,
+// both inclusive, in ascending order. When consumer is not empty only its
+// entries are returned. A negative count returns them all.
+func (g *ConsumerGroup) Pending(start, end StreamID, count int, consumer string) []*PendingEntry {
+	pending := g.pending
+	if consumer != "" {
+		pending = g.consumers[consumer]
+	}
+
+	result := []*PendingEntry{}
+	for _, p := range sortedPending(pending) {
+		if count == 0 {
+			break
+		}
+		if p.ID.Compare(start) < 0 || p.ID.Compare(end) > 0 {
+			continue
+		}
+		result = append(result, p)
+		count--
+	}
+	return result
+}
+
+// PendingByConsumer returns the number of pending entries of every consumer
+// that has at least one, keyed by consumer name.
+func (g *ConsumerGroup) PendingByConsumer() map[string]int {
+	result := make(map[string]int)
+	for consumer, pending := range g.consumers {
+		if len(pending) > 0 {
+			result[consumer] = len(pending)
+		}
+	}
+	return result
+}
+
+func sortedPending(pending map[StreamID]*PendingEntry) []*PendingEntry {
+	result := make([]*PendingEntry, 0, len(pending))
+	for _, p := range pending {
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID.Compare(result[j].ID) < 0
+	})
+	return result
+}
+
+// DeepCopy returns a copy of the stream that shares no state with s.
+func (s *Stream) DeepCopy() *Stream {
+	c := &Stream{
+		entries: make([]StreamEntry, len(s.entries)),
+		lastID:  s.lastID,
+		groups:  make(map[string]*ConsumerGroup, len(s.groups)),
+	}
+	for i, e := range s.entries {
+		c.entries[i] = StreamEntry{ID: e.ID, Fields: append([]string(nil), e.Fields...)}
+	}
+	for name, g := range s.groups {
+		cg := &ConsumerGroup{
+			Name:            g.Name,
+			LastDeliveredID: g.LastDeliveredID,
+			pending:         make(map[StreamID]*PendingEntry, len(g.pending)),
+			consumers:       make(map[string]map[StreamID]*PendingEntry, len(g.consumers)),
+		}
+		for consumer := range g.consumers {
+			cg.ensureConsumer(consumer)
+		}
+		for _, p := range g.pending {
+			cp := *p
+			cg.pending[cp.ID] = &cp
+			cg.consumers[cp.Consumer][cp.ID] = &cp
+		}
+		c.groups[name] = cg
+	}
+	return c
+}
+
+// Serialize encodes the stream into buf, along with its consumer groups and
+// their pending entries.
+func (s *Stream) Serialize(buf *bytes.Buffer) error {
+	if err := binary.Write(buf, binary.BigEndian, s.lastID); err != nil {
+		return err
+	}
+	if err := WriteLength(buf, len(s.entries)); err != nil {
+		return err
+	}
+	for _, e := range s.entries {
+		if err := binary.Write(buf, binary.BigEndian, e.ID); err != nil {
+			return err
+		}
+		if err := WriteLength(buf, len(e.Fields)); err != nil {
+			return err
+		}
+		for _, f := range e.Fields {
+			if err := WriteString(buf, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := WriteLength(buf, len(s.groups)); err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(s.groups)) {
+		g := s.groups[name]
+		if err := WriteString(buf, g.Name); err != nil {
+			return err
+		}
+		if err := binary.Write(buf, binary.BigEndian, g.LastDeliveredID); err != nil {
+			return err
+		}
+		// Consumers are written apart from the pending entries, as they
+		// remain in the group once they have acknowledged all of theirs.
+		if err := WriteLength(buf, len(g.consumers)); err != nil {
+			return err
+		}
+		for _, consumer := range slices.Sorted(maps.Keys(g.consumers)) {
+			if err := WriteString(buf, consumer); err != nil {
+				return err
+			}
+		}
+		if err := WriteLength(buf, len(g.pending)); err != nil {
+			return err
+		}
+		for _, p := range sortedPending(g.pending) {
+			if err := binary.Write(buf, binary.BigEndian, p.ID); err != nil {
+				return err
+			}
+			if err := WriteString(buf, p.Consumer); err != nil {
+				return err
+			}
+			if err := binary.Write(buf, binary.BigEndian, []int64{p.DeliveredAt, p.Deliveries}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// DeserializeStream decodes a stream encoded by Serialize from buf.
+func DeserializeStream(buf *bytes.Reader) (*Stream, error) {
+	s := NewStream()
+	if err := binary.Read(buf, binary.BigEndian, &s.lastID); err != nil {
+		return nil, err
+	}
+	n, err := ReadLength(buf, 24)
+	if err != nil {
+		return nil, err
+	}
+	s.entries = make([]StreamEntry, n)
+	for i := range s.entries {
+		e := &s.entries[i]
+		if err := binary.Read(buf, binary.BigEndian, &e.ID); err != nil {
+			return nil, err
+		}
+		if (i > 0 && e.ID.Compare(s.entries[i-1].ID) <= 0) || e.ID.Compare(s.lastID) > 0 {
+			return nil, ErrInvalidSerialization
+		}
+		nf, err := ReadLength(buf, 4)
+		if err != nil {
+			return nil, err
+		}
+		e.Fields = make([]string, nf)
+		for j := range e.Fields {
+			if e.Fields[j], err = ReadString(buf); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	ng, err := ReadLength(buf, 36)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < ng; i++ {
+		name, err := ReadString(buf)
+		if err != nil {
+			return nil, err
+		}
+		g := &ConsumerGroup{
+			Name:      name,
+			pending:   make(map[StreamID]*PendingEntry),
+			consumers: make(map[string]map[StreamID]*PendingEntry),
+		}
+		if err := binary.Read(buf, binary.BigEndian, &g.LastDeliveredID); err != nil {
+			return nil, err
+		}
+		nc, err := ReadLength(buf, 4)
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < nc; j++ {
+			consumer, err := ReadString(buf)
+			if err != nil {
+				return nil, err
+			}
+			g.ensureConsumer(consumer)
+		}
+		np, err := ReadLength(buf, 36)
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < np; j++ {
+			p := &PendingEntry{}
+			if err := binary.Read(buf, binary.BigEndian, &p.ID); err != nil {
+				return nil, err
+			}
+			if p.Consumer, err = ReadString(buf); err != nil {
+				return nil, err
+			}
+			if err := binary.Read(buf, binary.BigEndian, &p.DeliveredAt); err != nil {
+				return nil, err
+			}
+			if err := binary.Read(buf, binary.BigEndian, &p.Deliveries); err != nil {
+				return nil, err
+			}
+			g.ensureConsumer(p.Consumer)
+			g.pending[p.ID] = p
+			g.consumers[p.Consumer][p.ID] = p
+		}
+		s.groups[name] = g
+	}
+	return s, nil
+}
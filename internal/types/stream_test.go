@@ -0,0 +1,152 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func streamIDs(entries []types.StreamEntry) []string {
+	ids := []string{}
+	for _, e := range entries {
+		ids = append(ids, e.ID.String())
+	}
+	return ids
+}
+
+func TestStreamAddGeneratesIncreasingIDs(t *testing.T) {
+	s := types.NewStream()
+
+	id, err := s.Add("*", []string{"f", "v"}, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "100-0", id.String())
+
+	// The clock going backwards must not produce a smaller ID.
+	id, err = s.Add("*", []string{"f", "v"}, 90)
+	assert.NoError(t, err)
+	assert.Equal(t, "100-1", id.String())
+
+	id, err = s.Add("100-*", []string{"f", "v"}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "100-2", id.String())
+
+	id, err = s.Add("200-*", []string{"f", "v"}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "200-0", id.String())
+
+	_, err = s.Add("150-5", []string{"f", "v"}, 0)
+	assert.ErrorIs(t, err, types.ErrStreamIDTooSmall)
+	_, err = s.Add("0-0", []string{"f", "v"}, 0)
+	assert.ErrorIs(t, err, types.ErrStreamIDZero)
+	_, err = s.Add("x-1", []string{"f", "v"}, 0)
+	assert.ErrorIs(t, err, types.ErrInvalidStreamID)
+
+	assert.Equal(t, 4, s.Len())
+}
+
+func TestStreamRangeAndTrim(t *testing.T) {
+	s := types.NewStream()
+	for _, id := range []string{"1-1", "1-2", "2-1", "3-1", "3-2"} {
+		_, err := s.Add(id, []string{"f", id}, 0)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"1-1", "1-2", "2-1", "3-1", "3-2"}, streamIDs(s.Range(types.MinStreamID, types.MaxStreamID, -1, false)))
+	assert.Equal(t, []string{"3-1", "2-1"}, streamIDs(s.Range(types.StreamID{Ms: 2}, types.StreamID{Ms: 3, Seq: 1}, -1, true)))
+	assert.Equal(t, []string{"1-2", "2-1"}, streamIDs(s.After(types.StreamID{Ms: 1, Seq: 1}, 2)))
+	assert.Equal(t, []string{}, streamIDs(s.Range(types.StreamID{Ms: 3}, types.StreamID{Ms: 2}, -1, false)))
+
+	assert.Equal(t, 2, s.TrimMinID(types.StreamID{Ms: 2}))
+	assert.Equal(t, 1, s.TrimMaxLen(2))
+	assert.Equal(t, []string{"3-1", "3-2"}, streamIDs(s.Range(types.MinStreamID, types.MaxStreamID, -1, false)))
+
+	// Trimming never lowers the IDs that can be added.
+	_, err := s.Add("2-5", []string{"f", "v"}, 0)
+	assert.ErrorIs(t, err, types.ErrStreamIDTooSmall)
+}
+
+func TestStreamConsumerGroup(t *testing.T) {
+	s := types.NewStream()
+	for _, id := range []string{"1-1", "2-1", "3-1"} {
+		_, err := s.Add(id, []string{"f", id}, 0)
+		assert.NoError(t, err)
+	}
+
+	assert.NoError(t, s.CreateGroup("g", types.StreamID{Ms: 1, Seq: 1}))
+	assert.ErrorIs(t, s.CreateGroup("g", types.MinStreamID), types.ErrStreamGroupExists)
+	g := s.Group("g")
+
+	assert.Equal(t, []string{"2-1"}, streamIDs(s.ReadNew(g, "alice", 1, false, 1000)))
+	assert.Equal(t, []string{"3-1"}, streamIDs(s.ReadNew(g, "bob", -1, false, 1000)))
+	assert.Equal(t, []string{}, streamIDs(s.ReadNew(g, "bob", -1, false, 1000)))
+	assert.Equal(t, 2, g.PendingCount())
+	assert.Equal(t, map[string]int{"alice": 1, "bob": 1}, g.PendingByConsumer())
+
+	// Reading the history of a consumer counts as a new delivery.
+	assert.Equal(t, []string{"2-1"}, streamIDs(s.ReadPending(g, "alice", types.MinStreamID, -1, 2000)))
+	pending := g.Pending(types.MinStreamID, types.MaxStreamID, -1, "alice")
+	assert.Equal(t, []*types.PendingEntry{{ID: types.StreamID{Ms: 2, Seq: 1}, Consumer: "alice", DeliveredAt: 2000, Deliveries: 2}}, pending)
+
+	// Only entries idle for long enough are claimed.
+	ids := []types.StreamID{{Ms: 2, Seq: 1}, {Ms: 3, Seq: 1}}
+	assert.Equal(t, []string{"3-1"}, streamIDs(s.Claim(g, "carol", 1500, ids, 2600, true)))
+	assert.Equal(t, map[string]int{"alice": 1, "carol": 1}, g.PendingByConsumer())
+
+	// Claiming an entry trimmed from the stream acknowledges it instead.
+	s.TrimMaxLen(1)
+	assert.Equal(t, []string{}, streamIDs(s.Claim(g, "carol", 0, ids[:1], 3000, true)))
+	assert.Equal(t, 1, g.PendingCount())
+
+	assert.True(t, g.Ack(types.StreamID{Ms: 3, Seq: 1}))
+	assert.False(t, g.Ack(types.StreamID{Ms: 3, Seq: 1}))
+	assert.Equal(t, 0, g.PendingCount())
+
+	assert.True(t, s.DestroyGroup("g"))
+	assert.Nil(t, s.Group("g"))
+}
+
+func TestStreamDeepCopy(t *testing.T) {
+	s := types.NewStream()
+	_, _ = s.Add("1-1", []string{"f", "v"}, 0)
+	assert.NoError(t, s.CreateGroup("g", types.MinStreamID))
+	s.ReadNew(s.Group("g"), "alice", -1, false, 0)
+
+	c := s.DeepCopy()
+	c.Group("g").Ack(types.StreamID{Ms: 1, Seq: 1})
+	_, _ = c.Add("2-1", []string{"f", "v"}, 0)
+
+	assert.Equal(t, 1, s.Len())
+	assert.Equal(t, 1, s.Group("g").PendingCount())
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, 0, c.Group("g").PendingCount())
+}
+
+func TestStreamSerialize(t *testing.T) {
+	s := types.NewStream()
+	_, _ = s.Add("1-1", []string{"f", "v"}, 0)
+	_, _ = s.Add("2-1", []string{"g", "w"}, 0)
+	s.TrimMaxLen(1)
+	assert.NoError(t, s.CreateGroup("g", types.MinStreamID))
+	s.ReadNew(s.Group("g"), "alice", -1, false, 5)
+	s.Group("g").Ack(types.StreamID{Ms: 2, Seq: 1})
+	_, _ = s.Add("3-1", []string{"h", "x"}, 0)
+	s.ReadNew(s.Group("g"), "bob", -1, false, 7)
+
+	var buf bytes.Buffer
+	assert.NoError(t, s.Serialize(&buf))
+	r := bytes.NewReader(buf.Bytes())
+	c, err := types.DeserializeStream(r)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, s.LastID(), c.LastID())
+	assert.Equal(t, s.Range(types.MinStreamID, types.MaxStreamID, -1, false), c.Range(types.MinStreamID, types.MaxStreamID, -1, false))
+	assert.Equal(t, s.Group("g").LastDeliveredID, c.Group("g").LastDeliveredID)
+	assert.Equal(t, map[string]int{"bob": 1}, c.Group("g").PendingByConsumer())
+	assert.Equal(t, s.Group("g").Pending(types.MinStreamID, types.MaxStreamID, -1, ""),
+		c.Group("g").Pending(types.MinStreamID, types.MaxStreamID, -1, ""))
+}
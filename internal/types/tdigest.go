@@ -0,0 +1,365 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"math"
+	"slices"
+)
+
+const DefaultTDigestCompression = 100
+
+// Centroid is a cluster of the values added to a TDigest, summarized by
+// their mean and their number.
+type Centroid struct {
+	Mean   float64
+	Weight float64
+}
+
+// TDigest estimates the quantiles of a stream of values with bounded
+// memory, as described by Dunning and Ertl in "Computing Extremely Accurate
+// Quantiles Using t-Digests" (https://arxiv.org/abs/1902.04023).
+//
+// The values are clustered into centroids whose size is bounded by a scale
+// function of their quantile, so the centroids near the tails hold few
+// values and the extreme quantiles, such as the 99th percentile, are the
+// most accurate. The compression bounds the number of centroids: higher
+// values use more memory and give more accurate estimates.
+//
+// Added values are buffered and merged into the centroids when the buffer
+// fills up or the digest is queried.
+type TDigest struct {
+	compression float64
+	centroids   []Centroid
+	unmerged    []Centroid
+	count       float64
+	min         float64
+	max         float64
+}
+
+func NewTDigest(compression float64) *TDigest {
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Compression returns the compression of the digest.
+func (t *TDigest) Compression() float64 {
+	return t.compression
+}
+
+// Count returns the number of values added to the digest.
+func (t *TDigest) Count() float64 {
+	return t.count
+}
+
+// Min returns the smallest value added to the digest, or NaN if it is
+// empty.
+func (t *TDigest) Min() float64 {
+	if t.count == 0 {
+		return math.NaN()
+	}
+	return t.min
+}
+
+// Max returns the largest value added to the digest, or NaN if it is
+// empty.
+func (t *TDigest) Max() float64 {
+	if t.count == 0 {
+		return math.NaN()
+	}
+	return t.max
+}
+
+// Add adds values to the digest.
+func (t *TDigest) Add(values ...float64) {
+	for _, v := range values {
+		t.add(Centroid{Mean: v, Weight: 1}, v, v)
+	}
+}
+
+func (t *TDigest) add(c Centroid, minValue, maxValue float64) {
+	t.unmerged = append(t.unmerged, c)
+	t.count += c.Weight
+	t.min = math.Min(t.min, minValue)
+	t.max = math.Max(t.max, maxValue)
+	if float64(len(t.unmerged)) >= 5*t.compression {
+		t.compress()
+	}
+}
+
+// Merge adds the values of the sources to the digest. The sources are not
+// modified.
+func (t *TDigest) Merge(sources []*TDigest) {
+	for _, s := range sources {
+		if s.count == 0 {
+			continue
+		}
+		for _, c := range s.centroids {
+			t.add(c, s.min, s.max)
+		}
+		for _, c := range s.unmerged {
+			t.add(c, s.min, s.max)
+		}
+	}
+}
+
+// scale maps the quantile q to the k-scale, on which every centroid but
+// those made of a single value spans at most 1.
+func (t *TDigest) scale(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// compress merges the buffered values into the centroids.
+func (t *TDigest) compress() {
+	if len(t.unmerged) == 0 {
+		return
+	}
+	all := make([]Centroid, 0, len(t.centroids)+len(t.unmerged))
+	all = append(all, t.centroids...)
+	all = append(all, t.unmerged...)
+	slices.SortStableFunc(all, func(a, b Centroid) int {
+		return cmp.Compare(a.Mean, b.Mean)
+	})
+
+	merged := make([]Centroid, 0, len(t.centroids)+1)
+	current := all[0]
+	weightSoFar := 0.0
+	kLeft := t.scale(0)
+	for _, c := range all[1:] {
+		q := (weightSoFar + current.Weight + c.Weight) / t.count
+		if t.scale(q)-kLeft <= 1 {
+			current.Weight += c.Weight
+			current.Mean += (c.Mean - current.Mean) * c.Weight / current.Weight
+			continue
+		}
+		weightSoFar += current.Weight
+		merged = append(merged, current)
+		kLeft = t.scale(weightSoFar / t.count)
+		current = c
+	}
+	t.centroids = append(merged, current)
+	t.unmerged = t.unmerged[:0]
+}
+
+// Quantile returns the estimate of the value below which the fraction q
+// of the values fall, or NaN if the digest is empty. q must be between 0
+// and 1.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+	c, n := t.centroids, t.count
+	switch {
+	case len(c) == 0:
+		return math.NaN()
+	case len(c) == 1:
+		return c[0].Mean
+	}
+
+	// The tails are interpolated between the extreme centroids and the
+	// smallest and largest values, which are known exactly.
+	index := q * n
+	if index < 1 {
+		return t.min
+	}
+	first, last := c[0], c[len(c)-1]
+	if first.Weight > 1 && index < first.Weight/2 {
+		return t.min + (index-1)/(first.Weight/2-1)*(first.Mean-t.min)
+	}
+	if index > n-1 {
+		return t.max
+	}
+	if last.Weight > 1 && n-index <= last.Weight/2 {
+		return t.max - (n-index-1)/(last.Weight/2-1)*(t.max-last.Mean)
+	}
+
+	// Elsewhere the estimate is interpolated between the centers of the two
+	// centroids around the index, and a centroid of a single value is
+	// returned as is when it is the closest.
+	weightSoFar := first.Weight / 2
+	for i := 0; i < len(c)-1; i++ {
+		dw := (c[i].Weight + c[i+1].Weight) / 2
+		if weightSoFar+dw <= index {
+			weightSoFar += dw
+			continue
+		}
+		leftUnit := 0.0
+		if c[i].Weight == 1 {
+			if index-weightSoFar < 0.5 {
+				return c[i].Mean
+			}
+			leftUnit = 0.5
+		}
+		rightUnit := 0.0
+		if c[i+1].Weight == 1 {
+			if weightSoFar+dw-index <= 0.5 {
+				return c[i+1].Mean
+			}
+			rightUnit = 0.5
+		}
+		z1 := index - weightSoFar - leftUnit
+		z2 := weightSoFar + dw - index - rightUnit
+		return weightedAverage(c[i].Mean, z2, c[i+1].Mean, z1)
+	}
+	// The index lies between the center of the last centroid and the end.
+	z1 := index - (n - last.Weight/2)
+	z2 := n - index
+	return weightedAverage(last.Mean, z2, t.max, z1)
+}
+
+// CDF returns the estimate of the fraction of the values that are smaller
+// than x, counting half of those equal to x, or NaN if the digest is empty.
+func (t *TDigest) CDF(x float64) float64 {
+	t.compress()
+	c, n := t.centroids, t.count
+	switch {
+	case len(c) == 0:
+		return math.NaN()
+	case x < t.min:
+		return 0
+	case x > t.max:
+		return 1
+	case len(c) == 1:
+		if t.max-t.min == 0 {
+			return 0.5
+		}
+		return (x - t.min) / (t.max - t.min)
+	}
+
+	first, last := c[0], c[len(c)-1]
+	if x < first.Mean {
+		if x == t.min {
+			return 0.5 / n
+		}
+		return (1 + (x-t.min)/(first.Mean-t.min)*(first.Weight/2-1)) / n
+	}
+	if x > last.Mean {
+		if x == t.max {
+			return 1 - 0.5/n
+		}
+		return 1 - (1+(t.max-x)/(t.max-last.Mean)*(last.Weight/2-1))/n
+	}
+
+	weightSoFar := 0.0
+	for i := 0; i < len(c)-1; i++ {
+		if c[i].Mean == x {
+			dw := 0.0
+			for ; i < len(c) && c[i].Mean == x; i++ {
+				dw += c[i].Weight
+			}
+			return (weightSoFar + dw/2) / n
+		}
+		if x < c[i+1].Mean {
+			leftExcluded, rightExcluded := 0.0, 0.0
+			if c[i].Weight == 1 {
+				if c[i+1].Weight == 1 {
+					return (weightSoFar + 1) / n
+				}
+				leftExcluded = 0.5
+			} else if c[i+1].Weight == 1 {
+				rightExcluded = 0.5
+			}
+			dw := (c[i].Weight+c[i+1].Weight)/2 - leftExcluded - rightExcluded
+			base := weightSoFar + c[i].Weight/2 + leftExcluded
+			return (base + dw*(x-c[i].Mean)/(c[i+1].Mean-c[i].Mean)) / n
+		}
+		weightSoFar += c[i].Weight
+	}
+	// x is the mean of the last centroid.
+	return 1 - last.Weight/2/n
+}
+
+// TrimmedMean returns the estimate of the mean of the values between the
+// quantiles low and high, or NaN if the digest is empty. low must be
+// smaller than high and both must be between 0 and 1.
+func (t *TDigest) TrimmedMean(low, high float64) float64 {
+	t.compress()
+	if t.count == 0 {
+		return math.NaN()
+	}
+
+	// Every centroid is taken as its values spread evenly over its weight,
+	// and counts for the part of it between the two quantiles.
+	left, right := low*t.count, high*t.count
+	sum, weight, weightSoFar := 0.0, 0.0, 0.0
+	for _, c := range t.centroids {
+		overlap := math.Min(weightSoFar+c.Weight, right) - math.Max(weightSoFar, left)
+		if overlap > 0 {
+			sum += c.Mean * overlap
+			weight += overlap
+		}
+		weightSoFar += c.Weight
+	}
+	if weight == 0 {
+		return math.NaN()
+	}
+	return sum / weight
+}
+
+// weightedAverage returns the average of x1 and x2 weighted by w1 and w2,
+// clamped between them against rounding errors.
+func weightedAverage(x1, w1, x2, w2 float64) float64 {
+	if x1 > x2 {
+		x1, w1, x2, w2 = x2, w2, x1, w1
+	}
+	x := (x1*w1 + x2*w2) / (w1 + w2)
+	return math.Max(x1, math.Min(x, x2))
+}
+
+// DeepCopy returns a copy of the digest that shares no state with t.
+func (t *TDigest) DeepCopy() *TDigest {
+	return &TDigest{
+		compression: t.compression,
+		centroids:   slices.Clone(t.centroids),
+		unmerged:    slices.Clone(t.unmerged),
+		count:       t.count,
+		min:         t.min,
+		max:         t.max,
+	}
+}
+
+// Serialize encodes the digest into buf, merging the buffered values into
+// the centroids first.
+func (t *TDigest) Serialize(buf *bytes.Buffer) error {
+	t.compress()
+	header := []float64{t.compression, t.count, t.min, t.max}
+	if err := binary.Write(buf, binary.BigEndian, header); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, uint64(len(t.centroids))); err != nil {
+		return err
+	}
+	return binary.Write(buf, binary.BigEndian, t.centroids)
+}
+
+// DeserializeTDigest decodes a digest encoded by Serialize from buf.
+func DeserializeTDigest(buf *bytes.Reader) (*TDigest, error) {
+	header := make([]float64, 4)
+	if err := binary.Read(buf, binary.BigEndian, header); err != nil {
+		return nil, err
+	}
+	var n uint64
+	if err := binary.Read(buf, binary.BigEndian, &n); err != nil {
+		return nil, err
+	}
+	if header[0] <= 0 || n > uint64(buf.Len()) {
+		return nil, errors.New("invalid t-digest")
+	}
+	t := &TDigest{
+		compression: header[0],
+		centroids:   make([]Centroid, n),
+		count:       header[1],
+		min:         header[2],
+		max:         header[3],
+	}
+	if err := binary.Read(buf, binary.BigEndian, t.centroids); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
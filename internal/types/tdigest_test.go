@@ -0,0 +1,91 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTDigestQuantile(t *testing.T) {
+	td := types.NewTDigest(100)
+	assert.True(t, math.IsNaN(td.Quantile(0.5)))
+
+	r := rand.New(rand.NewSource(1))
+	for _, i := range r.Perm(100000) {
+		td.Add(float64(i + 1))
+	}
+	assert.Equal(t, float64(100000), td.Count())
+	assert.Equal(t, float64(1), td.Min())
+	assert.Equal(t, float64(100000), td.Max())
+	assert.Equal(t, float64(1), td.Quantile(0))
+	assert.Equal(t, float64(100000), td.Quantile(1))
+	assert.InEpsilon(t, 50000, td.Quantile(0.5), 0.01)
+	assert.InEpsilon(t, 99000, td.Quantile(0.99), 0.001)
+	assert.InEpsilon(t, 99900, td.Quantile(0.999), 0.0005)
+	assert.InEpsilon(t, 0.25, td.CDF(25000), 0.01)
+	assert.Equal(t, float64(0), td.CDF(0))
+	assert.Equal(t, float64(1), td.CDF(100001))
+	assert.InEpsilon(t, 50000, td.TrimmedMean(0.1, 0.9), 0.01)
+}
+
+func TestTDigestSmall(t *testing.T) {
+	td := types.NewTDigest(100)
+	td.Add(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+
+	// Every value is a centroid of its own, so the estimates are exact.
+	assert.Equal(t, float64(1), td.Quantile(0.05))
+	assert.Equal(t, float64(3), td.Quantile(0.25))
+	assert.Equal(t, float64(10), td.Quantile(0.99))
+	assert.Equal(t, 0.45, td.CDF(5))
+	assert.Equal(t, 5.5, td.TrimmedMean(0, 1))
+	assert.Equal(t, 5.5, td.TrimmedMean(0.1, 0.9))
+	assert.Equal(t, float64(3), td.TrimmedMean(0, 0.5))
+}
+
+func TestTDigestMerge(t *testing.T) {
+	a, b := types.NewTDigest(100), types.NewTDigest(100)
+	for i := 1; i <= 1000; i++ {
+		a.Add(float64(i))
+		b.Add(float64(i + 1000))
+	}
+
+	merged := types.NewTDigest(100)
+	merged.Merge([]*types.TDigest{a, b})
+	assert.Equal(t, float64(2000), merged.Count())
+	assert.Equal(t, float64(1), merged.Min())
+	assert.Equal(t, float64(2000), merged.Max())
+	assert.InEpsilon(t, 1000, merged.Quantile(0.5), 0.01)
+	assert.InEpsilon(t, 1980, merged.Quantile(0.99), 0.001)
+	// The sources are left as they were.
+	assert.Equal(t, float64(1000), a.Count())
+	assert.Equal(t, float64(1000), a.Max())
+}
+
+func TestTDigestSerialize(t *testing.T) {
+	td := types.NewTDigest(50)
+	for i := 0; i < 1000; i++ {
+		td.Add(float64(i * i))
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, td.Serialize(&buf))
+	restored, err := types.DeserializeTDigest(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, td.Compression(), restored.Compression())
+	assert.Equal(t, td.Count(), restored.Count())
+	for _, q := range []float64{0, 0.1, 0.5, 0.9, 0.99, 1} {
+		assert.Equal(t, td.Quantile(q), restored.Quantile(q))
+	}
+
+	c := td.DeepCopy()
+	c.Add(-1)
+	assert.Equal(t, float64(0), td.Min())
+	assert.Equal(t, float64(-1), c.Min())
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrTimestampTooOld = errors.New("timestamp is older than the retention period of the time series")
+	ErrDuplicateSample = errors.New("a sample with the same timestamp exists and the duplicate policy is BLOCK")
+)
+
+// DuplicatePolicy decides what happens when a sample is added at the
+// timestamp of an existing sample.
+type DuplicatePolicy uint8
+
+const (
+	DuplicatePolicyBlock DuplicatePolicy = iota // reject the new sample
+	DuplicatePolicyFirst                        // keep the existing sample
+	DuplicatePolicyLast                         // replace the existing sample
+	DuplicatePolicyMin                          // keep the smaller value
+	DuplicatePolicyMax                          // keep the larger value
+	DuplicatePolicySum                          // add the new value to the existing one
+)
+
+var duplicatePolicyNames = [...]string{"BLOCK", "FIRST", "LAST", "MIN", "MAX", "SUM"}
+
+// ParseDuplicatePolicy parses the name of a duplicate policy, ignoring case.
+func ParseDuplicatePolicy(name string) (DuplicatePolicy, bool) {
+	for i, n := range duplicatePolicyNames {
+		if strings.EqualFold(name, n) {
+			return DuplicatePolicy(i), true
+		}
+	}
+	return 0, false
+}
+
+func (p DuplicatePolicy) String() string {
+	return duplicatePolicyNames[p]
+}
+
+// Aggregator reduces the samples of a time bucket to a single value.
+type Aggregator uint8
+
+const (
+	AggregatorAvg Aggregator = iota
+	AggregatorMin
+	AggregatorMax
+	AggregatorSum
+	AggregatorCount
+	AggregatorFirst
+	AggregatorLast
+)
+
+var aggregatorNames = [...]string{"avg", "min", "max", "sum", "count", "first", "last"}
+
+// ParseAggregator parses the name of an aggregator, ignoring case.
+func ParseAggregator(name string) (Aggregator, bool) {
+	for i, n := range aggregatorNames {
+		if strings.EqualFold(name, n) {
+			return Aggregator(i), true
+		}
+	}
+	return 0, false
+}
+
+func (a Aggregator) String() string {
+	return aggregatorNames[a]
+}
+
+// Sample is a value recorded at a unix time in milliseconds.
+type Sample struct {
+	Timestamp int64
+	Value     float64
+}
+
+// CompactionRule downsamples the samples of a time series into the time
+// series stored at DestKey, one sample per bucket of BucketDuration
+// milliseconds holding the aggregate of the samples of the bucket.
+type CompactionRule struct {
+	DestKey        string
+	Aggregator     Aggregator
+	BucketDuration int64
+}
+
+// BucketStart returns the timestamp of the bucket that holds timestamp.
+func (r CompactionRule) BucketStart(timestamp int64) int64 {
+	return timestamp - timestamp%r.BucketDuration
+}
+
+// TimeSeries is a series of samples ordered by timestamp. Samples are kept
+// in a slice sorted by timestamp, so appending the newest sample is cheap
+// and ranges are found by binary search.
+type TimeSeries struct {
+	// Retention is the maximum age of a sample, in milliseconds, relative
+	// to the newest sample. Older samples are removed. 0 keeps every sample.
+	Retention       int64
+	DuplicatePolicy DuplicatePolicy
+	Rules           []CompactionRule
+
+	samples []Sample
+}
+
+func NewTimeSeries(retention int64, policy DuplicatePolicy) *TimeSeries {
+	return &TimeSeries{
+		Retention:       retention,
+		DuplicatePolicy: policy,
+	}
+}
+
+// Len returns the number of samples in the time series.
+func (ts *TimeSeries) Len() int {
+	return len(ts.samples)
+}
+
+// Add adds a sample to the time series. If a sample already exists at the
+// timestamp, policy decides which value is kept. Samples older than the
+// retention period are rejected, and the samples that fall out of it
+// because of the new one are removed.
+func (ts *TimeSeries) Add(timestamp int64, value float64, policy DuplicatePolicy) error {
+	n := len(ts.samples)
+	if n > 0 && ts.Retention > 0 && timestamp < ts.samples[n-1].Timestamp-ts.Retention {
+		return ErrTimestampTooOld
+	}
+
+	if n == 0 || timestamp > ts.samples[n-1].Timestamp {
+		ts.samples = append(ts.samples, Sample{Timestamp: timestamp, Value: value})
+		ts.trim()
+		return nil
+	}
+
+	i := ts.search(timestamp)
+	if i < n && ts.samples[i].Timestamp == timestamp {
+		existing := &ts.samples[i].Value
+		switch policy {
+		case DuplicatePolicyBlock:
+			return ErrDuplicateSample
+		case DuplicatePolicyLast:
+			*existing = value
+		case DuplicatePolicyMin:
+			*existing = math.Min(*existing, value)
+		case DuplicatePolicyMax:
+			*existing = math.Max(*existing, value)
+		case DuplicatePolicySum:
+			*existing += value
+		}
+		return nil
+	}
+
+	ts.samples = append(ts.samples, Sample{})
+	copy(ts.samples[i+1:], ts.samples[i:])
+	ts.samples[i] = Sample{Timestamp: timestamp, Value: value}
+	return nil
+}
+
+// trim removes the samples older than the retention period.
+func (ts *TimeSeries) trim() {
+	if ts.Retention == 0 {
+		return
+	}
+	if i := ts.search(ts.samples[len(ts.samples)-1].Timestamp - ts.Retention); i > 0 {
+		// Copy the remaining samples so that the backing array of the
+		// removed ones can be garbage collected.
+		ts.samples = append([]Sample(nil), ts.samples[i:]...)
+	}
+}
+
+// search returns the index of the first sample with a timestamp not smaller
+// than timestamp.
+func (ts *TimeSeries) search(timestamp int64) int {
+	return sort.Search(len(ts.samples), func(i int) bool {
+		return ts.samples[i].Timestamp >= timestamp
+	})
+}
+
+// Range returns the samples with a timestamp between from and to, both
+// inclusive, in ascending order.
+func (ts *TimeSeries) Range(from, to int64) []Sample {
+	result := []Sample{}
+	for i := ts.search(from); i < len(ts.samples) && ts.samples[i].Timestamp <= to; i++ {
+		result = append(result, ts.samples[i])
+	}
+	return result
+}
+
+// Aggregate groups samples, sorted by timestamp, into buckets of
+// bucketDuration milliseconds and reduces every non-empty bucket to a
+// sample holding the aggregate of its values at the start of the bucket.
+func Aggregate(samples []Sample, aggregator Aggregator, bucketDuration int64) []Sample {
+	rule := CompactionRule{Aggregator: aggregator, BucketDuration: bucketDuration}
+	result := []Sample{}
+	for start := 0; start < len(samples); {
+		bucket := rule.BucketStart(samples[start].Timestamp)
+		end := start
+		for end < len(samples) && rule.BucketStart(samples[end].Timestamp) == bucket {
+			end++
+		}
+		result = append(result, Sample{Timestamp: bucket, Value: aggregate(samples[start:end], aggregator)})
+		start = end
+	}
+	return result
+}
+
+func aggregate(samples []Sample, aggregator Aggregator) float64 {
+	switch aggregator {
+	case AggregatorCount:
+		return float64(len(samples))
+	case AggregatorFirst:
+		return samples[0].Value
+	case AggregatorLast:
+		return samples[len(samples)-1].Value
+	}
+
+	result := samples[0].Value
+	for _, s := range samples[1:] {
+		switch aggregator {
+		case AggregatorMin:
+			result = math.Min(result, s.Value)
+		case AggregatorMax:
+			result = math.Max(result, s.Value)
+		default:
+			result += s.Value
+		}
+	}
+	if aggregator == AggregatorAvg {
+		result /= float64(len(samples))
+	}
+	return result
+}
+
+// DeepCopy returns a copy of the time series that shares no state with ts.
+func (ts *TimeSeries) DeepCopy() *TimeSeries {
+	return &TimeSeries{
+		Retention:       ts.Retention,
+		DuplicatePolicy: ts.DuplicatePolicy,
+		Rules:           append([]CompactionRule(nil), ts.Rules...),
+		samples:         append([]Sample(nil), ts.samples...),
+	}
+}
+
+// Serialize encodes the time series into buf, along with its compaction
+// rules.
+func (ts *TimeSeries) Serialize(buf *bytes.Buffer) error {
+	if err := binary.Write(buf, binary.BigEndian, ts.Retention); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, ts.DuplicatePolicy); err != nil {
+		return err
+	}
+	if err := WriteLength(buf, len(ts.Rules)); err != nil {
+		return err
+	}
+	for _, r := range ts.Rules {
+		if err := WriteString(buf, r.DestKey); err != nil {
+			return err
+		}
+		if err := binary.Write(buf, binary.BigEndian, r.Aggregator); err != nil {
+			return err
+		}
+		if err := binary.Write(buf, binary.BigEndian, r.BucketDuration); err != nil {
+			return err
+		}
+	}
+	if err := WriteLength(buf, len(ts.samples)); err != nil {
+		return err
+	}
+	return binary.Write(buf, binary.BigEndian, ts.samples)
+}
+
+// DeserializeTimeSeries decodes a time series encoded by Serialize from
+// buf.
+func DeserializeTimeSeries(buf *bytes.Reader) (*TimeSeries, error) {
+	ts := &TimeSeries{}
+	if err := binary.Read(buf, binary.BigEndian, &ts.Retention); err != nil {
+		return nil, err
+	}
+	if err := binary.Read(buf, binary.BigEndian, &ts.DuplicatePolicy); err != nil {
+		return nil, err
+	}
+	n, err := ReadLength(buf, 13)
+	if err != nil {
+		return nil, err
+	}
+	ts.Rules = make([]CompactionRule, n)
+	for i := range ts.Rules {
+		r := &ts.Rules[i]
+		if r.DestKey, err = ReadString(buf); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(buf, binary.BigEndian, &r.Aggregator); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(buf, binary.BigEndian, &r.BucketDuration); err != nil {
+			return nil, err
+		}
+		if r.BucketDuration <= 0 {
+			return nil, ErrInvalidSerialization
+		}
+	}
+	if n, err = ReadLength(buf, 16); err != nil {
+		return nil, err
+	}
+	ts.samples = make([]Sample, n)
+	if err := binary.Read(buf, binary.BigEndian, ts.samples); err != nil {
+		return nil, err
+	}
+	for i := 1; i < n; i++ {
+		if ts.samples[i].Timestamp <= ts.samples[i-1].Timestamp {
+			return nil, ErrInvalidSerialization
+		}
+	}
+	return ts, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeSeriesAddOutOfOrderAndDuplicates(t *testing.T) {
+	ts := types.NewTimeSeries(0, types.DuplicatePolicyBlock)
+	for _, timestamp := range []int64{30, 10, 20} {
+		assert.NoError(t, ts.Add(timestamp, float64(timestamp), ts.DuplicatePolicy))
+	}
+	assert.Equal(t, []types.Sample{{10, 10}, {20, 20}, {30, 30}}, ts.Range(0, 100))
+
+	assert.ErrorIs(t, ts.Add(20, 5, types.DuplicatePolicyBlock), types.ErrDuplicateSample)
+	assert.NoError(t, ts.Add(20, 5, types.DuplicatePolicyFirst))
+	assert.NoError(t, ts.Add(20, 5, types.DuplicatePolicySum))
+	assert.NoError(t, ts.Add(10, 15, types.DuplicatePolicyMax))
+	assert.NoError(t, ts.Add(30, 35, types.DuplicatePolicyMin))
+	assert.Equal(t, []types.Sample{{10, 15}, {20, 25}, {30, 30}}, ts.Range(0, 100))
+	assert.NoError(t, ts.Add(30, 1, types.DuplicatePolicyLast))
+	assert.Equal(t, []types.Sample{{20, 25}, {30, 1}}, ts.Range(11, 30))
+	assert.Equal(t, 3, ts.Len())
+}
+
+func TestTimeSeriesRetention(t *testing.T) {
+	ts := types.NewTimeSeries(100, types.DuplicatePolicyBlock)
+	assert.NoError(t, ts.Add(50, 1, ts.DuplicatePolicy))
+	assert.NoError(t, ts.Add(120, 2, ts.DuplicatePolicy))
+	assert.NoError(t, ts.Add(200, 3, ts.DuplicatePolicy))
+
+	// The newest sample is at 200, so the sample at 50 is removed and no
+	// sample older than 100 can be added.
+	assert.Equal(t, []types.Sample{{120, 2}, {200, 3}}, ts.Range(0, 1000))
+	assert.ErrorIs(t, ts.Add(99, 1, ts.DuplicatePolicy), types.ErrTimestampTooOld)
+	assert.NoError(t, ts.Add(100, 1, ts.DuplicatePolicy))
+	assert.Equal(t, 3, ts.Len())
+}
+
+func TestTimeSeriesAggregate(t *testing.T) {
+	samples := []types.Sample{{1, 4}, {5, 2}, {9, 6}, {10, 1}, {25, 3}, {29, 5}}
+
+	tests := []struct {
+		aggregator string
+		expected   []types.Sample
+	}{
+		{"avg", []types.Sample{{0, 4}, {10, 1}, {20, 4}}},
+		{"min", []types.Sample{{0, 2}, {10, 1}, {20, 3}}},
+		{"max", []types.Sample{{0, 6}, {10, 1}, {20, 5}}},
+		{"sum", []types.Sample{{0, 12}, {10, 1}, {20, 8}}},
+		{"count", []types.Sample{{0, 3}, {10, 1}, {20, 2}}},
+		{"first", []types.Sample{{0, 4}, {10, 1}, {20, 3}}},
+		{"LAST", []types.Sample{{0, 6}, {10, 1}, {20, 5}}},
+	}
+	for _, tt := range tests {
+		aggregator, ok := types.ParseAggregator(tt.aggregator)
+		assert.True(t, ok)
+		assert.Equal(t, tt.expected, types.Aggregate(samples, aggregator, 10), tt.aggregator)
+	}
+
+	_, ok := types.ParseAggregator("median")
+	assert.False(t, ok)
+	assert.Equal(t, []types.Sample{}, types.Aggregate(nil, types.AggregatorAvg, 10))
+}
+
+func TestTimeSeriesDeepCopy(t *testing.T) {
+	ts := types.NewTimeSeries(0, types.DuplicatePolicyLast)
+	_ = ts.Add(1, 1, ts.DuplicatePolicy)
+	ts.Rules = append(ts.Rules, types.CompactionRule{DestKey: "dst", Aggregator: types.AggregatorSum, BucketDuration: 10})
+
+	c := ts.DeepCopy()
+	_ = c.Add(2, 2, c.DuplicatePolicy)
+	c.Rules[0].DestKey = "other"
+
+	assert.Equal(t, 1, ts.Len())
+	assert.Equal(t, "dst", ts.Rules[0].DestKey)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestTimeSeriesSerialize(t *testing.T) {
+	ts := types.NewTimeSeries(100, types.DuplicatePolicyLast)
+	_ = ts.Add(1, 1.5, ts.DuplicatePolicy)
+	_ = ts.Add(2, 2.5, ts.DuplicatePolicy)
+	ts.Rules = append(ts.Rules, types.CompactionRule{DestKey: "dst", Aggregator: types.AggregatorSum, BucketDuration: 10})
+
+	var buf bytes.Buffer
+	assert.NoError(t, ts.Serialize(&buf))
+	r := bytes.NewReader(buf.Bytes())
+	c, err := types.DeserializeTimeSeries(r)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, ts.Retention, c.Retention)
+	assert.Equal(t, ts.DuplicatePolicy, c.DuplicatePolicy)
+	assert.Equal(t, ts.Rules, c.Rules)
+	assert.Equal(t, ts.Range(0, 10), c.Range(0, 10))
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"math"
+	"sort"
+)
+
+// TopKItem is an item tracked by a TopK and its estimated count.
+type TopKItem struct {
+	Item  string
+	Count uint64
+}
+
+// TopK tracks the k items seen most often in a stream with bounded memory.
+// A Count-Min Sketch estimates the count of every item and a min-heap holds
+// the k items with the highest estimates. An item whose estimate grows past
+// the smallest one in the heap replaces it, which is expelled.
+//
+// The counts are estimates, which never undercount, so an infrequent item
+// sharing the counters of frequent ones may enter the top k.
+type TopK struct {
+	k    int
+	cms  *CountMinSketch
+	heap *topKHeap
+}
+
+// NewTopK creates a TopK tracking k items whose counts are estimated by a
+// Count-Min Sketch with the given width and depth.
+func NewTopK(k int, width, depth uint64) *TopK {
+	return &TopK{
+		k:    k,
+		cms:  NewCountMinSketch(NewCountMinSketchOptsWithDims(width, depth)),
+		heap: &topKHeap{index: map[string]int{}},
+	}
+}
+
+// K returns the number of items tracked.
+func (t *TopK) K() int {
+	return t.k
+}
+
+// Width returns the width of the Count-Min Sketch estimating the counts.
+func (t *TopK) Width() uint64 {
+	return t.cms.Width()
+}
+
+// Depth returns the depth of the Count-Min Sketch estimating the counts.
+func (t *TopK) Depth() uint64 {
+	return t.cms.Depth()
+}
+
+// IncrBy increases the count of item by increment and returns the item it
+// expelled from the top k to enter it, if any.
+func (t *TopK) IncrBy(item string, increment uint64) (expelled string, ok bool) {
+	t.cms.UpdateMatrix(item, increment)
+	count := t.cms.EstimateCount(item)
+
+	h := t.heap
+	if i, found := h.index[item]; found {
+		h.items[i].Count = count
+		heap.Fix(h, i)
+		return "", false
+	}
+	if h.Len() < t.k {
+		heap.Push(h, TopKItem{Item: item, Count: count})
+		return "", false
+	}
+	if count <= h.items[0].Count {
+		return "", false
+	}
+	expelled = h.items[0].Item
+	delete(h.index, expelled)
+	h.items[0] = TopKItem{Item: item, Count: count}
+	h.index[item] = 0
+	heap.Fix(h, 0)
+	return expelled, true
+}
+
+// Contains reports whether item is one of the top k items.
+func (t *TopK) Contains(item string) bool {
+	_, ok := t.heap.index[item]
+	return ok
+}
+
+// Count returns the estimated count of item, whether it is one of the top
+// k items or not.
+func (t *TopK) Count(item string) uint64 {
+	return t.cms.EstimateCount(item)
+}
+
+// List returns the top k items, the most frequent first. Items with the
+// same count are sorted by name.
+func (t *TopK) List() []TopKItem {
+	items := append([]TopKItem(nil), t.heap.items...)
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Item < items[j].Item
+	})
+	return items
+}
+
+// DeepCopy returns a copy of the TopK that shares no state with t.
+func (t *TopK) DeepCopy() *TopK {
+	index := make(map[string]int, len(t.heap.index))
+	for item, i := range t.heap.index {
+		index[item] = i
+	}
+	return &TopK{
+		k:    t.k,
+		cms:  t.cms.DeepCopy(),
+		heap: &topKHeap{items: append([]TopKItem(nil), t.heap.items...), index: index},
+	}
+}
+
+// Serialize encodes the TopK into buf, along with the Count-Min Sketch
+// estimating the counts.
+func (t *TopK) Serialize(buf *bytes.Buffer) error {
+	if err := binary.Write(buf, binary.BigEndian, uint64(t.k)); err != nil {
+		return err
+	}
+	if err := t.cms.Serialize(buf); err != nil {
+		return err
+	}
+	if err := WriteLength(buf, len(t.heap.items)); err != nil {
+		return err
+	}
+	for _, item := range t.heap.items {
+		if err := WriteString(buf, item.Item); err != nil {
+			return err
+		}
+		if err := binary.Write(buf, binary.BigEndian, item.Count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeserializeTopK decodes a TopK encoded by Serialize from buf.
+func DeserializeTopK(buf *bytes.Reader) (*TopK, error) {
+	var k uint64
+	if err := binary.Read(buf, binary.BigEndian, &k); err != nil {
+		return nil, err
+	}
+	cms, err := DeserializeCMS(buf)
+	if err != nil {
+		return nil, err
+	}
+	n, err := ReadLength(buf, 12)
+	if err != nil {
+		return nil, err
+	}
+	if k == 0 || k > math.MaxInt || uint64(n) > k {
+		return nil, ErrInvalidSerialization
+	}
+	h := &topKHeap{items: make([]TopKItem, n), index: make(map[string]int, n)}
+	for i := range h.items {
+		if h.items[i].Item, err = ReadString(buf); err != nil {
+			return nil, err
+		}
+		if err := binary.Read(buf, binary.BigEndian, &h.items[i].Count); err != nil {
+			return nil, err
+		}
+		h.index[h.items[i].Item] = i
+	}
+	if len(h.index) != n {
+		return nil, ErrInvalidSerialization
+	}
+	heap.Init(h)
+	return &TopK{k: int(k), cms: cms, heap: h}, nil
+}
+
+// topKHeap is a min-heap of items ordered by count, which keeps the
+// position of every item to update its count in place.
+type topKHeap struct {
+	items []TopKItem
+	index map[string]int
+}
+
+func (h *topKHeap) Len() int { return len(h.items) }
+
+func (h *topKHeap) Less(i, j int) bool {
+	if h.items[i].Count != h.items[j].Count {
+		return h.items[i].Count < h.items[j].Count
+	}
+	// The item expelled first among those with the same count is the
+	// greatest, so that List and the heap agree on the order.
+	return h.items[i].Item > h.items[j].Item
+}
+
+func (h *topKHeap) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.index[h.items[i].Item] = i
+	h.index[h.items[j].Item] = j
+}
+
+func (h *topKHeap) Push(x any) {
+	item := x.(TopKItem)
+	h.index[item.Item] = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *topKHeap) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	delete(h.index, last.Item)
+	return last
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopKIncrBy(t *testing.T) {
+	topk := types.NewTopK(2, 2000, 5)
+
+	_, expelled := topk.IncrBy("a", 1)
+	assert.False(t, expelled)
+	topk.IncrBy("b", 2)
+	assert.Equal(t, []types.TopKItem{{Item: "b", Count: 2}, {Item: "a", Count: 1}}, topk.List())
+
+	// c needs more than the count of a to expel it.
+	_, expelled = topk.IncrBy("c", 1)
+	assert.False(t, expelled)
+	item, expelled := topk.IncrBy("c", 2)
+	assert.True(t, expelled)
+	assert.Equal(t, "a", item)
+	assert.Equal(t, []types.TopKItem{{Item: "c", Count: 3}, {Item: "b", Count: 2}}, topk.List())
+
+	assert.True(t, topk.Contains("c"))
+	assert.False(t, topk.Contains("a"))
+	assert.Equal(t, uint64(1), topk.Count("a"))
+}
+
+func TestTopKHeavyHitters(t *testing.T) {
+	topk := types.NewTopK(5, 2000, 5)
+	// Items 0 to 4 occur 100 times more than the 1000 others.
+	for round := 0; round < 100; round++ {
+		for i := 0; i < 5; i++ {
+			topk.IncrBy("hot"+strconv.Itoa(i), 1)
+		}
+		for i := 0; i < 10; i++ {
+			topk.IncrBy("cold"+strconv.Itoa(round*10+i), 1)
+		}
+	}
+
+	items := topk.List()
+	assert.Equal(t, 5, len(items))
+	for _, item := range items {
+		assert.Contains(t, []string{"hot0", "hot1", "hot2", "hot3", "hot4"}, item.Item)
+		assert.GreaterOrEqual(t, item.Count, uint64(100))
+	}
+}
+
+func TestTopKDeepCopy(t *testing.T) {
+	topk := types.NewTopK(2, 100, 3)
+	topk.IncrBy("a", 5)
+
+	c := topk.DeepCopy()
+	c.IncrBy("a", 1)
+	c.IncrBy("b", 1)
+	assert.Equal(t, []types.TopKItem{{Item: "a", Count: 5}}, topk.List())
+	assert.Equal(t, []types.TopKItem{{Item: "a", Count: 6}, {Item: "b", Count: 1}}, c.List())
+}
+
+func TestTopKSerialize(t *testing.T) {
+	topk := types.NewTopK(2, 100, 3)
+	topk.IncrBy("a", 5)
+	topk.IncrBy("b", 3)
+	topk.IncrBy("c", 1)
+
+	var buf bytes.Buffer
+	assert.NoError(t, topk.Serialize(&buf))
+	r := bytes.NewReader(buf.Bytes())
+	c, err := types.DeserializeTopK(r)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, topk.List(), c.List())
+	assert.Equal(t, topk.Count("c"), c.Count("c"))
+
+	// c enters the top k once its count passes the count of b.
+	_, expelled := c.IncrBy("c", 3)
+	assert.True(t, expelled)
+	assert.True(t, c.Contains("c"))
+}
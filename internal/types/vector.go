@@ -0,0 +1,448 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrVectorDimMismatch = errors.New("the vector does not have the dimension of the index")
+	ErrZeroVector        = errors.New("a zero vector has no direction and cannot be compared with the cosine metric")
+)
+
+// VectorMetric measures the distance between two vectors. A smaller distance
+// means more similar vectors.
+type VectorMetric uint8
+
+const (
+	VectorMetricCosine VectorMetric = iota // 1 - the cosine of the angle between the vectors
+	VectorMetricL2                         // the euclidean distance
+	VectorMetricIP                         // 1 - the inner product
+)
+
+var vectorMetricNames = [...]string{"COSINE", "L2", "IP"}
+
+// ParseVectorMetric parses the name of a metric, ignoring case.
+func ParseVectorMetric(name string) (VectorMetric, bool) {
+	for i, n := range vectorMetricNames {
+		if strings.EqualFold(name, n) {
+			return VectorMetric(i), true
+		}
+	}
+	return 0, false
+}
+
+func (m VectorMetric) String() string {
+	return vectorMetricNames[m]
+}
+
+// Distance returns the distance between a and b, which must have the same
+// dimension.
+func (m VectorMetric) Distance(a, b []float32) float64 {
+	var dot, normA, normB, l2 float64
+	for i := range a {
+		x, y := float64(a[i]), float64(b[i])
+		switch m {
+		case VectorMetricL2:
+			l2 += (x - y) * (x - y)
+		default:
+			dot += x * y
+			normA += x * x
+			normB += y * y
+		}
+	}
+	switch m {
+	case VectorMetricL2:
+		return math.Sqrt(l2)
+	case VectorMetricIP:
+		return 1 - dot
+	default:
+		return 1 - dot/math.Sqrt(normA*normB)
+	}
+}
+
+// Vector is an embedding stored under a key and indexed by the vector index
+// named Index. Attrs are the attributes searches can filter on.
+type Vector struct {
+	Index  string
+	Values []float32
+	Attrs  map[string]string
+}
+
+// Matches reports whether the vector has every attribute of filter with the
+// same value.
+func (v *Vector) Matches(filter map[string]string) bool {
+	for field, value := range filter {
+		if v.Attrs[field] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns a copy of the vector that shares no state with v.
+func (v *Vector) DeepCopy() *Vector {
+	attrs := make(map[string]string, len(v.Attrs))
+	for field, value := range v.Attrs {
+		attrs[field] = value
+	}
+	return &Vector{
+		Index:  v.Index,
+		Values: append([]float32(nil), v.Values...),
+		Attrs:  attrs,
+	}
+}
+
+// Serialize encodes the vector into buf. The vector index holding it is not
+// part of the encoding, only its name.
+func (v *Vector) Serialize(buf *bytes.Buffer) error {
+	if err := WriteString(buf, v.Index); err != nil {
+		return err
+	}
+	if err := WriteLength(buf, len(v.Values)); err != nil {
+		return err
+	}
+	if err := binary.Write(buf, binary.BigEndian, v.Values); err != nil {
+		return err
+	}
+	return WriteStringMap(buf, v.Attrs)
+}
+
+// DeserializeVector decodes a vector encoded by Serialize from buf.
+func DeserializeVector(buf *bytes.Reader) (*Vector, error) {
+	index, err := ReadString(buf)
+	if err != nil {
+		return nil, err
+	}
+	n, err := ReadLength(buf, 4)
+	if err != nil {
+		return nil, err
+	}
+	v := &Vector{Index: index, Values: make([]float32, n)}
+	if err := binary.Read(buf, binary.BigEndian, v.Values); err != nil {
+		return nil, err
+	}
+	if v.Attrs, err = ReadStringMap(buf); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// VectorMatch is a vector found by a search and its distance to the query.
+type VectorMatch struct {
+	Key      string
+	Vector   *Vector
+	Distance float64
+}
+
+// hnswNode is a vector of the graph and its neighbours on every layer it
+// belongs to, from layer 0 up.
+type hnswNode struct {
+	key       string
+	vector    *Vector
+	neighbors [][]int
+	removed   bool
+}
+
+// VectorIndex indexes the vectors of a shard for nearest-neighbour search.
+// It supports an exact search, which compares the query with every vector,
+// and an approximate search over a hierarchical navigable small world
+// (HNSW) graph built incrementally as vectors are added.
+//
+// Removing a vector only marks its node as removed, since its edges keep
+// the graph connected: the node still routes searches but is never
+// returned. Once removed nodes make up half of the graph, the graph is
+// rebuilt from the vectors left, so that they do not accumulate.
+type VectorIndex struct {
+	Name           string
+	Dim            int
+	Metric         VectorMetric
+	M              int // the number of neighbours of a node on every layer but 0, which has 2*M
+	EfConstruction int // the number of candidates considered when a vector is added
+
+	mu       sync.RWMutex
+	nodes    []*hnswNode
+	byKey    map[string]int // the node of the vector stored at every key
+	removed  int            // the number of nodes marked as removed
+	entry    int
+	maxLevel int
+	rng      *rand.Rand
+}
+
+func NewVectorIndex(name string, dim int, metric VectorMetric, m, efConstruction int) *VectorIndex {
+	return &VectorIndex{
+		Name:           name,
+		Dim:            dim,
+		Metric:         metric,
+		M:              m,
+		EfConstruction: efConstruction,
+		byKey:          map[string]int{},
+		entry:          -1,
+		rng:            rand.New(rand.NewSource(rand.Int63())), //nolint:gosec
+	}
+}
+
+// Validate checks that values can be added to or searched in the index.
+func (idx *VectorIndex) Validate(values []float32) error {
+	if len(values) != idx.Dim {
+		return ErrVectorDimMismatch
+	}
+	if idx.Metric == VectorMetricCosine {
+		for _, x := range values {
+			if x != 0 {
+				return nil
+			}
+		}
+		return ErrZeroVector
+	}
+	return nil
+}
+
+// Len returns the number of nodes of the graph, including the nodes that
+// were removed since the graph was last rebuilt.
+func (idx *VectorIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.nodes)
+}
+
+func (idx *VectorIndex) distance(query []float32, node int) float64 {
+	return idx.Metric.Distance(query, idx.nodes[node].vector.Values)
+}
+
+// maxNeighbors returns the number of neighbours a node keeps on a layer.
+func (idx *VectorIndex) maxNeighbors(level int) int {
+	if level == 0 {
+		return 2 * idx.M
+	}
+	return idx.M
+}
+
+// Add adds the vector stored at key to the graph, replacing the vector
+// previously added for key, if any.
+func (idx *VectorIndex) Add(key string, v *Vector) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if id, ok := idx.byKey[key]; ok {
+		if idx.nodes[id].vector == v {
+			return
+		}
+		idx.remove(key)
+	}
+	idx.add(key, v)
+}
+
+// Remove removes the vector stored at key from the graph, if any.
+func (idx *VectorIndex) Remove(key string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(key)
+}
+
+func (idx *VectorIndex) remove(key string) {
+	id, ok := idx.byKey[key]
+	if !ok {
+		return
+	}
+	delete(idx.byKey, key)
+	idx.nodes[id].removed = true
+	idx.removed++
+	if 2*idx.removed >= len(idx.nodes) {
+		idx.rebuild()
+	}
+}
+
+// rebuild replaces the graph with a graph of the vectors that were not
+// removed.
+func (idx *VectorIndex) rebuild() {
+	nodes := idx.nodes
+	idx.nodes, idx.byKey, idx.removed = nil, map[string]int{}, 0
+	idx.entry, idx.maxLevel = -1, 0
+	for _, n := range nodes {
+		if !n.removed {
+			idx.add(n.key, n.vector)
+		}
+	}
+}
+
+func (idx *VectorIndex) add(key string, v *Vector) {
+	level := int(-math.Log(1-idx.rng.Float64()) / math.Log(float64(max(idx.M, 2))))
+	id := len(idx.nodes)
+	idx.nodes = append(idx.nodes, &hnswNode{key: key, vector: v, neighbors: make([][]int, level+1)})
+	idx.byKey[key] = id
+	if idx.entry < 0 {
+		idx.entry, idx.maxLevel = id, level
+		return
+	}
+
+	entry := idx.entry
+	for l := idx.maxLevel; l > level; l-- {
+		entry = idx.searchLayer(v.Values, entry, 1, l)[0].node
+	}
+	for l := min(level, idx.maxLevel); l >= 0; l-- {
+		candidates := idx.searchLayer(v.Values, entry, idx.EfConstruction, l)
+		neighbors := make([]int, 0, idx.M)
+		for _, c := range candidates[:min(idx.M, len(candidates))] {
+			neighbors = append(neighbors, c.node)
+		}
+		idx.nodes[id].neighbors[l] = neighbors
+		for _, n := range neighbors {
+			idx.connect(n, id, l)
+		}
+		entry = candidates[0].node
+	}
+	if level > idx.maxLevel {
+		idx.entry, idx.maxLevel = id, level
+	}
+}
+
+// connect adds an edge from node to neighbor on the given layer, keeping
+// only the closest neighbours of node if it has too many.
+func (idx *VectorIndex) connect(node, neighbor, level int) {
+	n := idx.nodes[node]
+	n.neighbors[level] = append(n.neighbors[level], neighbor)
+	if len(n.neighbors[level]) <= idx.maxNeighbors(level) {
+		return
+	}
+	candidates := make([]candidate, len(n.neighbors[level]))
+	for i, nb := range n.neighbors[level] {
+		candidates[i] = candidate{node: nb, distance: idx.distance(n.vector.Values, nb)}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	n.neighbors[level] = n.neighbors[level][:0]
+	for _, c := range candidates[:idx.maxNeighbors(level)] {
+		n.neighbors[level] = append(n.neighbors[level], c.node)
+	}
+}
+
+// searchLayer returns the ef nodes of a layer closest to query, found by a
+// best-first traversal from entry, sorted by distance.
+func (idx *VectorIndex) searchLayer(query []float32, entry, ef, level int) []candidate {
+	return idx.searchLayerFiltered(query, entry, ef, level, nil)
+}
+
+// searchLayerFiltered is searchLayer keeping only the nodes accepted by
+// accept in the result. The traversal still goes through the nodes that are
+// rejected, so that they keep connecting the graph.
+func (idx *VectorIndex) searchLayerFiltered(query []float32, entry, ef, level int, accept func(*hnswNode) bool) []candidate {
+	visited := map[int]bool{entry: true}
+	start := candidate{node: entry, distance: idx.distance(query, entry)}
+	candidates := &candidateHeap{items: []candidate{start}}
+	results := &candidateHeap{max: true}
+	if accept == nil || accept(idx.nodes[entry]) {
+		heap.Push(results, start)
+	}
+
+	for candidates.Len() > 0 {
+		c := heap.Pop(candidates).(candidate)
+		if results.Len() >= ef && c.distance > results.top().distance {
+			break
+		}
+		for _, nb := range idx.nodes[c.node].neighbors[level] {
+			if visited[nb] {
+				continue
+			}
+			visited[nb] = true
+			d := idx.distance(query, nb)
+			if results.Len() >= ef && d >= results.top().distance {
+				continue
+			}
+			heap.Push(candidates, candidate{node: nb, distance: d})
+			if accept == nil || accept(idx.nodes[nb]) {
+				heap.Push(results, candidate{node: nb, distance: d})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	sorted := results.items
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].distance < sorted[j].distance })
+	return sorted
+}
+
+// Search returns the k vectors closest to query among those accepted by
+// accept, sorted by distance. With exact set, query is compared with every
+// vector; otherwise the graph is searched keeping the ef closest candidates,
+// which finds the nearest vectors with high probability in a fraction of the
+// comparisons.
+func (idx *VectorIndex) Search(query []float32, k int, exact bool, ef int, accept func(key string, v *Vector) bool) []VectorMatch {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.entry < 0 || k <= 0 {
+		return []VectorMatch{}
+	}
+	acceptNode := func(n *hnswNode) bool {
+		return !n.removed && accept(n.key, n.vector)
+	}
+
+	var found []candidate
+	if exact {
+		for i, n := range idx.nodes {
+			if acceptNode(n) {
+				found = append(found, candidate{node: i, distance: idx.distance(query, i)})
+			}
+		}
+		sort.Slice(found, func(i, j int) bool { return found[i].distance < found[j].distance })
+	} else {
+		entry := idx.entry
+		for l := idx.maxLevel; l > 0; l-- {
+			entry = idx.searchLayer(query, entry, 1, l)[0].node
+		}
+		found = idx.searchLayerFiltered(query, entry, max(ef, k), 0, acceptNode)
+	}
+
+	matches := make([]VectorMatch, 0, min(k, len(found)))
+	for _, c := range found[:min(k, len(found))] {
+		n := idx.nodes[c.node]
+		matches = append(matches, VectorMatch{Key: n.key, Vector: n.vector, Distance: c.distance})
+	}
+	return matches
+}
+
+// candidate is a node of the graph and its distance to a query.
+type candidate struct {
+	node     int
+	distance float64
+}
+
+// candidateHeap is a heap of candidates ordered by distance, closest first,
+// or farthest first if max is set.
+type candidateHeap struct {
+	items []candidate
+	max   bool
+}
+
+func (h *candidateHeap) Len() int { return len(h.items) }
+
+func (h *candidateHeap) Less(i, j int) bool {
+	if h.max {
+		return h.items[i].distance > h.items[j].distance
+	}
+	return h.items[i].distance < h.items[j].distance
+}
+
+func (h *candidateHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *candidateHeap) Push(x any) { h.items = append(h.items, x.(candidate)) }
+
+func (h *candidateHeap) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
+
+func (h *candidateHeap) top() candidate { return h.items[0] }
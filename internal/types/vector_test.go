@@ -0,0 +1,151 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package types_test
+
+import (
+	"bytes"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/dicedb/dice/internal/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func matchKeys(matches []types.VectorMatch) []string {
+	keys := []string{}
+	for _, m := range matches {
+		keys = append(keys, m.Key)
+	}
+	return keys
+}
+
+func TestVectorMetricDistance(t *testing.T) {
+	a, b := []float32{1, 0}, []float32{0, 2}
+	assert.InDelta(t, 1, types.VectorMetricCosine.Distance(a, b), 1e-9)
+	assert.InDelta(t, 0, types.VectorMetricCosine.Distance(b, []float32{0, 5}), 1e-9)
+	assert.InDelta(t, 2.236068, types.VectorMetricL2.Distance(a, b), 1e-6)
+	assert.InDelta(t, -1, types.VectorMetricIP.Distance([]float32{1, 1}, []float32{1, 1}), 1e-9)
+}
+
+func TestVectorIndexSearch(t *testing.T) {
+	idx := types.NewVectorIndex("idx", 2, types.VectorMetricL2, 4, 20)
+	vectors := map[string]*types.Vector{}
+	for i := 0; i < 10; i++ {
+		key := "v" + strconv.Itoa(i)
+		vectors[key] = &types.Vector{Values: []float32{float32(i), 0}, Attrs: map[string]string{"even": strconv.FormatBool(i%2 == 0)}}
+		idx.Add(key, vectors[key])
+	}
+	alive := func(key string, v *types.Vector) bool { return vectors[key] == v }
+
+	for _, exact := range []bool{true, false} {
+		assert.Equal(t, []string{"v3", "v4", "v2"}, matchKeys(idx.Search([]float32{3.2, 0}, 3, exact, 10, alive)))
+	}
+
+	// A replaced vector keeps its node until the graph is rebuilt, but is no
+	// longer returned.
+	vectors["v3"] = &types.Vector{Values: []float32{100, 0}}
+	idx.Add("v3", vectors["v3"])
+	assert.Equal(t, 11, idx.Len())
+	all := func(string, *types.Vector) bool { return true }
+	assert.Equal(t, []string{"v4", "v2"}, matchKeys(idx.Search([]float32{3.2, 0}, 2, false, 10, all)))
+
+	odd := func(key string, v *types.Vector) bool {
+		return alive(key, v) && v.Matches(map[string]string{"even": "false"})
+	}
+	assert.Equal(t, []string{"v5", "v1", "v7"}, matchKeys(idx.Search([]float32{3.2, 0}, 3, false, 3, odd)))
+}
+
+func TestVectorIndexRemove(t *testing.T) {
+	idx := types.NewVectorIndex("idx", 2, types.VectorMetricL2, 4, 20)
+	vectors := map[string]*types.Vector{}
+	for i := 0; i < 10; i++ {
+		key := "v" + strconv.Itoa(i)
+		vectors[key] = &types.Vector{Values: []float32{float32(i), 0}}
+		idx.Add(key, vectors[key])
+	}
+	all := func(string, *types.Vector) bool { return true }
+
+	// Adding the vector a key already holds leaves the graph as it is.
+	idx.Add("v0", vectors["v0"])
+	assert.Equal(t, 10, idx.Len())
+
+	for i := 0; i < 4; i++ {
+		idx.Remove("v" + strconv.Itoa(i))
+	}
+	idx.Remove("missing")
+	assert.Equal(t, 10, idx.Len())
+	for _, exact := range []bool{true, false} {
+		assert.Equal(t, []string{"v4", "v5"}, matchKeys(idx.Search([]float32{0, 0}, 2, exact, 10, all)))
+	}
+
+	// Removing half of the vectors rebuilds the graph without them.
+	idx.Remove("v4")
+	assert.Equal(t, 5, idx.Len())
+	for _, exact := range []bool{true, false} {
+		assert.Equal(t, []string{"v5", "v6"}, matchKeys(idx.Search([]float32{0, 0}, 2, exact, 10, all)))
+	}
+
+	for i := 5; i < 10; i++ {
+		idx.Remove("v" + strconv.Itoa(i))
+	}
+	assert.Equal(t, 0, idx.Len())
+	assert.Empty(t, idx.Search([]float32{0, 0}, 2, false, 10, all))
+	idx.Add("v0", vectors["v0"])
+	assert.Equal(t, []string{"v0"}, matchKeys(idx.Search([]float32{0, 0}, 2, false, 10, all)))
+}
+
+func TestVectorIndexRecall(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randomVector := func() []float32 {
+		values := make([]float32, 16)
+		for i := range values {
+			values[i] = rng.Float32()
+		}
+		return values
+	}
+
+	idx := types.NewVectorIndex("idx", 16, types.VectorMetricCosine, 16, 100)
+	for i := 0; i < 1000; i++ {
+		idx.Add(strconv.Itoa(i), &types.Vector{Values: randomVector()})
+	}
+	alive := func(string, *types.Vector) bool { return true }
+
+	found, total := 0, 0
+	for q := 0; q < 20; q++ {
+		query := randomVector()
+		exact := map[string]bool{}
+		for _, key := range matchKeys(idx.Search(query, 10, true, 0, alive)) {
+			exact[key] = true
+		}
+		for _, key := range matchKeys(idx.Search(query, 10, false, 50, alive)) {
+			if exact[key] {
+				found++
+			}
+		}
+		total += 10
+	}
+	assert.GreaterOrEqual(t, float64(found)/float64(total), 0.9)
+}
+
+func TestVectorDeepCopy(t *testing.T) {
+	v := &types.Vector{Index: "idx", Values: []float32{1, 2}, Attrs: map[string]string{"a": "b"}}
+	c := v.DeepCopy()
+	c.Values[0] = 5
+	c.Attrs["a"] = "c"
+	assert.Equal(t, []float32{1, 2}, v.Values)
+	assert.Equal(t, "b", v.Attrs["a"])
+}
+
+func TestVectorSerialize(t *testing.T) {
+	v := &types.Vector{Index: "idx", Values: []float32{1, 2.5}, Attrs: map[string]string{"a": "b", "c": "d"}}
+
+	var buf bytes.Buffer
+	assert.NoError(t, v.Serialize(&buf))
+	r := bytes.NewReader(buf.Bytes())
+	c, err := types.DeserializeVector(r)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Len())
+	assert.Equal(t, v, c)
+}
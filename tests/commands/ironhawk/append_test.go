@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAPPEND(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "APPEND to a missing key",
+			commands:       []string{"APPEND ap1 hello", "GET ap1"},
+			expected:       []interface{}{int64(5), "hello"},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "APPEND to a string",
+			commands:       []string{"SET ap2 hello", "APPEND ap2 world", "GET ap2"},
+			expected:       []interface{}{"OK", int64(10), "helloworld"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "APPEND to an integer",
+			commands:       []string{"SET ap3 12", "APPEND ap3 34", "INCRBY ap3 1"},
+			expected:       []interface{}{"OK", int64(4), int64(1235)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "APPEND keeps the expiry",
+			commands:       []string{"SET ap4 v PX 100500", "APPEND ap4 w", "TTL ap4"},
+			expected:       []interface{}{"OK", int64(2), int64(100)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueTTL},
+		},
+		{
+			name:           "APPEND to a bitmap",
+			commands:       []string{"SETBIT ap5 1 1", "APPEND ap5 A", "GETBIT ap5 9"},
+			expected:       []interface{}{int64(0), int64(2), int64(1)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "APPEND on a key of another type",
+			commands:       []string{"SADD ap6 a", "APPEND ap6 b"},
+			expected:       []interface{}{int64(1), errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "APPEND with wrong number of arguments",
+			commands:       []string{"APPEND ap7"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'APPEND' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
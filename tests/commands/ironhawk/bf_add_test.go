@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBFADD(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestBFADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Add Item",
+			commands:       []string{"BF.ADD bfa alice", "BF.ADD bfa alice", "BF.ADD bfa bob"},
+			expected:       []interface{}{1, 0, 1},
+			valueExtractor: []ValueExtractorFn{extractValueBFADD, extractValueBFADD, extractValueBFADD},
+		},
+		{
+			name:           "Add to non-filter Key",
+			commands:       []string{"SET bfa1 v", "BF.ADD bfa1 alice"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "Add with Wrong Number of Arguments",
+			commands:       []string{"BF.ADD bfa"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BF.ADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBFCARD(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestBFCARD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Cardinality of Filter",
+			commands:       []string{"BF.ADD bfc alice", "BF.ADD bfc bob", "BF.ADD bfc alice", "BF.CARD bfc"},
+			expected:       []interface{}{1, 1, 0, 2},
+			valueExtractor: []ValueExtractorFn{extractValueBFADD, extractValueBFADD, extractValueBFADD, extractValueBFCARD},
+		},
+		{
+			name:           "Cardinality of Non-existent Key",
+			commands:       []string{"BF.CARD nokey"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueBFCARD},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
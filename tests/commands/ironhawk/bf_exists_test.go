@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBFEXISTS(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestBFEXISTS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Check Items",
+			commands:       []string{"BF.ADD bfe alice", "BF.EXISTS bfe alice", "BF.EXISTS bfe bob"},
+			expected:       []interface{}{1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueBFADD, extractValueBFEXISTS, extractValueBFEXISTS},
+		},
+		{
+			name:           "Check Non-existent Key",
+			commands:       []string{"BF.EXISTS nokey alice"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueBFEXISTS},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBFINFO(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestBFINFO(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Info of Filter",
+			commands:       []string{"BF.RESERVE bfi 0.01 1000", "BF.ADD bfi alice", "BF.INFO bfi CAPACITY", "BF.INFO bfi items"},
+			expected:       []interface{}{"OK", 1, []*wire.HElement{{Key: "Capacity", Value: "1000"}}, []*wire.HElement{{Key: "Number of items inserted", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueBFRESERVE, extractValueBFADD, extractValueBFINFO, extractValueBFINFO},
+		},
+		{
+			name:           "Info with Invalid Parameter",
+			commands:       []string{"BF.INFO bfi foo"},
+			expected:       []interface{}{errors.New("Invalid information value")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Info of Non-existent Key",
+			commands:       []string{"BF.INFO nokey"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
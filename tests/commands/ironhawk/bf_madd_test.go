@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBFMADD(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestBFMADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Add Items",
+			commands:       []string{"BF.ADD bfm alice", "BF.MADD bfm alice bob"},
+			expected:       []interface{}{1, []*wire.HElement{{Key: "alice", Value: "0"}, {Key: "bob", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueBFADD, extractValueBFMADD},
+		},
+		{
+			name:           "Add with no Items",
+			commands:       []string{"BF.MADD bfm"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BF.MADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
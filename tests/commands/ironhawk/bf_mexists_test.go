@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBFMEXISTS(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestBFMEXISTS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Check Items",
+			commands:       []string{"BF.ADD bfme alice", "BF.MEXISTS bfme alice bob"},
+			expected:       []interface{}{1, []*wire.HElement{{Key: "alice", Value: "1"}, {Key: "bob", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueBFADD, extractValueBFMEXISTS},
+		},
+		{
+			name:           "Check Non-existent Key",
+			commands:       []string{"BF.MEXISTS nokey alice"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "alice", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueBFMEXISTS},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
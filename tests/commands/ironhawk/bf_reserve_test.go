@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBFRESERVE(res *wire.Result) interface{} {
+	return res.GetMessage()
+}
+
+func TestBFRESERVE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Reserve Filter",
+			commands:       []string{"BF.RESERVE bfr 0.01 1000", "BF.RESERVE bfr 0.01 1000"},
+			expected:       []interface{}{"OK", errors.New("key exists")},
+			valueExtractor: []ValueExtractorFn{extractValueBFRESERVE, nil},
+		},
+		{
+			name:           "Reserve with Invalid Error Rate",
+			commands:       []string{"BF.RESERVE bfr1 abc 1000", "BF.RESERVE bfr1 1.5 1000"},
+			expected:       []interface{}{errors.New("bad error rate"), errors.New("(0 < error rate range < 1) ")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "Reserve with Invalid Capacity",
+			commands:       []string{"BF.RESERVE bfr1 0.01 abc", "BF.RESERVE bfr1 0.01 0"},
+			expected:       []interface{}{errors.New("bad capacity"), errors.New("(capacity should be larger than 0)")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "Reserve with Wrong Number of Arguments",
+			commands:       []string{"BF.RESERVE bfr1 0.01"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BF.RESERVE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBITCOUNT(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestBITCOUNT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "whole value",
+			commands:       []string{"SET bc1 foobar", "BITCOUNT bc1"},
+			expected:       []interface{}{"OK", 26},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueBITCOUNT},
+		},
+		{
+			name:           "byte ranges",
+			commands:       []string{"SET bc2 foobar", "BITCOUNT bc2 0 0", "BITCOUNT bc2 1 1", "BITCOUNT bc2 -2 -1 BYTE", "BITCOUNT bc2 3 1"},
+			expected:       []interface{}{"OK", 4, 6, 7, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueBITCOUNT, extractValueBITCOUNT, extractValueBITCOUNT, extractValueBITCOUNT},
+		},
+		{
+			name:           "bit range",
+			commands:       []string{"SET bc3 foobar", "BITCOUNT bc3 5 30 BIT", "BITCOUNT bc3 -8 -1 bit"},
+			expected:       []interface{}{"OK", 17, 4},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueBITCOUNT, extractValueBITCOUNT},
+		},
+		{
+			name:           "integer value",
+			commands:       []string{"SET bc4 12", "BITCOUNT bc4"},
+			expected:       []interface{}{"OK", 6},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueBITCOUNT},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"BITCOUNT bc5"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueBITCOUNT},
+		},
+		{
+			name:           "invalid syntax",
+			commands:       []string{"SET bc6 foobar", "BITCOUNT bc6 0", "BITCOUNT bc6 0 1 WORD"},
+			expected:       []interface{}{"OK", errors.New("invalid syntax for 'BITCOUNT' command"), errors.New("invalid syntax for 'BITCOUNT' command")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil, nil},
+		},
+		{
+			name:           "non-integer range",
+			commands:       []string{"SET bc7 foobar", "BITCOUNT bc7 a 1"},
+			expected:       []interface{}{"OK", errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SADD bc8 a", "BITCOUNT bc8"},
+			expected:       []interface{}{1, errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"BITCOUNT"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BITCOUNT' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
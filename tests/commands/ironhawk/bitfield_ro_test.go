@@ -0,0 +1,57 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBITFIELDRO(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestBITFIELD_RO(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "get fields",
+			commands:       []string{"BITFIELD bfr1 SET u8 0 200", "BITFIELD_RO bfr1 GET u8 0 GET i8 0 GET u4 #3"},
+			expected:       []interface{}{[]string{"0"}, []string{"200", "-56", "0"}},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD, extractValueBITFIELDRO},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"BITFIELD_RO bfr2 GET i16 0", "EXISTS bfr2"},
+			expected:       []interface{}{[]string{"0"}, 0},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELDRO, extractValueEXISTS},
+		},
+		{
+			name:           "only get is supported",
+			commands:       []string{"BITFIELD_RO bfr3 SET u8 0 1"},
+			expected:       []interface{}{errors.New("BITFIELD_RO only supports the GET subcommand")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:     "empty encoding and offset",
+			commands: []string{"BITFIELD_RO bfr4 GET  0", "BITFIELD_RO bfr4 GET u8 "},
+			expected: []interface{}{
+				errors.New("Invalid bitfield type. Use something like i16 u8. Note that u64 is not supported but i64 is"),
+				errors.New("bit offset is not an integer or out of range"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"BITFIELD_RO"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BITFIELD_RO' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBITFIELD(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestBITFIELD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "set get and incrby",
+			commands:       []string{"BITFIELD bf1 SET u8 0 200 GET u8 0 GET i8 0 INCRBY u8 0 100"},
+			expected:       []interface{}{[]string{"0", "200", "-56", "44"}},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD},
+		},
+		{
+			name:           "fields at multiples of the width",
+			commands:       []string{"BITFIELD bf2 SET u4 #1 15 GET u8 0 GET u4 #1"},
+			expected:       []interface{}{[]string{"0", "15", "15"}},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD},
+		},
+		{
+			name:           "fields are stored most significant bit first",
+			commands:       []string{"BITFIELD bf3 SET u8 0 97", "GET bf3"},
+			expected:       []interface{}{[]string{"0"}, "a"},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD, extractValueGET},
+		},
+		{
+			name:           "overflow sat",
+			commands:       []string{"BITFIELD bf4 OVERFLOW SAT SET i8 0 300 INCRBY i8 0 -500 INCRBY u4 8 100"},
+			expected:       []interface{}{[]string{"0", "-128", "15"}},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD},
+		},
+		{
+			name:           "overflow fail",
+			commands:       []string{"BITFIELD bf5 SET u8 0 250 OVERFLOW FAIL INCRBY u8 0 10 SET u8 0 256 GET u8 0"},
+			expected:       []interface{}{[]string{"0", "", "", "250"}},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD},
+		},
+		{
+			name:           "overflow wrap",
+			commands:       []string{"BITFIELD bf6 SET u8 0 300 INCRBY i8 0 100 GET i8 0"},
+			expected:       []interface{}{[]string{"0", "-112", "-112"}},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD},
+		},
+		{
+			name:           "get does not create the key",
+			commands:       []string{"BITFIELD bf7 GET u8 0", "EXISTS bf7"},
+			expected:       []interface{}{[]string{"0"}, 0},
+			valueExtractor: []ValueExtractorFn{extractValueBITFIELD, extractValueEXISTS},
+		},
+		{
+			name:           "invalid type",
+			commands:       []string{"BITFIELD bf8 GET u64 0"},
+			expected:       []interface{}{errors.New("Invalid bitfield type. Use something like i16 u8. Note that u64 is not supported but i64 is")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "invalid offset",
+			commands:       []string{"BITFIELD bf9 GET u8 -1"},
+			expected:       []interface{}{errors.New("bit offset is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "offset near the integer limit",
+			commands:       []string{"BITFIELD bf13 SET u8 9223372036854775807 1", "EXISTS bf13"},
+			expected:       []interface{}{errors.New("bit offset is not an integer or out of range"), 0},
+			valueExtractor: []ValueExtractorFn{nil, extractValueEXISTS},
+		},
+		{
+			name:           "invalid overflow",
+			commands:       []string{"BITFIELD bf10 OVERFLOW NONE"},
+			expected:       []interface{}{errors.New("Invalid OVERFLOW type specified")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "invalid syntax",
+			commands:       []string{"BITFIELD bf11 GET u8"},
+			expected:       []interface{}{errors.New("invalid syntax for 'BITFIELD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SADD bf12 a", "BITFIELD bf12 GET u8 0"},
+			expected:       []interface{}{1, errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"BITFIELD"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BITFIELD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
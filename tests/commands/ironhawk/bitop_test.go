@@ -0,0 +1,84 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBITOP(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestBITOP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "and",
+			commands:       []string{"SET bo1a abc", "SET bo1b ab", "BITOP AND bo1dst bo1a bo1b", "GET bo1dst"},
+			expected:       []interface{}{"OK", "OK", 3, "ab\x00"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueBITOP, extractValueGET},
+		},
+		{
+			name:           "or",
+			commands:       []string{"SET bo2a a", "SET bo2b b", "BITOP OR bo2dst bo2a bo2b bo2missing", "GET bo2dst"},
+			expected:       []interface{}{"OK", "OK", 1, "c"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueBITOP, extractValueGET},
+		},
+		{
+			name:           "xor",
+			commands:       []string{"SET bo3a a", "SET bo3b b", "BITOP XOR bo3dst bo3a bo3b", "GET bo3dst"},
+			expected:       []interface{}{"OK", "OK", 1, "\x03"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueBITOP, extractValueGET},
+		},
+		{
+			name:           "not",
+			commands:       []string{"SETBIT bo4a 0 1", "BITOP NOT bo4dst bo4a", "GET bo4dst"},
+			expected:       []interface{}{0, 1, "\x7f"},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueBITOP, extractValueGET},
+		},
+		{
+			name:           "retention across many keys",
+			commands:       []string{"SETBIT bo5d1 3 1", "SETBIT bo5d1 5 1", "SETBIT bo5d1 9 1", "SETBIT bo5d2 5 1", "SETBIT bo5d2 9 1", "SETBIT bo5d3 9 1", "BITOP AND bo5dst bo5d1 bo5d2 bo5d3", "BITCOUNT bo5dst", "BITPOS bo5dst 1"},
+			expected:       []interface{}{0, 0, 0, 0, 0, 0, 2, 1, 9},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueBITOP, extractValueBITCOUNT, extractValueBITPOS},
+		},
+		{
+			name:           "empty result deletes the destination",
+			commands:       []string{"SET bo6dst v", "BITOP OR bo6dst bo6missing", "EXISTS bo6dst"},
+			expected:       []interface{}{"OK", 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueBITOP, extractValueEXISTS},
+		},
+		{
+			name:           "not takes a single key",
+			commands:       []string{"BITOP NOT bo7dst bo7a bo7b"},
+			expected:       []interface{}{errors.New("BITOP NOT must be called with a single source key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "unknown operation",
+			commands:       []string{"BITOP NAND bo8dst bo8a"},
+			expected:       []interface{}{errors.New("invalid syntax for 'BITOP' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SADD bo9a a", "BITOP AND bo9dst bo9a"},
+			expected:       []interface{}{1, errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"BITOP AND bo10dst"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BITOP' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
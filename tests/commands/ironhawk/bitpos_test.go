@@ -0,0 +1,78 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBITPOS(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestBITPOS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "first set and clear bits",
+			commands:       []string{"SETBIT bp1 10 1", "BITPOS bp1 1", "BITPOS bp1 0"},
+			expected:       []interface{}{0, 10, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueBITPOS, extractValueBITPOS},
+		},
+		{
+			name:           "byte range",
+			commands:       []string{"SETBIT bp2 10 1", "SETBIT bp2 20 1", "BITPOS bp2 1 2", "BITPOS bp2 1 -1 -1 BYTE", "BITPOS bp2 1 0 0"},
+			expected:       []interface{}{0, 0, 20, 20, -1},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueSETBIT, extractValueBITPOS, extractValueBITPOS, extractValueBITPOS},
+		},
+		{
+			name:           "bit range",
+			commands:       []string{"SETBIT bp3 10 1", "SETBIT bp3 20 1", "BITPOS bp3 1 11 -1 BIT", "BITPOS bp3 0 10 12 BIT"},
+			expected:       []interface{}{0, 0, 20, 11},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueSETBIT, extractValueBITPOS, extractValueBITPOS},
+		},
+		{
+			name:           "all bits set",
+			commands:       []string{"SETBIT bp4 0 1", "SETBIT bp4 1 1", "SETBIT bp4 2 1", "SETBIT bp4 3 1", "SETBIT bp4 4 1", "SETBIT bp4 5 1", "SETBIT bp4 6 1", "SETBIT bp4 7 1", "BITPOS bp4 0", "BITPOS bp4 0 0 -1"},
+			expected:       []interface{}{0, 0, 0, 0, 0, 0, 0, 0, 8, -1},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueBITPOS, extractValueBITPOS},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"BITPOS bp5 0", "BITPOS bp5 1"},
+			expected:       []interface{}{0, -1},
+			valueExtractor: []ValueExtractorFn{extractValueBITPOS, extractValueBITPOS},
+		},
+		{
+			name:           "invalid bit",
+			commands:       []string{"BITPOS bp6 2"},
+			expected:       []interface{}{errors.New("the bit argument must be 1 or 0")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "invalid unit",
+			commands:       []string{"SET bp7 a", "BITPOS bp7 1 0 1 WORD"},
+			expected:       []interface{}{"OK", errors.New("invalid syntax for 'BITPOS' command")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SADD bp8 a", "BITPOS bp8 1"},
+			expected:       []interface{}{1, errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"BITPOS bp9"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BITPOS' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func extractValueBLPOP(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestBLPOP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Pop from First Non-empty List",
+			commands:       []string{"RPUSH q2 a b", "BLPOP q1 q2 0"},
+			expected:       []interface{}{2, []string{"q2", "a"}},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueBLPOP},
+		},
+		{
+			name:           "Pop Times Out",
+			commands:       []string{"BLPOP q1 0.1"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueBLPOP},
+		},
+		{
+			name:           "Pop with Invalid Timeout",
+			commands:       []string{"BLPOP q1 x"},
+			expected:       []interface{}{errors.New("timeout is not a float or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Pop with no Keys",
+			commands:       []string{"BLPOP 0"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'BLPOP' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
+
+func TestBLPOPBlocksUntilPush(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+	publisher := getLocalConnection()
+	defer publisher.Close()
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		publisher.Fire(&wire.Command{Cmd: "RPUSH", Args: []string{"blpopq", "x"}})
+	}()
+
+	start := time.Now()
+	res := client.Fire(&wire.Command{Cmd: "BLPOP", Args: []string{"blpopq", "5"}})
+	assert.Equal(t, wire.Status_OK, res.Status)
+	assert.Equal(t, []string{"blpopq", "x"}, res.GetKEYSRes().Keys)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestBLPOPBlocksUntilRename(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+	publisher := getLocalConnection()
+	defer publisher.Close()
+
+	publisher.Fire(&wire.Command{Cmd: "RPUSH", Args: []string{"blpoprensrc", "x"}})
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		publisher.Fire(&wire.Command{Cmd: "RENAME", Args: []string{"blpoprensrc", "blpopren"}})
+	}()
+
+	res := client.Fire(&wire.Command{Cmd: "BLPOP", Args: []string{"blpopren", "5"}})
+	assert.Equal(t, wire.Status_OK, res.Status)
+	assert.Equal(t, []string{"blpopren", "x"}, res.GetKEYSRes().Keys)
+}
+
+func TestBLPOPStopsOnDisconnect(t *testing.T) {
+	client := getLocalConnection()
+	publisher := getLocalConnection()
+	defer publisher.Close()
+
+	go client.Fire(&wire.Command{Cmd: "BLPOP", Args: []string{"blpopgone", "0"}})
+	time.Sleep(200 * time.Millisecond)
+	client.Close()
+	time.Sleep(200 * time.Millisecond)
+
+	// The client that disconnected no longer waits, so it does not take
+	// the element.
+	publisher.Fire(&wire.Command{Cmd: "RPUSH", Args: []string{"blpopgone", "x"}})
+	res := publisher.Fire(&wire.Command{Cmd: "LLEN", Args: []string{"blpopgone"}})
+	assert.Equal(t, int64(1), res.GetINCRBYRes().Value)
+}
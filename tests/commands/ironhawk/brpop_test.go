@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueBRPOP(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestBRPOP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Pop from First Non-empty List",
+			commands:       []string{"RPUSH q2 a b", "BRPOP q1 q2 0"},
+			expected:       []interface{}{2, []string{"q2", "b"}},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueBRPOP},
+		},
+		{
+			name:           "Pop Times Out",
+			commands:       []string{"BRPOP q1 0.1"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueBRPOP},
+		},
+		{
+			name:           "Pop with Invalid Timeout",
+			commands:       []string{"BRPOP q1 -1"},
+			expected:       []interface{}{errors.New("timeout is not a float or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCFADD(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestCFADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CF.ADD creates the filter",
+			commands:       []string{"CF.ADD cfa1 alice", "CF.INFO cfa1"},
+			expected:       []interface{}{int64(1), []*wire.HElement{{Key: "Size", Value: "2048"}, {Key: "Number of buckets", Value: "512"}, {Key: "Number of filters", Value: "1"}, {Key: "Number of items inserted", Value: "1"}, {Key: "Number of items deleted", Value: "0"}, {Key: "Bucket size", Value: "2"}, {Key: "Expansion rate", Value: "1"}, {Key: "Max iterations", Value: "20"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCFADD, extractValueCFINFO},
+		},
+		{
+			name:           "CF.ADD the same item twice",
+			commands:       []string{"CF.ADD cfa2 alice", "CF.ADD cfa2 alice", "CF.COUNT cfa2 alice"},
+			expected:       []interface{}{int64(1), int64(1), int64(2)},
+			valueExtractor: []ValueExtractorFn{extractValueCFADD, extractValueCFADD, extractValueCFCOUNT},
+		},
+		{
+			name:           "CF.ADD expands the filter",
+			commands:       []string{"CF.RESERVE cfa3 2 BUCKETSIZE 1 EXPANSION 2", "CF.ADD cfa3 a", "CF.ADD cfa3 b", "CF.ADD cfa3 c", "CF.ADD cfa3 d", "CF.EXISTS cfa3 a", "CF.EXISTS cfa3 d"},
+			expected:       []interface{}{"OK", int64(1), int64(1), int64(1), int64(1), int64(1), int64(1)},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, extractValueCFADD, extractValueCFADD, extractValueCFADD, extractValueCFADD, extractValueCFEXISTS, extractValueCFEXISTS},
+		},
+		{
+			name:           "CF.ADD to a full filter",
+			commands:       []string{"CF.RESERVE cfa4 1 BUCKETSIZE 1 EXPANSION 0", "CF.ADD cfa4 a", "CF.ADD cfa4 b"},
+			expected:       []interface{}{"OK", int64(1), errors.New("the cuckoo filter is full")},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, extractValueCFADD, nil},
+		},
+		{
+			name:           "CF.ADD to a key of another type",
+			commands:       []string{"SET cfa5 v", "CF.ADD cfa5 a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, nil},
+		},
+		{
+			name:           "CF.ADD with wrong number of arguments",
+			commands:       []string{"CF.ADD cfa6"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CF.ADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCFADDNX(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestCFADDNX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CF.ADDNX adds an item once",
+			commands:       []string{"CF.ADDNX cfn1 alice", "CF.ADDNX cfn1 alice", "CF.COUNT cfn1 alice"},
+			expected:       []interface{}{int64(1), int64(0), int64(1)},
+			valueExtractor: []ValueExtractorFn{extractValueCFADDNX, extractValueCFADDNX, extractValueCFCOUNT},
+		},
+		{
+			name:           "CF.ADDNX to a key of another type",
+			commands:       []string{"SET cfn2 v", "CF.ADDNX cfn2 a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, nil},
+		},
+		{
+			name:           "CF.ADDNX with wrong number of arguments",
+			commands:       []string{"CF.ADDNX cfn3 a b"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CF.ADDNX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCFCOUNT(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestCFCOUNT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CF.COUNT an added item",
+			commands:       []string{"CF.ADD cfc1 alice", "CF.ADD cfc1 alice", "CF.ADD cfc1 alice", "CF.COUNT cfc1 alice", "CF.COUNT cfc1 bob"},
+			expected:       []interface{}{int64(1), int64(1), int64(1), int64(3), int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueCFADD, extractValueCFADD, extractValueCFADD, extractValueCFCOUNT, extractValueCFCOUNT},
+		},
+		{
+			name:           "CF.COUNT a missing key",
+			commands:       []string{"CF.COUNT cfc2 alice"},
+			expected:       []interface{}{int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueCFCOUNT},
+		},
+		{
+			name:           "CF.COUNT with wrong number of arguments",
+			commands:       []string{"CF.COUNT cfc3"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CF.COUNT' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCFDEL(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestCFDEL(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CF.DEL an added item",
+			commands:       []string{"CF.ADD cfd1 alice", "CF.ADD cfd1 alice", "CF.DEL cfd1 alice", "CF.EXISTS cfd1 alice", "CF.DEL cfd1 alice", "CF.EXISTS cfd1 alice"},
+			expected:       []interface{}{int64(1), int64(1), int64(1), int64(1), int64(1), int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueCFADD, extractValueCFADD, extractValueCFDEL, extractValueCFEXISTS, extractValueCFDEL, extractValueCFEXISTS},
+		},
+		{
+			name:           "CF.DEL a missing item",
+			commands:       []string{"CF.ADD cfd2 alice", "CF.DEL cfd2 bob", "CF.INFO cfd2"},
+			expected:       []interface{}{int64(1), int64(0), []*wire.HElement{{Key: "Size", Value: "2048"}, {Key: "Number of buckets", Value: "512"}, {Key: "Number of filters", Value: "1"}, {Key: "Number of items inserted", Value: "1"}, {Key: "Number of items deleted", Value: "0"}, {Key: "Bucket size", Value: "2"}, {Key: "Expansion rate", Value: "1"}, {Key: "Max iterations", Value: "20"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCFADD, extractValueCFDEL, extractValueCFINFO},
+		},
+		{
+			name:           "CF.DEL updates the counters",
+			commands:       []string{"CF.ADD cfd3 alice", "CF.ADD cfd3 bob", "CF.DEL cfd3 alice", "CF.INFO cfd3"},
+			expected:       []interface{}{int64(1), int64(1), int64(1), []*wire.HElement{{Key: "Size", Value: "2048"}, {Key: "Number of buckets", Value: "512"}, {Key: "Number of filters", Value: "1"}, {Key: "Number of items inserted", Value: "1"}, {Key: "Number of items deleted", Value: "1"}, {Key: "Bucket size", Value: "2"}, {Key: "Expansion rate", Value: "1"}, {Key: "Max iterations", Value: "20"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCFADD, extractValueCFADD, extractValueCFDEL, extractValueCFINFO},
+		},
+		{
+			name:           "CF.DEL a missing key",
+			commands:       []string{"CF.DEL cfd4 alice"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "CF.DEL with wrong number of arguments",
+			commands:       []string{"CF.DEL cfd5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CF.DEL' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCFEXISTS(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestCFEXISTS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CF.EXISTS an added item",
+			commands:       []string{"CF.ADD cfe1 alice", "CF.EXISTS cfe1 alice", "CF.EXISTS cfe1 bob"},
+			expected:       []interface{}{int64(1), int64(1), int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueCFADD, extractValueCFEXISTS, extractValueCFEXISTS},
+		},
+		{
+			name:           "CF.EXISTS a missing key",
+			commands:       []string{"CF.EXISTS cfe2 alice"},
+			expected:       []interface{}{int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueCFEXISTS},
+		},
+		{
+			name:           "CF.EXISTS on a key of another type",
+			commands:       []string{"SET cfe3 v", "CF.EXISTS cfe3 a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, nil},
+		},
+		{
+			name:           "CF.EXISTS with wrong number of arguments",
+			commands:       []string{"CF.EXISTS cfe4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CF.EXISTS' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCFINFO(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestCFINFO(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CF.INFO a missing key",
+			commands:       []string{"CF.INFO cfi1"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "CF.INFO on a key of another type",
+			commands:       []string{"SET cfi2 v", "CF.INFO cfi2"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, nil},
+		},
+		{
+			name:           "CF.INFO with wrong number of arguments",
+			commands:       []string{"CF.INFO cfi3 extra"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CF.INFO' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
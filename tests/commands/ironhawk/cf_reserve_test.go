@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCFRESERVE(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestCFRESERVE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CF.RESERVE with defaults",
+			commands:       []string{"CF.RESERVE cfr1 8", "CF.INFO cfr1"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "Size", Value: "16"}, {Key: "Number of buckets", Value: "4"}, {Key: "Number of filters", Value: "1"}, {Key: "Number of items inserted", Value: "0"}, {Key: "Number of items deleted", Value: "0"}, {Key: "Bucket size", Value: "2"}, {Key: "Expansion rate", Value: "1"}, {Key: "Max iterations", Value: "20"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, extractValueCFINFO},
+		},
+		{
+			name:           "CF.RESERVE with options",
+			commands:       []string{"CF.RESERVE cfr2 100 BUCKETSIZE 4 MAXITERATIONS 50 EXPANSION 2", "CF.INFO cfr2"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "Size", Value: "256"}, {Key: "Number of buckets", Value: "32"}, {Key: "Number of filters", Value: "1"}, {Key: "Number of items inserted", Value: "0"}, {Key: "Number of items deleted", Value: "0"}, {Key: "Bucket size", Value: "4"}, {Key: "Expansion rate", Value: "2"}, {Key: "Max iterations", Value: "50"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, extractValueCFINFO},
+		},
+		{
+			name:           "CF.RESERVE an existing key",
+			commands:       []string{"CF.RESERVE cfr3 8", "CF.RESERVE cfr3 8"},
+			expected:       []interface{}{"OK", errors.New("key exists")},
+			valueExtractor: []ValueExtractorFn{extractValueCFRESERVE, nil},
+		},
+		{
+			name:           "CF.RESERVE with an invalid capacity",
+			commands:       []string{"CF.RESERVE cfr4 0"},
+			expected:       []interface{}{errors.New("capacity must be a positive integer up to 268435456")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "CF.RESERVE with an invalid bucket size",
+			commands:       []string{"CF.RESERVE cfr5 8 BUCKETSIZE 256"},
+			expected:       []interface{}{errors.New("bucket size must be an integer between 1 and 255")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "CF.RESERVE with an invalid expansion",
+			commands:       []string{"CF.RESERVE cfr6 8 EXPANSION -1"},
+			expected:       []interface{}{errors.New("expansion must be an integer between 0 and 32768")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "CF.RESERVE with an unknown option",
+			commands:       []string{"CF.RESERVE cfr7 8 SIZE 4"},
+			expected:       []interface{}{errors.New("invalid syntax for 'CF.RESERVE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "CF.RESERVE with wrong number of arguments",
+			commands:       []string{"CF.RESERVE cfr8 8 BUCKETSIZE"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CF.RESERVE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCMSINCRBY(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestCMSINCRBY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Increment Items",
+			commands:       []string{"CMS.INITBYDIM cmsi 2000 5", "CMS.INCRBY cmsi home 3 about 1", "CMS.INCRBY cmsi home 2"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "home", Value: "3"}, {Key: "about", Value: "1"}}, []*wire.HElement{{Key: "home", Value: "5"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, extractValueCMSINCRBY, extractValueCMSINCRBY},
+		},
+		{
+			name:           "Increment with Invalid Increment",
+			commands:       []string{"CMS.INCRBY cmsi blog 1 home x", "CMS.INCRBY cmsi blog 0"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range"), []*wire.HElement{{Key: "blog", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{nil, extractValueCMSINCRBY},
+		},
+		{
+			name:           "Increment Non-existent Key",
+			commands:       []string{"CMS.INCRBY nokey home 1"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Increment with Wrong Number of Arguments",
+			commands:       []string{"CMS.INCRBY cmsi home"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CMS.INCRBY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCMSINFO(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestCMSINFO(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Info of Sketch",
+			commands:       []string{"CMS.INITBYDIM cmsin 2000 5", "CMS.INCRBY cmsin home 3 about 1", "CMS.INFO cmsin"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "home", Value: "3"}, {Key: "about", Value: "1"}}, []*wire.HElement{{Key: "width", Value: "2000"}, {Key: "depth", Value: "5"}, {Key: "count", Value: "4"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, extractValueCMSINCRBY, extractValueCMSINFO},
+		},
+		{
+			name:           "Info of Non-existent Key",
+			commands:       []string{"CMS.INFO nokey"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCMSINITBYDIM(res *wire.Result) interface{} {
+	return res.GetMessage()
+}
+
+func TestCMSINITBYDIM(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Create Sketch",
+			commands:       []string{"CMS.INITBYDIM cmsd 2000 5", "CMS.INITBYDIM cmsd 2000 5"},
+			expected:       []interface{}{"OK", errors.New("key exists")},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, nil},
+		},
+		{
+			name:           "Create with Invalid Dimensions",
+			commands:       []string{"CMS.INITBYDIM cmsd1 abc 5", "CMS.INITBYDIM cmsd1 2000 0"},
+			expected:       []interface{}{errors.New("invalid width"), errors.New("invalid depth")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "Create with Wrong Number of Arguments",
+			commands:       []string{"CMS.INITBYDIM cmsd1 2000"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CMS.INITBYDIM' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
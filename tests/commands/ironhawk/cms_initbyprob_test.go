@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCMSINITBYPROB(res *wire.Result) interface{} {
+	return res.GetMessage()
+}
+
+func TestCMSINITBYPROB(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Create Sketch",
+			commands:       []string{"CMS.INITBYPROB cmsp 0.01 0.01", "CMS.INFO cmsp"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "width", Value: "272"}, {Key: "depth", Value: "5"}, {Key: "count", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYPROB, extractValueCMSINFO},
+		},
+		{
+			name:           "Create with Invalid Error and Probability",
+			commands:       []string{"CMS.INITBYPROB cmsp1 1.5 0.01", "CMS.INITBYPROB cmsp1 0.01 0"},
+			expected:       []interface{}{errors.New("invalid overestimation value"), errors.New("invalid prob value")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCMSMERGE(res *wire.Result) interface{} {
+	return res.GetMessage()
+}
+
+func TestCMSMERGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Merge Sketches",
+			commands:       []string{"CMS.INITBYDIM cmsm 2000 5", "CMS.INITBYDIM cmsm1 2000 5", "CMS.INITBYDIM cmsm2 2000 5", "CMS.INCRBY cmsm1 home 3", "CMS.INCRBY cmsm2 home 1 about 2", "CMS.MERGE cmsm 2 cmsm1 cmsm2", "CMS.INFO cmsm"},
+			expected:       []interface{}{"OK", "OK", "OK", []*wire.HElement{{Key: "home", Value: "3"}}, []*wire.HElement{{Key: "home", Value: "1"}, {Key: "about", Value: "2"}}, "OK", []*wire.HElement{{Key: "width", Value: "2000"}, {Key: "depth", Value: "5"}, {Key: "count", Value: "6"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, extractValueCMSINITBYDIM, extractValueCMSINITBYDIM, extractValueCMSINCRBY, extractValueCMSINCRBY, extractValueCMSMERGE, extractValueCMSINFO},
+		},
+		{
+			name:           "Merge with Weights Including Destination",
+			commands:       []string{"CMS.INITBYDIM cmsw 2000 5", "CMS.INCRBY cmsw home 1", "CMS.MERGE cmsw 2 cmsw cmsm1 WEIGHTS 2 3", "CMS.QUERY cmsw home"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "home", Value: "1"}}, "OK", []*wire.HElement{{Key: "home", Value: "11"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, extractValueCMSINCRBY, extractValueCMSMERGE, extractValueCMSQUERY},
+		},
+		{
+			name:           "Merge Sketches with Different Dimensions",
+			commands:       []string{"CMS.INITBYDIM cmsm3 100 5", "CMS.MERGE cmsm 2 cmsm1 cmsm3"},
+			expected:       []interface{}{"OK", errors.New("width/depth doesn't match")},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, nil},
+		},
+		{
+			name:           "Merge Non-existent Key",
+			commands:       []string{"CMS.MERGE cmsm 1 nokey"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Merge with Invalid Number of Keys",
+			commands:       []string{"CMS.MERGE cmsm 3 cmsm1 cmsm2", "CMS.MERGE cmsm 2 cmsm1 cmsm2 WEIGHTS 1"},
+			expected:       []interface{}{errors.New("invalid syntax for 'CMS.MERGE' command"), errors.New("invalid syntax for 'CMS.MERGE' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCMSQUERY(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestCMSQUERY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Query Items",
+			commands:       []string{"CMS.INITBYDIM cmsq 2000 5", "CMS.INCRBY cmsq home 3", "CMS.QUERY cmsq home blog"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "home", Value: "3"}}, []*wire.HElement{{Key: "home", Value: "3"}, {Key: "blog", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, extractValueCMSINCRBY, extractValueCMSQUERY},
+		},
+		{
+			name:           "Query Non-existent Key",
+			commands:       []string{"CMS.QUERY nokey home"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Query non-sketch Key",
+			commands:       []string{"SET cmsq1 v", "CMS.QUERY cmsq1 home"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
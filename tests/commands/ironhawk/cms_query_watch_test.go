@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueCMSQUERYWATCH(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestCMSQUERYWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "CMS.QUERY.WATCH without Items",
+			commands:       []string{"CMS.QUERY.WATCH cmsqw"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'CMS.QUERY.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "CMS.QUERY.WATCH with Key and Items",
+			commands:       []string{"CMS.INITBYDIM cmsqw 2000 5", "CMS.QUERY.WATCH cmsqw home"},
+			expected:       []interface{}{"OK", "OK"},
+			valueExtractor: []ValueExtractorFn{extractValueCMSINITBYDIM, extractValueCMSQUERYWATCH},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCOPY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "COPY a string",
+			commands:       []string{"SET cp1 v", "COPY cp1 cp2", "GET cp1", "GET cp2"},
+			expected:       []interface{}{"OK", int64(1), "v", "v"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "COPY to an existing key",
+			commands:       []string{"SET cp3 a", "SET cp4 b", "COPY cp3 cp4", "COPY cp3 cp4 REPLACE", "GET cp4"},
+			expected:       []interface{}{"OK", "OK", int64(0), int64(1), "a"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "COPY keeps the expiry",
+			commands:       []string{"SET cp5 v PX 100500", "COPY cp5 cp6", "TTL cp6"},
+			expected:       []interface{}{"OK", int64(1), int64(100)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueTTL},
+		},
+		{
+			name:           "COPY a set that is then modified",
+			commands:       []string{"SADD cp7 a b", "COPY cp7 cp8", "SADD cp8 c", "SMEMBERS cp7", "SMEMBERS cp8"},
+			expected:       []interface{}{int64(2), int64(1), int64(1), []string{"a", "b"}, []string{"a", "b", "c"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueINCRBY, extractValueSADD, extractValueSMEMBERS, extractValueSMEMBERS},
+		},
+		{
+			name:           "COPY a sorted set",
+			commands:       []string{"ZADD cp9 1 a 2 b", "COPY cp9 cp10", "ZCARD cp10"},
+			expected:       []interface{}{int64(2), int64(1), int64(2)},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueINCRBY, extractValueZCARD},
+		},
+		{
+			name:           "COPY a missing key",
+			commands:       []string{"COPY cp11 cp12"},
+			expected:       []interface{}{int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:           "COPY a key to itself",
+			commands:       []string{"SET cp13 v", "COPY cp13 cp13"},
+			expected:       []interface{}{"OK", errors.New("source and destination objects are the same")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "COPY with an invalid option",
+			commands:       []string{"COPY cp14 cp15 FOO"},
+			expected:       []interface{}{errors.New("invalid syntax for 'COPY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "COPY with wrong number of arguments",
+			commands:       []string{"COPY cp16"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'COPY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDBSIZE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "DBSIZE of an empty database",
+			commands:       []string{"DBSIZE"},
+			expected:       []interface{}{int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:           "DBSIZE counts the keys of every shard",
+			commands:       []string{"MSET dbs1 v dbs2 v dbs3 v", "SET dbs1 w", "SADD dbs4 a", "DBSIZE"},
+			expected:       []interface{}{"OK", "OK", int64(1), int64(4)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSADD, extractValueINCRBY},
+		},
+		{
+			name:           "DBSIZE does not count deleted keys",
+			commands:       []string{"DEL dbs1 dbs2", "DBSIZE"},
+			expected:       []interface{}{2, int64(2)},
+			valueExtractor: []ValueExtractorFn{extractValueDEL, extractValueINCRBY},
+		},
+		{
+			name:           "DBSIZE with arguments",
+			commands:       []string{"DBSIZE dbs3"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'DBSIZE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
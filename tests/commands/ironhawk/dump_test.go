@@ -0,0 +1,102 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func extractValueDUMP(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestDUMP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "DUMP a string",
+			commands:       []string{"SET dmp1 v1", "DUMP dmp1"},
+			expected:       []interface{}{"OK", "AQAAAAACdjGuM1L7/x8kYw=="},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueDUMP},
+		},
+		{
+			name:           "DUMP a non-existent key",
+			commands:       []string{"DUMP dmp2"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueDUMP},
+		},
+		{
+			name:           "DUMP with wrong number of arguments",
+			commands:       []string{"DUMP", "DUMP dmp3 dmp4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'DUMP' command"), errors.New("wrong number of arguments for 'DUMP' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
+
+func TestDUMPRoundTripsEveryType(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+	fire := func(cmd string) *wire.Result {
+		args := strings.Split(cmd, " ")
+		return client.Fire(&wire.Command{Cmd: args[0], Args: args[1:]})
+	}
+
+	// Every value is dumped and restored at a new key, where it must read
+	// the same as at the original key. {key} stands for the key in the
+	// commands. The reads do not depend on the time, which LOCK.INFO does.
+	testCases := []struct {
+		name  string
+		setup []string
+		read  string
+	}{
+		{"string", []string{"SET {key} v1"}, "GET {key}"},
+		{"int", []string{"SET {key} 42"}, "GET {key}"},
+		{"float", []string{"INCRBYFLOAT {key} 1.5"}, "GET {key}"},
+		{"bytearray", []string{"SETBIT {key} 9 1"}, "GETBIT {key} 9"},
+		{"set", []string{"SADD {key} a b c"}, "SMEMBERS {key}"},
+		{"ssmap", []string{"HSET {key} f1 v1 f2 v2"}, "HGET {key} f2"},
+		{"sortedset", []string{"ZADD {key} 1 a 2 b 3 c"}, "ZRANGE {key} 0 -1"},
+		{"deque", []string{"LPUSH {key} a b 3"}, "LRANGE {key} 0 -1"},
+		{"hll", []string{"PFADD {key} a b c d"}, "PFCOUNT {key}"},
+		{"json", []string{`JSON.SET {key} $ {"a":1,"b":[1,2]}`}, "JSON.GET {key}"},
+		{"countminsketch", []string{"CMS.INITBYDIM {key} 10 3", "CMS.INCRBY {key} a 5"}, "CMS.QUERY {key} a"},
+		{"bloomfilter", []string{"BF.ADD {key} a"}, "BF.EXISTS {key} a"},
+		{"stream", []string{"XADD {key} 1-1 f v", "XADD {key} 2-1 g w", "XGROUP CREATE {key} g1 0", "XREADGROUP GROUP g1 c1 COUNT 1 STREAMS {key} >"}, "XPENDING {key} g1"},
+		{"timeseries", []string{"TS.ADD {key} 1000 1.5", "TS.ADD {key} 2000 2.5"}, "TS.RANGE {key} - +"},
+		{"topk", []string{"TOPK.RESERVE {key} 2", "TOPK.ADD {key} a a b c"}, "TOPK.LIST {key}"},
+		{"cuckoo", []string{"CF.ADD {key} a", "CF.ADD {key} a"}, "CF.COUNT {key} a"},
+		{"tdigest", []string{"TDIGEST.CREATE {key}", "TDIGEST.ADD {key} 1 2 3 4 5"}, "TDIGEST.QUANTILE {key} 0.5"},
+		{"lock-token", []string{"LOCK.ACQUIRE {key} owner1 100000"}, "LOCK.ACQUIRE {key} owner1 100000"},
+		{"lock-lease", []string{"LOCK.ACQUIRE {key} owner1 100000"}, "LOCK.ACQUIRE {key} owner2 100000"},
+		{"vector", []string{"VINDEX.CREATE dmpidx DIM 2", "VADD {key} dmpidx 1 2 ATTR a b"}, "VGET {key}"},
+	}
+	fire("FLUSHDB")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, restored := "dmprt-"+tc.name, "dmprt-"+tc.name+"-restored"
+			for _, cmd := range tc.setup {
+				res := fire(strings.ReplaceAll(cmd, "{key}", key))
+				assert.Equal(t, wire.Status_OK, res.Status, "%s: %s", cmd, res.Message)
+			}
+			payload := fire("DUMP " + key).GetGETRes().Value
+			res := fire("RESTORE " + restored + " 0 " + payload)
+			assert.Equal(t, wire.Status_OK, res.Status, res.Message)
+
+			want := fire(strings.ReplaceAll(tc.read, "{key}", key))
+			got := fire(strings.ReplaceAll(tc.read, "{key}", restored))
+			want.Fingerprint64, got.Fingerprint64 = 0, 0
+			assert.True(t, proto.Equal(want, got), "want %v, got %v", want, got)
+		})
+	}
+}
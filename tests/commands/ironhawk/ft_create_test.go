@@ -0,0 +1,74 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestFTCREATE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "FT.CREATE over strings",
+			commands:       []string{"FT.CREATE fc1 ON STRING PREFIX fc1:"},
+			expected:       []interface{}{"OK"},
+			valueExtractor: []ValueExtractorFn{extractValueSET},
+		},
+		{
+			name:           "FT.CREATE an existing index",
+			commands:       []string{"FT.CREATE fc2 ON STRING", "FT.CREATE fc2 ON HASH SCHEMA body"},
+			expected:       []interface{}{"OK", errors.New("index 'fc2' already exists")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "FT.CREATE indexes the existing keys",
+			commands:       []string{"SET fc3:a quick,brown,fox", "HSET fc3:b body fox", "FT.CREATE fc3 ON STRING PREFIX fc3:", "FT.SEARCH fc3 fox WITHDOCS"},
+			expected:       []interface{}{"OK", 1, "OK", []*wire.HElement{{Key: "fc3:a", Value: "quick,brown,fox"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.CREATE over hashes with weights",
+			commands:       []string{"FT.CREATE fc4 ON HASH PREFIX fc4: SCHEMA title WEIGHT 5 body", "HSET fc4:a title dog body fox", "HSET fc4:b title fox body dog", "FT.SEARCH fc4 fox WITHDOCS"},
+			expected:       []interface{}{"OK", 2, 2, []*wire.HElement{{Key: "fc4:b", Value: "{\"body\":\"dog\",\"title\":\"fox\"}"}, {Key: "fc4:a", Value: "{\"body\":\"fox\",\"title\":\"dog\"}"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.CREATE with an unknown source",
+			commands:       []string{"FT.CREATE fc5 ON LIST"},
+			expected:       []interface{}{errors.New("unknown index source 'LIST', must be HASH or STRING")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "FT.CREATE over hashes without a schema",
+			commands:       []string{"FT.CREATE fc6 ON HASH"},
+			expected:       []interface{}{errors.New("invalid syntax for 'FT.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "FT.CREATE with an invalid weight",
+			commands:       []string{"FT.CREATE fc7 ON HASH SCHEMA title WEIGHT 0"},
+			expected:       []interface{}{errors.New("weight must be a positive number")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "FT.CREATE with a duplicate field",
+			commands:       []string{"FT.CREATE fc8 ON HASH SCHEMA title body title"},
+			expected:       []interface{}{errors.New("duplicate field 'title'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "FT.CREATE with wrong number of arguments",
+			commands:       []string{"FT.CREATE fc9"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'FT.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFTDROPINDEX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "FT.DROPINDEX deletes the index",
+			commands:       []string{"FT.CREATE fd1 ON STRING PREFIX fd1:", "SET fd1:a fox", "FT.DROPINDEX fd1", "FT.SEARCH fd1 fox", "GET fd1:a"},
+			expected:       []interface{}{"OK", "OK", "OK", errors.New("no such full-text index 'fd1'"), "fox"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, nil, extractValueGET},
+		},
+		{
+			name:           "FT.DROPINDEX a missing index",
+			commands:       []string{"FT.DROPINDEX fd2"},
+			expected:       []interface{}{errors.New("no such full-text index 'fd2'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "FT.DROPINDEX a secondary index",
+			commands:       []string{"INDEX.CREATE fd3 ON HASH SCHEMA age NUMERIC", "FT.DROPINDEX fd3"},
+			expected:       []interface{}{"OK", errors.New("no such full-text index 'fd3'")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "FT.DROPINDEX with wrong number of arguments",
+			commands:       []string{"FT.DROPINDEX"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'FT.DROPINDEX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
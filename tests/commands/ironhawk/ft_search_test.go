@@ -0,0 +1,102 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueFTSEARCH(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestFTSEARCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "FT.SEARCH ranks by relevance across shards",
+			commands:       []string{"FT.CREATE fs1 ON STRING PREFIX fs1:", "SET fs1:a The,quick,brown,fox", "SET fs1:b A,quick,brown,dog,jumps,over,the,lazy,fox", "SET fs1:c Café,au,lait", "FT.SEARCH fs1 quick fox"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", []*wire.HElement{{Key: "fs1:a", Value: "1.0470966930031578"}, {Key: "fs1:b", Value: "0.733664201749441"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH with a negation",
+			commands:       []string{"FT.CREATE fs2 ON STRING PREFIX fs2:", "SET fs2:a The,quick,brown,fox", "SET fs2:b A,quick,brown,dog,jumps,over,the,lazy,fox", "SET fs2:c Café,au,lait", "FT.SEARCH fs2 fox -dog WITHDOCS"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", []*wire.HElement{{Key: "fs2:a", Value: "The,quick,brown,fox"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH with alternatives",
+			commands:       []string{"FT.CREATE fs3 ON STRING PREFIX fs3:", "SET fs3:a The,quick,brown,fox", "SET fs3:b A,quick,brown,dog,jumps,over,the,lazy,fox", "SET fs3:c Café,au,lait", "FT.SEARCH fs3 lazy | CAFE WITHDOCS"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", []*wire.HElement{{Key: "fs3:c", Value: "Café,au,lait"}, {Key: "fs3:b", Value: "A,quick,brown,dog,jumps,over,the,lazy,fox"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH with a phrase",
+			commands:       []string{"FT.CREATE fs4 ON STRING PREFIX fs4:", "SET fs4:a The,quick,brown,fox", "SET fs4:b A,quick,brown,dog,jumps,over,the,lazy,fox", "SET fs4:c Café,au,lait", "FT.SEARCH fs4 \"brown fox\" HIGHLIGHT"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", []*wire.HElement{{Key: "fs4:a", Value: "The,quick,<b>brown</b>,<b>fox</b>"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH with a prefix and custom tags",
+			commands:       []string{"FT.CREATE fs5 ON STRING PREFIX fs5:", "SET fs5:a The,quick,brown,fox", "SET fs5:b A,quick,brown,dog,jumps,over,the,lazy,fox", "SET fs5:c Café,au,lait", "FT.SEARCH fs5 (la* | zebra) NOT fox HIGHLIGHT TAGS [ ]"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", []*wire.HElement{{Key: "fs5:c", Value: "Café,au,[lait]"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH with LIMIT",
+			commands:       []string{"FT.CREATE fs6 ON STRING PREFIX fs6:", "SET fs6:a The,quick,brown,fox", "SET fs6:b A,quick,brown,dog,jumps,over,the,lazy,fox", "SET fs6:c Café,au,lait", "FT.SEARCH fs6 quick LIMIT 1 5 WITHDOCS"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", []*wire.HElement{{Key: "fs6:b", Value: "A,quick,brown,dog,jumps,over,the,lazy,fox"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH follows updates and deletes",
+			commands:       []string{"FT.CREATE fs7 ON STRING PREFIX fs7:", "SET fs7:a The,quick,brown,fox", "SET fs7:b A,quick,brown,dog,jumps,over,the,lazy,fox", "SET fs7:c Café,au,lait", "DEL fs7:a", "SET fs7:b slow,fox", "HSET fs7:d body fox", "FT.SEARCH fs7 fox WITHDOCS"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", 1, "OK", 1, []*wire.HElement{{Key: "fs7:b", Value: "slow,fox"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueDEL, extractValueSET, extractValueHSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH follows INCR and DECRBY",
+			commands:       []string{"FT.CREATE fs11 ON STRING PREFIX fs11:", "SET fs11:a 41", "SET fs11:b 50", "INCR fs11:a", "DECRBY fs11:b 8", "FT.SEARCH fs11 42 WITHDOCS", "FT.SEARCH fs11 41 | 50"},
+			expected:       []interface{}{"OK", "OK", "OK", 42, 42, []*wire.HElement{{Key: "fs11:a", Value: "42"}, {Key: "fs11:b", Value: "42"}}, []*wire.HElement(nil)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueINCR, extractValueDECRBY, extractValueFTSEARCH, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH follows APPEND, SETRANGE and INCRBYFLOAT",
+			commands:       []string{"FT.CREATE fs12 ON STRING PREFIX fs12:", "SET fs12:a quick", "APPEND fs12:a ,fox", "SETRANGE fs12:b 0 lazy,fox", "SET fs12:c 1", "INCRBYFLOAT fs12:c 2", "FT.SEARCH fs12 fox WITHDOCS", "FT.SEARCH fs12 3 WITHDOCS"},
+			expected:       []interface{}{"OK", "OK", 9, 8, "OK", "3", []*wire.HElement{{Key: "fs12:a", Value: "quick,fox"}, {Key: "fs12:b", Value: "lazy,fox"}}, []*wire.HElement{{Key: "fs12:c", Value: "3.000000"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueSET, extractValueGET, extractValueFTSEARCH, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH highlights the indexed fields of hashes",
+			commands:       []string{"FT.CREATE fs8 ON HASH PREFIX fs8: SCHEMA title", "HSET fs8:a title Big-Fox body fox", "FT.SEARCH fs8 fox HIGHLIGHT"},
+			expected:       []interface{}{"OK", 2, []*wire.HElement{{Key: "fs8:a", Value: "{\"body\":\"fox\",\"title\":\"Big-<b>Fox</b>\"}"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueFTSEARCH},
+		},
+		{
+			name:           "FT.SEARCH a missing index",
+			commands:       []string{"FT.SEARCH fs9 fox"},
+			expected:       []interface{}{errors.New("no such full-text index 'fs9'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "FT.SEARCH with an invalid query",
+			commands:       []string{"FT.CREATE fs10 ON STRING", "FT.SEARCH fs10 (fox", "FT.SEARCH fs10 -fox"},
+			expected:       []interface{}{"OK", errors.New("syntax error in full-text query"), errors.New("the full-text query has no terms")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil, nil},
+		},
+		{
+			name:           "FT.SEARCH with wrong number of arguments",
+			commands:       []string{"FT.SEARCH fs11"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'FT.SEARCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
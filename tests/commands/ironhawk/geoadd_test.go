@@ -0,0 +1,84 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueGEOADD(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestGEOADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "add new members",
+			commands:       []string{"GEOADD ga1 13.361389 38.115556 Palermo 15.087269 37.502669 Catania"},
+			expected:       []interface{}{2},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD},
+		},
+		{
+			name:           "re-adding counts only new members",
+			commands:       []string{"GEOADD ga2 13.361389 38.115556 Palermo", "GEOADD ga2 13.361389 38.115556 Palermo 15.087269 37.502669 Catania"},
+			expected:       []interface{}{1, 1},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOADD},
+		},
+		{
+			name:           "CH counts changed members",
+			commands:       []string{"GEOADD ga3 13.361389 38.115556 Palermo", "GEOADD ga3 CH 13.5 38.1 Palermo 15.087269 37.502669 Catania"},
+			expected:       []interface{}{1, 2},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOADD},
+		},
+		{
+			name:           "NX does not update",
+			commands:       []string{"GEOADD ga4 13.361389 38.115556 Palermo", "GEOADD ga4 NX CH 13.5 38.1 Palermo"},
+			expected:       []interface{}{1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOADD},
+		},
+		{
+			name:           "XX does not add",
+			commands:       []string{"GEOADD ga5 XX 13.361389 38.115556 Palermo", "GEOADD ga5 13.361389 38.115556 Palermo", "GEOADD ga5 XX CH 13.5 38.1 Palermo 15.087269 37.502669 Catania"},
+			expected:       []interface{}{0, 1, 1},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOADD, extractValueGEOADD},
+		},
+		{
+			name:           "NX and XX together",
+			commands:       []string{"GEOADD ga6 NX XX 13.361389 38.115556 Palermo"},
+			expected:       []interface{}{errors.New("XX and NX options at the same time are not compatible")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "invalid longitude",
+			commands:       []string{"GEOADD ga7 13.361389 38.115556 Palermo 181 10 bad"},
+			expected:       []interface{}{errors.New("invalid longitude")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "invalid latitude",
+			commands:       []string{"GEOADD ga7 13.361389 38.115556 Palermo 10 86 bad", "ZCARD ga7"},
+			expected:       []interface{}{errors.New("invalid latitude"), 0},
+			valueExtractor: []ValueExtractorFn{nil, extractValueZCARD},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"GEOADD ga8 13.361387 38.115556"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GEOADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SET ga9 v", "GEOADD ga9 13.361389 38.115556 Palermo"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
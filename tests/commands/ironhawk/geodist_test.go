@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGEODIST(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "distance in meters",
+			commands:       []string{"GEOADD gd1 13.361389 38.115556 Palermo 15.087269 37.502669 Catania", "GEODIST gd1 Palermo Catania"},
+			expected:       []interface{}{2, "166274.1440"},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGET},
+		},
+		{
+			name:           "distance in kilometers",
+			commands:       []string{"GEOADD gd2 13.361389 38.115556 Palermo 15.087269 37.502669 Catania", "GEODIST gd2 Palermo Catania KM"},
+			expected:       []interface{}{2, "166.2741"},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGET},
+		},
+		{
+			name:           "distance in miles",
+			commands:       []string{"GEOADD gd3 13.361389 38.115556 Palermo 15.087269 37.502669 Catania", "GEODIST gd3 Palermo Catania mi"},
+			expected:       []interface{}{2, "103.3182"},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGET},
+		},
+		{
+			name:           "missing member",
+			commands:       []string{"GEOADD gd4 13.361389 38.115556 Palermo", "GEODIST gd4 Palermo Rome"},
+			expected:       []interface{}{1, ""},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGET},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"GEODIST gd5 Palermo Catania"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueGET},
+		},
+		{
+			name:           "unsupported unit",
+			commands:       []string{"GEOADD gd6 13.361389 38.115556 Palermo 15.087269 37.502669 Catania", "GEODIST gd6 Palermo Catania yd"},
+			expected:       []interface{}{2, errors.New("unsupported unit provided. please use m, km, ft, mi")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"GEODIST gd7 Palermo"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GEODIST' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
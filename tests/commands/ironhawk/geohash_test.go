@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueGEOHASH(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestGEOHASH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "geohashes of members",
+			commands:       []string{"GEOADD gh1 13.361389 38.115556 Palermo 15.087269 37.502669 Catania", "GEOHASH gh1 Palermo Catania Rome"},
+			expected:       []interface{}{2, []*wire.HElement{{Key: "Palermo", Value: "sqc8b49rny"}, {Key: "Catania", Value: "sqdtr74hyu"}, {Key: "Rome", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOHASH},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"GEOHASH gh2 Palermo"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "Palermo", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOHASH},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"GEOHASH gh3"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GEOHASH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueGEOPOS(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestGEOPOS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "positions of members",
+			commands:       []string{"GEOADD gp1 13.361389 38.115556 Palermo 15.087269 37.502669 Catania", "GEOPOS gp1 Palermo Rome Catania"},
+			expected:       []interface{}{2, []*wire.HElement{{Key: "Palermo", Value: "13.361387 38.115556"}, {Key: "Rome", Value: ""}, {Key: "Catania", Value: "15.087265 37.502668"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOPOS},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"GEOPOS gp2 Palermo"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "Palermo", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOPOS},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"GEOPOS gp3"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GEOPOS' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SET gp4 v", "GEOPOS gp4 Palermo"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
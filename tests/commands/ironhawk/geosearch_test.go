@@ -0,0 +1,172 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueGEOSEARCH(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func extractValueGEOSEARCHPairs(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestGEOSEARCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "by radius around a position",
+			commands:       []string{"GEOADD gs1 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs1 FROMLONLAT 15 37 BYRADIUS 200 KM"},
+			expected:       []interface{}{3, []string{"Catania", "Palermo"}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCH},
+		},
+		{
+			name:           "by radius with distances sorted ascending",
+			commands:       []string{"GEOADD gs2 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs2 FROMLONLAT 15 37 BYRADIUS 200 KM WITHDIST"},
+			expected:       []interface{}{3, []*wire.HElement{{Key: "Catania", Value: "56.4412"}, {Key: "Palermo", Value: "190.4426"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHPairs},
+		},
+		{
+			name:           "by radius sorted descending",
+			commands:       []string{"GEOADD gs3 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs3 FROMLONLAT 15 37 BYRADIUS 200 KM DESC WITHDIST"},
+			expected:       []interface{}{3, []*wire.HElement{{Key: "Palermo", Value: "190.4426"}, {Key: "Catania", Value: "56.4412"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHPairs},
+		},
+		{
+			name:           "around a member",
+			commands:       []string{"GEOADD gs4 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs4 FROMMEMBER Palermo BYRADIUS 500 KM WITHDIST"},
+			expected:       []interface{}{3, []*wire.HElement{{Key: "Palermo", Value: "0.0000"}, {Key: "Catania", Value: "166.2741"}, {Key: "Rome", Value: "427.6294"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHPairs},
+		},
+		{
+			name:           "by box with coordinates",
+			commands:       []string{"GEOADD gs5 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs5 FROMLONLAT 15 37 BYBOX 400 400 KM WITHDIST WITHCOORD"},
+			expected:       []interface{}{3, []*wire.HElement{{Key: "Catania", Value: "56.4412 15.087265 37.502668"}, {Key: "Palermo", Value: "190.4426 13.361387 38.115556"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHPairs},
+		},
+		{
+			name:           "box excludes points outside its width",
+			commands:       []string{"GEOADD gs6 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs6 FROMLONLAT 15 37 BYBOX 200 400 KM"},
+			expected:       []interface{}{3, []string{"Catania"}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCH},
+		},
+		{
+			name:           "count limits to the nearest members",
+			commands:       []string{"GEOADD gs7 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs7 FROMMEMBER Rome BYRADIUS 1000 KM COUNT 2 WITHDIST"},
+			expected:       []interface{}{3, []*wire.HElement{{Key: "Rome", Value: "0.0000"}, {Key: "Palermo", Value: "427.6294"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHPairs},
+		},
+		{
+			name:           "count any",
+			commands:       []string{"GEOADD gs8 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs8 FROMMEMBER Rome BYRADIUS 1000 KM COUNT 3 ANY"},
+			expected:       []interface{}{3, []string{"Rome", "Palermo", "Catania"}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCH},
+		},
+		{
+			name:           "zero radius",
+			commands:       []string{"GEOADD gs9 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs9 FROMMEMBER Catania BYRADIUS 0 M"},
+			expected:       []interface{}{3, []string{"Catania"}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCH},
+		},
+		{
+			name:           "nothing in range",
+			commands:       []string{"GEOADD gs10 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs10 FROMLONLAT 0 0 BYRADIUS 100 KM"},
+			expected:       []interface{}{3, []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCH},
+		},
+		{
+			name:           "across the antimeridian",
+			commands:       []string{"GEOADD gs11 179.9 10 east -179.9 10 west 170 10 far", "GEOSEARCH gs11 FROMLONLAT -179.95 10 BYRADIUS 50 KM"},
+			expected:       []interface{}{3, []string{"east", "west"}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCH},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"GEOSEARCH gs12 FROMLONLAT 15 37 BYRADIUS 200 KM"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOSEARCH},
+		},
+		{
+			name:           "missing member",
+			commands:       []string{"GEOADD gs13 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs13 FROMMEMBER Naples BYRADIUS 200 KM"},
+			expected:       []interface{}{3, errors.New("could not decode requested zset member")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "no center",
+			commands:       []string{"GEOADD gs14a 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14a BYRADIUS 200 KM ASC WITHDIST"},
+			expected:       []interface{}{3, errors.New("exactly one of FROMMEMBER or FROMLONLAT can be specified for GEOSEARCH")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "two centers",
+			commands:       []string{"GEOADD gs14c 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14c FROMMEMBER Rome FROMLONLAT 15 37 BYRADIUS 200 KM"},
+			expected:       []interface{}{3, errors.New("exactly one of FROMMEMBER or FROMLONLAT can be specified for GEOSEARCH")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "no area",
+			commands:       []string{"GEOADD gs14e 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14e FROMMEMBER Rome ASC WITHDIST WITHCOORD"},
+			expected:       []interface{}{3, errors.New("exactly one of BYRADIUS and BYBOX can be specified for GEOSEARCH")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "two areas",
+			commands:       []string{"GEOADD gs14g 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14g FROMMEMBER Rome BYRADIUS 200 KM BYBOX 1 1 KM"},
+			expected:       []interface{}{3, errors.New("exactly one of BYRADIUS and BYBOX can be specified for GEOSEARCH")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "any without count",
+			commands:       []string{"GEOADD gs14i 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14i FROMMEMBER Rome BYRADIUS 200 KM ANY"},
+			expected:       []interface{}{3, errors.New("the ANY argument requires COUNT argument")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "zero count",
+			commands:       []string{"GEOADD gs14k 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14k FROMMEMBER Rome BYRADIUS 200 KM COUNT 0"},
+			expected:       []interface{}{3, errors.New("COUNT must be > 0")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "negative radius",
+			commands:       []string{"GEOADD gs14m 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14m FROMMEMBER Rome BYRADIUS -1 KM"},
+			expected:       []interface{}{3, errors.New("radius cannot be negative")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "unsupported unit",
+			commands:       []string{"GEOADD gs14o 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14o FROMMEMBER Rome BYRADIUS 1 YD"},
+			expected:       []interface{}{3, errors.New("unsupported unit provided. please use m, km, ft, mi")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "unknown option",
+			commands:       []string{"GEOADD gs14q 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCH gs14q FROMMEMBER Rome BYRADIUS 1 KM WITHHASH"},
+			expected:       []interface{}{3, errors.New("invalid syntax for 'GEOSEARCH' command")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SET gs15 v", "GEOSEARCH gs15 FROMLONLAT 15 37 BYRADIUS 200 KM"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"GEOSEARCH gs16 FROMLONLAT 15 37"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GEOSEARCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueGEOSEARCHSTORE(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestGEOSEARCHSTORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "store the members found",
+			commands:       []string{"GEOADD gss1 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCHSTORE gss1dst gss1 FROMLONLAT 15 37 BYRADIUS 200 KM", "GEOPOS gss1dst Catania Palermo Rome"},
+			expected:       []interface{}{3, 2, []*wire.HElement{{Key: "Catania", Value: "15.087265 37.502668"}, {Key: "Palermo", Value: "13.361387 38.115556"}, {Key: "Rome", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHSTORE, extractValueGEOPOS},
+		},
+		{
+			name:           "store the nearest members",
+			commands:       []string{"GEOADD gss2 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCHSTORE gss2dst gss2 FROMMEMBER Rome BYBOX 2000 2000 KM COUNT 1", "ZCARD gss2dst"},
+			expected:       []interface{}{3, 1, 1},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHSTORE, extractValueZCARD},
+		},
+		{
+			name:           "overwrite the destination",
+			commands:       []string{"GEOADD gss3 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "SET gss3dst v", "GEOSEARCHSTORE gss3dst gss3 FROMMEMBER Rome BYRADIUS 1 KM", "GEOHASH gss3dst Rome"},
+			expected:       []interface{}{3, "OK", 1, []*wire.HElement{{Key: "Rome", Value: "sr2ykk5t6k"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueSET, extractValueGEOSEARCHSTORE, extractValueGEOHASH},
+		},
+		{
+			name:           "delete the destination if nothing is found",
+			commands:       []string{"GEOADD gss4 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOADD gss4dst 13.361389 38.115556 Palermo", "GEOSEARCHSTORE gss4dst gss4 FROMLONLAT 0 0 BYRADIUS 1 KM", "EXISTS gss4dst"},
+			expected:       []interface{}{3, 1, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOADD, extractValueGEOSEARCHSTORE, extractValueEXISTS},
+		},
+		{
+			name:           "store the distances with STOREDIST",
+			commands:       []string{"GEOADD gss8 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCHSTORE gss8dst gss8 FROMLONLAT 15 37 BYRADIUS 200 KM ASC STOREDIST", "ZSCORE gss8dst Catania", "ZSCORE gss8dst Palermo", "ZSCORE gss8dst Rome"},
+			expected:       []interface{}{3, 2, "56.44118143671698", "190.4425579231353", ""},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, extractValueGEOSEARCHSTORE, extractValueGET, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "missing source",
+			commands:       []string{"GEOSEARCHSTORE gss5dst gss5 FROMLONLAT 0 0 BYRADIUS 1 KM"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueGEOSEARCHSTORE},
+		},
+		{
+			name:           "WITH options are not supported",
+			commands:       []string{"GEOADD gss6 13.361389 38.115556 Palermo 15.087269 37.502669 Catania 12.496366 41.902782 Rome", "GEOSEARCHSTORE gss6dst gss6 FROMMEMBER Rome BYRADIUS 1 KM WITHDIST"},
+			expected:       []interface{}{3, errors.New("invalid syntax for 'GEOSEARCHSTORE' command")},
+			valueExtractor: []ValueExtractorFn{extractValueGEOADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"GEOSEARCHSTORE gss7dst gss7 FROMMEMBER Rome"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GEOSEARCHSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueGETBIT(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestGETBIT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "bits of a string value",
+			commands:       []string{"SET gb1 a", "GETBIT gb1 1", "GETBIT gb1 2", "GETBIT gb1 7", "GETBIT gb1 8"},
+			expected:       []interface{}{"OK", 1, 1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGETBIT, extractValueGETBIT, extractValueGETBIT, extractValueGETBIT},
+		},
+		{
+			name:           "missing key",
+			commands:       []string{"GETBIT gb2 10"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueGETBIT},
+		},
+		{
+			name:           "invalid offset",
+			commands:       []string{"GETBIT gb3 x"},
+			expected:       []interface{}{errors.New("bit offset is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SADD gb4 a", "GETBIT gb4 1"},
+			expected:       []interface{}{1, errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"GETBIT gb5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GETBIT' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGETRANGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "GETRANGE with positive offsets",
+			commands:       []string{"SET gr1 helloworld", "GETRANGE gr1 0 4", "GETRANGE gr1 5 100"},
+			expected:       []interface{}{"OK", "hello", "world"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "GETRANGE with negative offsets",
+			commands:       []string{"SET gr2 helloworld", "GETRANGE gr2 -5 -1", "GETRANGE gr2 -100 1"},
+			expected:       []interface{}{"OK", "world", "he"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "GETRANGE with an empty range",
+			commands:       []string{"SET gr3 hello", "GETRANGE gr3 3 1", "GETRANGE gr3 10 20"},
+			expected:       []interface{}{"OK", "", ""},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "GETRANGE of an integer",
+			commands:       []string{"SET gr4 12345", "GETRANGE gr4 1 2"},
+			expected:       []interface{}{"OK", "23"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGET},
+		},
+		{
+			name:           "GETRANGE of a missing key",
+			commands:       []string{"GETRANGE gr5 0 -1"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueGET},
+		},
+		{
+			name:           "GETRANGE with an invalid offset",
+			commands:       []string{"GETRANGE gr6 a 1"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "GETRANGE on a key of another type",
+			commands:       []string{"SADD gr7 a", "GETRANGE gr7 0 1"},
+			expected:       []interface{}{int64(1), errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "GETRANGE with wrong number of arguments",
+			commands:       []string{"GETRANGE gr8 0"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'GETRANGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
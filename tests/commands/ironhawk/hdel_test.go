@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHDEL(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestHDEL(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HDEL removes existing fields",
+			commands:       []string{"HSET hd1 f1 v1 f2 v2 f3 v3", "HDEL hd1 f1 f3 f4", "HGETALL hd1"},
+			expected:       []interface{}{3, 2, "f2: v2\n"},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHDEL, extractValueHGETALL},
+		},
+		{
+			name:           "HDEL removes the key with its last field",
+			commands:       []string{"HSET hd2 f1 v1", "HDEL hd2 f1", "EXISTS hd2"},
+			expected:       []interface{}{1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHDEL, extractValueEXISTS},
+		},
+		{
+			name:           "HDEL on non-existent key",
+			commands:       []string{"HDEL hd3 f1"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueHDEL},
+		},
+		{
+			name:           "HDEL on non-hash key",
+			commands:       []string{"SET hd4 v", "HDEL hd4 f1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HDEL with wrong number of arguments",
+			commands:       []string{"HDEL hd5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HDEL' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHEXISTS(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestHEXISTS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HEXISTS on existing and missing fields",
+			commands:       []string{"HSET he1 f1 v1", "HEXISTS he1 f1", "HEXISTS he1 f2"},
+			expected:       []interface{}{1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHEXISTS, extractValueHEXISTS},
+		},
+		{
+			name:           "HEXISTS on non-existent key",
+			commands:       []string{"HEXISTS he2 f1"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueHEXISTS},
+		},
+		{
+			name:           "HEXISTS on non-hash key",
+			commands:       []string{"SET he3 v", "HEXISTS he3 f1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HEXISTS with wrong number of arguments",
+			commands:       []string{"HEXISTS he4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HEXISTS' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
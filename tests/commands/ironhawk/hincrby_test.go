@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHINCRBY(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestHINCRBY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HINCRBY increments existing and missing fields",
+			commands:       []string{"HSET hi1 f1 5", "HINCRBY hi1 f1 10", "HINCRBY hi1 f2 -3", "HGETALL hi1"},
+			expected:       []interface{}{1, 15, -3, "f1: 15\nf2: -3\n"},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHINCRBY, extractValueHINCRBY, extractValueHGETALL},
+		},
+		{
+			name:           "HINCRBY on non-integer field",
+			commands:       []string{"HSET hi2 f1 abc", "HINCRBY hi2 f1 1"},
+			expected:       []interface{}{1, errors.New("hash value is not an integer")},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, nil},
+		},
+		{
+			name:           "HINCRBY with non-integer increment",
+			commands:       []string{"HINCRBY hi3 f1 1.5"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "HINCRBY overflow",
+			commands:       []string{"HSET hi4 f1 9223372036854775807", "HINCRBY hi4 f1 1"},
+			expected:       []interface{}{1, errors.New("increment or decrement would overflow")},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, nil},
+		},
+		{
+			name:           "HINCRBY on non-hash key",
+			commands:       []string{"SET hi5 v", "HINCRBY hi5 f1 1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HINCRBY with wrong number of arguments",
+			commands:       []string{"HINCRBY hi6 f1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HINCRBY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHINCRBYFLOAT(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestHINCRBYFLOAT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HINCRBYFLOAT increments existing and missing fields",
+			commands:       []string{"HSET hif1 f1 10.5", "HINCRBYFLOAT hif1 f1 0.1", "HINCRBYFLOAT hif1 f2 -5"},
+			expected:       []interface{}{1, "10.6", "-5"},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHINCRBYFLOAT, extractValueHINCRBYFLOAT},
+		},
+		{
+			name:           "HINCRBYFLOAT on non-numeric field",
+			commands:       []string{"HSET hif2 f1 abc", "HINCRBYFLOAT hif2 f1 1"},
+			expected:       []interface{}{1, errors.New("value is not an integer or a float")},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, nil},
+		},
+		{
+			name:           "HINCRBYFLOAT with non-numeric increment",
+			commands:       []string{"HINCRBYFLOAT hif3 f1 abc"},
+			expected:       []interface{}{errors.New("value is not an integer or a float")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "HINCRBYFLOAT on non-hash key",
+			commands:       []string{"SET hif4 v", "HINCRBYFLOAT hif4 f1 1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HINCRBYFLOAT with wrong number of arguments",
+			commands:       []string{"HINCRBYFLOAT hif5 f1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HINCRBYFLOAT' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
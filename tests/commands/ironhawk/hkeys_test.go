@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHKEYS(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestHKEYS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HKEYS returns all fields",
+			commands:       []string{"HSET hk1 f1 v1 f2 v2", "HKEYS hk1"},
+			expected:       []interface{}{2, []string{"f1", "f2"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHKEYS},
+		},
+		{
+			name:           "HKEYS on non-existent key",
+			commands:       []string{"HKEYS hk2"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueHKEYS},
+		},
+		{
+			name:           "HKEYS on non-hash key",
+			commands:       []string{"SET hk3 v", "HKEYS hk3"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HKEYS with wrong number of arguments",
+			commands:       []string{"HKEYS hk4 hk5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HKEYS' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
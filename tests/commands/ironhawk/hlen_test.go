@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHLEN(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestHLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HLEN returns the number of fields",
+			commands:       []string{"HSET hl1 f1 v1 f2 v2 f3 v3", "HLEN hl1"},
+			expected:       []interface{}{3, 3},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHLEN},
+		},
+		{
+			name:           "HLEN on non-existent key",
+			commands:       []string{"HLEN hl2"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueHLEN},
+		},
+		{
+			name:           "HLEN on non-hash key",
+			commands:       []string{"SET hl3 v", "HLEN hl3"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HLEN with wrong number of arguments",
+			commands:       []string{"HLEN"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HLEN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
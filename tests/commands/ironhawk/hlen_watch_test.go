@@ -0,0 +1,30 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHLENWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HLEN.WATCH without key arg",
+			commands:       []string{"HLEN.WATCH"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HLEN.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "HLEN.WATCH with key arg",
+			commands:       []string{"HSET hlw1 f1 v1", "HLEN.WATCH hlw1"},
+			expected:       []interface{}{1, 1},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHLEN},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHMGET(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestHMGET(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HMGET returns values in request order",
+			commands:       []string{"HSET hm1 f1 v1 f2 v2", "HMGET hm1 f2 f3 f1"},
+			expected:       []interface{}{2, []*wire.HElement{{Key: "f2", Value: "v2"}, {Key: "f3", Value: ""}, {Key: "f1", Value: "v1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHMGET},
+		},
+		{
+			name:           "HMGET on non-existent key",
+			commands:       []string{"HMGET hm2 f1 f2"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "f1", Value: ""}, {Key: "f2", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueHMGET},
+		},
+		{
+			name:           "HMGET on non-hash key",
+			commands:       []string{"SET hm3 v", "HMGET hm3 f1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HMGET with wrong number of arguments",
+			commands:       []string{"HMGET hm4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HMGET' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestHMGETWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HMGET.WATCH without field arg",
+			commands:       []string{"HMGET.WATCH hmw1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HMGET.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "HMGET.WATCH with key and field args",
+			commands:       []string{"HSET hmw2 f1 v1", "HMGET.WATCH hmw2 f1 f2"},
+			expected:       []interface{}{1, []*wire.HElement{{Key: "f1", Value: "v1"}, {Key: "f2", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHMGET},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
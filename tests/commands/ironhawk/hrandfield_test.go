@@ -0,0 +1,76 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHRANDFIELD(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func extractValueHRANDFIELDCount(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestHRANDFIELD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HRANDFIELD on single-field hash",
+			commands:       []string{"HSET hr1 f1 v1", "HRANDFIELD hr1"},
+			expected:       []interface{}{1, "f1"},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHRANDFIELD},
+		},
+		{
+			name:           "HRANDFIELD with count larger than the hash",
+			commands:       []string{"HSET hr2 f1 v1 f2 v2", "HRANDFIELD hr2 5"},
+			expected:       []interface{}{2, []string{"f1", "f2"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHRANDFIELDCount},
+		},
+		{
+			name:           "HRANDFIELD with negative count repeats fields",
+			commands:       []string{"HSET hr3 f1 v1", "HRANDFIELD hr3 -3"},
+			expected:       []interface{}{1, []string{"f1", "f1", "f1"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHRANDFIELDCount},
+		},
+		{
+			name:           "HRANDFIELD with values",
+			commands:       []string{"HSET hr4 f1 v1", "HRANDFIELD hr4 1 WITHVALUES"},
+			expected:       []interface{}{1, "f1: v1\n"},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHGETALL},
+		},
+		{
+			name:           "HRANDFIELD on non-existent key",
+			commands:       []string{"HRANDFIELD hr5", "HRANDFIELD hr5 2"},
+			expected:       []interface{}{"", []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueHRANDFIELD, extractValueHRANDFIELDCount},
+		},
+		{
+			name:           "HRANDFIELD with invalid options",
+			commands:       []string{"HRANDFIELD hr6 abc", "HRANDFIELD hr6 1 VALUES"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range"), errors.New("invalid syntax for 'HRANDFIELD' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "HRANDFIELD on non-hash key",
+			commands:       []string{"SET hr7 v", "HRANDFIELD hr7"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HRANDFIELD with wrong number of arguments",
+			commands:       []string{"HRANDFIELD hr8 1 WITHVALUES x"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HRANDFIELD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
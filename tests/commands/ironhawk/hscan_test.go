@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHSCAN(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestHSCAN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HSCAN iterates with COUNT",
+			commands:       []string{"HSET hsc1 f1 v1 f2 v2 f3 v3", "HSCAN hsc1 0 COUNT 2", "HSCAN hsc1 246058661394715 COUNT 2"},
+			expected:       []interface{}{3, []string{"246058661394715", "f3", "v3", "f2", "v2"}, []string{"0", "f1", "v1"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHSCAN, extractValueHSCAN},
+		},
+		{
+			name:           "HSCAN keeps its place when the map is modified",
+			commands:       []string{"HSET hsc7 f1 v1 f2 v2 f3 v3", "HSCAN hsc7 0 COUNT 2", "HDEL hsc7 f3", "HSCAN hsc7 246058661394715 COUNT 2"},
+			expected:       []interface{}{3, []string{"246058661394715", "f3", "v3", "f2", "v2"}, 1, []string{"0", "f1", "v1"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHSCAN, extractValueHDEL, extractValueHSCAN},
+		},
+		{
+			name:           "HSCAN with MATCH",
+			commands:       []string{"HSET hsc2 a1 v1 b1 v2 a2 v3", "HSCAN hsc2 0 MATCH a*"},
+			expected:       []interface{}{3, []string{"0", "a1", "v1", "a2", "v3"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHSCAN},
+		},
+		{
+			name:           "HSCAN on non-existent key",
+			commands:       []string{"HSCAN hsc3 0"},
+			expected:       []interface{}{[]string{"0"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSCAN},
+		},
+		{
+			name:           "HSCAN with invalid options",
+			commands:       []string{"HSCAN hsc4 abc", "HSCAN hsc4 0 COUNT 0", "HSCAN hsc4 0 FOO bar", "HSCAN hsc4 0 MATCH", "HSCAN hsc4 99999999999999999"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range"), errors.New("value is not an integer or out of range"), errors.New("invalid syntax for 'HSCAN' command"), errors.New("invalid syntax for 'HSCAN' command"), errors.New("invalid cursor")},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil, nil, nil},
+		},
+		{
+			name:           "HSCAN on non-hash key",
+			commands:       []string{"SET hsc5 v", "HSCAN hsc5 0"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HSCAN with wrong number of arguments",
+			commands:       []string{"HSCAN hsc6"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HSCAN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHSETNX(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestHSETNX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HSETNX only sets missing fields",
+			commands:       []string{"HSETNX hn1 f1 v1", "HSETNX hn1 f1 v2", "HGETALL hn1"},
+			expected:       []interface{}{1, 0, "f1: v1\n"},
+			valueExtractor: []ValueExtractorFn{extractValueHSETNX, extractValueHSETNX, extractValueHGETALL},
+		},
+		{
+			name:           "HSETNX on non-hash key",
+			commands:       []string{"SET hn2 v", "HSETNX hn2 f1 v1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HSETNX with wrong number of arguments",
+			commands:       []string{"HSETNX hn3 f1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HSETNX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHSTRLEN(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestHSTRLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HSTRLEN returns the value length",
+			commands:       []string{"HSET hsl1 f1 hello", "HSTRLEN hsl1 f1", "HSTRLEN hsl1 f2"},
+			expected:       []interface{}{1, 5, 0},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHSTRLEN, extractValueHSTRLEN},
+		},
+		{
+			name:           "HSTRLEN on non-existent key",
+			commands:       []string{"HSTRLEN hsl2 f1"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueHSTRLEN},
+		},
+		{
+			name:           "HSTRLEN on non-hash key",
+			commands:       []string{"SET hsl3 v", "HSTRLEN hsl3 f1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HSTRLEN with wrong number of arguments",
+			commands:       []string{"HSTRLEN hsl4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HSTRLEN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
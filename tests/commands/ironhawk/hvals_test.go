@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueHVALS(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestHVALS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "HVALS returns all values",
+			commands:       []string{"HSET hv1 f1 v1 f2 v2", "HVALS hv1"},
+			expected:       []interface{}{2, []string{"v1", "v2"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHVALS},
+		},
+		{
+			name:           "HVALS on non-existent key",
+			commands:       []string{"HVALS hv2"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueHVALS},
+		},
+		{
+			name:           "HVALS on non-hash key",
+			commands:       []string{"SET hv3 v", "HVALS hv3"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "HVALS with wrong number of arguments",
+			commands:       []string{"HVALS"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'HVALS' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
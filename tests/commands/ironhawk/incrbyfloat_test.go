@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestINCRBYFLOAT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "INCRBYFLOAT a missing key",
+			commands:       []string{"INCRBYFLOAT if1 1.5"},
+			expected:       []interface{}{"1.5"},
+			valueExtractor: []ValueExtractorFn{extractValueGET},
+		},
+		{
+			name:           "INCRBYFLOAT an integer",
+			commands:       []string{"SET if2 10", "INCRBYFLOAT if2 0.25", "INCRBYFLOAT if2 -10.25"},
+			expected:       []interface{}{"OK", "10.25", "0"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "INCRBYFLOAT a string",
+			commands:       []string{"SET if3 2.5e1", "INCRBYFLOAT if3 1"},
+			expected:       []interface{}{"OK", "26"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGET},
+		},
+		{
+			name:           "INCRBYFLOAT a string that is not a number",
+			commands:       []string{"SET if4 abc", "INCRBYFLOAT if4 1"},
+			expected:       []interface{}{"OK", errors.New("value is not a valid float")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "INCRBYFLOAT with an invalid increment",
+			commands:       []string{"INCRBYFLOAT if5 abc"},
+			expected:       []interface{}{errors.New("value is not a valid float")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "INCRBYFLOAT on a key of another type",
+			commands:       []string{"SADD if6 a", "INCRBYFLOAT if6 1"},
+			expected:       []interface{}{int64(1), errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "INCRBYFLOAT with wrong number of arguments",
+			commands:       []string{"INCRBYFLOAT if7"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'INCRBYFLOAT' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestINDEXCREATE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "INDEX.CREATE indexes the existing keys",
+			commands:       []string{"HSET ic1:a age 25", "HSET ic1:b age 40", "INDEX.CREATE ic1 ON HASH PREFIX ic1: SCHEMA age NUMERIC", "INDEX.QUERY ic1 @age:[0 30]"},
+			expected:       []interface{}{1, 1, "OK", []string{"ic1:a"}},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueHSET, extractValueSET, extractValueKEYS},
+		},
+		{
+			name:           "INDEX.CREATE an existing index",
+			commands:       []string{"INDEX.CREATE ic2 ON HASH SCHEMA age NUMERIC", "INDEX.CREATE ic2 ON JSON SCHEMA age NUMERIC"},
+			expected:       []interface{}{"OK", errors.New("index 'ic2' already exists")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "INDEX.CREATE with an unknown source",
+			commands:       []string{"INDEX.CREATE ic3 ON SET SCHEMA age NUMERIC"},
+			expected:       []interface{}{errors.New("unknown index source 'SET', must be HASH or JSON")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "INDEX.CREATE with an unknown field type",
+			commands:       []string{"INDEX.CREATE ic4 ON HASH SCHEMA age TEXT"},
+			expected:       []interface{}{errors.New("unknown field type 'TEXT', must be NUMERIC or TAG")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "INDEX.CREATE with a duplicate field",
+			commands:       []string{"INDEX.CREATE ic5 ON HASH SCHEMA age NUMERIC years AS age TAG"},
+			expected:       []interface{}{errors.New("duplicate field 'age'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "INDEX.CREATE without SCHEMA",
+			commands:       []string{"INDEX.CREATE ic6 ON HASH PREFIX ic6: age NUMERIC"},
+			expected:       []interface{}{errors.New("invalid syntax for 'INDEX.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "INDEX.CREATE with wrong number of arguments",
+			commands:       []string{"INDEX.CREATE ic7 ON HASH SCHEMA age"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'INDEX.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
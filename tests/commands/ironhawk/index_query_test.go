@@ -0,0 +1,96 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueINDEXQUERY(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestINDEXQUERY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "INDEX.QUERY across shards",
+			commands:       []string{"INDEX.CREATE iq1 ON HASH PREFIX iq1: SCHEMA age NUMERIC city TAG", "HSET iq1:a age 25 city Berlin", "HSET iq1:b age 35 city Berlin,Paris", "HSET iq1:c age 28 city Paris", "HSET other:iq1 age 20 city Berlin", "INDEX.QUERY iq1 @age:[18 30] @city:{berlin}"},
+			expected:       []interface{}{"OK", 2, 2, 2, 2, []string{"iq1:a"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueKEYS},
+		},
+		{
+			name:           "INDEX.QUERY with tag alternatives and exclusive bounds",
+			commands:       []string{"INDEX.CREATE iq2 ON HASH PREFIX iq2: SCHEMA age NUMERIC city TAG", "HSET iq2:a age 25 city Berlin", "HSET iq2:b age 35 city Berlin,Paris", "HSET iq2:c age 28 city Paris", "HSET other:iq2 age 20 city Berlin", "INDEX.QUERY iq2 @city:{paris | rome} @age:[(28 +inf]"},
+			expected:       []interface{}{"OK", 2, 2, 2, 2, []string{"iq2:b"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueKEYS},
+		},
+		{
+			name:           "INDEX.QUERY every key",
+			commands:       []string{"INDEX.CREATE iq3 ON HASH PREFIX iq3: SCHEMA age NUMERIC city TAG", "HSET iq3:a age 25 city Berlin", "HSET iq3:b age 35 city Berlin,Paris", "HSET iq3:c age 28 city Paris", "HSET other:iq3 age 20 city Berlin", "INDEX.QUERY iq3 *"},
+			expected:       []interface{}{"OK", 2, 2, 2, 2, []string{"iq3:a", "iq3:b", "iq3:c"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueKEYS},
+		},
+		{
+			name:           "INDEX.QUERY follows updates and deletes",
+			commands:       []string{"INDEX.CREATE iq4 ON HASH PREFIX iq4: SCHEMA age NUMERIC city TAG", "HSET iq4:a age 25 city Berlin", "HSET iq4:b age 35 city Berlin,Paris", "HSET iq4:c age 28 city Paris", "HSET other:iq4 age 20 city Berlin", "HINCRBY iq4:a age 10", "HDEL iq4:c city", "DEL iq4:b", "HSET iq4:d age 30 city berlin", "INDEX.QUERY iq4 @age:[30 40]"},
+			expected:       []interface{}{"OK", 2, 2, 2, 2, 35, 1, 1, 2, []string{"iq4:a", "iq4:d"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHINCRBY, extractValueHDEL, extractValueDEL, extractValueHSET, extractValueKEYS},
+		},
+		{
+			name:           "INDEX.QUERY ignores keys overwritten with another type",
+			commands:       []string{"INDEX.CREATE iq5 ON HASH PREFIX iq5: SCHEMA age NUMERIC city TAG", "HSET iq5:a age 25 city Berlin", "HSET iq5:b age 35 city Berlin,Paris", "HSET iq5:c age 28 city Paris", "HSET other:iq5 age 20 city Berlin", "SET iq5:a v", "INDEX.QUERY iq5 @city:{berlin}"},
+			expected:       []interface{}{"OK", 2, 2, 2, 2, "OK", []string{"iq5:b"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueSET, extractValueKEYS},
+		},
+		{
+			name:           "INDEX.QUERY with LIMIT",
+			commands:       []string{"INDEX.CREATE iq6 ON HASH PREFIX iq6: SCHEMA age NUMERIC city TAG", "HSET iq6:a age 25 city Berlin", "HSET iq6:b age 35 city Berlin,Paris", "HSET iq6:c age 28 city Paris", "HSET other:iq6 age 20 city Berlin", "INDEX.QUERY iq6 * LIMIT 1 1"},
+			expected:       []interface{}{"OK", 2, 2, 2, 2, []string{"iq6:b"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueKEYS},
+		},
+		{
+			name:           "INDEX.QUERY WITHDOCS",
+			commands:       []string{"INDEX.CREATE iq7 ON HASH PREFIX iq7: SCHEMA age NUMERIC city TAG", "HSET iq7:a age 25 city Berlin", "HSET iq7:b age 35 city Berlin,Paris", "HSET iq7:c age 28 city Paris", "HSET other:iq7 age 20 city Berlin", "INDEX.QUERY iq7 @age:[25 25] WITHDOCS"},
+			expected:       []interface{}{"OK", 2, 2, 2, 2, []*wire.HElement{{Key: "iq7:a", Value: "{\"age\":\"25\",\"city\":\"Berlin\"}"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueHSET, extractValueINDEXQUERY},
+		},
+		{
+			name:           "INDEX.QUERY JSON documents",
+			commands:       []string{"INDEX.CREATE iq8 ON JSON PREFIX iq8: SCHEMA $.address.city AS city TAG age NUMERIC", "JSON.SET iq8:a $ {\"age\":30,\"address\":{\"city\":\"Berlin\"}}", "JSON.SET iq8:b $ {\"age\":30,\"address\":{\"city\":[\"Rome\",\"Paris\"]}}", "JSON.NUMINCRBY iq8:a $.age 5", "INDEX.QUERY iq8 @age:[30 30] @city:{paris}", "INDEX.QUERY iq8 @age:[35 35] WITHDOCS"},
+			expected:       []interface{}{"OK", "OK", "OK", "[35]", []string{"iq8:b"}, []*wire.HElement{{Key: "iq8:a", Value: "{\"address\":{\"city\":\"Berlin\"},\"age\":35}"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueJSONSET, extractValueJSONSET, extractValueJSONNUMINCRBY, extractValueKEYS, extractValueINDEXQUERY},
+		},
+		{
+			name:           "INDEX.QUERY a missing index",
+			commands:       []string{"INDEX.QUERY iq9 *"},
+			expected:       []interface{}{errors.New("no such index 'iq9'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "INDEX.QUERY with an unknown field",
+			commands:       []string{"INDEX.CREATE iq10 ON HASH SCHEMA age NUMERIC", "INDEX.QUERY iq10 @name:{bob}"},
+			expected:       []interface{}{"OK", errors.New("unknown field 'name'")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "INDEX.QUERY with an invalid query",
+			commands:       []string{"INDEX.QUERY iq11 age:[1 2]"},
+			expected:       []interface{}{errors.New("syntax error in query at 'age:[1 2]'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "INDEX.QUERY with wrong number of arguments",
+			commands:       []string{"INDEX.QUERY iq12"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'INDEX.QUERY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONARRAPPEND(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONARRAPPEND(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Append to Arrays",
+			commands:       []string{"JSON.SET jsonarrappend $ {\"a\":[1],\"b\":\"x\"}", "JSON.ARRAPPEND jsonarrappend $.a 2 \"3\"", "JSON.ARRAPPEND jsonarrappend $.b 1", "JSON.GET jsonarrappend $.a"},
+			expected:       []interface{}{"OK", "[3]", "[null]", "[1,2,\"3\"]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRAPPEND, extractValueJSONARRAPPEND, extractValueJSONGET},
+		},
+		{
+			name:           "Append to Non-existent Key",
+			commands:       []string{"JSON.ARRAPPEND jsonarrappendnokey $ 1"},
+			expected:       []interface{}{errors.New("could not perform this operation on a key that doesn't exist")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Append with Wrong Number of Arguments",
+			commands:       []string{"JSON.ARRAPPEND jsonarrappend $"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'JSON.ARRAPPEND' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
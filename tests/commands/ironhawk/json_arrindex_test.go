@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONARRINDEX(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONARRINDEX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Index of Value in Array",
+			commands:       []string{"JSON.SET jsonarrindex $ {\"a\":[1,\"x\",{\"b\":2},1]}", "JSON.ARRINDEX jsonarrindex $.a 1", "JSON.ARRINDEX jsonarrindex $.a 1 1", "JSON.ARRINDEX jsonarrindex $.a {\"b\":2}", "JSON.ARRINDEX jsonarrindex $.a \"y\"", "JSON.ARRINDEX jsonarrindex $.a 1 1 -1"},
+			expected:       []interface{}{"OK", "[0]", "[3]", "[2]", "[-1]", "[-1]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRINDEX, extractValueJSONARRINDEX, extractValueJSONARRINDEX, extractValueJSONARRINDEX, extractValueJSONARRINDEX},
+		},
+		{
+			name:           "Index on non-array Value",
+			commands:       []string{"JSON.SET jsonarrindex1 $ {\"a\":1}", "JSON.ARRINDEX jsonarrindex1 $.a 1"},
+			expected:       []interface{}{"OK", "[null]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRINDEX},
+		},
+		{
+			name:           "Index with Invalid Start",
+			commands:       []string{"JSON.SET jsonarrindex2 $ []", "JSON.ARRINDEX jsonarrindex2 $ 1 x"},
+			expected:       []interface{}{"OK", errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
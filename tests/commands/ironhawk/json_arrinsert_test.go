@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONARRINSERT(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONARRINSERT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Insert into Array",
+			commands:       []string{"JSON.SET jsonarrinsert $ {\"a\":[1,4]}", "JSON.ARRINSERT jsonarrinsert $.a 1 2 3", "JSON.ARRINSERT jsonarrinsert $.a -1 \"x\"", "JSON.GET jsonarrinsert $.a"},
+			expected:       []interface{}{"OK", "[4]", "[5]", "[1,2,3,\"x\",4]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRINSERT, extractValueJSONARRINSERT, extractValueJSONGET},
+		},
+		{
+			name:           "Insert at Index out of Range",
+			commands:       []string{"JSON.SET jsonarrinsert1 $ [1]", "JSON.ARRINSERT jsonarrinsert1 $ 3 2"},
+			expected:       []interface{}{"OK", errors.New("index out of bounds")},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONARRLEN(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONARRLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Length of Arrays",
+			commands:       []string{"JSON.SET jsonarrlen $ [1,2,3]", "JSON.ARRLEN jsonarrlen", "JSON.SET jsonarrlen1 $ {\"a\":[1],\"b\":\"x\"}", "JSON.ARRLEN jsonarrlen1 $.a", "JSON.ARRLEN jsonarrlen1 $.b"},
+			expected:       []interface{}{"OK", "[3]", "OK", "[1]", "[null]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRLEN, extractValueJSONSET, extractValueJSONARRLEN, extractValueJSONARRLEN},
+		},
+		{
+			name:           "Length of Non-existent Key",
+			commands:       []string{"JSON.ARRLEN jsonarrlennokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONARRLEN},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
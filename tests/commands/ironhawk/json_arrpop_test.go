@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONARRPOP(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONARRPOP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Pop from Array",
+			commands:       []string{"JSON.SET jsonarrpop $ [1,\"b\",{\"c\":3}]", "JSON.ARRPOP jsonarrpop", "JSON.ARRPOP jsonarrpop $ 0", "JSON.ARRPOP jsonarrpop $ 5", "JSON.ARRPOP jsonarrpop", "JSON.GET jsonarrpop"},
+			expected:       []interface{}{"OK", "[{\"c\":3}]", "[1]", "[\"b\"]", "[null]", "[]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRPOP, extractValueJSONARRPOP, extractValueJSONARRPOP, extractValueJSONARRPOP, extractValueJSONGET},
+		},
+		{
+			name:           "Pop from non-array Value",
+			commands:       []string{"JSON.SET jsonarrpop1 $ {\"a\":1}", "JSON.ARRPOP jsonarrpop1 $.a"},
+			expected:       []interface{}{"OK", "[null]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRPOP},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONARRTRIM(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONARRTRIM(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Trim Array",
+			commands:       []string{"JSON.SET jsonarrtrim $ [1,2,3,4,5]", "JSON.ARRTRIM jsonarrtrim $ 1 -2", "JSON.GET jsonarrtrim"},
+			expected:       []interface{}{"OK", "[3]", "[2,3,4]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRTRIM, extractValueJSONGET},
+		},
+		{
+			name:           "Trim Array with Start past the End",
+			commands:       []string{"JSON.SET jsonarrtrim1 $ [1,2,3]", "JSON.ARRTRIM jsonarrtrim1 $ 5 10", "JSON.GET jsonarrtrim1"},
+			expected:       []interface{}{"OK", "[0]", "[]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONARRTRIM, extractValueJSONGET},
+		},
+		{
+			name:           "Trim with Wrong Number of Arguments",
+			commands:       []string{"JSON.ARRTRIM jsonarrtrim $ 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'JSON.ARRTRIM' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONDEL(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestJSONDEL(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Delete Values at Path",
+			commands:       []string{"JSON.SET jsondel $ {\"a\":1,\"b\":[1,2,3]}", "JSON.DEL jsondel $.b[0]", "JSON.DEL jsondel $.a", "JSON.GET jsondel"},
+			expected:       []interface{}{"OK", 1, 1, "{\"b\":[2,3]}"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONDEL, extractValueJSONDEL, extractValueJSONGET},
+		},
+		{
+			name:           "Delete Path with no Match",
+			commands:       []string{"JSON.SET jsondel1 $ {\"a\":1}", "JSON.DEL jsondel1 $.b"},
+			expected:       []interface{}{"OK", 0},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONDEL},
+		},
+		{
+			name:           "Delete Document",
+			commands:       []string{"JSON.SET jsondel2 $ {\"a\":1}", "JSON.DEL jsondel2", "JSON.GET jsondel2"},
+			expected:       []interface{}{"OK", 1, ""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONDEL, extractValueJSONGET},
+		},
+		{
+			name:           "Delete Non-existent Key",
+			commands:       []string{"JSON.DEL jsondelnokey"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueJSONDEL},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONGET(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONGET(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Get Document",
+			commands:       []string{"JSON.SET jsonget $ {\"name\":\"alice\",\"tags\":[\"a\",\"b\"]}", "JSON.GET jsonget"},
+			expected:       []interface{}{"OK", "{\"name\":\"alice\",\"tags\":[\"a\",\"b\"]}"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Get Single Value at Path",
+			commands:       []string{"JSON.SET jsonget3 $ {\"name\":\"alice\"}", "JSON.GET jsonget3 $.name"},
+			expected:       []interface{}{"OK", "\"alice\""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Get Multiple Values at Path",
+			commands:       []string{"JSON.SET jsonget4 $ {\"tags\":[\"a\",\"b\"]}", "JSON.GET jsonget4 $.tags[*]"},
+			expected:       []interface{}{"OK", "[\"a\",\"b\"]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Get Document with Legacy Root Path",
+			commands:       []string{"JSON.SET jsonget5 $ [1]", "JSON.GET jsonget5 ."},
+			expected:       []interface{}{"OK", "[1]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Get Non-existent Key",
+			commands:       []string{"JSON.GET jsongetnokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONGET},
+		},
+		{
+			name:           "Get Path with no Match",
+			commands:       []string{"JSON.SET jsonget1 $ {\"a\":1}", "JSON.GET jsonget1 $.b"},
+			expected:       []interface{}{"OK", ""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Get on non-JSON Key",
+			commands:       []string{"SET jsonget2 v", "JSON.GET jsonget2"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "Get with Wrong Number of Arguments",
+			commands:       []string{"JSON.GET"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'JSON.GET' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
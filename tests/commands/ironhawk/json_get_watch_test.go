@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func extractValueJSONGETWATCH(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestJSONGETWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "JSON.GET.WATCH without key arg",
+			commands:       []string{"JSON.GET.WATCH"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'JSON.GET.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "JSON.GET.WATCH with key and path args",
+			commands:       []string{"JSON.GET.WATCH jsongetwatch $.a"},
+			expected:       []interface{}{"OK"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONGETWATCH},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
+
+func TestJSONGETWATCHPushesOnlyChangedPath(t *testing.T) {
+	publisher := getLocalConnection()
+	defer publisher.Close()
+	// The watcher is not closed, as closing a client with a watch channel
+	// races with the goroutine feeding it.
+	watcher := getLocalConnection()
+
+	publisher.Fire(&wire.Command{Cmd: "JSON.SET", Args: []string{"jsongetwatchpath", "$", `{"a":1,"b":1}`}})
+	res := watcher.Fire(&wire.Command{Cmd: "JSON.GET.WATCH", Args: []string{"jsongetwatchpath", "$.a"}})
+	assert.Equal(t, "1", res.GetGETRes().Value)
+	ch, err := watcher.WatchCh()
+	assert.Nil(t, err)
+
+	// The write to $.b leaves $.a unchanged, so only the write to $.a is
+	// pushed.
+	publisher.Fire(&wire.Command{Cmd: "JSON.SET", Args: []string{"jsongetwatchpath", "$.b", "2"}})
+	publisher.Fire(&wire.Command{Cmd: "JSON.SET", Args: []string{"jsongetwatchpath", "$.a", "3"}})
+	assert.Equal(t, "3", receiveWatchValue(t, ch))
+}
+
+func receiveWatchValue(t *testing.T, ch <-chan *wire.Result) string {
+	t.Helper()
+	select {
+	case res := <-ch:
+		return res.GetGETRes().Value
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a watch notification")
+		return ""
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONNUMINCRBY(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONNUMINCRBY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Increment Numbers",
+			commands:       []string{"JSON.SET jsonnumincrby $ {\"a\":1,\"b\":\"x\"}", "JSON.NUMINCRBY jsonnumincrby $.a 2", "JSON.NUMINCRBY jsonnumincrby $.a 0.5", "JSON.NUMINCRBY jsonnumincrby $.b 1", "JSON.GET jsonnumincrby $.a"},
+			expected:       []interface{}{"OK", "[3]", "[3.5]", "[null]", "3.5"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONNUMINCRBY, extractValueJSONNUMINCRBY, extractValueJSONNUMINCRBY, extractValueJSONGET},
+		},
+		{
+			name:           "Increment by Invalid Number",
+			commands:       []string{"JSON.SET jsonnumincrby1 $ 1", "JSON.NUMINCRBY jsonnumincrby1 $ x"},
+			expected:       []interface{}{"OK", errors.New("value is not an integer or a float")},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, nil},
+		},
+		{
+			name:           "Increment Non-existent Key",
+			commands:       []string{"JSON.NUMINCRBY jsonnumincrbynokey $ 1"},
+			expected:       []interface{}{errors.New("could not perform this operation on a key that doesn't exist")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
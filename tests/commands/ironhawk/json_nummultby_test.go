@@ -0,0 +1,29 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONNUMMULTBY(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONNUMMULTBY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Multiply Numbers",
+			commands:       []string{"JSON.SET jsonnummultby $ {\"a\":3,\"b\":\"x\"}", "JSON.NUMMULTBY jsonnummultby $.a 2", "JSON.NUMMULTBY jsonnummultby $.a 0.5", "JSON.NUMMULTBY jsonnummultby $.b 2"},
+			expected:       []interface{}{"OK", "[6]", "[3]", "[null]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONNUMMULTBY, extractValueJSONNUMMULTBY, extractValueJSONNUMMULTBY},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
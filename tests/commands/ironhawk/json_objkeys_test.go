@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONOBJKEYS(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONOBJKEYS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Keys of Objects",
+			commands:       []string{"JSON.SET jsonobjkeys $ {\"b\":1,\"a\":{\"c\":2},\"d\":\"x\"}", "JSON.OBJKEYS jsonobjkeys", "JSON.OBJKEYS jsonobjkeys $.a", "JSON.OBJKEYS jsonobjkeys $.d"},
+			expected:       []interface{}{"OK", "[[\"a\",\"b\",\"d\"]]", "[[\"c\"]]", "[null]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONOBJKEYS, extractValueJSONOBJKEYS, extractValueJSONOBJKEYS},
+		},
+		{
+			name:           "Keys of Non-existent Key",
+			commands:       []string{"JSON.OBJKEYS jsonobjkeysnokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONOBJKEYS},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
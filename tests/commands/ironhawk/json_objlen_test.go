@@ -0,0 +1,29 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONOBJLEN(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONOBJLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Length of Objects",
+			commands:       []string{"JSON.SET jsonobjlen $ {\"b\":1,\"a\":{\"c\":2},\"d\":\"x\"}", "JSON.OBJLEN jsonobjlen", "JSON.OBJLEN jsonobjlen $.a", "JSON.OBJLEN jsonobjlen $.d"},
+			expected:       []interface{}{"OK", "[3]", "[1]", "[null]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONOBJLEN, extractValueJSONOBJLEN, extractValueJSONOBJLEN},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
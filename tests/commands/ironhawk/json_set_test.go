@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONSET(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestJSONSET(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Set and Get Document",
+			commands:       []string{"JSON.SET jsonset $ {\"a\":1,\"b\":[1,2]}", "JSON.GET jsonset"},
+			expected:       []interface{}{"OK", "{\"a\":1,\"b\":[1,2]}"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Set Value at Path",
+			commands:       []string{"JSON.SET jsonset1 $ {\"a\":1}", "JSON.SET jsonset1 $.a \"x\"", "JSON.SET jsonset1 $.b true", "JSON.GET jsonset1"},
+			expected:       []interface{}{"OK", "OK", "OK", "{\"a\":\"x\",\"b\":true}"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONSET, extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Set New Key at Non-root Path",
+			commands:       []string{"JSON.SET jsonset2 $.a 1"},
+			expected:       []interface{}{errors.New("new objects must be created at the root")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Set with NX and XX",
+			commands:       []string{"JSON.SET jsonset3 $ {\"a\":1} XX", "JSON.OBJKEYS jsonset3", "JSON.SET jsonset3 $ {\"b\":1} NX", "JSON.SET jsonset3 $ {\"c\":1} NX", "JSON.OBJKEYS jsonset3", "JSON.SET jsonset3 $ {\"d\":1} XX", "JSON.GET jsonset3"},
+			expected:       []interface{}{"OK", "", "OK", "OK", "[[\"b\"]]", "OK", "{\"d\":1}"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONOBJKEYS, extractValueJSONSET, extractValueJSONSET, extractValueJSONOBJKEYS, extractValueJSONSET, extractValueJSONGET},
+		},
+		{
+			name:           "Set Invalid JSON",
+			commands:       []string{"JSON.SET jsonset4 $ {a}"},
+			expected:       []interface{}{errors.New("invalid JSON")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Set with Wrong Number of Arguments",
+			commands:       []string{"JSON.SET jsonset5 $"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'JSON.SET' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Set on non-JSON Key",
+			commands:       []string{"SET jsonset6 v", "JSON.SET jsonset6 $ 1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONSTRAPPEND(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONSTRAPPEND(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Append to Strings",
+			commands:       []string{"JSON.SET jsonstrappend $ {\"a\":\"foo\",\"b\":1}", "JSON.STRAPPEND jsonstrappend $.a \"bar\"", "JSON.STRAPPEND jsonstrappend $.b \"bar\"", "JSON.GET jsonstrappend $.a"},
+			expected:       []interface{}{"OK", "[6]", "[null]", "\"foobar\""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONSTRAPPEND, extractValueJSONSTRAPPEND, extractValueJSONGET},
+		},
+		{
+			name:           "Append to Root String",
+			commands:       []string{"JSON.SET jsonstrappend1 $ \"foo\"", "JSON.STRAPPEND jsonstrappend1 \"!\"", "JSON.GET jsonstrappend1"},
+			expected:       []interface{}{"OK", "[4]", "\"foo!\""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONSTRAPPEND, extractValueJSONGET},
+		},
+		{
+			name:           "Append non-string Value",
+			commands:       []string{"JSON.SET jsonstrappend2 $ \"foo\"", "JSON.STRAPPEND jsonstrappend2 $ 1"},
+			expected:       []interface{}{"OK", errors.New("value must be a JSON string")},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
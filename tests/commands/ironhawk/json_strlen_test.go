@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONSTRLEN(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONSTRLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Length of Strings",
+			commands:       []string{"JSON.SET jsonstrlen $ {\"a\":\"foo\",\"b\":1}", "JSON.STRLEN jsonstrlen $.a", "JSON.STRLEN jsonstrlen $.b", "JSON.STRLEN jsonstrlen $.*"},
+			expected:       []interface{}{"OK", "[3]", "[null]", "[3,null]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONSTRLEN, extractValueJSONSTRLEN, extractValueJSONSTRLEN},
+		},
+		{
+			name:           "Length of Non-existent Key",
+			commands:       []string{"JSON.STRLEN jsonstrlennokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSTRLEN},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
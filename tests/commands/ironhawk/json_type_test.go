@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueJSONTYPE(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestJSONTYPE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Type of Values at Path",
+			commands:       []string{"JSON.SET jsontype $ {\"a\":1,\"b\":1.5,\"c\":\"x\",\"d\":[],\"e\":{},\"f\":true,\"g\":null}", "JSON.TYPE jsontype", "JSON.TYPE jsontype $.a", "JSON.TYPE jsontype $.b", "JSON.TYPE jsontype $.c", "JSON.TYPE jsontype $.d", "JSON.TYPE jsontype $.f", "JSON.TYPE jsontype $.g"},
+			expected:       []interface{}{"OK", "[\"object\"]", "[\"integer\"]", "[\"number\"]", "[\"string\"]", "[\"array\"]", "[\"boolean\"]", "[\"null\"]"},
+			valueExtractor: []ValueExtractorFn{extractValueJSONSET, extractValueJSONTYPE, extractValueJSONTYPE, extractValueJSONTYPE, extractValueJSONTYPE, extractValueJSONTYPE, extractValueJSONTYPE, extractValueJSONTYPE},
+		},
+		{
+			name:           "Type of Non-existent Key",
+			commands:       []string{"JSON.TYPE jsontypenokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueJSONTYPE},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLINDEX(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestLINDEX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Element at Index",
+			commands:       []string{"RPUSH q a b c", "LINDEX q 0", "LINDEX q -1", "LINDEX q 3"},
+			expected:       []interface{}{3, "a", "c", ""},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLINDEX, extractValueLINDEX, extractValueLINDEX},
+		},
+		{
+			name:           "Element of Non-existent Key",
+			commands:       []string{"LINDEX nokey 0"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueLINDEX},
+		},
+		{
+			name:           "Element with Invalid Index",
+			commands:       []string{"LINDEX q x"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLINSERT(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestLINSERT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Insert Before and After Pivot",
+			commands:       []string{"RPUSH q a c", "LINSERT q BEFORE c b", "LINSERT q after c d", "LINDEX q 1", "LINDEX q 3"},
+			expected:       []interface{}{2, 3, 4, "b", "d"},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLINSERT, extractValueLINSERT, extractValueLINDEX, extractValueLINDEX},
+		},
+		{
+			name:           "Insert with Missing Pivot",
+			commands:       []string{"RPUSH q1 a", "LINSERT q1 BEFORE x y"},
+			expected:       []interface{}{1, -1},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLINSERT},
+		},
+		{
+			name:           "Insert on Non-existent Key",
+			commands:       []string{"LINSERT nokey BEFORE a b"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueLINSERT},
+		},
+		{
+			name:           "Insert with Invalid Position",
+			commands:       []string{"LINSERT q MIDDLE a b"},
+			expected:       []interface{}{errors.New("invalid syntax for 'LINSERT' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLLEN(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestLLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Length of List",
+			commands:       []string{"RPUSH q a b c", "LLEN q"},
+			expected:       []interface{}{3, 3},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLLEN},
+		},
+		{
+			name:           "Length of Non-existent Key",
+			commands:       []string{"LLEN nokey"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueLLEN},
+		},
+		{
+			name:           "Length of non-list Key",
+			commands:       []string{"SET k v", "LLEN k"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
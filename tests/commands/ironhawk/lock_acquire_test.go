@@ -0,0 +1,56 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLOCKACQUIRE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "LOCK.ACQUIRE returns increasing fencing tokens",
+			commands:       []string{"LOCK.ACQUIRE la1 n1 10000", "LOCK.ACQUIRE la1 n1 10000", "LOCK.RELEASE la1 n1", "LOCK.ACQUIRE la1 n2 10000"},
+			expected:       []interface{}{int64(1), int64(2), int64(1), int64(3)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "LOCK.ACQUIRE a lock held by another owner",
+			commands:       []string{"LOCK.ACQUIRE la2 n1 10000", "LOCK.ACQUIRE la2 n2 10000"},
+			expected:       []interface{}{int64(1), errors.New("lock is held by another owner")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "LOCK.ACQUIRE after the lease expired",
+			commands:       []string{"LOCK.ACQUIRE la3 n1 1", "LOCK.ACQUIRE la3 n2 10000"},
+			expected:       []interface{}{int64(1), int64(2)},
+			delay:          []time.Duration{0, 100 * time.Millisecond},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "LOCK.ACQUIRE on a key of another type",
+			commands:       []string{"SET la4 v", "LOCK.ACQUIRE la4 n1 10000"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "LOCK.ACQUIRE with an invalid ttl",
+			commands:       []string{"LOCK.ACQUIRE la5 n1 0", "LOCK.ACQUIRE la5 n1 abc"},
+			expected:       []interface{}{errors.New("invalid expire time in 'LOCK.ACQUIRE' command"), errors.New("invalid expire time in 'LOCK.ACQUIRE' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "LOCK.ACQUIRE with wrong number of arguments",
+			commands:       []string{"LOCK.ACQUIRE la6 n1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'LOCK.ACQUIRE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLOCKINFO(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestLOCKINFO(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "LOCK.INFO a missing lock",
+			commands:       []string{"LOCK.INFO li1"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "owner", Value: ""}, {Key: "token", Value: "0"}, {Key: "ttl_ms", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueLOCKINFO},
+		},
+		{
+			name:           "LOCK.INFO a released lock",
+			commands:       []string{"LOCK.ACQUIRE li2 n1 10000", "LOCK.RELEASE li2 n1", "LOCK.INFO li2"},
+			expected:       []interface{}{int64(1), int64(1), []*wire.HElement{{Key: "owner", Value: ""}, {Key: "token", Value: "1"}, {Key: "ttl_ms", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueLOCKINFO},
+		},
+		{
+			name:           "LOCK.INFO a lock whose lease expired",
+			commands:       []string{"LOCK.ACQUIRE li3 n1 1", "LOCK.INFO li3"},
+			expected:       []interface{}{int64(1), []*wire.HElement{{Key: "owner", Value: ""}, {Key: "token", Value: "1"}, {Key: "ttl_ms", Value: "0"}}},
+			delay:          []time.Duration{0, 100 * time.Millisecond},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueLOCKINFO},
+		},
+		{
+			name:           "LOCK.INFO with wrong number of arguments",
+			commands:       []string{"LOCK.INFO"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'LOCK.INFO' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLOCKRELEASE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "LOCK.RELEASE by the owner",
+			commands:       []string{"LOCK.ACQUIRE lrl1 n1 10000", "LOCK.RELEASE lrl1 n1", "LOCK.RELEASE lrl1 n1"},
+			expected:       []interface{}{int64(1), int64(1), int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "LOCK.RELEASE by another owner",
+			commands:       []string{"LOCK.ACQUIRE lrl2 n1 10000", "LOCK.RELEASE lrl2 n2", "LOCK.ACQUIRE lrl2 n2 10000"},
+			expected:       []interface{}{int64(1), int64(0), errors.New("lock is held by another owner")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, nil},
+		},
+		{
+			name:           "LOCK.RELEASE a missing lock",
+			commands:       []string{"LOCK.RELEASE lrl3 n1"},
+			expected:       []interface{}{int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:           "LOCK.RELEASE on a key of another type",
+			commands:       []string{"SET lrl4 v", "LOCK.RELEASE lrl4 n1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "LOCK.RELEASE with wrong number of arguments",
+			commands:       []string{"LOCK.RELEASE lrl5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'LOCK.RELEASE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLOCKRENEW(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "LOCK.RENEW keeps the fencing token",
+			commands:       []string{"LOCK.ACQUIRE lr1 n1 10000", "LOCK.RENEW lr1 n1 20000"},
+			expected:       []interface{}{int64(1), int64(1)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "LOCK.RENEW by another owner",
+			commands:       []string{"LOCK.ACQUIRE lr2 n1 10000", "LOCK.RENEW lr2 n2 10000"},
+			expected:       []interface{}{int64(1), errors.New("lock is not held by 'n2'")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "LOCK.RENEW a missing lock",
+			commands:       []string{"LOCK.RENEW lr3 n1 10000"},
+			expected:       []interface{}{errors.New("lock is not held by 'n1'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "LOCK.RENEW with an invalid ttl",
+			commands:       []string{"LOCK.ACQUIRE lr4 n1 10000", "LOCK.RENEW lr4 n1 -5"},
+			expected:       []interface{}{int64(1), errors.New("invalid expire time in 'LOCK.RENEW' command")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "LOCK.RENEW with wrong number of arguments",
+			commands:       []string{"LOCK.RENEW lr5 n1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'LOCK.RENEW' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLOCKWATCH(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestLOCKWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "LOCK.WATCH with wrong number of arguments",
+			commands:       []string{"LOCK.WATCH"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'LOCK.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "LOCK.WATCH a lock",
+			commands:       []string{"LOCK.ACQUIRE lw1 n1 10000", "LOCK.WATCH lw1"},
+			expected:       []interface{}{int64(1), "OK"},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueLOCKWATCH},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLPOP(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func extractValueLPOPCount(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestLPOP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Pop from Head",
+			commands:       []string{"RPUSH q a b c d", "LPOP q", "LPOP q 2", "LLEN q"},
+			expected:       []interface{}{4, "a", []string{"b", "c"}, 1},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLPOP, extractValueLPOPCount, extractValueLLEN},
+		},
+		{
+			name:           "Pop Last Element Deletes Key",
+			commands:       []string{"RPUSH q1 a", "LPOP q1", "EXISTS q1"},
+			expected:       []interface{}{1, "a", 0},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLPOP, extractValueEXISTS},
+		},
+		{
+			name:           "Pop from Non-existent Key",
+			commands:       []string{"LPOP nokey", "LPOP nokey 2"},
+			expected:       []interface{}{"", []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueLPOP, extractValueLPOPCount},
+		},
+		{
+			name:           "Pop with Invalid Count",
+			commands:       []string{"LPOP q x"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Pop on non-list Key",
+			commands:       []string{"SET k v", "LPOP k"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
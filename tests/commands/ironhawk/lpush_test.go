@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLPUSH(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestLPUSH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Push Elements to Head",
+			commands:       []string{"LPUSH q a b", "LPUSH q c", "LRANGE q 0 0", "LLEN q"},
+			expected:       []interface{}{2, 3, []string{"c"}, 3},
+			valueExtractor: []ValueExtractorFn{extractValueLPUSH, extractValueLPUSH, extractValueLRANGE, extractValueLLEN},
+		},
+		{
+			name:           "Push on non-list Key",
+			commands:       []string{"SET k v", "LPUSH k a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "Push with no Elements",
+			commands:       []string{"LPUSH q"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'LPUSH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
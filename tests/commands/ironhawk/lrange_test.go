@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLRANGE(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestLRANGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Range of List",
+			commands:       []string{"RPUSH q a b c d", "LRANGE q 0 -1", "LRANGE q 1 2", "LRANGE q -2 10", "LRANGE q 3 1"},
+			expected:       []interface{}{4, []string{"a", "b", "c", "d"}, []string{"b", "c"}, []string{"c", "d"}, []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLRANGE, extractValueLRANGE, extractValueLRANGE, extractValueLRANGE},
+		},
+		{
+			name:           "Range of Non-existent Key",
+			commands:       []string{"LRANGE nokey 0 -1"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueLRANGE},
+		},
+		{
+			name:           "Range with Invalid Index",
+			commands:       []string{"LRANGE q a 1"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLSET(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestLSET(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Set Element at Index",
+			commands:       []string{"RPUSH q a b c", "LSET q 1 x", "LSET q -1 y", "LINDEX q 1", "LINDEX q 2"},
+			expected:       []interface{}{3, "OK", "OK", "x", "y"},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLSET, extractValueLSET, extractValueLINDEX, extractValueLINDEX},
+		},
+		{
+			name:           "Set Element out of Range",
+			commands:       []string{"RPUSH q1 a", "LSET q1 1 x"},
+			expected:       []interface{}{1, errors.New("index out of range")},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, nil},
+		},
+		{
+			name:           "Set Element of Non-existent Key",
+			commands:       []string{"LSET nokey 0 x"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
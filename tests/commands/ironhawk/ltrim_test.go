@@ -0,0 +1,41 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueLTRIM(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestLTRIM(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Trim List",
+			commands:       []string{"RPUSH q a b c d", "LTRIM q 1 -2", "LRANGE q 0 -1"},
+			expected:       []interface{}{4, "OK", []string{"b", "c"}},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLTRIM, extractValueLRANGE},
+		},
+		{
+			name:           "Trim to Empty Range Deletes Key",
+			commands:       []string{"RPUSH q1 a b", "LTRIM q1 2 1", "EXISTS q1"},
+			expected:       []interface{}{2, "OK", 0},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueLTRIM, extractValueEXISTS},
+		},
+		{
+			name:           "Trim Non-existent Key",
+			commands:       []string{"LTRIM nokey 0 1"},
+			expected:       []interface{}{"OK"},
+			valueExtractor: []ValueExtractorFn{extractValueLTRIM},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueMGET(res *wire.Result) interface{} {
+	return strings.Join(res.GetKEYSRes().Keys, ",")
+}
+
+func TestMGET(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "MGET keys across shards in order",
+			commands:       []string{"MSET mg1 a mg2 b mg3 c mg4 d", "MGET mg4 mg1 mg5 mg3 mg2"},
+			expected:       []interface{}{"OK", "d,a,,c,b"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueMGET},
+		},
+		{
+			name:           "MGET a key of another type",
+			commands:       []string{"SADD mg6 a", "SET mg7 7", "MGET mg6 mg7"},
+			expected:       []interface{}{int64(1), "OK", ",7"},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueSET, extractValueMGET},
+		},
+		{
+			name:           "MGET with wrong number of arguments",
+			commands:       []string{"MGET"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'MGET' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
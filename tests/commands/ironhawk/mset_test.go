@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMSET(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "MSET keys across shards",
+			commands:       []string{"MSET ms1 a ms2 b ms3 c ms4 d", "MGET ms1 ms2 ms3 ms4"},
+			expected:       []interface{}{"OK", "a,b,c,d"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueMGET},
+		},
+		{
+			name:           "MSET replaces the values and the expiry",
+			commands:       []string{"SET ms5 old EX 100", "MSET ms5 new", "TTL ms5"},
+			expected:       []interface{}{"OK", "OK", int64(-1)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueTTL},
+		},
+		{
+			name:           "MSET with wrong number of arguments",
+			commands:       []string{"MSET ms6 a ms7"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'MSET' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMSETNX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "MSETNX sets all the keys",
+			commands:       []string{"MSETNX mn1 a mn2 b mn3 c", "MGET mn1 mn2 mn3"},
+			expected:       []interface{}{int64(1), "a,b,c"},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueMGET},
+		},
+		{
+			name:           "MSETNX sets none of the keys if one exists",
+			commands:       []string{"SET mn6 x", "MSETNX mn4 a mn5 b mn6 c", "MGET mn4 mn5 mn6"},
+			expected:       []interface{}{"OK", int64(0), ",,x"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueMGET},
+		},
+		{
+			name:           "MSETNX with wrong number of arguments",
+			commands:       []string{"MSETNX mn7"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'MSETNX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
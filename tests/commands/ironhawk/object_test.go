@@ -0,0 +1,69 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOBJECT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name: "OBJECT ENCODING",
+			commands: []string{
+				"SET obj1 v", "SET obj2 12", "SADD obj3 a", "ZADD obj4 1 a", "LPUSH obj5 a", "HSET obj6 f v", "BF.RESERVE obj7 0.01 100",
+				"OBJECT ENCODING obj1", "OBJECT ENCODING obj2", "OBJECT ENCODING obj3", "OBJECT encoding obj4",
+				"OBJECT ENCODING obj5", "OBJECT ENCODING obj6", "OBJECT ENCODING obj7",
+			},
+			expected: []interface{}{
+				"OK", "OK", int64(1), int64(1), int64(1), int64(1), "OK",
+				"raw", "int", "hashtable", "skiplist", "quicklist", "hashtable", "bf",
+			},
+			valueExtractor: []ValueExtractorFn{
+				extractValueSET, extractValueSET, extractValueSADD, extractValueZADD, extractValueLPUSH, extractValueHSET, extractValueSET,
+				extractValueGET, extractValueGET, extractValueGET, extractValueGET, extractValueGET, extractValueGET, extractValueGET,
+			},
+		},
+		{
+			name:           "OBJECT FREQ counts accesses but not OBJECT itself",
+			commands:       []string{"SET obj8 v", "OBJECT FREQ obj8", "STRLEN obj8", "OBJECT FREQ obj8", "OBJECT FREQ obj8"},
+			expected:       []interface{}{"OK", int64(5), int64(1), int64(6), int64(6)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "OBJECT IDLETIME",
+			commands:       []string{"SET obj9 v", "OBJECT IDLETIME obj9", "OBJECT IDLETIME obj9", "STRLEN obj9", "OBJECT IDLETIME obj9"},
+			expected:       []interface{}{"OK", int64(0), int64(2), int64(1), int64(0)},
+			delay:          []time.Duration{0, 0, 2 * time.Second, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:     "OBJECT on a missing key",
+			commands: []string{"OBJECT ENCODING obj10", "OBJECT FREQ obj10", "OBJECT IDLETIME obj10"},
+			expected: []interface{}{
+				errors.New("no such key"),
+				errors.New("no such key"),
+				errors.New("no such key"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil},
+		},
+		{
+			name:     "OBJECT with invalid arguments",
+			commands: []string{"SET obj11 v", "OBJECT REFCOUNT obj11", "OBJECT ENCODING", "OBJECT ENCODING obj11 obj11"},
+			expected: []interface{}{
+				"OK",
+				errors.New("invalid syntax for 'OBJECT' command"),
+				errors.New("wrong number of arguments for 'OBJECT' command"),
+				errors.New("wrong number of arguments for 'OBJECT' command"),
+			},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil, nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
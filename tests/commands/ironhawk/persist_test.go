@@ -0,0 +1,45 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPERSIST(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "PERSIST a key with an expiry",
+			commands:       []string{"SET pst1 v EX 100", "PERSIST pst1", "TTL pst1", "PERSIST pst1"},
+			expected:       []interface{}{"OK", int64(1), -1, int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueTTL, extractValueINCRBY},
+		},
+		{
+			name:           "PERSIST a key without an expiry",
+			commands:       []string{"SET pst2 v", "PERSIST pst2", "TTL pst2"},
+			expected:       []interface{}{"OK", int64(0), -1},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueTTL},
+		},
+		{
+			name:           "PERSIST a missing key",
+			commands:       []string{"PERSIST pst3"},
+			expected:       []interface{}{int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:     "PERSIST with a wrong number of arguments",
+			commands: []string{"PERSIST", "PERSIST pst4 pst5"},
+			expected: []interface{}{
+				errors.New("wrong number of arguments for 'PERSIST' command"),
+				errors.New("wrong number of arguments for 'PERSIST' command"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPEXPIRE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "PEXPIRE sets an expiry in milliseconds",
+			commands:       []string{"SET pex1 v", "PEXPIRE pex1 100500", "TTL pex1"},
+			expected:       []interface{}{"OK", true, 100},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIRE, extractValueTTL},
+		},
+		{
+			name:           "PEXPIRE deletes the key once expired",
+			commands:       []string{"SET pex2 v", "PEXPIRE pex2 200", "EXISTS pex2", "EXISTS pex2"},
+			expected:       []interface{}{"OK", true, int64(1), int64(0)},
+			delay:          []time.Duration{0, 0, 0, 500 * time.Millisecond},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIRE, extractValueEXISTS, extractValueEXISTS},
+		},
+		{
+			name:           "PEXPIRE a missing key",
+			commands:       []string{"PEXPIRE pex3 1000"},
+			expected:       []interface{}{false},
+			valueExtractor: []ValueExtractorFn{extractValueEXPIRE},
+		},
+		{
+			name: "PEXPIRE with options",
+			commands: []string{
+				"SET pex4 v", "PEXPIRE pex4 100000 XX", "PEXPIRE pex4 100000 NX", "PEXPIRE pex4 50000 GT",
+				"PEXPIRE pex4 200500 GT", "PEXPIRE pex4 300000 LT", "TTL pex4",
+			},
+			expected:       []interface{}{"OK", false, true, false, true, false, 200},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIRE, extractValueEXPIRE, extractValueEXPIRE, extractValueEXPIRE, extractValueEXPIRE, extractValueTTL},
+		},
+		{
+			name:     "PEXPIRE with invalid arguments",
+			commands: []string{"SET pex5 v", "PEXPIRE pex5 -1", "PEXPIRE pex5 abc", "PEXPIRE pex5 9223372036854775807", "PEXPIRE pex5 1000 NX XX", "PEXPIRE pex5"},
+			expected: []interface{}{
+				"OK",
+				errors.New("invalid expire time in 'PEXPIRE' command"),
+				errors.New("invalid expire time in 'PEXPIRE' command"),
+				errors.New("invalid expire time in 'PEXPIRE' command"),
+				errors.New("NX and XX, GT or LT options at the same time are not compatible"),
+				errors.New("wrong number of arguments for 'PEXPIRE' command"),
+			},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil, nil, nil, nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPEXPIREAT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	expireAtUnixMs := time.Now().UnixMilli() + 100500
+
+	testCases := []TestCase{
+		{
+			name:           "PEXPIREAT sets an expiry as a timestamp in milliseconds",
+			commands:       []string{"SET pxa1 v", "PEXPIREAT pxa1 " + strconv.FormatInt(expireAtUnixMs, 10), "PEXPIRETIME pxa1"},
+			expected:       []interface{}{"OK", true, expireAtUnixMs},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIREAT, extractValueINCRBY},
+		},
+		{
+			name:           "PEXPIREAT in the past deletes the key",
+			commands:       []string{"SET pxa2 v", "PEXPIREAT pxa2 1000", "GET pxa2"},
+			expected:       []interface{}{"OK", true, ""},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIREAT, extractValueGET},
+		},
+		{
+			name:           "PEXPIREAT a missing key",
+			commands:       []string{"PEXPIREAT pxa3 " + strconv.FormatInt(expireAtUnixMs, 10)},
+			expected:       []interface{}{false},
+			valueExtractor: []ValueExtractorFn{extractValueEXPIREAT},
+		},
+		{
+			name: "PEXPIREAT with options",
+			commands: []string{
+				"SET pxa4 v",
+				"PEXPIREAT pxa4 " + strconv.FormatInt(expireAtUnixMs, 10) + " NX",
+				"PEXPIREAT pxa4 " + strconv.FormatInt(expireAtUnixMs-1, 10) + " GT",
+				"PEXPIREAT pxa4 " + strconv.FormatInt(expireAtUnixMs-1, 10) + " LT",
+				"PEXPIRETIME pxa4",
+			},
+			expected:       []interface{}{"OK", true, false, true, expireAtUnixMs - 1},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIREAT, extractValueEXPIREAT, extractValueEXPIREAT, extractValueINCRBY},
+		},
+		{
+			name:     "PEXPIREAT with invalid arguments",
+			commands: []string{"SET pxa5 v", "PEXPIREAT pxa5 -1", "PEXPIREAT pxa5 9223372036854775807", "PEXPIREAT pxa5"},
+			expected: []interface{}{
+				"OK",
+				errors.New("invalid expire time in 'PEXPIREAT' command"),
+				errors.New("invalid expire time in 'PEXPIREAT' command"),
+				errors.New("wrong number of arguments for 'PEXPIREAT' command"),
+			},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil, nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPEXPIRETIME(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	expireAtUnixSec := time.Now().Unix() + 10
+
+	testCases := []TestCase{
+		{
+			name:           "PEXPIRETIME of a key with an expiry",
+			commands:       []string{"SET pxt1 v", "EXPIREAT pxt1 " + strconv.FormatInt(expireAtUnixSec, 10), "PEXPIRETIME pxt1"},
+			expected:       []interface{}{"OK", true, expireAtUnixSec * 1000},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIREAT, extractValueINCRBY},
+		},
+		{
+			name:           "PEXPIRETIME of a key without an expiry",
+			commands:       []string{"SET pxt2 v", "PEXPIRETIME pxt2"},
+			expected:       []interface{}{"OK", int64(-1)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY},
+		},
+		{
+			name:           "PEXPIRETIME of a missing key",
+			commands:       []string{"PEXPIRETIME pxt3"},
+			expected:       []interface{}{int64(-2)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:     "PEXPIRETIME with a wrong number of arguments",
+			commands: []string{"PEXPIRETIME", "PEXPIRETIME pxt4 pxt5"},
+			expected: []interface{}{
+				errors.New("wrong number of arguments for 'PEXPIRETIME' command"),
+				errors.New("wrong number of arguments for 'PEXPIRETIME' command"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
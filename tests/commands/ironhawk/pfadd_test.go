@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValuePFADD(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestPFADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Add Elements",
+			commands:       []string{"PFADD pfa a b c", "PFADD pfa a", "PFADD pfa d"},
+			expected:       []interface{}{1, 0, 1},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValuePFADD, extractValuePFADD},
+		},
+		{
+			name:           "Add with no Elements Creates Key",
+			commands:       []string{"PFADD pfa1", "EXISTS pfa1", "PFADD pfa1"},
+			expected:       []interface{}{1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValueEXISTS, extractValuePFADD},
+		},
+		{
+			name:           "Add to non-HyperLogLog Key",
+			commands:       []string{"SET pfa2 v", "PFADD pfa2 a"},
+			expected:       []interface{}{"OK", errors.New("WRONGTYPE Key is not a valid HyperLogLog string value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "Add with no Key",
+			commands:       []string{"PFADD"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'PFADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
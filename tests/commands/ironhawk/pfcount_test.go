@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValuePFCOUNT(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestPFCOUNT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Count Elements",
+			commands:       []string{"PFADD pfc a b c a", "PFCOUNT pfc"},
+			expected:       []interface{}{1, 3},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValuePFCOUNT},
+		},
+		{
+			name:           "Count Union of Keys",
+			commands:       []string{"PFADD pfc1 a b", "PFADD pfc2 b c d", "PFCOUNT pfc2 pfc1 nokey"},
+			expected:       []interface{}{1, 1, 4},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValuePFADD, extractValuePFCOUNT},
+		},
+		{
+			name:           "Count Non-existent Key",
+			commands:       []string{"PFCOUNT nokey"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValuePFCOUNT},
+		},
+		{
+			name:           "Count non-HyperLogLog Key",
+			commands:       []string{"SET pfc3 v", "PFCOUNT pfc3"},
+			expected:       []interface{}{"OK", errors.New("WRONGTYPE Key is not a valid HyperLogLog string value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
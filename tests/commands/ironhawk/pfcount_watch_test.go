@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValuePFCOUNTWATCH(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestPFCOUNTWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "PFCOUNT.WATCH without Key",
+			commands:       []string{"PFCOUNT.WATCH"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'PFCOUNT.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "PFCOUNT.WATCH with Key",
+			commands:       []string{"PFADD pfcw a", "PFCOUNT.WATCH pfcw"},
+			expected:       []interface{}{1, "OK"},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValuePFCOUNTWATCH},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
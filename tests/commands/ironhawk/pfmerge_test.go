@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValuePFMERGE(res *wire.Result) interface{} {
+	return res.GetMessage()
+}
+
+func TestPFMERGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Merge into New Key",
+			commands:       []string{"PFADD pfm1 a b", "PFADD pfm2 b c d", "PFMERGE pfm pfm1 pfm2 nokey", "PFCOUNT pfm"},
+			expected:       []interface{}{1, 1, "OK", 4},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValuePFADD, extractValuePFMERGE, extractValuePFCOUNT},
+		},
+		{
+			name:           "Merge into Existing Key",
+			commands:       []string{"PFADD pfm3 x", "PFMERGE pfm3 pfm1", "PFCOUNT pfm3"},
+			expected:       []interface{}{1, "OK", 3},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValuePFMERGE, extractValuePFCOUNT},
+		},
+		{
+			name:           "Merge with Expiry Kept",
+			commands:       []string{"PFADD pfm4 x", "EXPIREAT pfm4 2000000000", "PFMERGE pfm4 pfm1", "EXPIRETIME pfm4"},
+			expected:       []interface{}{1, true, "OK", 2000000000},
+			valueExtractor: []ValueExtractorFn{extractValuePFADD, extractValueEXPIREAT, extractValuePFMERGE, extractValueEXPIRETIME},
+		},
+		{
+			name:           "Merge non-HyperLogLog Key",
+			commands:       []string{"SET pfm5 v", "PFMERGE pfm6 pfm1 pfm5"},
+			expected:       []interface{}{"OK", errors.New("WRONGTYPE Key is not a valid HyperLogLog string value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "Merge with no Key",
+			commands:       []string{"PFMERGE"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'PFMERGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPSETEX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "PSETEX sets the value and the expiry",
+			commands:       []string{"PSETEX pe1 100500 v", "TTL pe1", "GET pe1"},
+			expected:       []interface{}{"OK", int64(100), "v"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTTL, extractValueGET},
+		},
+		{
+			name:           "PSETEX with an invalid expiry",
+			commands:       []string{"PSETEX pe2 0 v", "PSETEX pe2 abc v"},
+			expected:       []interface{}{errors.New("invalid expire time in 'PSETEX' command"), errors.New("invalid expire time in 'PSETEX' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "PSETEX with wrong number of arguments",
+			commands:       []string{"PSETEX pe3 100"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'PSETEX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
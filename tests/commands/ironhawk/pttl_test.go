@@ -0,0 +1,49 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPTTL(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "PTTL of a key without an expiry",
+			commands:       []string{"SET ptl1 v", "PTTL ptl1"},
+			expected:       []interface{}{"OK", int64(-1)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY},
+		},
+		{
+			name:           "PTTL of a missing key",
+			commands:       []string{"PTTL ptl2"},
+			expected:       []interface{}{int64(-2)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:     "PTTL with a wrong number of arguments",
+			commands: []string{"PTTL", "PTTL ptl3 ptl4"},
+			expected: []interface{}{
+				errors.New("wrong number of arguments for 'PTTL' command"),
+				errors.New("wrong number of arguments for 'PTTL' command"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+
+	t.Run("PTTL of a key with an expiry", func(t *testing.T) {
+		client.Fire(&wire.Command{Cmd: "PSETEX", Args: []string{"ptl5", "5000", "v"}})
+		res := client.Fire(&wire.Command{Cmd: "PTTL", Args: []string{"ptl5"}})
+		assert.Equal(t, wire.Status_OK, res.Status)
+		assert.InDelta(t, 5000, res.GetINCRBYRes().Value, 100)
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRANDOMKEY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "RANDOMKEY of an empty database",
+			commands:       []string{"RANDOMKEY"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueGET},
+		},
+		{
+			name:           "RANDOMKEY skips expired keys",
+			commands:       []string{"SET rk1 v", "PSETEX rk2 100 v", "RANDOMKEY", "RANDOMKEY", "RANDOMKEY"},
+			expected:       []interface{}{"OK", "OK", "rk1", "rk1", "rk1"},
+			delay:          []time.Duration{0, 0, 300 * time.Millisecond, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueGET, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "RANDOMKEY with arguments",
+			commands:       []string{"RANDOMKEY rk1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'RANDOMKEY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+
+	t.Run("RANDOMKEY returns every key", func(t *testing.T) {
+		client.Fire(&wire.Command{Cmd: "FLUSHDB"})
+		keys := []string{"rk3", "rk4", "rk5", "rk6"}
+		client.Fire(&wire.Command{Cmd: "MSET", Args: []string{"rk3", "v", "rk4", "v", "rk5", "v", "rk6", "v"}})
+
+		seen := map[string]bool{}
+		for i := 0; i < 200; i++ {
+			res := client.Fire(&wire.Command{Cmd: "RANDOMKEY"})
+			seen[res.GetGETRes().Value] = true
+		}
+		assert.Len(t, seen, len(keys))
+		for _, k := range keys {
+			assert.True(t, seen[k], k)
+		}
+	})
+}
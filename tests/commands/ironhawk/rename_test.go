@@ -0,0 +1,75 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestRENAME(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "RENAME a string",
+			commands:       []string{"SET rn1 v", "RENAME rn1 rn2", "EXISTS rn1", "GET rn2"},
+			expected:       []interface{}{"OK", "OK", int64(0), "v"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueEXISTS, extractValueGET},
+		},
+		{
+			name:           "RENAME keeps the expiry",
+			commands:       []string{"SET rn3 v PX 100500", "RENAME rn3 rn4", "TTL rn4"},
+			expected:       []interface{}{"OK", "OK", int64(100)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueTTL},
+		},
+		{
+			name:           "RENAME replaces the destination",
+			commands:       []string{"SET rn5 a", "SET rn6 b EX 100", "RENAME rn5 rn6", "TTL rn6", "GET rn6"},
+			expected:       []interface{}{"OK", "OK", "OK", int64(-1), "a"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueTTL, extractValueGET},
+		},
+		{
+			name:           "RENAME a list across shards",
+			commands:       []string{"RPUSH rn7 a b c", "RENAME rn7 rn8", "LRANGE rn8 0 -1"},
+			expected:       []interface{}{int64(3), "OK", []string{"a", "b", "c"}},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueSET, extractValueLRANGE},
+		},
+		{
+			name:           "RENAME a hash",
+			commands:       []string{"HSET rn9 f v", "RENAME rn9 rn10", "HGET rn10 f"},
+			expected:       []interface{}{int64(1), "OK", "v"},
+			valueExtractor: []ValueExtractorFn{extractValueHSET, extractValueSET, extractValueHGET},
+		},
+		{
+			// rnv:a and rnv:b0 live on the same shard for up to 16 shards.
+			name:           "RENAME a vector within a shard",
+			commands:       []string{"VINDEX.CREATE rnidx DIM 2 METRIC l2", "VADD rnv:a rnidx 0 0", "RENAME rnv:a rnv:b0", "VSEARCH rnidx 1 0 0"},
+			expected:       []interface{}{"OK", "OK", "OK", []*wire.HElement{{Key: "rnv:b0", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueVSEARCH},
+		},
+		{
+			name:           "RENAME a missing key",
+			commands:       []string{"RENAME rn11 rn12"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "RENAME a key to itself",
+			commands:       []string{"SET rn13 v", "RENAME rn13 rn13", "GET rn13"},
+			expected:       []interface{}{"OK", "OK", "v"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueGET},
+		},
+		{
+			name:           "RENAME with wrong number of arguments",
+			commands:       []string{"RENAME rn14"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'RENAME' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
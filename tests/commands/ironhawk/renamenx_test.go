@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRENAMENX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "RENAMENX to a missing key",
+			commands:       []string{"SET rx1 v", "RENAMENX rx1 rx2", "GET rx2"},
+			expected:       []interface{}{"OK", int64(1), "v"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "RENAMENX to an existing key",
+			commands:       []string{"SET rx3 a", "SET rx4 b", "RENAMENX rx3 rx4", "GET rx4"},
+			expected:       []interface{}{"OK", "OK", int64(0), "b"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "RENAMENX a missing key",
+			commands:       []string{"RENAMENX rx5 rx6"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "RENAMENX with wrong number of arguments",
+			commands:       []string{"RENAMENX rx7"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'RENAMENX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRESTORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	// The payload of the string v1, as dumped by "SET k v1" and "DUMP k".
+	const payload = "AQAAAAACdjGuM1L7/x8kYw=="
+
+	testCases := []TestCase{
+		{
+			name:           "RESTORE a string",
+			commands:       []string{"RESTORE rst1 0 " + payload, "TTL rst1", "GET rst1"},
+			expected:       []interface{}{"OK", -1, "v1"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTTL, extractValueGET},
+		},
+		{
+			name:           "RESTORE with a TTL",
+			commands:       []string{"RESTORE rst2 100500 " + payload, "TTL rst2", "GET rst2"},
+			expected:       []interface{}{"OK", 100, "v1"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTTL, extractValueGET},
+		},
+		{
+			name:           "RESTORE with an absolute TTL in the past does not create the key",
+			commands:       []string{"RESTORE rst3 1 " + payload + " ABSTTL", "GET rst3"},
+			expected:       []interface{}{"OK", ""},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueGET},
+		},
+		{
+			name:           "RESTORE an existing key",
+			commands:       []string{"SET rst4 v", "RESTORE rst4 0 " + payload, "RESTORE rst4 0 " + payload + " REPLACE IDLETIME 10", "GET rst4"},
+			expected:       []interface{}{"OK", errors.New("target key name already exists"), "OK", "v1"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil, extractValueSET, extractValueGET},
+		},
+		{
+			name: "RESTORE a damaged payload",
+			commands: []string{
+				"RESTORE rst5 0 AQAAAAACdkGuM1L7/x8kYw==",
+				"RESTORE rst5 0 AgAAAAACdjGuM1L7/x8kYw==",
+				"RESTORE rst5 0 AQAAAA==",
+				"RESTORE rst5 0 !!!",
+			},
+			expected: []interface{}{
+				errors.New("DUMP payload version or checksum are wrong"),
+				errors.New("DUMP payload version or checksum are wrong"),
+				errors.New("DUMP payload version or checksum are wrong"),
+				errors.New("DUMP payload version or checksum are wrong"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil, nil},
+		},
+		{
+			name: "RESTORE with invalid options",
+			commands: []string{
+				"RESTORE rst6 abc " + payload,
+				"RESTORE rst6 -1 " + payload,
+				"RESTORE rst6 0 " + payload + " IDLETIME -1",
+				"RESTORE rst6 0 " + payload + " IDLETIME",
+				"RESTORE rst6 0 " + payload + " FOO",
+			},
+			expected: []interface{}{
+				errors.New("value is not an integer or out of range"),
+				errors.New("invalid TTL value, must be >= 0"),
+				errors.New("invalid IDLETIME value, must be >= 0"),
+				errors.New("invalid syntax for 'RESTORE' command"),
+				errors.New("invalid syntax for 'RESTORE' command"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil, nil, nil},
+		},
+		{
+			name:           "RESTORE a vector without its index",
+			commands:       []string{"RESTORE rst7 0 ARAAAAAFbm9pZHgAAAAAAAAAAj+AAABAAAAAAAAAAAAAAADDmZc3DWSlKQ=="},
+			expected:       []interface{}{errors.New("no such vector index 'noidx'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			// Headers announcing more items than the payload holds, or a
+			// number of items whose size overflows.
+			name: "RESTORE a payload with oversized dimensions",
+			commands: []string{
+				"RESTORE rst9 0 AQkAAAAAAAAD6AAAAAAAAAPoAAAAAAAAAACD+jjkcaxiRw==",
+				"RESTORE rst9 0 AQlAAAAAAAAAAAAAAAAAAAAEAAAAAAAAAAC1IoDd7z+dNA==",
+				"RESTORE rst9 0 AREAAAAAAAAACgAAAAAAAAPoAAAAAAAAA+gAAAAAAAAAAAAAAAAAAAAAz7yhEd4P7uM=",
+				"RESTORE rst9 0 AREAAAAAAAAACkAAAAAAAAAAAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAA89J5FD+y6kM=",
+				"RESTORE rst9 0 ARIAAAAAAAAEAAAAAAAAAAACAAAAAAAAABQAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAABAAAAAAAQAACqcov1A5SNvQ==",
+				"RESTORE rst9 0 ARIAAAAAAAAEAEAAAAAAAAAAAAAAAAAAABQAAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAQAAAAAAAAABAAAAAAAAAAQVMcdjpz9r4g==",
+				"RESTORE rst9 0 ART/////dYNjF2aF8rA=",
+				"EXISTS rst9",
+			},
+			expected: []interface{}{
+				errors.New("bad data format"),
+				errors.New("bad data format"),
+				errors.New("bad data format"),
+				errors.New("bad data format"),
+				errors.New("bad data format"),
+				errors.New("bad data format"),
+				errors.New("bad data format"),
+				int64(0),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil, nil, nil, nil, nil, extractValueEXISTS},
+		},
+		{
+			name:           "RESTORE with wrong number of arguments",
+			commands:       []string{"RESTORE rst8 0"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'RESTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueRPOP(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func extractValueRPOPCount(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestRPOP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Pop from Tail",
+			commands:       []string{"RPUSH q a b c d", "RPOP q", "RPOP q 2", "LINDEX q 0"},
+			expected:       []interface{}{4, "d", []string{"c", "b"}, "a"},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueRPOP, extractValueRPOPCount, extractValueLINDEX},
+		},
+		{
+			name:           "Pop from Non-existent Key",
+			commands:       []string{"RPOP nokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueRPOP},
+		},
+		{
+			name:           "Pop with Wrong Number of Arguments",
+			commands:       []string{"RPOP q 1 2"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'RPOP' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueRPUSH(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestRPUSH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Push Elements to Tail",
+			commands:       []string{"RPUSH q a b", "RPUSH q c", "LINDEX q -1", "LLEN q"},
+			expected:       []interface{}{2, 3, "c", 3},
+			valueExtractor: []ValueExtractorFn{extractValueRPUSH, extractValueRPUSH, extractValueLINDEX, extractValueLLEN},
+		},
+		{
+			name:           "Push on non-list Key",
+			commands:       []string{"SET k v", "RPUSH k a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "Push with no Elements",
+			commands:       []string{"RPUSH q"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'RPUSH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
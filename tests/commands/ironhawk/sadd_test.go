@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSADD(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestSADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Add Members",
+			commands:       []string{"SADD s a b", "SADD s b c", "SMEMBERS s"},
+			expected:       []interface{}{2, 1, []string{"a", "b", "c"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSADD, extractValueSMEMBERS},
+		},
+		{
+			name:           "Add on non-set Key",
+			commands:       []string{"SET k v", "SADD k a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "Add with no Members",
+			commands:       []string{"SADD s"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
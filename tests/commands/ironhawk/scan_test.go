@@ -0,0 +1,98 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func extractValueSCAN(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestSCAN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "SCAN returns every key when COUNT covers them",
+			commands:       []string{"SET scn1 v", "SET scn2 v", "SET scn3 v", "SCAN 0 COUNT 100"},
+			expected:       []interface{}{"OK", "OK", "OK", []string{"0", "scn1", "scn2", "scn3"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSCAN},
+		},
+		{
+			name:           "SCAN with MATCH",
+			commands:       []string{"SET scm1 v", "SET scx1 v", "SCAN 0 MATCH scm* COUNT 100"},
+			expected:       []interface{}{"OK", "OK", []string{"0", "scm1"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSCAN},
+		},
+		{
+			name:           "SCAN with TYPE",
+			commands:       []string{"SET sct1 1", "HSET sct2 f v", "SCAN 0 MATCH sct* TYPE ssmap COUNT 100"},
+			expected:       []interface{}{"OK", 1, []string{"0", "sct2"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueHSET, extractValueSCAN},
+		},
+		{
+			name:     "SCAN with invalid options",
+			commands: []string{"SCAN abc", "SCAN -1", "SCAN 0 COUNT 0", "SCAN 0 FOO bar", "SCAN 0 MATCH", "SCAN 0 MATCH [", "SCAN 99999999999999999"},
+			expected: []interface{}{
+				errors.New("value is not an integer or out of range"),
+				errors.New("value is not an integer or out of range"),
+				errors.New("value is not an integer or out of range"),
+				errors.New("invalid syntax for 'SCAN' command"),
+				errors.New("invalid syntax for 'SCAN' command"),
+				errors.New("invalid glob pattern"),
+				errors.New("invalid cursor"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil, nil, nil, nil, nil},
+		},
+		{
+			name:           "SCAN with wrong number of arguments",
+			commands:       []string{"SCAN"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SCAN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
+
+func TestSCANReturnsKeysPresentForTheWholeIteration(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+	fire := func(cmd string, args ...string) *wire.Result {
+		return client.Fire(&wire.Command{Cmd: cmd, Args: args})
+	}
+
+	fire("FLUSHDB")
+	for i := 0; i < 200; i++ {
+		fire("SET", "scs"+strconv.Itoa(i), "v")
+	}
+
+	// Half of the keys are deleted and as many are added while the keys are
+	// walked 7 at a time, so every key kept must be returned.
+	seen := map[string]bool{}
+	cursor := "0"
+	for i := 0; ; i++ {
+		keys := fire("SCAN", cursor, "COUNT", "7").GetKEYSRes().Keys
+		for _, k := range keys[1:] {
+			seen[k] = true
+		}
+		if cursor = keys[0]; cursor == "0" {
+			break
+		}
+		if i < 100 {
+			fire("DEL", "scs"+strconv.Itoa(2*i+1))
+			fire("SET", "scn"+strconv.Itoa(i), "v")
+		}
+	}
+	for i := 0; i < 200; i += 2 {
+		assert.True(t, seen["scs"+strconv.Itoa(i)], "key scs%d was not returned", i)
+	}
+}
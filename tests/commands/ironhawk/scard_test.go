@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSCARD(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestSCARD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Cardinality of Set",
+			commands:       []string{"SADD s a b c", "SCARD s"},
+			expected:       []interface{}{3, 3},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSCARD},
+		},
+		{
+			name:           "Cardinality of Non-existent Key",
+			commands:       []string{"SCARD nokey"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueSCARD},
+		},
+		{
+			name:           "Cardinality with Wrong Number of Arguments",
+			commands:       []string{"SCARD s x"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SCARD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSDIFF(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestSDIFF(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Difference of Sets",
+			commands:       []string{"SADD s1 a b c d", "SADD s2 c", "SADD s3 d e", "SDIFF s1 s2 s3", "SDIFF s1", "SDIFF s2 s1"},
+			expected:       []interface{}{4, 1, 2, []string{"a", "b"}, []string{"a", "b", "c", "d"}, []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSADD, extractValueSADD, extractValueSDIFF, extractValueSDIFF, extractValueSDIFF},
+		},
+		{
+			name:           "Difference of Non-existent Key",
+			commands:       []string{"SDIFF nokey s1"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueSDIFF},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
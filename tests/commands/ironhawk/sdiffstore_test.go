@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSDIFFSTORE(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestSDIFFSTORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Store Result in Destination",
+			commands:       []string{"SADD s1 a b c", "SADD s2 b c d", "SET dst v", "SDIFFSTORE dst s1 s2", "SMEMBERS dst"},
+			expected:       []interface{}{3, 3, "OK", 1, []string{"a"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSADD, extractValueSET, extractValueSDIFFSTORE, extractValueSMEMBERS},
+		},
+		{
+			name:           "Store Empty Result Deletes Destination",
+			commands:       []string{"SADD dst1 a", "SDIFFSTORE dst1 nokey", "EXISTS dst1"},
+			expected:       []interface{}{1, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSDIFFSTORE, extractValueEXISTS},
+		},
+		{
+			name:           "Store with no Keys",
+			commands:       []string{"SDIFFSTORE dst"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SDIFFSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSETBIT(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestSETBIT(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "set and clear a bit",
+			commands:       []string{"SETBIT sb1 7 1", "SETBIT sb1 7 1", "SETBIT sb1 7 0", "GETBIT sb1 7"},
+			expected:       []interface{}{0, 1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueSETBIT, extractValueSETBIT, extractValueGETBIT},
+		},
+		{
+			name:           "value is readable as a string",
+			commands:       []string{"SETBIT sb2 1 1", "GET sb2"},
+			expected:       []interface{}{0, "@"},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueGET},
+		},
+		{
+			name:           "grow the value with zero bytes",
+			commands:       []string{"SETBIT sb3 1 1", "SETBIT sb3 23 1", "BITCOUNT sb3", "BITPOS sb3 0 1 -1"},
+			expected:       []interface{}{0, 0, 2, 8},
+			valueExtractor: []ValueExtractorFn{extractValueSETBIT, extractValueSETBIT, extractValueBITCOUNT, extractValueBITPOS},
+		},
+		{
+			name:           "modify a string value",
+			commands:       []string{"SET sb4 a", "SETBIT sb4 6 1", "GET sb4"},
+			expected:       []interface{}{"OK", 0, "c"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSETBIT, extractValueGET},
+		},
+		{
+			name:           "keep the expiry of the key",
+			commands:       []string{"SET sb5 a", "EXPIREAT sb5 2000000000", "SETBIT sb5 6 1", "EXPIRETIME sb5"},
+			expected:       []interface{}{"OK", true, 0, 2000000000},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueEXPIREAT, extractValueSETBIT, extractValueEXPIRETIME},
+		},
+		{
+			name:           "invalid offset",
+			commands:       []string{"SETBIT sb6 -1 1", "SETBIT sb6 4294967296 1"},
+			expected:       []interface{}{errors.New("bit offset is not an integer or out of range"), errors.New("bit offset is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "invalid bit",
+			commands:       []string{"SETBIT sb7 1 2"},
+			expected:       []interface{}{errors.New("bit is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "wrong type",
+			commands:       []string{"SADD sb8 a", "SETBIT sb8 1 1"},
+			expected:       []interface{}{1, errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, nil},
+		},
+		{
+			name:           "wrong number of arguments",
+			commands:       []string{"SETBIT sb9 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SETBIT' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSETNX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "SETNX a missing key",
+			commands:       []string{"SETNX sn1 v1", "SETNX sn1 v2", "GET sn1"},
+			expected:       []interface{}{int64(1), int64(0), "v1"},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "SETNX with wrong number of arguments",
+			commands:       []string{"SETNX sn2"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SETNX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSETRANGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "SETRANGE overwrites a string",
+			commands:       []string{"SET sr1 helloworld", "SETRANGE sr1 5 there", "GET sr1"},
+			expected:       []interface{}{"OK", int64(10), "hellothere"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "SETRANGE extends a string",
+			commands:       []string{"SET sr2 hello", "SETRANGE sr2 3 world", "GET sr2"},
+			expected:       []interface{}{"OK", int64(8), "helworld"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueGET},
+		},
+		{
+			name:           "SETRANGE pads a missing key",
+			commands:       []string{"SETRANGE sr3 2 ab", "STRLEN sr3"},
+			expected:       []interface{}{int64(4), int64(4)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "SETRANGE with an empty value",
+			commands:       []string{"SETRANGE sr4 5 ", "EXISTS sr4"},
+			expected:       []interface{}{int64(0), int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueEXISTS},
+		},
+		{
+			name:           "SETRANGE with a negative offset",
+			commands:       []string{"SETRANGE sr5 -1 a"},
+			expected:       []interface{}{errors.New("offset is out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "SETRANGE with an offset past the maximum string size",
+			commands:       []string{"SETRANGE sr8 9223372036854775807 x", "EXISTS sr8"},
+			expected:       []interface{}{errors.New("string exceeds maximum allowed size (512MB)"), int64(0)},
+			valueExtractor: []ValueExtractorFn{nil, extractValueEXISTS},
+		},
+		{
+			name:           "SETRANGE on a key of another type",
+			commands:       []string{"SADD sr6 a", "SETRANGE sr6 0 b"},
+			expected:       []interface{}{int64(1), errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "SETRANGE with wrong number of arguments",
+			commands:       []string{"SETRANGE sr7 0"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SETRANGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSINTER(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestSINTER(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Intersection of Sets",
+			commands:       []string{"SADD s1 a b c", "SADD s2 b c d", "SADD s3 c d", "SINTER s1 s2", "SINTER s1 s2 s3"},
+			expected:       []interface{}{3, 3, 2, []string{"b", "c"}, []string{"c"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSADD, extractValueSADD, extractValueSINTER, extractValueSINTER},
+		},
+		{
+			name:           "Intersection with Non-existent Key",
+			commands:       []string{"SADD s4 a", "SINTER s4 nokey"},
+			expected:       []interface{}{1, []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSINTER},
+		},
+		{
+			name:           "Intersection with non-set Key",
+			commands:       []string{"SET k v", "SINTER s1 k"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSINTERSTORE(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestSINTERSTORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Store Result in Destination",
+			commands:       []string{"SADD s1 a b c", "SADD s2 b c d", "SET dst v", "SINTERSTORE dst s1 s2", "SMEMBERS dst"},
+			expected:       []interface{}{3, 3, "OK", 2, []string{"b", "c"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSADD, extractValueSET, extractValueSINTERSTORE, extractValueSMEMBERS},
+		},
+		{
+			name:           "Store Empty Result Deletes Destination",
+			commands:       []string{"SADD dst1 a", "SINTERSTORE dst1 nokey", "EXISTS dst1"},
+			expected:       []interface{}{1, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSINTERSTORE, extractValueEXISTS},
+		},
+		{
+			name:           "Store with no Keys",
+			commands:       []string{"SINTERSTORE dst"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SINTERSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSISMEMBER(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestSISMEMBER(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Membership of Member",
+			commands:       []string{"SADD s a", "SISMEMBER s a", "SISMEMBER s b"},
+			expected:       []interface{}{1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSISMEMBER, extractValueSISMEMBER},
+		},
+		{
+			name:           "Membership on Non-existent Key",
+			commands:       []string{"SISMEMBER nokey a"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueSISMEMBER},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSMEMBERS(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestSMEMBERS(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Members of Set",
+			commands:       []string{"SADD s b a", "SMEMBERS s"},
+			expected:       []interface{}{2, []string{"a", "b"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSMEMBERS},
+		},
+		{
+			name:           "Members of Non-existent Key",
+			commands:       []string{"SMEMBERS nokey"},
+			expected:       []interface{}{[]string{}},
+			valueExtractor: []ValueExtractorFn{extractValueSMEMBERS},
+		},
+		{
+			name:           "Members of non-set Key",
+			commands:       []string{"SET k v", "SMEMBERS k"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
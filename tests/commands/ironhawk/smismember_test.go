@@ -0,0 +1,33 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSMISMEMBER(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestSMISMEMBER(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:     "Membership of Members",
+			commands: []string{"SADD s a c", "SMISMEMBER s a b c"},
+			expected: []interface{}{2, []*wire.HElement{
+				{Key: "a", Value: "1"},
+				{Key: "b", Value: "0"},
+				{Key: "c", Value: "1"},
+			}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSMISMEMBER},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
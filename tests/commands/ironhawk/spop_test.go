@@ -0,0 +1,52 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSPOP(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func extractValueSPOPCount(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestSPOP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Pop Member",
+			commands:       []string{"SADD s a", "SPOP s", "EXISTS s"},
+			expected:       []interface{}{1, "a", 0},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSPOP, extractValueEXISTS},
+		},
+		{
+			name:           "Pop Members with Count",
+			commands:       []string{"SADD s1 a b c", "SPOP s1 5", "SCARD s1"},
+			expected:       []interface{}{3, []string{"a", "b", "c"}, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSPOPCount, extractValueSCARD},
+		},
+		{
+			name:           "Pop from Non-existent Key",
+			commands:       []string{"SPOP nokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueSPOP},
+		},
+		{
+			name:           "Pop with Invalid Count",
+			commands:       []string{"SPOP s x"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
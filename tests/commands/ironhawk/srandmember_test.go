@@ -0,0 +1,45 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSRANDMEMBER(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func extractValueSRANDMEMBERCount(res *wire.Result) interface{} {
+	return int64(len(res.GetKEYSRes().Keys))
+}
+
+func TestSRANDMEMBER(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Random Member",
+			commands:       []string{"SADD s a", "SRANDMEMBER s", "SCARD s"},
+			expected:       []interface{}{1, "a", 1},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSRANDMEMBER, extractValueSCARD},
+		},
+		{
+			name:           "Random Members with Count",
+			commands:       []string{"SADD s1 a b c", "SRANDMEMBER s1 2", "SRANDMEMBER s1 5", "SRANDMEMBER s1 -5"},
+			expected:       []interface{}{3, 2, 3, 5},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSRANDMEMBERCount, extractValueSRANDMEMBERCount, extractValueSRANDMEMBERCount},
+		},
+		{
+			name:           "Random Member of Non-existent Key",
+			commands:       []string{"SRANDMEMBER nokey"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueSRANDMEMBER},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSREM(res *wire.Result) interface{} {
+	return res.GetINCRBYRes().Value
+}
+
+func TestSREM(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Remove Members",
+			commands:       []string{"SADD s a b c", "SREM s a x", "SMEMBERS s"},
+			expected:       []interface{}{3, 1, []string{"b", "c"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSREM, extractValueSMEMBERS},
+		},
+		{
+			name:           "Remove Last Member Deletes Key",
+			commands:       []string{"SADD s1 a", "SREM s1 a", "EXISTS s1"},
+			expected:       []interface{}{1, 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSREM, extractValueEXISTS},
+		},
+		{
+			name:           "Remove from Non-existent Key",
+			commands:       []string{"SREM nokey a"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueSREM},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSTRLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "STRLEN of a string",
+			commands:       []string{"SET sl1 hello", "STRLEN sl1"},
+			expected:       []interface{}{"OK", int64(5)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY},
+		},
+		{
+			name:           "STRLEN of an integer",
+			commands:       []string{"SET sl2 -123", "STRLEN sl2"},
+			expected:       []interface{}{"OK", int64(4)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY},
+		},
+		{
+			name:           "STRLEN of a missing key",
+			commands:       []string{"STRLEN sl3"},
+			expected:       []interface{}{int64(0)},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:           "STRLEN on a key of another type",
+			commands:       []string{"SADD sl4 a", "STRLEN sl4"},
+			expected:       []interface{}{int64(1), errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "STRLEN with wrong number of arguments",
+			commands:       []string{"STRLEN"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'STRLEN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueSUNION(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestSUNION(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "Union of Sets",
+			commands:       []string{"SADD s1 a b", "SADD s2 b c", "SUNION s1 s2 nokey"},
+			expected:       []interface{}{2, 2, []string{"a", "b", "c"}},
+			valueExtractor: []ValueExtractorFn{extractValueSADD, extractValueSADD, extractValueSUNION},
+		},
+		{
+			name:           "Union with no Keys",
+			commands:       []string{"SUNION"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'SUNION' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
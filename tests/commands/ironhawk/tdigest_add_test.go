@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTADD(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestTDIGESTADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.ADD values",
+			commands:       []string{"TDIGEST.CREATE tda1", "TDIGEST.ADD tda1 12.5 8 31 9.25", "TDIGEST.MIN tda1", "TDIGEST.MAX tda1"},
+			expected:       []interface{}{"OK", "OK", "8", "31"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTMIN, extractValueTDIGESTMAX},
+		},
+		{
+			name:           "TDIGEST.ADD an invalid value adds none",
+			commands:       []string{"TDIGEST.CREATE tda2", "TDIGEST.ADD tda2 1 x", "TDIGEST.ADD tda2 inf", "TDIGEST.MAX tda2"},
+			expected:       []interface{}{"OK", errors.New("value is not an integer or a float"), errors.New("value is not an integer or a float"), "nan"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, nil, nil, extractValueTDIGESTMAX},
+		},
+		{
+			name:           "TDIGEST.ADD to a missing key",
+			commands:       []string{"TDIGEST.ADD tda3 1"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.ADD to a key of another type",
+			commands:       []string{"SET tda4 v", "TDIGEST.ADD tda4 1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTADD, nil},
+		},
+		{
+			name:           "TDIGEST.ADD with wrong number of arguments",
+			commands:       []string{"TDIGEST.ADD tda5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.ADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTCDF(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTDIGESTCDF(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.CDF of exact values",
+			commands:       []string{"TDIGEST.CREATE tdf1", "TDIGEST.ADD tdf1 1 2 3 4 5 6 7 8 9 10", "TDIGEST.CDF tdf1 0 5 20"},
+			expected:       []interface{}{"OK", "OK", []*wire.HElement{{Key: "0", Value: "0"}, {Key: "5", Value: "0.45"}, {Key: "20", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTCDF},
+		},
+		{
+			name:           "TDIGEST.CDF of an empty digest",
+			commands:       []string{"TDIGEST.CREATE tdf2", "TDIGEST.CDF tdf2 1"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "1", Value: "nan"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTCDF},
+		},
+		{
+			name:           "TDIGEST.CDF of a missing key",
+			commands:       []string{"TDIGEST.CDF tdf3 1"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.CDF with wrong number of arguments",
+			commands:       []string{"TDIGEST.CDF tdf4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.CDF' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTCREATE(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestTDIGESTCREATE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.CREATE with the default compression",
+			commands:       []string{"TDIGEST.CREATE tdc1", "TDIGEST.MIN tdc1"},
+			expected:       []interface{}{"OK", "nan"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTMIN},
+		},
+		{
+			name:           "TDIGEST.CREATE with a compression",
+			commands:       []string{"TDIGEST.CREATE tdc2 COMPRESSION 200"},
+			expected:       []interface{}{"OK"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE},
+		},
+		{
+			name:           "TDIGEST.CREATE an existing key",
+			commands:       []string{"TDIGEST.CREATE tdc3", "TDIGEST.CREATE tdc3"},
+			expected:       []interface{}{"OK", errors.New("key exists")},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, nil},
+		},
+		{
+			name:           "TDIGEST.CREATE with an invalid compression",
+			commands:       []string{"TDIGEST.CREATE tdc4 COMPRESSION 0"},
+			expected:       []interface{}{errors.New("compression must be an integer between 1 and 10000")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.CREATE with an unknown option",
+			commands:       []string{"TDIGEST.CREATE tdc5 SIZE 100"},
+			expected:       []interface{}{errors.New("invalid syntax for 'TDIGEST.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.CREATE with wrong number of arguments",
+			commands:       []string{"TDIGEST.CREATE tdc6 COMPRESSION"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTMAX(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestTDIGESTMAX(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.MAX of values",
+			commands:       []string{"TDIGEST.CREATE tdx1", "TDIGEST.ADD tdx1 1 2 3 4 5 6 7 8 9 10", "TDIGEST.MAX tdx1"},
+			expected:       []interface{}{"OK", "OK", "10"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTMAX},
+		},
+		{
+			name:           "TDIGEST.MAX of an empty digest",
+			commands:       []string{"TDIGEST.CREATE tdx2", "TDIGEST.MAX tdx2"},
+			expected:       []interface{}{"OK", "nan"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTMAX},
+		},
+		{
+			name:           "TDIGEST.MAX of a missing key",
+			commands:       []string{"TDIGEST.MAX tdx3"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.MAX with wrong number of arguments",
+			commands:       []string{"TDIGEST.MAX tdx4 extra"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.MAX' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
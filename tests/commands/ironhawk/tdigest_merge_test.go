@@ -0,0 +1,78 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTMERGE(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestTDIGESTMERGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.MERGE into a new key",
+			commands:       []string{"TDIGEST.CREATE tdm1a", "TDIGEST.CREATE tdm1b", "TDIGEST.ADD tdm1a 1 2 3 4 5", "TDIGEST.ADD tdm1b 6 7 8 9 10", "TDIGEST.MERGE tdm1 2 tdm1a tdm1b", "TDIGEST.QUANTILE tdm1 0 0.5 1"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", []*wire.HElement{{Key: "0", Value: "1"}, {Key: "0.5", Value: "6"}, {Key: "1", Value: "10"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTADD, extractValueTDIGESTMERGE, extractValueTDIGESTQUANTILE},
+		},
+		{
+			name:           "TDIGEST.MERGE into an existing key",
+			commands:       []string{"TDIGEST.CREATE tdm2a", "TDIGEST.CREATE tdm2", "TDIGEST.ADD tdm2a 1 2", "TDIGEST.ADD tdm2 100", "TDIGEST.MERGE tdm2 1 tdm2a", "TDIGEST.CDF tdm2 50"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", []*wire.HElement{{Key: "50", Value: "0.6666666666666666"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTADD, extractValueTDIGESTMERGE, extractValueTDIGESTCDF},
+		},
+		{
+			name:           "TDIGEST.MERGE with OVERRIDE",
+			commands:       []string{"TDIGEST.CREATE tdm3a", "TDIGEST.CREATE tdm3", "TDIGEST.ADD tdm3a 1 2", "TDIGEST.ADD tdm3 100", "TDIGEST.MERGE tdm3 1 tdm3a COMPRESSION 50 OVERRIDE", "TDIGEST.MAX tdm3"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", "2"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTADD, extractValueTDIGESTMERGE, extractValueTDIGESTMAX},
+		},
+		{
+			name:           "TDIGEST.MERGE a missing source",
+			commands:       []string{"TDIGEST.CREATE tdm4a", "TDIGEST.MERGE tdm4 2 tdm4a tdm4b"},
+			expected:       []interface{}{"OK", errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, nil},
+		},
+		{
+			name:           "TDIGEST.MERGE into a key of another type",
+			commands:       []string{"TDIGEST.CREATE tdm5a", "SET tdm5 v", "TDIGEST.MERGE tdm5 1 tdm5a"},
+			expected:       []interface{}{"OK", "OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTMERGE, nil},
+		},
+		{
+			name:           "TDIGEST.MERGE with an invalid numkeys",
+			commands:       []string{"TDIGEST.MERGE tdm6 0 tdm6a"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.MERGE with numkeys near the integer limit",
+			commands:       []string{"TDIGEST.MERGE tdm9 9223372036854775807 tdm9a"},
+			expected:       []interface{}{errors.New("invalid syntax for 'TDIGEST.MERGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.MERGE with an unknown option",
+			commands:       []string{"TDIGEST.CREATE tdm7a", "TDIGEST.MERGE tdm7 1 tdm7a REPLACE"},
+			expected:       []interface{}{"OK", errors.New("invalid syntax for 'TDIGEST.MERGE' command")},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, nil},
+		},
+		{
+			name:           "TDIGEST.MERGE with wrong number of arguments",
+			commands:       []string{"TDIGEST.MERGE tdm8 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.MERGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
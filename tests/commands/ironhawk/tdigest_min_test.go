@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTMIN(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestTDIGESTMIN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.MIN of values",
+			commands:       []string{"TDIGEST.CREATE tdn1", "TDIGEST.ADD tdn1 1 2 3 4 5 6 7 8 9 10", "TDIGEST.MIN tdn1"},
+			expected:       []interface{}{"OK", "OK", "1"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTMIN},
+		},
+		{
+			name:           "TDIGEST.MIN of an empty digest",
+			commands:       []string{"TDIGEST.CREATE tdn2", "TDIGEST.MIN tdn2"},
+			expected:       []interface{}{"OK", "nan"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTMIN},
+		},
+		{
+			name:           "TDIGEST.MIN of a missing key",
+			commands:       []string{"TDIGEST.MIN tdn3"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.MIN with wrong number of arguments",
+			commands:       []string{"TDIGEST.MIN tdn4 extra"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.MIN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
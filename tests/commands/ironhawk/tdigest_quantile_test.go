@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTQUANTILE(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTDIGESTQUANTILE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.QUANTILE of exact values",
+			commands:       []string{"TDIGEST.CREATE tdq1", "TDIGEST.ADD tdq1 1 2 3 4 5 6 7 8 9 10", "TDIGEST.QUANTILE tdq1 0 0.25 0.5 0.9 1"},
+			expected:       []interface{}{"OK", "OK", []*wire.HElement{{Key: "0", Value: "1"}, {Key: "0.25", Value: "3"}, {Key: "0.5", Value: "6"}, {Key: "0.9", Value: "10"}, {Key: "1", Value: "10"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTQUANTILE},
+		},
+		{
+			name:           "TDIGEST.QUANTILE of an empty digest",
+			commands:       []string{"TDIGEST.CREATE tdq2", "TDIGEST.QUANTILE tdq2 0.5"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "0.5", Value: "nan"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTQUANTILE},
+		},
+		{
+			name:           "TDIGEST.QUANTILE out of range",
+			commands:       []string{"TDIGEST.CREATE tdq3", "TDIGEST.QUANTILE tdq3 1.5"},
+			expected:       []interface{}{"OK", errors.New("quantile must be between 0 and 1")},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, nil},
+		},
+		{
+			name:           "TDIGEST.QUANTILE of a missing key",
+			commands:       []string{"TDIGEST.QUANTILE tdq4 0.5"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.QUANTILE with wrong number of arguments",
+			commands:       []string{"TDIGEST.QUANTILE tdq5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.QUANTILE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
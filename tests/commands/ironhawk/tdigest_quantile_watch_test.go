@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTQUANTILEWATCH(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestTDIGESTQUANTILEWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.QUANTILE.WATCH with wrong number of arguments",
+			commands:       []string{"TDIGEST.QUANTILE.WATCH"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.QUANTILE.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.QUANTILE.WATCH a digest",
+			commands:       []string{"TDIGEST.CREATE tdqw1", "TDIGEST.QUANTILE.WATCH tdqw1 0.99"},
+			expected:       []interface{}{"OK", "OK"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTDIGESTQUANTILEWATCH},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
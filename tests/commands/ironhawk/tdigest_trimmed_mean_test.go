@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTDIGESTTRIMMEDMEAN(res *wire.Result) interface{} {
+	return res.GetGETRes().Value
+}
+
+func TestTDIGESTTRIMMEDMEAN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TDIGEST.TRIMMED_MEAN leaves out the outliers",
+			commands:       []string{"TDIGEST.CREATE tdt1", "TDIGEST.ADD tdt1 1 2 3 4 5 6 7 8 9 1000", "TDIGEST.TRIMMED_MEAN tdt1 0.1 0.9", "TDIGEST.TRIMMED_MEAN tdt1 0 1"},
+			expected:       []interface{}{"OK", "OK", "5.5", "104.5"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTADD, extractValueTDIGESTTRIMMEDMEAN, extractValueTDIGESTTRIMMEDMEAN},
+		},
+		{
+			name:           "TDIGEST.TRIMMED_MEAN of an empty digest",
+			commands:       []string{"TDIGEST.CREATE tdt2", "TDIGEST.TRIMMED_MEAN tdt2 0.1 0.9"},
+			expected:       []interface{}{"OK", "nan"},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, extractValueTDIGESTTRIMMEDMEAN},
+		},
+		{
+			name:           "TDIGEST.TRIMMED_MEAN with inverted quantiles",
+			commands:       []string{"TDIGEST.CREATE tdt3", "TDIGEST.TRIMMED_MEAN tdt3 0.9 0.1"},
+			expected:       []interface{}{"OK", errors.New("quantiles must be between 0 and 1, the low one smaller than the high one")},
+			valueExtractor: []ValueExtractorFn{extractValueTDIGESTCREATE, nil},
+		},
+		{
+			name:           "TDIGEST.TRIMMED_MEAN of a missing key",
+			commands:       []string{"TDIGEST.TRIMMED_MEAN tdt4 0.1 0.9"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TDIGEST.TRIMMED_MEAN with wrong number of arguments",
+			commands:       []string{"TDIGEST.TRIMMED_MEAN tdt5 0.1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TDIGEST.TRIMMED_MEAN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
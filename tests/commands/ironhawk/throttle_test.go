@@ -0,0 +1,72 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTHROTTLE(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTHROTTLE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "THROTTLE allows the burst then limits",
+			commands:       []string{"THROTTLE th1 2 1 60", "THROTTLE th1 2 1 60", "THROTTLE th1 2 1 60", "THROTTLE th1 2 1 60"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "allowed", Value: "1"}, {Key: "limit", Value: "3"}, {Key: "remaining", Value: "2"}, {Key: "retry_after", Value: "-1"}, {Key: "reset_after", Value: "60"}}, []*wire.HElement{{Key: "allowed", Value: "1"}, {Key: "limit", Value: "3"}, {Key: "remaining", Value: "1"}, {Key: "retry_after", Value: "-1"}, {Key: "reset_after", Value: "120"}}, []*wire.HElement{{Key: "allowed", Value: "1"}, {Key: "limit", Value: "3"}, {Key: "remaining", Value: "0"}, {Key: "retry_after", Value: "-1"}, {Key: "reset_after", Value: "180"}}, []*wire.HElement{{Key: "allowed", Value: "0"}, {Key: "limit", Value: "3"}, {Key: "remaining", Value: "0"}, {Key: "retry_after", Value: "60"}, {Key: "reset_after", Value: "180"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTHROTTLE, extractValueTHROTTLE, extractValueTHROTTLE, extractValueTHROTTLE},
+		},
+		{
+			name:           "THROTTLE with a quantity",
+			commands:       []string{"THROTTLE th2 10 1 60 5", "THROTTLE th2 10 1 60 7", "THROTTLE th2 10 1 60 0"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "allowed", Value: "1"}, {Key: "limit", Value: "11"}, {Key: "remaining", Value: "6"}, {Key: "retry_after", Value: "-1"}, {Key: "reset_after", Value: "300"}}, []*wire.HElement{{Key: "allowed", Value: "0"}, {Key: "limit", Value: "11"}, {Key: "remaining", Value: "6"}, {Key: "retry_after", Value: "60"}, {Key: "reset_after", Value: "300"}}, []*wire.HElement{{Key: "allowed", Value: "1"}, {Key: "limit", Value: "11"}, {Key: "remaining", Value: "6"}, {Key: "retry_after", Value: "-1"}, {Key: "reset_after", Value: "300"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTHROTTLE, extractValueTHROTTLE, extractValueTHROTTLE},
+		},
+		{
+			name:           "THROTTLE a quantity above the limit",
+			commands:       []string{"THROTTLE th3 1 1 60 3"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "allowed", Value: "0"}, {Key: "limit", Value: "2"}, {Key: "remaining", Value: "2"}, {Key: "retry_after", Value: "-1"}, {Key: "reset_after", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTHROTTLE},
+		},
+		{
+			name:           "THROTTLE on a key of another type",
+			commands:       []string{"SET th5 v", "THROTTLE th5 1 1 60"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "THROTTLE with a zero period",
+			commands:       []string{"THROTTLE th6 1 1 0"},
+			expected:       []interface{}{errors.New("count_per_period and period must be positive")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "THROTTLE with a negative burst",
+			commands:       []string{"THROTTLE th7 -1 1 60"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "THROTTLE with the largest burst that fits in time",
+			commands:       []string{"THROTTLE th9 9223372035 1 1", "THROTTLE th9 9223372036 1 1", "THROTTLE th9 9223372036854775807 1 1"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "allowed", Value: "1"}, {Key: "limit", Value: "9223372036"}, {Key: "remaining", Value: "9223372035"}, {Key: "retry_after", Value: "-1"}, {Key: "reset_after", Value: "1"}}, errors.New("value is not an integer or out of range"), errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{extractValueTHROTTLE, nil, nil},
+		},
+		{
+			name:           "THROTTLE with wrong number of arguments",
+			commands:       []string{"THROTTLE th8 1 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'THROTTLE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTOPKADD(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTOPKADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOPK.ADD expels the least frequent item",
+			commands:       []string{"TOPK.RESERVE tka1 1", "TOPK.ADD tka1 go rust rust"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "go", Value: ""}, {Key: "rust", Value: ""}, {Key: "rust", Value: "go"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTOPKADD},
+		},
+		{
+			name:           "TOPK.ADD to a missing key",
+			commands:       []string{"TOPK.ADD tka2 go"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TOPK.ADD to a key of another type",
+			commands:       []string{"SET tka3 v", "TOPK.ADD tka3 go"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "TOPK.ADD with wrong number of arguments",
+			commands:       []string{"TOPK.ADD tka4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TOPK.ADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
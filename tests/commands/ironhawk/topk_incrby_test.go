@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTOPKINCRBY(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTOPKINCRBY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOPK.INCRBY expels the least frequent item",
+			commands:       []string{"TOPK.RESERVE tki1 2", "TOPK.INCRBY tki1 go 3 rust 5 zig 4"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "go", Value: ""}, {Key: "rust", Value: ""}, {Key: "zig", Value: "go"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTOPKINCRBY},
+		},
+		{
+			name:           "TOPK.INCRBY with an invalid increment",
+			commands:       []string{"TOPK.RESERVE tki2 2", "TOPK.INCRBY tki2 go 3 rust 0", "TOPK.QUERY tki2 go"},
+			expected:       []interface{}{"OK", errors.New("value is not an integer or out of range"), []*wire.HElement{{Key: "go", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil, extractValueTOPKQUERY},
+		},
+		{
+			name:           "TOPK.INCRBY with wrong number of arguments",
+			commands:       []string{"TOPK.INCRBY tki3 go"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TOPK.INCRBY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
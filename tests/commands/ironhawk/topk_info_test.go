@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTOPKINFO(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTOPKINFO(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOPK.INFO a missing key",
+			commands:       []string{"TOPK.INFO tkf1"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TOPK.INFO with wrong number of arguments",
+			commands:       []string{"TOPK.INFO tkf2 extra"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TOPK.INFO' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTOPKLIST(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTOPKLIST(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOPK.LIST most frequent first",
+			commands:       []string{"TOPK.RESERVE tkl1 2", "TOPK.INCRBY tkl1 go 3 rust 5 zig 1", "TOPK.LIST tkl1"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "go", Value: ""}, {Key: "rust", Value: ""}, {Key: "zig", Value: ""}}, []string{"rust", "go"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTOPKINCRBY, extractValueKEYS},
+		},
+		{
+			name:           "TOPK.LIST WITHCOUNT",
+			commands:       []string{"TOPK.RESERVE tkl2 2", "TOPK.INCRBY tkl2 go 3 rust 5 zig 4", "TOPK.LIST tkl2 WITHCOUNT"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "go", Value: ""}, {Key: "rust", Value: ""}, {Key: "zig", Value: "go"}}, []*wire.HElement{{Key: "rust", Value: "5"}, {Key: "zig", Value: "4"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTOPKINCRBY, extractValueTOPKLIST},
+		},
+		{
+			name:           "TOPK.LIST an empty sketch",
+			commands:       []string{"TOPK.RESERVE tkl3 2", "TOPK.LIST tkl3"},
+			expected:       []interface{}{"OK", []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueKEYS},
+		},
+		{
+			name:           "TOPK.LIST with an invalid option",
+			commands:       []string{"TOPK.LIST tkl4 WITHSCORES"},
+			expected:       []interface{}{errors.New("invalid syntax for 'TOPK.LIST' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TOPK.LIST a missing key",
+			commands:       []string{"TOPK.LIST tkl5"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTOPKLISTWATCH(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestTOPKLISTWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOPK.LIST.WATCH with wrong number of arguments",
+			commands:       []string{"TOPK.LIST.WATCH"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TOPK.LIST.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TOPK.LIST.WATCH a sketch",
+			commands:       []string{"TOPK.RESERVE tklw1 2", "TOPK.LIST.WATCH tklw1"},
+			expected:       []interface{}{"OK", "OK"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTOPKLISTWATCH},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTOPKQUERY(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTOPKQUERY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOPK.QUERY items in and out of the top k",
+			commands:       []string{"TOPK.RESERVE tkq1 1", "TOPK.INCRBY tkq1 go 3 rust 5", "TOPK.QUERY tkq1 go rust zig"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "go", Value: ""}, {Key: "rust", Value: "go"}}, []*wire.HElement{{Key: "go", Value: "0"}, {Key: "rust", Value: "1"}, {Key: "zig", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTOPKINCRBY, extractValueTOPKQUERY},
+		},
+		{
+			name:           "TOPK.QUERY a missing key",
+			commands:       []string{"TOPK.QUERY tkq2 go"},
+			expected:       []interface{}{errors.New("no such key")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TOPK.QUERY with wrong number of arguments",
+			commands:       []string{"TOPK.QUERY tkq3"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TOPK.QUERY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTOPKRESERVE(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestTOPKRESERVE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOPK.RESERVE with defaults",
+			commands:       []string{"TOPK.RESERVE tkr1 3", "TOPK.INFO tkr1"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "k", Value: "3"}, {Key: "width", Value: "2000"}, {Key: "depth", Value: "5"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTOPKRESERVE, extractValueTOPKINFO},
+		},
+		{
+			name:           "TOPK.RESERVE with dimensions",
+			commands:       []string{"TOPK.RESERVE tkr2 10 100 4", "TOPK.INFO tkr2"},
+			expected:       []interface{}{"OK", []*wire.HElement{{Key: "k", Value: "10"}, {Key: "width", Value: "100"}, {Key: "depth", Value: "4"}}},
+			valueExtractor: []ValueExtractorFn{extractValueTOPKRESERVE, extractValueTOPKINFO},
+		},
+		{
+			name:           "TOPK.RESERVE an existing key",
+			commands:       []string{"TOPK.RESERVE tkr3 3", "TOPK.RESERVE tkr3 3"},
+			expected:       []interface{}{"OK", errors.New("key exists")},
+			valueExtractor: []ValueExtractorFn{extractValueTOPKRESERVE, nil},
+		},
+		{
+			name:           "TOPK.RESERVE with an invalid topk",
+			commands:       []string{"TOPK.RESERVE tkr4 0"},
+			expected:       []interface{}{errors.New("topk must be a positive integer")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TOPK.RESERVE with invalid dimensions",
+			commands:       []string{"TOPK.RESERVE tkr5 3 0 5"},
+			expected:       []interface{}{errors.New("width and depth must be positive integers")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TOPK.RESERVE with wrong number of arguments",
+			commands:       []string{"TOPK.RESERVE tkr6 3 100"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TOPK.RESERVE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
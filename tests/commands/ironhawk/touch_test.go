@@ -0,0 +1,44 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTOUCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TOUCH resets the idle time",
+			commands:       []string{"SET tch1 v", "OBJECT IDLETIME tch1", "TOUCH tch1", "OBJECT IDLETIME tch1"},
+			expected:       []interface{}{"OK", int64(2), int64(1), int64(0)},
+			delay:          []time.Duration{0, 2 * time.Second, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "TOUCH counts as an access",
+			commands:       []string{"SET tch2 v", "TOUCH tch2", "OBJECT FREQ tch2"},
+			expected:       []interface{}{"OK", int64(1), int64(6)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "TOUCH multiple keys",
+			commands:       []string{"MSET tch3 v tch4 v tch5 v", "TOUCH tch3 tch4 tch5 tch6 tch3"},
+			expected:       []interface{}{"OK", int64(4)},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY},
+		},
+		{
+			name:           "TOUCH without keys",
+			commands:       []string{"TOUCH"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TOUCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestTSADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.ADD creates the time series",
+			commands:       []string{"TS.ADD tsa1 1000 21.5", "TS.ADD tsa1 500 20", "TS.REVRANGE tsa1 - +"},
+			expected:       []interface{}{1000, 500, []*wire.HElement{{Key: "1000", Value: "21.5"}, {Key: "500", Value: "20"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.ADD at the timestamp of an existing sample",
+			commands:       []string{"TS.ADD tsa2 1000 1", "TS.ADD tsa2 1000 2"},
+			expected:       []interface{}{1000, errors.New("a sample with the same timestamp exists and the duplicate policy is BLOCK")},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil},
+		},
+		{
+			name:           "TS.ADD with ON_DUPLICATE",
+			commands:       []string{"TS.ADD tsa3 1000 1", "TS.ADD tsa3 1000 2 ON_DUPLICATE sum", "TS.ADD tsa3 1000 1 ON_DUPLICATE max", "TS.REVRANGE tsa3 - +"},
+			expected:       []interface{}{1000, 1000, 1000, []*wire.HElement{{Key: "1000", Value: "3"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.ADD uses the duplicate policy of the time series",
+			commands:       []string{"TS.CREATE tsa4 DUPLICATE_POLICY last", "TS.ADD tsa4 1000 1", "TS.ADD tsa4 1000 2", "TS.REVRANGE tsa4 - +"},
+			expected:       []interface{}{"OK", 1000, 1000, []*wire.HElement{{Key: "1000", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.ADD older than the retention period",
+			commands:       []string{"TS.ADD tsa5 1000 1 RETENTION 100", "TS.ADD tsa5 899 1", "TS.ADD tsa5 1100 2", "TS.REVRANGE tsa5 - +"},
+			expected:       []interface{}{1000, errors.New("timestamp is older than the retention period of the time series"), 1100, []*wire.HElement{{Key: "1100", Value: "2"}, {Key: "1000", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, nil, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.ADD with an invalid timestamp",
+			commands:       []string{"TS.ADD tsa6 -1 1"},
+			expected:       []interface{}{errors.New("invalid timestamp, must be a non-negative integer")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.ADD with an invalid value",
+			commands:       []string{"TS.ADD tsa7 1000 abc"},
+			expected:       []interface{}{errors.New("value is not an integer or a float")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.ADD on wrong type",
+			commands:       []string{"SET tsa8 v", "TS.ADD tsa8 1000 1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "TS.ADD with wrong number of arguments",
+			commands:       []string{"TS.ADD tsa9 1000"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.ADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTSCREATE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.CREATE a new time series",
+			commands:       []string{"TS.CREATE tsc1 RETENTION 1000 DUPLICATE_POLICY last", "TYPE tsc1"},
+			expected:       []interface{}{"OK", "timeseries"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTYPE},
+		},
+		{
+			name:           "TS.CREATE an existing key",
+			commands:       []string{"SET tsc2 v", "TS.CREATE tsc2"},
+			expected:       []interface{}{"OK", errors.New("key exists")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "TS.CREATE with an unknown duplicate policy",
+			commands:       []string{"TS.CREATE tsc3 DUPLICATE_POLICY newest"},
+			expected:       []interface{}{errors.New("unknown duplicate policy 'newest'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.CREATE with a negative retention",
+			commands:       []string{"TS.CREATE tsc4 RETENTION -1"},
+			expected:       []interface{}{errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.CREATE with ON_DUPLICATE",
+			commands:       []string{"TS.CREATE tsc5 ON_DUPLICATE last"},
+			expected:       []interface{}{errors.New("invalid syntax for 'TS.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.CREATE with wrong number of arguments",
+			commands:       []string{"TS.CREATE"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
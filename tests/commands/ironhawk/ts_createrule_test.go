@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestTSCREATERULE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.CREATERULE downsamples new samples",
+			commands:       []string{"TS.CREATE tscr1", "TS.CREATE tscr1:avg", "TS.CREATERULE tscr1 tscr1:avg AGGREGATION avg 60000", "TS.ADD tscr1 1000 1", "TS.ADD tscr1 2000 2", "TS.ADD tscr1 61000 4", "TS.REVRANGE tscr1:avg - +"},
+			expected:       []interface{}{"OK", "OK", "OK", 1000, 2000, 61000, []*wire.HElement{{Key: "60000", Value: "4"}, {Key: "0", Value: "1.5"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.CREATERULE into several time series",
+			commands:       []string{"TS.CREATE tscr2", "TS.CREATE tscr2:max", "TS.CREATE tscr2:count", "TS.CREATERULE tscr2 tscr2:max AGGREGATION max 10", "TS.CREATERULE tscr2 tscr2:count AGGREGATION count 10", "TS.MADD tscr2 1 5 tscr2 2 3 tscr2 11 1", "TS.REVRANGE tscr2:max - +", "TS.REVRANGE tscr2:count - +"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", []string{"1", "2", "11"}, []*wire.HElement{{Key: "10", Value: "1"}, {Key: "0", Value: "5"}}, []*wire.HElement{{Key: "10", Value: "1"}, {Key: "0", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueKEYS, extractValueTSRANGE, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.CREATERULE with a missing destination",
+			commands:       []string{"TS.CREATE tscr3", "TS.CREATERULE tscr3 tscr3:avg AGGREGATION avg 10"},
+			expected:       []interface{}{"OK", errors.New("could not perform this operation on a key that doesn't exist")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "TS.CREATERULE into the source",
+			commands:       []string{"TS.CREATE tscr4", "TS.CREATERULE tscr4 tscr4 AGGREGATION avg 10"},
+			expected:       []interface{}{"OK", errors.New("the source and the destination key cannot be the same")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "TS.CREATERULE twice into the same destination",
+			commands:       []string{"TS.CREATE tscr5", "TS.CREATE tscr5:avg", "TS.CREATERULE tscr5 tscr5:avg AGGREGATION avg 10", "TS.CREATERULE tscr5 tscr5:avg AGGREGATION sum 10"},
+			expected:       []interface{}{"OK", "OK", "OK", errors.New("a compaction rule into the destination key already exists")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, nil},
+		},
+		{
+			name:           "TS.CREATERULE into a destination with rules",
+			commands:       []string{"TS.CREATE tscr6", "TS.CREATE tscr6:a", "TS.CREATE tscr6:b", "TS.CREATERULE tscr6:a tscr6:b AGGREGATION avg 10", "TS.CREATERULE tscr6 tscr6:a AGGREGATION avg 10"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", errors.New("the destination key has compaction rules of its own")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, nil},
+		},
+		{
+			name:           "TS.CREATERULE without AGGREGATION",
+			commands:       []string{"TS.CREATERULE tscr7 tscr7:avg BUCKET avg 10"},
+			expected:       []interface{}{errors.New("invalid syntax for 'TS.CREATERULE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.CREATERULE with wrong number of arguments",
+			commands:       []string{"TS.CREATERULE tscr8 tscr8:avg"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.CREATERULE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
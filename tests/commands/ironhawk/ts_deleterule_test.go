@@ -0,0 +1,44 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestTSDELETERULE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.DELETERULE stops downsampling",
+			commands:       []string{"TS.CREATE tsdr1", "TS.CREATE tsdr1:sum", "TS.CREATERULE tsdr1 tsdr1:sum AGGREGATION sum 10", "TS.ADD tsdr1 1 1", "TS.DELETERULE tsdr1 tsdr1:sum", "TS.ADD tsdr1 2 1", "TS.REVRANGE tsdr1:sum - +"},
+			expected:       []interface{}{"OK", "OK", "OK", 1, "OK", 2, []*wire.HElement{{Key: "0", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueINCRBY, extractValueSET, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.DELETERULE of a missing rule",
+			commands:       []string{"TS.CREATE tsdr2", "TS.DELETERULE tsdr2 tsdr2:sum"},
+			expected:       []interface{}{"OK", errors.New("compaction rule does not exist")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "TS.DELETERULE of a non-existing key",
+			commands:       []string{"TS.DELETERULE tsdr3 tsdr3:sum"},
+			expected:       []interface{}{errors.New("could not perform this operation on a key that doesn't exist")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.DELETERULE with wrong number of arguments",
+			commands:       []string{"TS.DELETERULE tsdr4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.DELETERULE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestTSMADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.MADD across time series",
+			commands:       []string{"TS.CREATE tsm1", "TS.CREATE tsm2", "TS.MADD tsm1 1000 1 tsm2 1000 2 tsm1 2000 3", "TS.REVRANGE tsm1 - +", "TS.REVRANGE tsm2 - +"},
+			expected:       []interface{}{"OK", "OK", []string{"1000", "1000", "2000"}, []*wire.HElement{{Key: "2000", Value: "3"}, {Key: "1000", Value: "1"}}, []*wire.HElement{{Key: "1000", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueKEYS, extractValueTSRANGE, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.MADD reports the samples that cannot be added",
+			commands:       []string{"TS.CREATE tsm3", "SET tsm4 v", "TS.MADD tsm3 1000 1 tsm3 1000 2 tsm4 1000 1 tsm5 1000 1 tsm3 2000 x"},
+			expected:       []interface{}{"OK", "OK", []string{"1000", "a sample with the same timestamp exists and the duplicate policy is BLOCK", "wrongtype operation against a key holding the wrong kind of value", "could not perform this operation on a key that doesn't exist", "value is not an integer or a float"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueKEYS},
+		},
+		{
+			name:           "TS.MADD with wrong number of arguments",
+			commands:       []string{"TS.MADD tsm6 1000 1 tsm6"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.MADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
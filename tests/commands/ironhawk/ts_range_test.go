@@ -0,0 +1,84 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTSRANGE(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestTSRANGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.RANGE of a time range",
+			commands:       []string{"TS.MADD tsr1 1 1", "TS.CREATE tsr1", "TS.ADD tsr1 1000 1", "TS.ADD tsr1 2000 2", "TS.ADD tsr1 3000 3", "TS.RANGE tsr1 1500 3000"},
+			expected:       []interface{}{[]string{"could not perform this operation on a key that doesn't exist"}, "OK", 1000, 2000, 3000, []*wire.HElement{{Key: "2000", Value: "2"}, {Key: "3000", Value: "3"}}},
+			valueExtractor: []ValueExtractorFn{extractValueKEYS, extractValueSET, extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.RANGE with COUNT",
+			commands:       []string{"TS.ADD tsr2 1000 1", "TS.ADD tsr2 2000 2", "TS.ADD tsr2 3000 3", "TS.RANGE tsr2 - + COUNT 2"},
+			expected:       []interface{}{1000, 2000, 3000, []*wire.HElement{{Key: "1000", Value: "1"}, {Key: "2000", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.RANGE with AGGREGATION avg",
+			commands:       []string{"TS.ADD tsr3 1000 1", "TS.ADD tsr3 2000 2", "TS.ADD tsr3 61000 4.5", "TS.RANGE tsr3 - + AGGREGATION avg 60000"},
+			expected:       []interface{}{1000, 2000, 61000, []*wire.HElement{{Key: "0", Value: "1.5"}, {Key: "60000", Value: "4.5"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.RANGE with AGGREGATION count and COUNT",
+			commands:       []string{"TS.ADD tsr4 1000 1", "TS.ADD tsr4 2000 2", "TS.ADD tsr4 61000 4", "TS.RANGE tsr4 - + COUNT 1 AGGREGATION count 60000"},
+			expected:       []interface{}{1000, 2000, 61000, []*wire.HElement{{Key: "0", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.RANGE of a non-existing key",
+			commands:       []string{"TS.RANGE tsr5 - +"},
+			expected:       []interface{}{[]*wire.HElement(nil)},
+			valueExtractor: []ValueExtractorFn{extractValueTSRANGE},
+		},
+		{
+			name:           "TS.RANGE with an unknown aggregator",
+			commands:       []string{"TS.RANGE tsr6 - + AGGREGATION median 10"},
+			expected:       []interface{}{errors.New("unknown aggregator 'median'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.RANGE with an invalid bucket duration",
+			commands:       []string{"TS.RANGE tsr6 - + AGGREGATION avg 0"},
+			expected:       []interface{}{errors.New("bucket duration must be a positive integer")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.RANGE with an invalid timestamp",
+			commands:       []string{"TS.RANGE tsr6 abc +"},
+			expected:       []interface{}{errors.New("invalid timestamp, must be a non-negative integer")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.RANGE on wrong type",
+			commands:       []string{"SET tsr7 v", "TS.RANGE tsr7 - +"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "TS.RANGE with wrong number of arguments",
+			commands:       []string{"TS.RANGE tsr8 -"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.RANGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
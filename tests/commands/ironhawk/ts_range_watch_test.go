@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueTSRANGEWATCH(res *wire.Result) interface{} {
+	return res.Message
+}
+
+func TestTSRANGEWATCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.RANGE.WATCH with wrong number of arguments",
+			commands:       []string{"TS.RANGE.WATCH tsrw1 -"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.RANGE.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "TS.RANGE.WATCH with an aggregation",
+			commands:       []string{"TS.CREATE tsrw2", "TS.RANGE.WATCH tsrw2 - + AGGREGATION avg 60000"},
+			expected:       []interface{}{"OK", "OK"},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueTSRANGEWATCH},
+		},
+		{
+			name:           "TS.RANGE.WATCH with COUNT",
+			commands:       []string{"TS.CREATE tsrw3", "TS.RANGE.WATCH tsrw3 - + COUNT 2"},
+			expected:       []interface{}{"OK", errors.New("invalid syntax for 'TS.RANGE.WATCH' command")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
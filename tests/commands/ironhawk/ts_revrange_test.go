@@ -0,0 +1,38 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestTSREVRANGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "TS.REVRANGE with COUNT",
+			commands:       []string{"TS.ADD tsrr1 1000 1", "TS.ADD tsrr1 2000 2", "TS.ADD tsrr1 3000 3", "TS.REVRANGE tsrr1 - + COUNT 2"},
+			expected:       []interface{}{1000, 2000, 3000, []*wire.HElement{{Key: "3000", Value: "3"}, {Key: "2000", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.REVRANGE with AGGREGATION",
+			commands:       []string{"TS.ADD tsrr2 1000 1", "TS.ADD tsrr2 2000 2", "TS.ADD tsrr2 61000 4", "TS.REVRANGE tsrr2 0 70000 AGGREGATION max 60000"},
+			expected:       []interface{}{1000, 2000, 61000, []*wire.HElement{{Key: "60000", Value: "4"}, {Key: "0", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueINCRBY, extractValueINCRBY, extractValueTSRANGE},
+		},
+		{
+			name:           "TS.REVRANGE with wrong number of arguments",
+			commands:       []string{"TS.REVRANGE tsrr3"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'TS.REVRANGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
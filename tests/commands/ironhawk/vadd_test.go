@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "VADD stores a vector",
+			commands:       []string{"VINDEX.CREATE va1 DIM 2", "VADD va1:a va1 0.5 -1 ATTR lang en", "TYPE va1:a", "VGET va1:a"},
+			expected:       []interface{}{"OK", "OK", "vector", []string{"0.5", "-1"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueTYPE, extractValueKEYS},
+		},
+		{
+			name:           "VADD replaces a vector",
+			commands:       []string{"VINDEX.CREATE va2 DIM 2", "VADD va2:a va2 1 2", "VADD va2:a va2 3 4", "VGET va2:a"},
+			expected:       []interface{}{"OK", "OK", "OK", []string{"3", "4"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueKEYS},
+		},
+		{
+			name:           "VADD into a missing index",
+			commands:       []string{"VADD va3:a va3 1 2"},
+			expected:       []interface{}{errors.New("no such vector index 'va3'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "VADD with the wrong dimension",
+			commands:       []string{"VINDEX.CREATE va4 DIM 2", "VADD va4:a va4 1 2 3"},
+			expected:       []interface{}{"OK", errors.New("the vector does not have the dimension of the index")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "VADD a zero vector with the cosine metric",
+			commands:       []string{"VINDEX.CREATE va5 DIM 2", "VADD va5:a va5 0 0"},
+			expected:       []interface{}{"OK", errors.New("a zero vector has no direction and cannot be compared with the cosine metric")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "VADD with invalid attributes",
+			commands:       []string{"VINDEX.CREATE va6 DIM 2", "VADD va6:a va6 1 2 ATTR lang"},
+			expected:       []interface{}{"OK", errors.New("invalid syntax for 'VADD' command")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "VADD on wrong type",
+			commands:       []string{"VINDEX.CREATE va7 DIM 2", "SET va7:a v", "VADD va7:a va7 1 2"},
+			expected:       []interface{}{"OK", "OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, nil},
+		},
+		{
+			name:           "VADD with wrong number of arguments",
+			commands:       []string{"VADD va8:a va8"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'VADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
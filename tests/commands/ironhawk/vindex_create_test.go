@@ -0,0 +1,54 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVINDEXCREATE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "VINDEX.CREATE a vector index",
+			commands:       []string{"VINDEX.CREATE vic1 DIM 3 METRIC l2 M 8 EF_CONSTRUCTION 50", "VADD vic1:a vic1 1 2 3", "VGET vic1:a"},
+			expected:       []interface{}{"OK", "OK", []string{"1", "2", "3"}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueKEYS},
+		},
+		{
+			name:           "VINDEX.CREATE an existing index",
+			commands:       []string{"VINDEX.CREATE vic2 DIM 3", "VINDEX.CREATE vic2 DIM 4"},
+			expected:       []interface{}{"OK", errors.New("index 'vic2' already exists")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "VINDEX.CREATE with an unknown metric",
+			commands:       []string{"VINDEX.CREATE vic3 DIM 3 METRIC manhattan"},
+			expected:       []interface{}{errors.New("unknown metric 'manhattan'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "VINDEX.CREATE with an invalid dimension",
+			commands:       []string{"VINDEX.CREATE vic4 DIM 0"},
+			expected:       []interface{}{errors.New("DIM must be a positive integer")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "VINDEX.CREATE without a dimension",
+			commands:       []string{"VINDEX.CREATE vic5 METRIC l2"},
+			expected:       []interface{}{errors.New("invalid syntax for 'VINDEX.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "VINDEX.CREATE with wrong number of arguments",
+			commands:       []string{"VINDEX.CREATE vic6 DIM"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'VINDEX.CREATE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
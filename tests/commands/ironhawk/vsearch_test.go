@@ -0,0 +1,90 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueVSEARCH(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestVSEARCH(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "VSEARCH the nearest vectors across shards",
+			commands:       []string{"VINDEX.CREATE vs1 DIM 2 METRIC l2", "VADD vs1:a vs1 0 0 ATTR lang en", "VADD vs1:b vs1 3 4 ATTR lang fr", "VADD vs1:c vs1 6 8 ATTR lang en", "VADD vs1:d vs1 10 10 ATTR lang fr", "VSEARCH vs1 2 0 1"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", []*wire.HElement{{Key: "vs1:a", Value: "1"}, {Key: "vs1:b", Value: "4.242640687119285"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueVSEARCH},
+		},
+		{
+			name:           "VSEARCH EXACT",
+			commands:       []string{"VINDEX.CREATE vs2 DIM 2 METRIC l2", "VADD vs2:a vs2 0 0 ATTR lang en", "VADD vs2:b vs2 3 4 ATTR lang fr", "VADD vs2:c vs2 6 8 ATTR lang en", "VADD vs2:d vs2 10 10 ATTR lang fr", "VSEARCH vs2 3 10 10 EXACT"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", []*wire.HElement{{Key: "vs2:d", Value: "0"}, {Key: "vs2:c", Value: "4.47213595499958"}, {Key: "vs2:b", Value: "9.219544457292887"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueVSEARCH},
+		},
+		{
+			name:           "VSEARCH with FILTER",
+			commands:       []string{"VINDEX.CREATE vs3 DIM 2 METRIC l2", "VADD vs3:a vs3 0 0 ATTR lang en", "VADD vs3:b vs3 3 4 ATTR lang fr", "VADD vs3:c vs3 6 8 ATTR lang en", "VADD vs3:d vs3 10 10 ATTR lang fr", "VSEARCH vs3 2 0 1 EF 10 FILTER lang en"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", []*wire.HElement{{Key: "vs3:a", Value: "1"}, {Key: "vs3:c", Value: "9.219544457292887"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueVSEARCH},
+		},
+		{
+			name:           "VSEARCH skips deleted and replaced vectors",
+			commands:       []string{"VINDEX.CREATE vs4 DIM 2 METRIC l2", "VADD vs4:a vs4 0 0 ATTR lang en", "VADD vs4:b vs4 3 4 ATTR lang fr", "VADD vs4:c vs4 6 8 ATTR lang en", "VADD vs4:d vs4 10 10 ATTR lang fr", "DEL vs4:a", "VADD vs4:b vs4 20 20", "VSEARCH vs4 2 0 1"},
+			expected:       []interface{}{"OK", "OK", "OK", "OK", "OK", 1, "OK", []*wire.HElement{{Key: "vs4:c", Value: "9.219544457292887"}, {Key: "vs4:d", Value: "13.45362404707371"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueSET, extractValueDEL, extractValueSET, extractValueVSEARCH},
+		},
+		{
+			name:           "VSEARCH with the inner product metric",
+			commands:       []string{"VINDEX.CREATE vs5 DIM 2 METRIC ip", "VADD vs5:a vs5 1 0", "VADD vs5:b vs5 0 1", "VSEARCH vs5 2 2 1"},
+			expected:       []interface{}{"OK", "OK", "OK", []*wire.HElement{{Key: "vs5:a", Value: "-1"}, {Key: "vs5:b", Value: "0"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueSET, extractValueVSEARCH},
+		},
+		{
+			name:           "VSEARCH more vectors than the index holds",
+			commands:       []string{"VINDEX.CREATE vs6 DIM 2 METRIC l2", "VADD vs6:a vs6 0 0", "VSEARCH vs6 5 3 4"},
+			expected:       []interface{}{"OK", "OK", []*wire.HElement{{Key: "vs6:a", Value: "5"}}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueSET, extractValueVSEARCH},
+		},
+		{
+			name:           "VSEARCH a missing index",
+			commands:       []string{"VSEARCH vs7 1 0 1"},
+			expected:       []interface{}{errors.New("no such vector index 'vs7'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "VSEARCH with the wrong dimension",
+			commands:       []string{"VINDEX.CREATE vs8 DIM 2", "VSEARCH vs8 1 1"},
+			expected:       []interface{}{"OK", errors.New("the vector does not have the dimension of the index")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "VSEARCH with an invalid k",
+			commands:       []string{"VSEARCH vs9 0 1 2"},
+			expected:       []interface{}{errors.New("k must be a positive integer")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "VSEARCH with an invalid option",
+			commands:       []string{"VSEARCH vs9 1 1 2 FAST"},
+			expected:       []interface{}{errors.New("invalid syntax for 'VSEARCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "VSEARCH with wrong number of arguments",
+			commands:       []string{"VSEARCH vs9 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'VSEARCH' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestXACK(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XACK of delivered entries",
+			commands:       []string{"XADD xack1 1-1 f v", "XADD xack1 2-1 f v", "XGROUP CREATE xack1 g 0", "XREADGROUP GROUP g c STREAMS xack1 >", "XACK xack1 g 1-1 2-1 3-1", "XACK xack1 g 1-1"},
+			expected:       []interface{}{"1-1", "2-1", "OK", []*wire.HElement{{Key: "xack1 1-1 f", Value: "v"}, {Key: "xack1 2-1 f", Value: "v"}}, 2, 0},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueSET, extractValueXRANGE, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "XACK on a non-existing key or group",
+			commands:       []string{"XACK xack2 g 1-1", "XADD xack2 1-1 f v", "XACK xack2 g 1-1"},
+			expected:       []interface{}{0, "1-1", 0},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY, extractValueGET, extractValueINCRBY},
+		},
+		{
+			name:           "XACK with an invalid ID",
+			commands:       []string{"XACK xack3 g x"},
+			expected:       []interface{}{errors.New("invalid stream ID specified as stream command argument")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "XACK with wrong number of arguments",
+			commands:       []string{"XACK xack4 g"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XACK' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestXADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XADD with explicit IDs",
+			commands:       []string{"XADD xadd1 1-1 f1 v1", "XADD xadd1 1-* f2 v2", "XADD xadd1 5 f3 v3", "XLEN xadd1"},
+			expected:       []interface{}{"1-1", "1-2", "5-0", 3},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueINCRBY},
+		},
+		{
+			name:           "XADD with an ID not greater than the last one",
+			commands:       []string{"XADD xadd2 2-1 f v", "XADD xadd2 2-1 f v", "XADD xadd2 1-* f v", "XADD xadd2 0-0 f v"},
+			expected:       []interface{}{"2-1", errors.New("the ID specified in XADD is equal or smaller than the target stream top item"), errors.New("the ID specified in XADD is equal or smaller than the target stream top item"), errors.New("the ID specified in XADD must be greater than 0-0")},
+			valueExtractor: []ValueExtractorFn{extractValueGET, nil, nil, nil},
+		},
+		{
+			name:           "XADD with ID 0-0 on a new stream",
+			commands:       []string{"XADD xadd3 0-0 f v"},
+			expected:       []interface{}{errors.New("the ID specified in XADD must be greater than 0-0")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "XADD with an invalid ID",
+			commands:       []string{"XADD xadd4 abc f v"},
+			expected:       []interface{}{errors.New("invalid stream ID specified as stream command argument")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "XADD with MAXLEN",
+			commands:       []string{"XADD xadd5 1-1 f v", "XADD xadd5 2-1 f v", "XADD xadd5 MAXLEN ~ 1 3-1 f v", "XRANGE xadd5 - +"},
+			expected:       []interface{}{"1-1", "2-1", "3-1", []*wire.HElement{{Key: "3-1 f", Value: "v"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XADD with MINID",
+			commands:       []string{"XADD xadd6 1-1 f v", "XADD xadd6 2-1 f v", "XADD xadd6 MINID = 2 3-1 f v", "XLEN xadd6"},
+			expected:       []interface{}{"1-1", "2-1", "3-1", 2},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueINCRBY},
+		},
+		{
+			name:           "XADD with NOMKSTREAM",
+			commands:       []string{"XADD xadd7 NOMKSTREAM 1-1 f v", "EXISTS xadd7"},
+			expected:       []interface{}{"", 0},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueEXISTS},
+		},
+		{
+			name:           "XADD with an odd number of field values",
+			commands:       []string{"XADD xadd8 1-1 f v g"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "XADD on wrong type",
+			commands:       []string{"SET xadd9 v", "XADD xadd9 1-1 f v"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "XADD with wrong number of arguments",
+			commands:       []string{"XADD xadd10 1-1 f"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XADD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestXCLAIM(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XCLAIM of idle entries",
+			commands:       []string{"XADD xclaim1 1-1 f v", "XADD xclaim1 2-1 f v", "XGROUP CREATE xclaim1 g 0", "XREADGROUP GROUP g alice STREAMS xclaim1 >", "XCLAIM xclaim1 g bob 0 1-1 3-1", "XPENDING xclaim1 g"},
+			expected:       []interface{}{"1-1", "2-1", "OK", []*wire.HElement{{Key: "xclaim1 1-1 f", Value: "v"}, {Key: "xclaim1 2-1 f", Value: "v"}}, []*wire.HElement{{Key: "1-1 f", Value: "v"}}, []string{"2", "1-1", "2-1", "alice", "1", "bob", "1"}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueSET, extractValueXRANGE, extractValueXRANGE, extractValueKEYS},
+		},
+		{
+			name:           "XCLAIM with a min idle time not reached",
+			commands:       []string{"XADD xclaim2 1-1 f v", "XGROUP CREATE xclaim2 g 0", "XREADGROUP GROUP g alice STREAMS xclaim2 >", "XCLAIM xclaim2 g bob 100000 1-1"},
+			expected:       []interface{}{"1-1", "OK", []*wire.HElement{{Key: "xclaim2 1-1 f", Value: "v"}}, []*wire.HElement(nil)},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueSET, extractValueXRANGE, extractValueXRANGE},
+		},
+		{
+			name:           "XCLAIM with JUSTID",
+			commands:       []string{"XADD xclaim3 1-1 f v", "XGROUP CREATE xclaim3 g 0", "XREADGROUP GROUP g alice STREAMS xclaim3 >", "XCLAIM xclaim3 g bob 0 1-1 JUSTID"},
+			expected:       []interface{}{"1-1", "OK", []*wire.HElement{{Key: "xclaim3 1-1 f", Value: "v"}}, []string{"1-1"}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueSET, extractValueXRANGE, extractValueKEYS},
+		},
+		{
+			name:           "XCLAIM on a non-existing group",
+			commands:       []string{"XCLAIM xclaim4 g bob 0 1-1"},
+			expected:       []interface{}{errors.New("NOGROUP no such key 'xclaim4' or consumer group 'g'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "XCLAIM with wrong number of arguments",
+			commands:       []string{"XCLAIM xclaim5 g bob 0"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XCLAIM' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestXGROUP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XGROUP CREATE and DESTROY",
+			commands:       []string{"XADD xgroup1 1-1 f v", "XGROUP CREATE xgroup1 g $", "XGROUP CREATE xgroup1 g 0", "XGROUP DESTROY xgroup1 g", "XGROUP DESTROY xgroup1 g"},
+			expected:       []interface{}{"1-1", "OK", errors.New("BUSYGROUP consumer group name already exists"), 1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueSET, nil, extractValueINCRBY, extractValueINCRBY},
+		},
+		{
+			name:           "XGROUP CREATE on a non-existing key",
+			commands:       []string{"XGROUP CREATE xgroup2 g $", "XGROUP CREATE xgroup2 g $ MKSTREAM", "XLEN xgroup2"},
+			expected:       []interface{}{errors.New("the XGROUP subcommand requires the key to exist, use MKSTREAM to create an empty stream"), "OK", 0},
+			valueExtractor: []ValueExtractorFn{nil, extractValueSET, extractValueINCRBY},
+		},
+		{
+			name:           "XGROUP with invalid arguments",
+			commands:       []string{"XGROUP CREATE xgroup3 g x MKSTREAM", "XGROUP CREATE xgroup3 g 0 NOSTREAM", "XGROUP SETID xgroup3 g"},
+			expected:       []interface{}{errors.New("invalid stream ID specified as stream command argument"), errors.New("invalid syntax for 'XGROUP' command"), errors.New("invalid syntax for 'XGROUP' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil},
+		},
+		{
+			name:           "XGROUP on wrong type",
+			commands:       []string{"SET xgroup4 v", "XGROUP CREATE xgroup4 g $"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "XGROUP with wrong number of arguments",
+			commands:       []string{"XGROUP CREATE xgroup5"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XGROUP' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
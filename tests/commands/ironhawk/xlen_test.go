@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestXLEN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XLEN of a stream",
+			commands:       []string{"XADD xlen1 1-1 f v", "XADD xlen1 1-2 f v", "XLEN xlen1"},
+			expected:       []interface{}{"1-1", "1-2", 2},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueINCRBY},
+		},
+		{
+			name:           "XLEN of a non-existing key",
+			commands:       []string{"XLEN xlen2"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueINCRBY},
+		},
+		{
+			name:           "XLEN on wrong type",
+			commands:       []string{"SET xlen3 v", "XLEN xlen3"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "XLEN with wrong number of arguments",
+			commands:       []string{"XLEN"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XLEN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
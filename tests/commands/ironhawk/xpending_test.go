@@ -0,0 +1,93 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXPENDING(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XPENDING summary",
+			commands:       []string{"XADD xpending1 1-1 f v", "XADD xpending1 2-1 f v", "XADD xpending1 3-1 f v", "XGROUP CREATE xpending1 g 0", "XREADGROUP GROUP g alice COUNT 2 STREAMS xpending1 >", "XREADGROUP GROUP g bob STREAMS xpending1 >", "XPENDING xpending1 g"},
+			expected:       []interface{}{"1-1", "2-1", "3-1", "OK", []*wire.HElement{{Key: "xpending1 1-1 f", Value: "v"}, {Key: "xpending1 2-1 f", Value: "v"}}, []*wire.HElement{{Key: "xpending1 3-1 f", Value: "v"}}, []string{"3", "1-1", "3-1", "alice", "2", "bob", "1"}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueSET, extractValueXRANGE, extractValueXRANGE, extractValueKEYS},
+		},
+		{
+			name:           "XPENDING summary of an empty group",
+			commands:       []string{"XGROUP CREATE xpending2 g $ MKSTREAM", "XPENDING xpending2 g"},
+			expected:       []interface{}{"OK", []string{"0", "", ""}},
+			valueExtractor: []ValueExtractorFn{extractValueSET, extractValueKEYS},
+		},
+		{
+			name:           "XPENDING range filtered by idle time",
+			commands:       []string{"XADD xpending3 1-1 f v", "XGROUP CREATE xpending3 g 0", "XREADGROUP GROUP g alice STREAMS xpending3 >", "XPENDING xpending3 g IDLE 100000 - + 10"},
+			expected:       []interface{}{"1-1", "OK", []*wire.HElement{{Key: "xpending3 1-1 f", Value: "v"}}, []string{}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueSET, extractValueXRANGE, extractValueKEYS},
+		},
+		{
+			name:           "XPENDING on a non-existing group",
+			commands:       []string{"XPENDING xpending4 g"},
+			expected:       []interface{}{errors.New("NOGROUP no such key 'xpending4' or consumer group 'g'")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "XPENDING with invalid arguments",
+			commands:       []string{"XPENDING xpending5 g - +", "XPENDING xpending5 g IDLE x - + 10"},
+			expected:       []interface{}{errors.New("invalid syntax for 'XPENDING' command"), errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "XPENDING with wrong number of arguments",
+			commands:       []string{"XPENDING xpending6"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XPENDING' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
+
+func TestXPENDINGRange(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	for _, c := range []*wire.Command{
+		{Cmd: "XADD", Args: []string{"xpendingrange", "1-1", "f", "v"}},
+		{Cmd: "XADD", Args: []string{"xpendingrange", "2-1", "f", "v"}},
+		{Cmd: "XGROUP", Args: []string{"CREATE", "xpendingrange", "g", "0"}},
+		{Cmd: "XREADGROUP", Args: []string{"GROUP", "g", "alice", "COUNT", "1", "STREAMS", "xpendingrange", ">"}},
+		{Cmd: "XREADGROUP", Args: []string{"GROUP", "g", "bob", "STREAMS", "xpendingrange", ">"}},
+		{Cmd: "XREADGROUP", Args: []string{"GROUP", "g", "bob", "STREAMS", "xpendingrange", "0"}},
+	} {
+		res := client.Fire(c)
+		assert.Equal(t, wire.Status_OK, res.Status)
+	}
+
+	// Every entry is described by its ID, its consumer, its idle time and
+	// its number of deliveries. The idle time depends on timing, so it is
+	// only checked to be a number.
+	res := client.Fire(&wire.Command{Cmd: "XPENDING", Args: []string{"xpendingrange", "g", "-", "+", "10"}})
+	keys := res.GetKEYSRes().Keys
+	assert.Len(t, keys, 8)
+	assert.Equal(t, []string{"1-1", "alice"}, keys[0:2])
+	assert.Equal(t, "1", keys[3])
+	assert.Equal(t, []string{"2-1", "bob"}, keys[4:6])
+	assert.Equal(t, "2", keys[7])
+	for _, idle := range []string{keys[2], keys[6]} {
+		_, err := strconv.ParseInt(idle, 10, 64)
+		assert.NoError(t, err)
+	}
+
+	res = client.Fire(&wire.Command{Cmd: "XPENDING", Args: []string{"xpendingrange", "g", "-", "+", "10", "bob"}})
+	assert.Len(t, res.GetKEYSRes().Keys, 4)
+}
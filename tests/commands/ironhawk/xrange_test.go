@@ -0,0 +1,66 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueXRANGE(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestXRANGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XRANGE of the whole stream",
+			commands:       []string{"XADD xrange1 1-1 a 1 b 2", "XADD xrange1 2-1 c 3", "XRANGE xrange1 - +"},
+			expected:       []interface{}{"1-1", "2-1", []*wire.HElement{{Key: "1-1 a", Value: "1"}, {Key: "1-1 b", Value: "2"}, {Key: "2-1 c", Value: "3"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XRANGE with IDs without sequence numbers",
+			commands:       []string{"XADD xrange2 1-1 f v", "XADD xrange2 1-2 f v", "XADD xrange2 2-1 f v", "XRANGE xrange2 1 1"},
+			expected:       []interface{}{"1-1", "1-2", "2-1", []*wire.HElement{{Key: "1-1 f", Value: "v"}, {Key: "1-2 f", Value: "v"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XRANGE with exclusive bounds and COUNT",
+			commands:       []string{"XADD xrange3 1-1 f v", "XADD xrange3 2-1 f v", "XADD xrange3 3-1 f v", "XRANGE xrange3 (1-1 + COUNT 1"},
+			expected:       []interface{}{"1-1", "2-1", "3-1", []*wire.HElement{{Key: "2-1 f", Value: "v"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XRANGE of a non-existing key",
+			commands:       []string{"XRANGE xrange4 - +"},
+			expected:       []interface{}{[]*wire.HElement(nil)},
+			valueExtractor: []ValueExtractorFn{extractValueXRANGE},
+		},
+		{
+			name:           "XRANGE with invalid arguments",
+			commands:       []string{"XRANGE xrange5 x +", "XRANGE xrange5 - + LIMIT 1", "XRANGE xrange5 - + COUNT -1"},
+			expected:       []interface{}{errors.New("invalid stream ID specified as stream command argument"), errors.New("invalid syntax for 'XRANGE' command"), errors.New("value is not an integer or out of range")},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil},
+		},
+		{
+			name:           "XRANGE on wrong type",
+			commands:       []string{"SET xrange6 v", "XRANGE xrange6 - +"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "XRANGE with wrong number of arguments",
+			commands:       []string{"XRANGE xrange7 -"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XRANGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
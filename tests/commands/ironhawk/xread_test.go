@@ -0,0 +1,95 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXREAD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XREAD from several streams",
+			commands:       []string{"XADD xread{a} 1-1 f a1", "XADD xread{a} 2-1 f a2", "XADD xread{b} 1-1 f b1", "XREAD STREAMS xread{a} xread{b} 1-1 0"},
+			expected:       []interface{}{"1-1", "2-1", "1-1", []*wire.HElement{{Key: "xread{a} 2-1 f", Value: "a2"}, {Key: "xread{b} 1-1 f", Value: "b1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XREAD with COUNT",
+			commands:       []string{"XADD xread2 1-1 f 1", "XADD xread2 2-1 f 2", "XREAD COUNT 1 STREAMS xread2 0"},
+			expected:       []interface{}{"1-1", "2-1", []*wire.HElement{{Key: "xread2 1-1 f", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XREAD with $ and BLOCK times out",
+			commands:       []string{"XADD xread3 1-1 f v", "XREAD BLOCK 100 STREAMS xread3 $"},
+			expected:       []interface{}{"1-1", []*wire.HElement(nil)},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XREAD with invalid arguments",
+			commands:       []string{"XREAD STREAMS xread4", "XREAD COUNT x STREAMS xread4 0", "XREAD BLOCK -1 STREAMS xread4 0", "XREAD NOACK STREAMS xread4 0", "XREAD STREAMS xread4 x"},
+			expected:       []interface{}{errors.New("unbalanced 'XREAD' list of streams: for each stream key an ID must be specified"), errors.New("value is not an integer or out of range"), errors.New("timeout is not a float or out of range"), errors.New("invalid syntax for 'XREAD' command"), errors.New("invalid stream ID specified as stream command argument")},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil, nil, nil},
+		},
+		{
+			name:           "XREAD on wrong type",
+			commands:       []string{"SET xread5 v", "XREAD STREAMS xread5 0"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "XREAD with wrong number of arguments",
+			commands:       []string{"XREAD COUNT 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XREAD' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
+
+func TestXREADBlocksUntilXADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+	publisher := getLocalConnection()
+	defer publisher.Close()
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		publisher.Fire(&wire.Command{Cmd: "XADD", Args: []string{"xreadblock", "1-1", "f", "v"}})
+	}()
+
+	start := time.Now()
+	res := client.Fire(&wire.Command{Cmd: "XREAD", Args: []string{"BLOCK", "5000", "STREAMS", "xreadblock", "$"}})
+	assert.Equal(t, wire.Status_OK, res.Status)
+	assert.Equal(t, []*wire.HElement{{Key: "xreadblock 1-1 f", Value: "v"}}, res.GetHGETALLRes().Elements)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestXREADBlocksUntilRename(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+	publisher := getLocalConnection()
+	defer publisher.Close()
+
+	publisher.Fire(&wire.Command{Cmd: "XADD", Args: []string{"xreadrensrc", "1-1", "f", "v"}})
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		publisher.Fire(&wire.Command{Cmd: "RENAME", Args: []string{"xreadrensrc", "xreadren"}})
+	}()
+
+	start := time.Now()
+	res := client.Fire(&wire.Command{Cmd: "XREAD", Args: []string{"BLOCK", "5000", "STREAMS", "xreadren", "0"}})
+	assert.Equal(t, wire.Status_OK, res.Status)
+	assert.Equal(t, []*wire.HElement{{Key: "xreadren 1-1 f", Value: "v"}}, res.GetHGETALLRes().Elements)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
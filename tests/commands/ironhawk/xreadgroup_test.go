@@ -0,0 +1,105 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dicedb/dicedb-go/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXREADGROUP(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XREADGROUP delivers new entries once",
+			commands:       []string{"XADD xrg1 1-1 f 1", "XADD xrg1 2-1 f 2", "XGROUP CREATE xrg1 g 0", "XREADGROUP GROUP g alice COUNT 1 STREAMS xrg1 >", "XREADGROUP GROUP g bob STREAMS xrg1 >", "XREADGROUP GROUP g bob STREAMS xrg1 >"},
+			expected:       []interface{}{"1-1", "2-1", "OK", []*wire.HElement{{Key: "xrg1 1-1 f", Value: "1"}}, []*wire.HElement{{Key: "xrg1 2-1 f", Value: "2"}}, []*wire.HElement(nil)},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueSET, extractValueXRANGE, extractValueXRANGE, extractValueXRANGE},
+		},
+		{
+			name:           "XREADGROUP reads the pending entries of a consumer",
+			commands:       []string{"XADD xrg2 1-1 f 1", "XADD xrg2 2-1 f 2", "XGROUP CREATE xrg2 g 0", "XREADGROUP GROUP g alice STREAMS xrg2 >", "XACK xrg2 g 1-1", "XREADGROUP GROUP g alice STREAMS xrg2 0"},
+			expected:       []interface{}{"1-1", "2-1", "OK", []*wire.HElement{{Key: "xrg2 1-1 f", Value: "1"}, {Key: "xrg2 2-1 f", Value: "2"}}, 1, []*wire.HElement{{Key: "xrg2 2-1 f", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueSET, extractValueXRANGE, extractValueINCRBY, extractValueXRANGE},
+		},
+		{
+			name:           "XREADGROUP with NOACK",
+			commands:       []string{"XADD xrg3 1-1 f v", "XGROUP CREATE xrg3 g 0", "XREADGROUP GROUP g alice NOACK STREAMS xrg3 >", "XPENDING xrg3 g"},
+			expected:       []interface{}{"1-1", "OK", []*wire.HElement{{Key: "xrg3 1-1 f", Value: "v"}}, []string{"0", "", ""}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueSET, extractValueXRANGE, extractValueKEYS},
+		},
+		{
+			name:           "XREADGROUP returns trimmed pending entries without fields",
+			commands:       []string{"XADD xrg4 1-1 f v", "XGROUP CREATE xrg4 g 0", "XREADGROUP GROUP g alice STREAMS xrg4 >", "XADD xrg4 MAXLEN 0 2-1 f v", "XREADGROUP GROUP g alice STREAMS xrg4 0"},
+			expected:       []interface{}{"1-1", "OK", []*wire.HElement{{Key: "xrg4 1-1 f", Value: "v"}}, "2-1", []*wire.HElement{{Key: "xrg4 1-1", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueSET, extractValueXRANGE, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XREADGROUP on a non-existing group",
+			commands:       []string{"XADD xrg5 1-1 f v", "XREADGROUP GROUP g alice STREAMS xrg5 >"},
+			expected:       []interface{}{"1-1", errors.New("NOGROUP no such key 'xrg5' or consumer group 'g'")},
+			valueExtractor: []ValueExtractorFn{extractValueGET, nil},
+		},
+		{
+			name:           "XREADGROUP with invalid arguments",
+			commands:       []string{"XREADGROUP GRP g alice STREAMS xrg6 >", "XREADGROUP GROUP g alice STREAMS xrg6 x"},
+			expected:       []interface{}{errors.New("invalid syntax for 'XREADGROUP' command"), errors.New("invalid stream ID specified as stream command argument")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "XREADGROUP with wrong number of arguments",
+			commands:       []string{"XREADGROUP GROUP g alice STREAMS xrg7"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XREADGROUP' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
+
+func TestXREADGROUPBlocksUntilXADD(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+	publisher := getLocalConnection()
+	defer publisher.Close()
+
+	res := client.Fire(&wire.Command{Cmd: "XGROUP", Args: []string{"CREATE", "xrgblock", "g", "$", "MKSTREAM"}})
+	assert.Equal(t, wire.Status_OK, res.Status)
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		publisher.Fire(&wire.Command{Cmd: "XADD", Args: []string{"xrgblock", "1-1", "f", "v"}})
+	}()
+
+	start := time.Now()
+	res = client.Fire(&wire.Command{Cmd: "XREADGROUP", Args: []string{"GROUP", "g", "alice", "BLOCK", "5000", "STREAMS", "xrgblock", ">"}})
+	assert.Equal(t, wire.Status_OK, res.Status)
+	assert.Equal(t, []*wire.HElement{{Key: "xrgblock 1-1 f", Value: "v"}}, res.GetHGETALLRes().Elements)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestXREADGROUPStopsOnDisconnect(t *testing.T) {
+	client := getLocalConnection()
+	publisher := getLocalConnection()
+	defer publisher.Close()
+
+	res := publisher.Fire(&wire.Command{Cmd: "XGROUP", Args: []string{"CREATE", "xrggone", "g", "$", "MKSTREAM"}})
+	assert.Equal(t, wire.Status_OK, res.Status)
+
+	go client.Fire(&wire.Command{Cmd: "XREADGROUP", Args: []string{"GROUP", "g", "alice", "BLOCK", "0", "STREAMS", "xrggone", ">"}})
+	time.Sleep(200 * time.Millisecond)
+	client.Close()
+	time.Sleep(200 * time.Millisecond)
+
+	// The client that disconnected no longer waits, so the entry is not
+	// added to its pending list.
+	publisher.Fire(&wire.Command{Cmd: "XADD", Args: []string{"xrggone", "1-1", "f", "v"}})
+	res = publisher.Fire(&wire.Command{Cmd: "XPENDING", Args: []string{"xrggone", "g"}})
+	assert.Equal(t, []string{"0", "", ""}, res.GetKEYSRes().Keys)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestXREVRANGE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "XREVRANGE of the whole stream",
+			commands:       []string{"XADD xrevrange1 1-1 f 1", "XADD xrevrange1 2-1 f 2", "XREVRANGE xrevrange1 + -"},
+			expected:       []interface{}{"1-1", "2-1", []*wire.HElement{{Key: "2-1 f", Value: "2"}, {Key: "1-1 f", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XREVRANGE with COUNT",
+			commands:       []string{"XADD xrevrange2 1-1 f 1", "XADD xrevrange2 2-1 f 2", "XADD xrevrange2 3-1 f 3", "XREVRANGE xrevrange2 (3-1 - COUNT 1"},
+			expected:       []interface{}{"1-1", "2-1", "3-1", []*wire.HElement{{Key: "2-1 f", Value: "2"}}},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET, extractValueXRANGE},
+		},
+		{
+			name:           "XREVRANGE with wrong number of arguments",
+			commands:       []string{"XREVRANGE xrevrange3 +"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'XREVRANGE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestZINCRBY(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZINCRBY on new key and member",
+			commands:       []string{"ZINCRBY zincr1 1.5 a", "ZINCRBY zincr1 -0.25 a", "ZINCRBY zincr1 2 b"},
+			expected:       []interface{}{"1.5", "1.25", "2"},
+			valueExtractor: []ValueExtractorFn{extractValueGET, extractValueGET, extractValueGET},
+		},
+		{
+			name:           "ZINCRBY with invalid increment",
+			commands:       []string{"ZINCRBY zincr2 abc a"},
+			expected:       []interface{}{errors.New("value is not an integer or a float")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "ZINCRBY on wrong type",
+			commands:       []string{"SET zincr3 v", "ZINCRBY zincr3 1 a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "ZINCRBY with wrong number of arguments",
+			commands:       []string{"ZINCRBY zincr4 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZINCRBY' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
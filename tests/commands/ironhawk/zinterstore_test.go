@@ -0,0 +1,56 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestZINTERSTORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZINTERSTORE with default SUM",
+			commands:       []string{"ZADD zi1 1 a 2 b", "ZADD zi2 3 b 4 c", "ZINTERSTORE zidst 2 zi1 zi2", "ZRANGE zidst 1 -1"},
+			expected:       []interface{}{2, 2, 1, []*wire.ZElement{{Member: "b", Score: 5, Rank: 1}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZADD, extractValueINCRBY, extractValueZRANGE},
+		},
+		{
+			name:           "ZINTERSTORE with AGGREGATE MIN",
+			commands:       []string{"ZADD zim1 1 a 2 b", "ZADD zim2 3 a 1 b", "ZINTERSTORE zimdst 2 zim1 zim2 AGGREGATE MIN", "ZRANGE zimdst 1 -1"},
+			expected:       []interface{}{2, 2, 2, []*wire.ZElement{{Member: "a", Score: 1, Rank: 1}, {Member: "b", Score: 1, Rank: 2}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZADD, extractValueINCRBY, extractValueZRANGE},
+		},
+		{
+			name:           "ZINTERSTORE with missing key",
+			commands:       []string{"ZADD zie1 1 a", "ZINTERSTORE ziedst 2 zie1 ziemissing", "EXISTS ziedst"},
+			expected:       []interface{}{1, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueINCRBY, extractValueEXISTS},
+		},
+		{
+			name:           "ZINTERSTORE with invalid syntax",
+			commands:       []string{"ZINTERSTORE zidst 1 zi1 AGGREGATE AVG"},
+			expected:       []interface{}{errors.New("invalid syntax for 'ZINTERSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "ZINTERSTORE with numkeys near the integer limit",
+			commands:       []string{"ZINTERSTORE zidst 9223372036854775807 zi1"},
+			expected:       []interface{}{errors.New("invalid syntax for 'ZINTERSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "ZINTERSTORE with wrong number of arguments",
+			commands:       []string{"ZINTERSTORE zidst 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZINTERSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueZMSCORE(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
+func TestZMSCORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZMSCORE of existing and missing members",
+			commands:       []string{"ZADD zmscore1 1 a 2.5 b", "ZMSCORE zmscore1 b c a"},
+			expected:       []interface{}{2, []*wire.HElement{{Key: "b", Value: "2.5"}, {Key: "c", Value: ""}, {Key: "a", Value: "1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZMSCORE},
+		},
+		{
+			name:           "ZMSCORE on non-existing key",
+			commands:       []string{"ZMSCORE zmscore2 a"},
+			expected:       []interface{}{[]*wire.HElement{{Key: "a", Value: ""}}},
+			valueExtractor: []ValueExtractorFn{extractValueZMSCORE},
+		},
+		{
+			name:           "ZMSCORE on wrong type",
+			commands:       []string{"SET zmscore3 v", "ZMSCORE zmscore3 a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "ZMSCORE with wrong number of arguments",
+			commands:       []string{"ZMSCORE zmscore4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZMSCORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
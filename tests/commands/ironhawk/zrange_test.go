@@ -5,15 +5,22 @@ package ironhawk
 
 import (
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/dicedb/dicedb-go/wire"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 )
 
 func extractValueZRANGE(res *wire.Result) interface{} {
 	return res.GetZRANGERes().Elements
 }
 
+func extractValueZRANGEWithScores(res *wire.Result) interface{} {
+	return res.GetHGETALLRes().Elements
+}
+
 func TestZRANGE(t *testing.T) {
 	client := getLocalConnection()
 	defer client.Close()
@@ -55,6 +62,142 @@ func TestZRANGE(t *testing.T) {
 			},
 			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANGE},
 		},
+		{
+			name:           "Test ZRANGE with REV",
+			commands:       []string{"ZADD zrrev 1 a 2 b 3 c", "ZRANGE zrrev 1 2 REV"},
+			expected:       []interface{}{3, []*wire.ZElement{{Member: "c", Score: 3, Rank: 1}, {Member: "b", Score: 2, Rank: 2}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANGE},
+		},
+		{
+			name:           "Test ZRANGE BYSCORE with exclusive bound",
+			commands:       []string{"ZADD zrscore 1 a 2 b 3 c", "ZRANGE zrscore (1 3 BYSCORE"},
+			expected:       []interface{}{3, []*wire.ZElement{{Member: "b", Score: 2, Rank: 2}, {Member: "c", Score: 3, Rank: 3}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANGE},
+		},
+		{
+			name:           "Test ZRANGE BYSCORE REV with LIMIT",
+			commands:       []string{"ZADD zrlimit 1 a 2 b 3 c", "ZRANGE zrlimit +inf -inf BYSCORE REV LIMIT 1 1"},
+			expected:       []interface{}{3, []*wire.ZElement{{Member: "b", Score: 2, Rank: 2}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANGE},
+		},
+		{
+			name:           "Test ZRANGE BYLEX",
+			commands:       []string{"ZADD zrlex 0 a 0 b 0 c", "ZRANGE zrlex [b + BYLEX"},
+			expected:       []interface{}{3, []*wire.ZElement{{Member: "b", Score: 0, Rank: 2}, {Member: "c", Score: 0, Rank: 3}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANGE},
+		},
+		{
+			name:           "Test ZRANGE BYLEX with invalid bound",
+			commands:       []string{"ZADD zrlexbad 0 a", "ZRANGE zrlexbad a + BYLEX"},
+			expected:       []interface{}{1, errors.New("min or max not valid string range item")},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, nil},
+		},
+		{
+			name:           "Test ZRANGE LIMIT without BYSCORE or BYLEX",
+			commands:       []string{"ZRANGE zrnolimit 1 2 LIMIT 0 1"},
+			expected:       []interface{}{errors.New("syntax error, LIMIT is only supported in combination with either BYSCORE or BYLEX")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "Test ZRANGE WITHSCORES with fractional scores",
+			commands:       []string{"ZADD zrfrac 2.5 b 1.5 a 1.75 c", "ZRANGE zrfrac 1 3 WITHSCORES"},
+			expected:       []interface{}{3, []*wire.HElement{{Key: "a", Value: "1.5"}, {Key: "c", Value: "1.75"}, {Key: "b", Value: "2.5"}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANGEWithScores},
+		},
+		{
+			name:           "Test ZRANGE BYSCORE REV WITHSCORES",
+			commands:       []string{"ZADD zrfracrev 0.1 a 0.2 b 0.3 c", "ZRANGE zrfracrev 0.25 -inf BYSCORE REV WITHSCORES"},
+			expected:       []interface{}{3, []*wire.HElement{{Key: "b", Value: "0.2"}, {Key: "a", Value: "0.1"}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANGEWithScores},
+		},
+	}
+
+	runTestcases(t, client, testCases)
+}
+
+// exactScore returns the score m carries in its exact_score field, which
+// the wire messages do not declare, or its integer score if it is not set.
+func exactScore(m proto.Message, score int64) float64 {
+	b := m.ProtoReflect().GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			break
+		}
+		b = b[n:]
+		if num == 15 && typ == protowire.Fixed64Type {
+			v, _ := protowire.ConsumeFixed64(b)
+			return math.Float64frombits(v)
+		}
+		if n = protowire.ConsumeFieldValue(num, typ, b); n < 0 {
+			break
+		}
+		b = b[n:]
+	}
+	return float64(score)
+}
+
+func extractExactScoresZRANGE(res *wire.Result) interface{} {
+	scores := []float64{}
+	for _, e := range res.GetZRANGERes().Elements {
+		scores = append(scores, exactScore(e, e.Score))
+	}
+	return scores
+}
+
+func extractExactScoresZPOPMIN(res *wire.Result) interface{} {
+	scores := []float64{}
+	for _, e := range res.GetZPOPMINRes().Elements {
+		scores = append(scores, exactScore(e, e.Score))
+	}
+	return scores
+}
+
+func extractExactScoreZRANK(res *wire.Result) interface{} {
+	e := res.GetZRANKRes().Element
+	return exactScore(e, e.Score)
+}
+
+func extractExactScoreZADD(res *wire.Result) interface{} {
+	r := res.GetZADDRes()
+	return exactScore(r, r.Count)
+}
+
+func TestExactScores(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZRANGE carries the exact fractional scores",
+			commands:       []string{"ZADD zes1 1.5 a 2 b -2.25 c", "ZRANGE zes1 1 3"},
+			expected:       []interface{}{3, []float64{-2.25, 1.5, 2}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractExactScoresZRANGE},
+		},
+		{
+			name:           "ZRANGE carries the exact infinite scores",
+			commands:       []string{"ZADD zes2 +inf a -inf b", "ZRANGE zes2 1 2"},
+			expected:       []interface{}{2, []float64{math.Inf(-1), math.Inf(1)}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractExactScoresZRANGE},
+		},
+		{
+			name:           "ZRANK and ZREVRANK carry the exact score",
+			commands:       []string{"ZADD zes3 0.5 a 1 b", "ZRANK zes3 a", "ZADD zes6 0.5 a 1 b", "ZREVRANK zes6 a"},
+			expected:       []interface{}{2, 0.5, 2, 0.5},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractExactScoreZRANK, extractValueZADD, extractExactScoreZRANK},
+		},
+		{
+			name:           "ZPOPMIN carries the exact score",
+			commands:       []string{"ZADD zes4 0.75 a 1 b", "ZPOPMIN zes4 2"},
+			expected:       []interface{}{2, []float64{0.75, 1}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractExactScoresZPOPMIN},
+		},
+		{
+			name:           "ZADD with INCR carries the exact score",
+			commands:       []string{"ZADD zes5 INCR 1.25 a", "ZADD zes5 INCR 1 a"},
+			expected:       []interface{}{1.25, 2.25},
+			valueExtractor: []ValueExtractorFn{extractExactScoreZADD, extractExactScoreZADD},
+		},
 	}
 
 	runTestcases(t, client, testCases)
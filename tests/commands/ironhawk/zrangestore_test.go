@@ -0,0 +1,56 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestZRANGESTORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZRANGESTORE by rank",
+			commands:       []string{"ZADD zrssrc 1 a 2 b 3 c", "ZRANGESTORE zrsdst zrssrc 2 3", "ZRANGE zrsdst 1 -1"},
+			expected:       []interface{}{3, 2, []*wire.ZElement{{Member: "b", Score: 2, Rank: 1}, {Member: "c", Score: 3, Rank: 2}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueINCRBY, extractValueZRANGE},
+		},
+		{
+			name:           "ZRANGESTORE by score with REV and LIMIT",
+			commands:       []string{"ZADD zrssrc2 1 a 2 b 3 c", "ZRANGESTORE zrsdst2 zrssrc2 3 1 BYSCORE REV LIMIT 0 2", "ZRANGE zrsdst2 1 -1"},
+			expected:       []interface{}{3, 2, []*wire.ZElement{{Member: "b", Score: 2, Rank: 1}, {Member: "c", Score: 3, Rank: 2}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueINCRBY, extractValueZRANGE},
+		},
+		{
+			name:           "ZRANGESTORE with empty result deletes destination",
+			commands:       []string{"ZADD zrsdst3 1 x", "ZRANGESTORE zrsdst3 zrsmissing 1 -1", "EXISTS zrsdst3"},
+			expected:       []interface{}{1, 0, 0},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueINCRBY, extractValueEXISTS},
+		},
+		{
+			name:           "ZRANGESTORE on wrong type",
+			commands:       []string{"SET zrssrc4 v", "ZRANGESTORE zrsdst4 zrssrc4 1 -1"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "ZRANGESTORE does not accept WITHSCORES",
+			commands:       []string{"ZRANGESTORE zrsdst6 zrssrc6 1 -1 WITHSCORES"},
+			expected:       []interface{}{errors.New("invalid syntax for 'ZRANGESTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "ZRANGESTORE with wrong number of arguments",
+			commands:       []string{"ZRANGESTORE zrsdst5 zrssrc5 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZRANGESTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
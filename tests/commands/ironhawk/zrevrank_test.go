@@ -0,0 +1,42 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestZREVRANK(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZREVRANK of existing member",
+			commands:       []string{"ZADD zrevrank1 20 bob 10 alice 30 charlie", "ZREVRANK zrevrank1 charlie", "ZREVRANK zrevrank1 alice"},
+			expected:       []interface{}{3, 1, 3},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANK, extractValueZRANK},
+		},
+		{
+			name:           "ZREVRANK of missing member",
+			commands:       []string{"ZADD zrevrank2 1 a", "ZREVRANK zrevrank2 b"},
+			expected:       []interface{}{1, 0},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZRANK},
+		},
+		{
+			name:           "ZREVRANK on non-existing key",
+			commands:       []string{"ZREVRANK zrevrank3 a"},
+			expected:       []interface{}{0},
+			valueExtractor: []ValueExtractorFn{extractValueZRANK},
+		},
+		{
+			name:           "ZREVRANK with wrong number of arguments",
+			commands:       []string{"ZREVRANK zrevrank4"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZREVRANK' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func extractValueZSCAN(res *wire.Result) interface{} {
+	return res.GetKEYSRes().Keys
+}
+
+func TestZSCAN(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZSCAN iterates with COUNT",
+			commands:       []string{"ZADD zsc1 1 m1 2 m2 3 m3", "ZSCAN zsc1 0 COUNT 2", "ZSCAN zsc1 241344391202643 COUNT 2"},
+			expected:       []interface{}{3, []string{"241344391202643", "m1", "1", "m3", "3"}, []string{"0", "m2", "2"}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZSCAN, extractValueZSCAN},
+		},
+		{
+			name:           "ZSCAN keeps its place when the sorted set is modified",
+			commands:       []string{"ZADD zsc2 1 m1 2 m2 3 m3", "ZSCAN zsc2 0 COUNT 2", "ZREM zsc2 m1", "ZADD zsc2 0 m0", "ZSCAN zsc2 241344391202643 COUNT 2"},
+			expected:       []interface{}{3, []string{"241344391202643", "m1", "1", "m3", "3"}, 1, 1, []string{"0", "m2", "2"}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZSCAN, extractValueZREM, extractValueZADD, extractValueZSCAN},
+		},
+		{
+			name:           "ZSCAN with MATCH",
+			commands:       []string{"ZADD zsc3 1 a1 2 b1 3 a2", "ZSCAN zsc3 0 MATCH a*"},
+			expected:       []interface{}{3, []string{"0", "a1", "1", "a2", "3"}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZSCAN},
+		},
+		{
+			name:           "ZSCAN on non-existent key",
+			commands:       []string{"ZSCAN zsc4 0"},
+			expected:       []interface{}{[]string{"0"}},
+			valueExtractor: []ValueExtractorFn{extractValueZSCAN},
+		},
+		{
+			name:     "ZSCAN with invalid options",
+			commands: []string{"ZSCAN zsc5 abc", "ZSCAN zsc5 0 COUNT 0", "ZSCAN zsc5 0 TYPE string", "ZSCAN zsc5 99999999999999999"},
+			expected: []interface{}{
+				errors.New("value is not an integer or out of range"),
+				errors.New("value is not an integer or out of range"),
+				errors.New("invalid syntax for 'ZSCAN' command"),
+				errors.New("invalid cursor"),
+			},
+			valueExtractor: []ValueExtractorFn{nil, nil, nil, nil},
+		},
+		{
+			name:           "ZSCAN on non-sorted-set key",
+			commands:       []string{"SET zsc6 v", "ZSCAN zsc6 0"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "ZSCAN with wrong number of arguments",
+			commands:       []string{"ZSCAN zsc7"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZSCAN' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestZSCORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZSCORE of existing member",
+			commands:       []string{"ZADD zscore1 1.5 a 2 b", "ZSCORE zscore1 a"},
+			expected:       []interface{}{2, "1.5"},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueGET},
+		},
+		{
+			name:           "ZSCORE of missing member",
+			commands:       []string{"ZADD zscore2 1 a", "ZSCORE zscore2 b"},
+			expected:       []interface{}{1, ""},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueGET},
+		},
+		{
+			name:           "ZSCORE on non-existing key",
+			commands:       []string{"ZSCORE zscore3 a"},
+			expected:       []interface{}{""},
+			valueExtractor: []ValueExtractorFn{extractValueGET},
+		},
+		{
+			name:           "ZSCORE on wrong type",
+			commands:       []string{"SET zscore4 v", "ZSCORE zscore4 a"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "ZSCORE with wrong number of arguments",
+			commands:       []string{"ZSCORE zscore5", "ZSCORE zscore5 a b"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZSCORE' command"), errors.New("wrong number of arguments for 'ZSCORE' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}
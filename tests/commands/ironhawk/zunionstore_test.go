@@ -0,0 +1,68 @@
+// Copyright (c) 2022-present, DiceDB contributors
+// All rights reserved. Licensed under the BSD 3-Clause License. See LICENSE file in the project root for full license information.
+
+package ironhawk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dicedb/dicedb-go/wire"
+)
+
+func TestZUNIONSTORE(t *testing.T) {
+	client := getLocalConnection()
+	defer client.Close()
+
+	testCases := []TestCase{
+		{
+			name:           "ZUNIONSTORE with default SUM",
+			commands:       []string{"ZADD zu{a} 1 a 2 b", "ZADD zu{b} 3 b 4 c", "ZUNIONSTORE zudst 2 zu{a} zu{b}", "ZRANGE zudst 1 -1"},
+			expected:       []interface{}{2, 2, 3, []*wire.ZElement{{Member: "a", Score: 1, Rank: 1}, {Member: "c", Score: 4, Rank: 2}, {Member: "b", Score: 5, Rank: 3}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZADD, extractValueINCRBY, extractValueZRANGE},
+		},
+		{
+			name:           "ZUNIONSTORE with WEIGHTS and AGGREGATE MAX",
+			commands:       []string{"ZADD zuw1 1 a 2 b", "ZADD zuw2 3 b 4 c", "ZUNIONSTORE zuwdst 2 zuw1 zuw2 WEIGHTS 10 1 AGGREGATE MAX", "ZRANGE zuwdst 1 -1"},
+			expected:       []interface{}{2, 2, 3, []*wire.ZElement{{Member: "c", Score: 4, Rank: 1}, {Member: "a", Score: 10, Rank: 2}, {Member: "b", Score: 20, Rank: 3}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueZADD, extractValueINCRBY, extractValueZRANGE},
+		},
+		{
+			name:           "ZUNIONSTORE with a plain set",
+			commands:       []string{"ZADD zus1 5 a", "SADD zus2 a b", "ZUNIONSTORE zusdst 2 zus1 zus2", "ZRANGE zusdst 1 -1"},
+			expected:       []interface{}{1, 2, 2, []*wire.ZElement{{Member: "b", Score: 1, Rank: 1}, {Member: "a", Score: 6, Rank: 2}}},
+			valueExtractor: []ValueExtractorFn{extractValueZADD, extractValueSADD, extractValueINCRBY, extractValueZRANGE},
+		},
+		{
+			name:           "ZUNIONSTORE with invalid numkeys",
+			commands:       []string{"ZUNIONSTORE zudst 0 zu1", "ZUNIONSTORE zudst 3 zu1 zu2"},
+			expected:       []interface{}{errors.New("at least 1 input key is needed for 'ZUNIONSTORE' command"), errors.New("invalid syntax for 'ZUNIONSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil, nil},
+		},
+		{
+			name:           "ZUNIONSTORE with numkeys near the integer limit",
+			commands:       []string{"ZUNIONSTORE zudst 9223372036854775807 zu1"},
+			expected:       []interface{}{errors.New("invalid syntax for 'ZUNIONSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "ZUNIONSTORE with invalid weight",
+			commands:       []string{"ZUNIONSTORE zudst 1 zu1 WEIGHTS x"},
+			expected:       []interface{}{errors.New("weight value is not a float")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+		{
+			name:           "ZUNIONSTORE on wrong type",
+			commands:       []string{"SET zuwt v", "ZUNIONSTORE zudst 1 zuwt"},
+			expected:       []interface{}{"OK", errors.New("wrongtype operation against a key holding the wrong kind of value")},
+			valueExtractor: []ValueExtractorFn{extractValueSET, nil},
+		},
+		{
+			name:           "ZUNIONSTORE with wrong number of arguments",
+			commands:       []string{"ZUNIONSTORE zudst 1"},
+			expected:       []interface{}{errors.New("wrong number of arguments for 'ZUNIONSTORE' command")},
+			valueExtractor: []ValueExtractorFn{nil},
+		},
+	}
+	runTestcases(t, client, testCases)
+}